@@ -0,0 +1,683 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: agents.proto
+
+package api
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import duration "github.com/golang/protobuf/ptypes/duration"
+import timestamp "github.com/golang/protobuf/ptypes/timestamp"
+import _ "google.golang.org/genproto/googleapis/api/annotations"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+// AgentsHealth represents aggregated health of instance's Agents.
+type AgentsHealth int32
+
+const (
+	// There are no Agents, or their status can't be determined.
+	AgentsHealth_AGENTS_HEALTH_UNKNOWN AgentsHealth = 0
+	// All Agents are down.
+	AgentsHealth_AGENTS_HEALTH_DOWN AgentsHealth = 1
+	// Some Agents are down.
+	AgentsHealth_AGENTS_HEALTH_DEGRADED AgentsHealth = 2
+	// All Agents are up.
+	AgentsHealth_AGENTS_HEALTH_UP AgentsHealth = 3
+)
+
+var AgentsHealth_name = map[int32]string{
+	0: "AGENTS_HEALTH_UNKNOWN",
+	1: "AGENTS_HEALTH_DOWN",
+	2: "AGENTS_HEALTH_DEGRADED",
+	3: "AGENTS_HEALTH_UP",
+}
+var AgentsHealth_value = map[string]int32{
+	"AGENTS_HEALTH_UNKNOWN":  0,
+	"AGENTS_HEALTH_DOWN":     1,
+	"AGENTS_HEALTH_DEGRADED": 2,
+	"AGENTS_HEALTH_UP":       3,
+}
+
+func (x AgentsHealth) String() string {
+	return proto.EnumName(AgentsHealth_name, int32(x))
+}
+func (AgentsHealth) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_agents_104ef4958cee16bd, []int{0}
+}
+
+// Supervisor process state: unknown, running, stopped, or failed (exited and waiting for restart).
+type AgentStatus_State int32
+
+const (
+	AgentStatus_UNKNOWN AgentStatus_State = 0
+	AgentStatus_RUNNING AgentStatus_State = 1
+	AgentStatus_STOPPED AgentStatus_State = 2
+	AgentStatus_FAILED  AgentStatus_State = 3
+)
+
+var AgentStatus_State_name = map[int32]string{
+	0: "UNKNOWN",
+	1: "RUNNING",
+	2: "STOPPED",
+	3: "FAILED",
+}
+var AgentStatus_State_value = map[string]int32{
+	"UNKNOWN": 0,
+	"RUNNING": 1,
+	"STOPPED": 2,
+	"FAILED":  3,
+}
+
+func (x AgentStatus_State) String() string {
+	return proto.EnumName(AgentStatus_State_name, int32(x))
+}
+func (AgentStatus_State) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_agents_104ef4958cee16bd, []int{0, 0}
+}
+
+type PortConflict_Kind int32
+
+const (
+	PortConflict_UNKNOWN PortConflict_Kind = 0
+	// Port is used by Agent, but not allocated.
+	PortConflict_UNALLOCATED PortConflict_Kind = 1
+	// Port is allocated, but not used by any Agent.
+	PortConflict_UNUSED PortConflict_Kind = 2
+	// Port is used by several Agents.
+	PortConflict_SHARED PortConflict_Kind = 3
+	// Allocated port is outside of configured range.
+	PortConflict_OUT_OF_RANGE PortConflict_Kind = 4
+)
+
+var PortConflict_Kind_name = map[int32]string{
+	0: "UNKNOWN",
+	1: "UNALLOCATED",
+	2: "UNUSED",
+	3: "SHARED",
+	4: "OUT_OF_RANGE",
+}
+var PortConflict_Kind_value = map[string]int32{
+	"UNKNOWN":      0,
+	"UNALLOCATED":  1,
+	"UNUSED":       2,
+	"SHARED":       3,
+	"OUT_OF_RANGE": 4,
+}
+
+func (x PortConflict_Kind) String() string {
+	return proto.EnumName(PortConflict_Kind_name, int32(x))
+}
+func (PortConflict_Kind) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_agents_104ef4958cee16bd, []int{4, 0}
+}
+
+// AgentStatus represents Agent runtime status.
+type AgentStatus struct {
+	Id         int32             `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type       string            `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	ListenPort uint32            `protobuf:"varint,3,opt,name=listen_port,json=listenPort,proto3" json:"listen_port,omitempty"`
+	State      AgentStatus_State `protobuf:"varint,4,opt,name=state,proto3,enum=api.AgentStatus_State" json:"state,omitempty"`
+	// Process ID, if known
+	Pid uint32 `protobuf:"varint,5,opt,name=pid,proto3" json:"pid,omitempty"`
+	// Process uptime, if known
+	Uptime *duration.Duration `protobuf:"bytes,6,opt,name=uptime,proto3" json:"uptime,omitempty"`
+	// Number of process restarts, if known
+	Restarts uint32 `protobuf:"varint,7,opt,name=restarts,proto3" json:"restarts,omitempty"`
+	// Last process exit error, if known
+	LastError string `protobuf:"bytes,8,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	// True if Agent answers on /metrics endpoint
+	MetricsUp            bool     `protobuf:"varint,9,opt,name=metrics_up,json=metricsUp,proto3" json:"metrics_up,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AgentStatus) Reset()         { *m = AgentStatus{} }
+func (m *AgentStatus) String() string { return proto.CompactTextString(m) }
+func (*AgentStatus) ProtoMessage()    {}
+func (*AgentStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_agents_104ef4958cee16bd, []int{0}
+}
+func (m *AgentStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AgentStatus.Unmarshal(m, b)
+}
+func (m *AgentStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AgentStatus.Marshal(b, m, deterministic)
+}
+func (dst *AgentStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AgentStatus.Merge(dst, src)
+}
+func (m *AgentStatus) XXX_Size() int {
+	return xxx_messageInfo_AgentStatus.Size(m)
+}
+func (m *AgentStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_AgentStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AgentStatus proto.InternalMessageInfo
+
+func (m *AgentStatus) GetId() int32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *AgentStatus) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *AgentStatus) GetListenPort() uint32 {
+	if m != nil {
+		return m.ListenPort
+	}
+	return 0
+}
+
+func (m *AgentStatus) GetState() AgentStatus_State {
+	if m != nil {
+		return m.State
+	}
+	return AgentStatus_UNKNOWN
+}
+
+func (m *AgentStatus) GetPid() uint32 {
+	if m != nil {
+		return m.Pid
+	}
+	return 0
+}
+
+func (m *AgentStatus) GetUptime() *duration.Duration {
+	if m != nil {
+		return m.Uptime
+	}
+	return nil
+}
+
+func (m *AgentStatus) GetRestarts() uint32 {
+	if m != nil {
+		return m.Restarts
+	}
+	return 0
+}
+
+func (m *AgentStatus) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
+func (m *AgentStatus) GetMetricsUp() bool {
+	if m != nil {
+		return m.MetricsUp
+	}
+	return false
+}
+
+type AgentsStatusRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AgentsStatusRequest) Reset()         { *m = AgentsStatusRequest{} }
+func (m *AgentsStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*AgentsStatusRequest) ProtoMessage()    {}
+func (*AgentsStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_agents_104ef4958cee16bd, []int{1}
+}
+func (m *AgentsStatusRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AgentsStatusRequest.Unmarshal(m, b)
+}
+func (m *AgentsStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AgentsStatusRequest.Marshal(b, m, deterministic)
+}
+func (dst *AgentsStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AgentsStatusRequest.Merge(dst, src)
+}
+func (m *AgentsStatusRequest) XXX_Size() int {
+	return xxx_messageInfo_AgentsStatusRequest.Size(m)
+}
+func (m *AgentsStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AgentsStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AgentsStatusRequest proto.InternalMessageInfo
+
+type AgentsStatusResponse struct {
+	Agents               []*AgentStatus `protobuf:"bytes,1,rep,name=agents,proto3" json:"agents,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *AgentsStatusResponse) Reset()         { *m = AgentsStatusResponse{} }
+func (m *AgentsStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*AgentsStatusResponse) ProtoMessage()    {}
+func (*AgentsStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_agents_104ef4958cee16bd, []int{2}
+}
+func (m *AgentsStatusResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AgentsStatusResponse.Unmarshal(m, b)
+}
+func (m *AgentsStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AgentsStatusResponse.Marshal(b, m, deterministic)
+}
+func (dst *AgentsStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AgentsStatusResponse.Merge(dst, src)
+}
+func (m *AgentsStatusResponse) XXX_Size() int {
+	return xxx_messageInfo_AgentsStatusResponse.Size(m)
+}
+func (m *AgentsStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AgentsStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AgentsStatusResponse proto.InternalMessageInfo
+
+func (m *AgentsStatusResponse) GetAgents() []*AgentStatus {
+	if m != nil {
+		return m.Agents
+	}
+	return nil
+}
+
+// PortAllocation represents allocated Agent listen port.
+type PortAllocation struct {
+	Port      uint32               `protobuf:"varint,1,opt,name=port,proto3" json:"port,omitempty"`
+	CreatedAt *timestamp.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// IDs of Agents using that port.
+	AgentIds             []int32  `protobuf:"varint,3,rep,packed,name=agent_ids,json=agentIds,proto3" json:"agent_ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PortAllocation) Reset()         { *m = PortAllocation{} }
+func (m *PortAllocation) String() string { return proto.CompactTextString(m) }
+func (*PortAllocation) ProtoMessage()    {}
+func (*PortAllocation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_agents_104ef4958cee16bd, []int{3}
+}
+func (m *PortAllocation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PortAllocation.Unmarshal(m, b)
+}
+func (m *PortAllocation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PortAllocation.Marshal(b, m, deterministic)
+}
+func (dst *PortAllocation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PortAllocation.Merge(dst, src)
+}
+func (m *PortAllocation) XXX_Size() int {
+	return xxx_messageInfo_PortAllocation.Size(m)
+}
+func (m *PortAllocation) XXX_DiscardUnknown() {
+	xxx_messageInfo_PortAllocation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PortAllocation proto.InternalMessageInfo
+
+func (m *PortAllocation) GetPort() uint32 {
+	if m != nil {
+		return m.Port
+	}
+	return 0
+}
+
+func (m *PortAllocation) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+func (m *PortAllocation) GetAgentIds() []int32 {
+	if m != nil {
+		return m.AgentIds
+	}
+	return nil
+}
+
+// PortConflict represents conflict between port allocation and Agents.
+type PortConflict struct {
+	Port uint32            `protobuf:"varint,1,opt,name=port,proto3" json:"port,omitempty"`
+	Kind PortConflict_Kind `protobuf:"varint,2,opt,name=kind,proto3,enum=api.PortConflict_Kind" json:"kind,omitempty"`
+	// IDs of Agents using that port.
+	AgentIds             []int32  `protobuf:"varint,3,rep,packed,name=agent_ids,json=agentIds,proto3" json:"agent_ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PortConflict) Reset()         { *m = PortConflict{} }
+func (m *PortConflict) String() string { return proto.CompactTextString(m) }
+func (*PortConflict) ProtoMessage()    {}
+func (*PortConflict) Descriptor() ([]byte, []int) {
+	return fileDescriptor_agents_104ef4958cee16bd, []int{4}
+}
+func (m *PortConflict) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PortConflict.Unmarshal(m, b)
+}
+func (m *PortConflict) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PortConflict.Marshal(b, m, deterministic)
+}
+func (dst *PortConflict) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PortConflict.Merge(dst, src)
+}
+func (m *PortConflict) XXX_Size() int {
+	return xxx_messageInfo_PortConflict.Size(m)
+}
+func (m *PortConflict) XXX_DiscardUnknown() {
+	xxx_messageInfo_PortConflict.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PortConflict proto.InternalMessageInfo
+
+func (m *PortConflict) GetPort() uint32 {
+	if m != nil {
+		return m.Port
+	}
+	return 0
+}
+
+func (m *PortConflict) GetKind() PortConflict_Kind {
+	if m != nil {
+		return m.Kind
+	}
+	return PortConflict_UNKNOWN
+}
+
+func (m *PortConflict) GetAgentIds() []int32 {
+	if m != nil {
+		return m.AgentIds
+	}
+	return nil
+}
+
+type AgentsListPortsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AgentsListPortsRequest) Reset()         { *m = AgentsListPortsRequest{} }
+func (m *AgentsListPortsRequest) String() string { return proto.CompactTextString(m) }
+func (*AgentsListPortsRequest) ProtoMessage()    {}
+func (*AgentsListPortsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_agents_104ef4958cee16bd, []int{5}
+}
+func (m *AgentsListPortsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AgentsListPortsRequest.Unmarshal(m, b)
+}
+func (m *AgentsListPortsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AgentsListPortsRequest.Marshal(b, m, deterministic)
+}
+func (dst *AgentsListPortsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AgentsListPortsRequest.Merge(dst, src)
+}
+func (m *AgentsListPortsRequest) XXX_Size() int {
+	return xxx_messageInfo_AgentsListPortsRequest.Size(m)
+}
+func (m *AgentsListPortsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AgentsListPortsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AgentsListPortsRequest proto.InternalMessageInfo
+
+type AgentsListPortsResponse struct {
+	// Configured ports range (inclusive).
+	MinPort              uint32            `protobuf:"varint,1,opt,name=min_port,json=minPort,proto3" json:"min_port,omitempty"`
+	MaxPort              uint32            `protobuf:"varint,2,opt,name=max_port,json=maxPort,proto3" json:"max_port,omitempty"`
+	Allocations          []*PortAllocation `protobuf:"bytes,3,rep,name=allocations,proto3" json:"allocations,omitempty"`
+	Conflicts            []*PortConflict   `protobuf:"bytes,4,rep,name=conflicts,proto3" json:"conflicts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *AgentsListPortsResponse) Reset()         { *m = AgentsListPortsResponse{} }
+func (m *AgentsListPortsResponse) String() string { return proto.CompactTextString(m) }
+func (*AgentsListPortsResponse) ProtoMessage()    {}
+func (*AgentsListPortsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_agents_104ef4958cee16bd, []int{6}
+}
+func (m *AgentsListPortsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AgentsListPortsResponse.Unmarshal(m, b)
+}
+func (m *AgentsListPortsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AgentsListPortsResponse.Marshal(b, m, deterministic)
+}
+func (dst *AgentsListPortsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AgentsListPortsResponse.Merge(dst, src)
+}
+func (m *AgentsListPortsResponse) XXX_Size() int {
+	return xxx_messageInfo_AgentsListPortsResponse.Size(m)
+}
+func (m *AgentsListPortsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AgentsListPortsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AgentsListPortsResponse proto.InternalMessageInfo
+
+func (m *AgentsListPortsResponse) GetMinPort() uint32 {
+	if m != nil {
+		return m.MinPort
+	}
+	return 0
+}
+
+func (m *AgentsListPortsResponse) GetMaxPort() uint32 {
+	if m != nil {
+		return m.MaxPort
+	}
+	return 0
+}
+
+func (m *AgentsListPortsResponse) GetAllocations() []*PortAllocation {
+	if m != nil {
+		return m.Allocations
+	}
+	return nil
+}
+
+func (m *AgentsListPortsResponse) GetConflicts() []*PortConflict {
+	if m != nil {
+		return m.Conflicts
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*AgentStatus)(nil), "api.AgentStatus")
+	proto.RegisterType((*AgentsStatusRequest)(nil), "api.AgentsStatusRequest")
+	proto.RegisterType((*AgentsStatusResponse)(nil), "api.AgentsStatusResponse")
+	proto.RegisterType((*PortAllocation)(nil), "api.PortAllocation")
+	proto.RegisterType((*PortConflict)(nil), "api.PortConflict")
+	proto.RegisterType((*AgentsListPortsRequest)(nil), "api.AgentsListPortsRequest")
+	proto.RegisterType((*AgentsListPortsResponse)(nil), "api.AgentsListPortsResponse")
+	proto.RegisterEnum("api.AgentsHealth", AgentsHealth_name, AgentsHealth_value)
+	proto.RegisterEnum("api.AgentStatus_State", AgentStatus_State_name, AgentStatus_State_value)
+	proto.RegisterEnum("api.PortConflict_Kind", PortConflict_Kind_name, PortConflict_Kind_value)
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// AgentsClient is the client API for Agents service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type AgentsClient interface {
+	// Status returns runtime status of all Agents.
+	Status(ctx context.Context, in *AgentsStatusRequest, opts ...grpc.CallOption) (*AgentsStatusResponse, error)
+	// ListPorts returns Agent listen ports allocations and conflicts between them and Agents.
+	ListPorts(ctx context.Context, in *AgentsListPortsRequest, opts ...grpc.CallOption) (*AgentsListPortsResponse, error)
+}
+
+type agentsClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewAgentsClient(cc *grpc.ClientConn) AgentsClient {
+	return &agentsClient{cc}
+}
+
+func (c *agentsClient) Status(ctx context.Context, in *AgentsStatusRequest, opts ...grpc.CallOption) (*AgentsStatusResponse, error) {
+	out := new(AgentsStatusResponse)
+	err := c.cc.Invoke(ctx, "/api.Agents/Status", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentsClient) ListPorts(ctx context.Context, in *AgentsListPortsRequest, opts ...grpc.CallOption) (*AgentsListPortsResponse, error) {
+	out := new(AgentsListPortsResponse)
+	err := c.cc.Invoke(ctx, "/api.Agents/ListPorts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AgentsServer is the server API for Agents service.
+type AgentsServer interface {
+	// Status returns runtime status of all Agents.
+	Status(context.Context, *AgentsStatusRequest) (*AgentsStatusResponse, error)
+	// ListPorts returns Agent listen ports allocations and conflicts between them and Agents.
+	ListPorts(context.Context, *AgentsListPortsRequest) (*AgentsListPortsResponse, error)
+}
+
+func RegisterAgentsServer(s *grpc.Server, srv AgentsServer) {
+	s.RegisterService(&_Agents_serviceDesc, srv)
+}
+
+func _Agents_Status_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AgentsStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentsServer).Status(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Agents/Status",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentsServer).Status(ctx, req.(*AgentsStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Agents_ListPorts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AgentsListPortsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentsServer).ListPorts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Agents/ListPorts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentsServer).ListPorts(ctx, req.(*AgentsListPortsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Agents_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "api.Agents",
+	HandlerType: (*AgentsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Status",
+			Handler:    _Agents_Status_Handler,
+		},
+		{
+			MethodName: "ListPorts",
+			Handler:    _Agents_ListPorts_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "agents.proto",
+}
+
+func init() { proto.RegisterFile("agents.proto", fileDescriptor_agents_104ef4958cee16bd) }
+
+var fileDescriptor_agents_104ef4958cee16bd = []byte{
+	// 744 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0x4d, 0x8f, 0xe3, 0x44,
+	0x10, 0xc5, 0x76, 0x92, 0x89, 0xcb, 0x61, 0xf0, 0xf6, 0xec, 0x86, 0x4e, 0x76, 0x61, 0x2d, 0x9f,
+	0xac, 0x15, 0x72, 0x20, 0x88, 0x03, 0x9c, 0xb0, 0x36, 0xde, 0x64, 0xb4, 0x91, 0x13, 0x75, 0x12,
+	0xc1, 0xcd, 0xf4, 0xc6, 0x9e, 0xa1, 0x45, 0x62, 0x1b, 0x77, 0x07, 0x0d, 0x12, 0x27, 0xfe, 0x02,
+	0x3f, 0x86, 0x03, 0x57, 0xfe, 0x01, 0x47, 0xae, 0xfc, 0x10, 0xd4, 0x6d, 0x4f, 0xbe, 0x66, 0xb4,
+	0xa7, 0xe9, 0xae, 0x57, 0xae, 0xd7, 0xef, 0xbd, 0x9a, 0x40, 0x87, 0xde, 0xa6, 0x99, 0xe0, 0x7e,
+	0x51, 0xe6, 0x22, 0x47, 0x06, 0x2d, 0x58, 0xff, 0xc5, 0x6d, 0x9e, 0xdf, 0x6e, 0xd2, 0x01, 0x2d,
+	0xd8, 0x80, 0x66, 0x59, 0x2e, 0xa8, 0x60, 0x79, 0x56, 0xb7, 0xf4, 0x3f, 0xad, 0x51, 0x75, 0x7b,
+	0xb7, 0xbb, 0x19, 0x24, 0xbb, 0x52, 0x35, 0xd4, 0xf8, 0xcb, 0x73, 0x5c, 0xb0, 0x6d, 0xca, 0x05,
+	0xdd, 0x16, 0x55, 0x83, 0xfb, 0xaf, 0x0e, 0x56, 0x20, 0x49, 0x17, 0x82, 0x8a, 0x1d, 0x47, 0x97,
+	0xa0, 0xb3, 0x04, 0x6b, 0x8e, 0xe6, 0x35, 0x89, 0xce, 0x12, 0x84, 0xa0, 0x21, 0x7e, 0x2d, 0x52,
+	0xac, 0x3b, 0x9a, 0x67, 0x12, 0x75, 0x46, 0x2f, 0xc1, 0xda, 0x30, 0x2e, 0xd2, 0x2c, 0x2e, 0xf2,
+	0x52, 0x60, 0xc3, 0xd1, 0xbc, 0x0f, 0x09, 0x54, 0xa5, 0x79, 0x5e, 0x0a, 0xf4, 0x19, 0x34, 0xb9,
+	0xa0, 0x22, 0xc5, 0x0d, 0x47, 0xf3, 0x2e, 0x87, 0x5d, 0x9f, 0x16, 0xcc, 0x3f, 0x62, 0xf1, 0xe5,
+	0x9f, 0x94, 0x54, 0x4d, 0xc8, 0x06, 0xa3, 0x60, 0x09, 0x6e, 0xaa, 0x31, 0xf2, 0x88, 0xbe, 0x80,
+	0xd6, 0xae, 0x90, 0x2f, 0xc5, 0x2d, 0x47, 0xf3, 0xac, 0x61, 0xcf, 0xaf, 0x64, 0xf8, 0xf7, 0x32,
+	0xfc, 0x51, 0x2d, 0x93, 0xd4, 0x8d, 0xa8, 0x0f, 0xed, 0x52, 0x0a, 0x2b, 0x05, 0xc7, 0x17, 0x6a,
+	0xd2, 0xfe, 0x8e, 0x3e, 0x01, 0xd8, 0x50, 0x2e, 0xe2, 0xb4, 0x2c, 0xf3, 0x12, 0xb7, 0x95, 0x12,
+	0x53, 0x56, 0x42, 0x59, 0x90, 0xf0, 0x36, 0x15, 0x25, 0x5b, 0xf3, 0x78, 0x57, 0x60, 0xd3, 0xd1,
+	0xbc, 0x36, 0x31, 0xeb, 0xca, 0xaa, 0x70, 0xbf, 0x81, 0xa6, 0x7a, 0x2e, 0xb2, 0xe0, 0x62, 0x15,
+	0xbd, 0x8d, 0x66, 0xdf, 0x45, 0xf6, 0x07, 0xf2, 0x42, 0x56, 0x51, 0x74, 0x1d, 0x8d, 0x6d, 0x4d,
+	0x5e, 0x16, 0xcb, 0xd9, 0x7c, 0x1e, 0x8e, 0x6c, 0x1d, 0x01, 0xb4, 0xde, 0x04, 0xd7, 0xd3, 0x70,
+	0x64, 0x1b, 0xee, 0x33, 0xb8, 0x52, 0xb2, 0x79, 0xa5, 0x9b, 0xa4, 0x3f, 0xef, 0x52, 0x2e, 0xdc,
+	0x6f, 0xe1, 0xe9, 0x69, 0x99, 0x17, 0x79, 0xc6, 0x53, 0xe4, 0x41, 0xab, 0x5a, 0x00, 0xac, 0x39,
+	0x86, 0x67, 0x0d, 0xed, 0x73, 0xe3, 0x48, 0x8d, 0xbb, 0xbf, 0xc1, 0xa5, 0x74, 0x3a, 0xd8, 0x6c,
+	0xf2, 0xb5, 0x32, 0x42, 0x06, 0xa5, 0xd2, 0xd0, 0x94, 0x78, 0x75, 0x46, 0x5f, 0x03, 0xac, 0xcb,
+	0x94, 0x8a, 0x34, 0x89, 0xa9, 0x50, 0x11, 0x5a, 0xc3, 0xfe, 0x03, 0x2f, 0x97, 0xf7, 0x2b, 0x41,
+	0xcc, 0xba, 0x3b, 0x10, 0xe8, 0x39, 0x98, 0x8a, 0x2a, 0x66, 0x09, 0xc7, 0x86, 0x63, 0x78, 0x4d,
+	0xd2, 0x56, 0x85, 0xeb, 0x84, 0xbb, 0x7f, 0x69, 0xd0, 0x91, 0xf4, 0xaf, 0xf3, 0xec, 0x66, 0xc3,
+	0xd6, 0xe2, 0x51, 0xf2, 0x57, 0xd0, 0xf8, 0x89, 0x65, 0x09, 0xd6, 0x8f, 0x76, 0xe0, 0xf8, 0x23,
+	0xff, 0x2d, 0xcb, 0x12, 0xa2, 0x7a, 0xde, 0xcf, 0x16, 0x41, 0x43, 0xb6, 0x9e, 0xfa, 0xff, 0x11,
+	0x58, 0xab, 0x28, 0x98, 0x4e, 0x67, 0xaf, 0x83, 0x65, 0x38, 0xb2, 0x35, 0x69, 0xfb, 0x2a, 0x5a,
+	0x2d, 0xee, 0x23, 0x58, 0x4c, 0x02, 0x22, 0x23, 0x40, 0x36, 0x74, 0x66, 0xab, 0x65, 0x3c, 0x7b,
+	0x13, 0x93, 0x20, 0x1a, 0x87, 0x76, 0xc3, 0xc5, 0xd0, 0xad, 0xdc, 0x9f, 0x32, 0x2e, 0xe4, 0x8b,
+	0xf6, 0xb9, 0xfc, 0xa9, 0xc1, 0xc7, 0x0f, 0xa0, 0x3a, 0x9b, 0x1e, 0xb4, 0xb7, 0x2c, 0x8b, 0x8f,
+	0x64, 0x5e, 0x6c, 0x59, 0xb5, 0xee, 0x12, 0xa2, 0x77, 0x15, 0xa4, 0xd7, 0x10, 0xbd, 0x53, 0xd0,
+	0x57, 0x60, 0xd1, 0x7d, 0x46, 0x95, 0x34, 0x6b, 0x78, 0xb5, 0xf7, 0xe2, 0x90, 0x1f, 0x39, 0xee,
+	0x43, 0x03, 0x30, 0xd7, 0xb5, 0x4d, 0x1c, 0x37, 0xd4, 0x47, 0x4f, 0x1e, 0x18, 0x48, 0x0e, 0x3d,
+	0xaf, 0x38, 0x74, 0xaa, 0x87, 0x4f, 0x52, 0xba, 0x11, 0x3f, 0xa2, 0x1e, 0x3c, 0x0b, 0xc6, 0x61,
+	0xb4, 0x5c, 0xc4, 0x93, 0x30, 0x98, 0x2e, 0x27, 0xf1, 0xc1, 0xb9, 0x2e, 0xa0, 0x53, 0x68, 0x24,
+	0xeb, 0x1a, 0xea, 0x43, 0xf7, 0xac, 0x1e, 0x8e, 0x49, 0x30, 0x52, 0x86, 0x3e, 0x05, 0xfb, 0x6c,
+	0xdc, 0xdc, 0x36, 0x86, 0x7f, 0x6b, 0xd0, 0xaa, 0x58, 0xd1, 0xf7, 0xd0, 0xaa, 0x7f, 0x40, 0xf0,
+	0x61, 0x67, 0x4f, 0xb7, 0xbe, 0xdf, 0x7b, 0x04, 0xa9, 0xcc, 0x75, 0x7b, 0xbf, 0xff, 0xf3, 0xdf,
+	0x1f, 0xfa, 0x15, 0x7a, 0x32, 0xf8, 0xe5, 0xf3, 0x41, 0xb5, 0xe2, 0x03, 0x5e, 0xcd, 0xfb, 0x01,
+	0xcc, 0x7d, 0x18, 0xe8, 0xf9, 0xd1, 0x88, 0xf3, 0xf4, 0xfa, 0x2f, 0x1e, 0x07, 0x6b, 0x0a, 0xac,
+	0x28, 0x10, 0xb2, 0x8f, 0x28, 0x64, 0x62, 0xfc, 0x5d, 0x4b, 0xfd, 0x27, 0x7c, 0xf9, 0xff, 0x00,
+	0x4c, 0xa9, 0x8f, 0x3c, 0x7d, 0x05, 0x00, 0x00,
+}
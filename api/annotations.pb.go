@@ -6,6 +6,7 @@ package api
 import proto "github.com/golang/protobuf/proto"
 import fmt "fmt"
 import math "math"
+import timestamp "github.com/golang/protobuf/ptypes/timestamp"
 import _ "google.golang.org/genproto/googleapis/api/annotations"
 
 import (
@@ -24,9 +25,89 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
+type Annotation struct {
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Annotation time or region start time.
+	Time *timestamp.Timestamp `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`
+	// Region end time, not set for point annotations.
+	TimeEnd              *timestamp.Timestamp `protobuf:"bytes,3,opt,name=time_end,json=timeEnd,proto3" json:"time_end,omitempty"`
+	Tags                 []string             `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	Text                 string               `protobuf:"bytes,5,opt,name=text,proto3" json:"text,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *Annotation) Reset()         { *m = Annotation{} }
+func (m *Annotation) String() string { return proto.CompactTextString(m) }
+func (*Annotation) ProtoMessage()    {}
+func (*Annotation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_annotations_0e0a3e49782868a5, []int{0}
+}
+func (m *Annotation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Annotation.Unmarshal(m, b)
+}
+func (m *Annotation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Annotation.Marshal(b, m, deterministic)
+}
+func (dst *Annotation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Annotation.Merge(dst, src)
+}
+func (m *Annotation) XXX_Size() int {
+	return xxx_messageInfo_Annotation.Size(m)
+}
+func (m *Annotation) XXX_DiscardUnknown() {
+	xxx_messageInfo_Annotation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Annotation proto.InternalMessageInfo
+
+func (m *Annotation) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Annotation) GetTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.Time
+	}
+	return nil
+}
+
+func (m *Annotation) GetTimeEnd() *timestamp.Timestamp {
+	if m != nil {
+		return m.TimeEnd
+	}
+	return nil
+}
+
+func (m *Annotation) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *Annotation) GetText() string {
+	if m != nil {
+		return m.Text
+	}
+	return ""
+}
+
 type AnnotationsCreateRequest struct {
-	Tags                 []string `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
-	Text                 string   `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	Tags []string `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	Text string   `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	// Annotation time or region start time; current time is used if not set.
+	Time *timestamp.Timestamp `protobuf:"bytes,3,opt,name=time,proto3" json:"time,omitempty"`
+	// Region end time; point annotation is created if not set.
+	TimeEnd *timestamp.Timestamp `protobuf:"bytes,4,opt,name=time_end,json=timeEnd,proto3" json:"time_end,omitempty"`
+	// Names of instances to tag annotation with ("instance:<name>" tags).
+	InstanceNames []string `protobuf:"bytes,5,rep,name=instance_names,json=instanceNames,proto3" json:"instance_names,omitempty"`
+	// Names of nodes to tag annotation with ("node:<name>" tags).
+	NodeNames            []string `protobuf:"bytes,6,rep,name=node_names,json=nodeNames,proto3" json:"node_names,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -36,7 +117,7 @@ func (m *AnnotationsCreateRequest) Reset()         { *m = AnnotationsCreateReque
 func (m *AnnotationsCreateRequest) String() string { return proto.CompactTextString(m) }
 func (*AnnotationsCreateRequest) ProtoMessage()    {}
 func (*AnnotationsCreateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_annotations_b308d9a339e5faed, []int{0}
+	return fileDescriptor_annotations_0e0a3e49782868a5, []int{1}
 }
 func (m *AnnotationsCreateRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AnnotationsCreateRequest.Unmarshal(m, b)
@@ -70,8 +151,37 @@ func (m *AnnotationsCreateRequest) GetText() string {
 	return ""
 }
 
+func (m *AnnotationsCreateRequest) GetTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.Time
+	}
+	return nil
+}
+
+func (m *AnnotationsCreateRequest) GetTimeEnd() *timestamp.Timestamp {
+	if m != nil {
+		return m.TimeEnd
+	}
+	return nil
+}
+
+func (m *AnnotationsCreateRequest) GetInstanceNames() []string {
+	if m != nil {
+		return m.InstanceNames
+	}
+	return nil
+}
+
+func (m *AnnotationsCreateRequest) GetNodeNames() []string {
+	if m != nil {
+		return m.NodeNames
+	}
+	return nil
+}
+
 type AnnotationsCreateResponse struct {
 	Message              string   `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Id                   int64    `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -81,7 +191,7 @@ func (m *AnnotationsCreateResponse) Reset()         { *m = AnnotationsCreateResp
 func (m *AnnotationsCreateResponse) String() string { return proto.CompactTextString(m) }
 func (*AnnotationsCreateResponse) ProtoMessage()    {}
 func (*AnnotationsCreateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_annotations_b308d9a339e5faed, []int{1}
+	return fileDescriptor_annotations_0e0a3e49782868a5, []int{2}
 }
 func (m *AnnotationsCreateResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AnnotationsCreateResponse.Unmarshal(m, b)
@@ -108,9 +218,260 @@ func (m *AnnotationsCreateResponse) GetMessage() string {
 	return ""
 }
 
+func (m *AnnotationsCreateResponse) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type AnnotationsListRequest struct {
+	// Annotations should have all of those tags.
+	Tags                 []string             `protobuf:"bytes,1,rep,name=tags,proto3" json:"tags,omitempty"`
+	From                 *timestamp.Timestamp `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To                   *timestamp.Timestamp `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	Limit                uint32               `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *AnnotationsListRequest) Reset()         { *m = AnnotationsListRequest{} }
+func (m *AnnotationsListRequest) String() string { return proto.CompactTextString(m) }
+func (*AnnotationsListRequest) ProtoMessage()    {}
+func (*AnnotationsListRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_annotations_0e0a3e49782868a5, []int{3}
+}
+func (m *AnnotationsListRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AnnotationsListRequest.Unmarshal(m, b)
+}
+func (m *AnnotationsListRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AnnotationsListRequest.Marshal(b, m, deterministic)
+}
+func (dst *AnnotationsListRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AnnotationsListRequest.Merge(dst, src)
+}
+func (m *AnnotationsListRequest) XXX_Size() int {
+	return xxx_messageInfo_AnnotationsListRequest.Size(m)
+}
+func (m *AnnotationsListRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AnnotationsListRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AnnotationsListRequest proto.InternalMessageInfo
+
+func (m *AnnotationsListRequest) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *AnnotationsListRequest) GetFrom() *timestamp.Timestamp {
+	if m != nil {
+		return m.From
+	}
+	return nil
+}
+
+func (m *AnnotationsListRequest) GetTo() *timestamp.Timestamp {
+	if m != nil {
+		return m.To
+	}
+	return nil
+}
+
+func (m *AnnotationsListRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type AnnotationsListResponse struct {
+	Annotations          []*Annotation `protobuf:"bytes,1,rep,name=annotations,proto3" json:"annotations,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *AnnotationsListResponse) Reset()         { *m = AnnotationsListResponse{} }
+func (m *AnnotationsListResponse) String() string { return proto.CompactTextString(m) }
+func (*AnnotationsListResponse) ProtoMessage()    {}
+func (*AnnotationsListResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_annotations_0e0a3e49782868a5, []int{4}
+}
+func (m *AnnotationsListResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AnnotationsListResponse.Unmarshal(m, b)
+}
+func (m *AnnotationsListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AnnotationsListResponse.Marshal(b, m, deterministic)
+}
+func (dst *AnnotationsListResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AnnotationsListResponse.Merge(dst, src)
+}
+func (m *AnnotationsListResponse) XXX_Size() int {
+	return xxx_messageInfo_AnnotationsListResponse.Size(m)
+}
+func (m *AnnotationsListResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AnnotationsListResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AnnotationsListResponse proto.InternalMessageInfo
+
+func (m *AnnotationsListResponse) GetAnnotations() []*Annotation {
+	if m != nil {
+		return m.Annotations
+	}
+	return nil
+}
+
+type AnnotationsUpdateRequest struct {
+	Annotation           *Annotation `protobuf:"bytes,1,opt,name=annotation,proto3" json:"annotation,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *AnnotationsUpdateRequest) Reset()         { *m = AnnotationsUpdateRequest{} }
+func (m *AnnotationsUpdateRequest) String() string { return proto.CompactTextString(m) }
+func (*AnnotationsUpdateRequest) ProtoMessage()    {}
+func (*AnnotationsUpdateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_annotations_0e0a3e49782868a5, []int{5}
+}
+func (m *AnnotationsUpdateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AnnotationsUpdateRequest.Unmarshal(m, b)
+}
+func (m *AnnotationsUpdateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AnnotationsUpdateRequest.Marshal(b, m, deterministic)
+}
+func (dst *AnnotationsUpdateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AnnotationsUpdateRequest.Merge(dst, src)
+}
+func (m *AnnotationsUpdateRequest) XXX_Size() int {
+	return xxx_messageInfo_AnnotationsUpdateRequest.Size(m)
+}
+func (m *AnnotationsUpdateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AnnotationsUpdateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AnnotationsUpdateRequest proto.InternalMessageInfo
+
+func (m *AnnotationsUpdateRequest) GetAnnotation() *Annotation {
+	if m != nil {
+		return m.Annotation
+	}
+	return nil
+}
+
+type AnnotationsUpdateResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AnnotationsUpdateResponse) Reset()         { *m = AnnotationsUpdateResponse{} }
+func (m *AnnotationsUpdateResponse) String() string { return proto.CompactTextString(m) }
+func (*AnnotationsUpdateResponse) ProtoMessage()    {}
+func (*AnnotationsUpdateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_annotations_0e0a3e49782868a5, []int{6}
+}
+func (m *AnnotationsUpdateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AnnotationsUpdateResponse.Unmarshal(m, b)
+}
+func (m *AnnotationsUpdateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AnnotationsUpdateResponse.Marshal(b, m, deterministic)
+}
+func (dst *AnnotationsUpdateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AnnotationsUpdateResponse.Merge(dst, src)
+}
+func (m *AnnotationsUpdateResponse) XXX_Size() int {
+	return xxx_messageInfo_AnnotationsUpdateResponse.Size(m)
+}
+func (m *AnnotationsUpdateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AnnotationsUpdateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AnnotationsUpdateResponse proto.InternalMessageInfo
+
+type AnnotationsDeleteRequest struct {
+	Id                   int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AnnotationsDeleteRequest) Reset()         { *m = AnnotationsDeleteRequest{} }
+func (m *AnnotationsDeleteRequest) String() string { return proto.CompactTextString(m) }
+func (*AnnotationsDeleteRequest) ProtoMessage()    {}
+func (*AnnotationsDeleteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_annotations_0e0a3e49782868a5, []int{7}
+}
+func (m *AnnotationsDeleteRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AnnotationsDeleteRequest.Unmarshal(m, b)
+}
+func (m *AnnotationsDeleteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AnnotationsDeleteRequest.Marshal(b, m, deterministic)
+}
+func (dst *AnnotationsDeleteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AnnotationsDeleteRequest.Merge(dst, src)
+}
+func (m *AnnotationsDeleteRequest) XXX_Size() int {
+	return xxx_messageInfo_AnnotationsDeleteRequest.Size(m)
+}
+func (m *AnnotationsDeleteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AnnotationsDeleteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AnnotationsDeleteRequest proto.InternalMessageInfo
+
+func (m *AnnotationsDeleteRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type AnnotationsDeleteResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AnnotationsDeleteResponse) Reset()         { *m = AnnotationsDeleteResponse{} }
+func (m *AnnotationsDeleteResponse) String() string { return proto.CompactTextString(m) }
+func (*AnnotationsDeleteResponse) ProtoMessage()    {}
+func (*AnnotationsDeleteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_annotations_0e0a3e49782868a5, []int{8}
+}
+func (m *AnnotationsDeleteResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AnnotationsDeleteResponse.Unmarshal(m, b)
+}
+func (m *AnnotationsDeleteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AnnotationsDeleteResponse.Marshal(b, m, deterministic)
+}
+func (dst *AnnotationsDeleteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AnnotationsDeleteResponse.Merge(dst, src)
+}
+func (m *AnnotationsDeleteResponse) XXX_Size() int {
+	return xxx_messageInfo_AnnotationsDeleteResponse.Size(m)
+}
+func (m *AnnotationsDeleteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AnnotationsDeleteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AnnotationsDeleteResponse proto.InternalMessageInfo
+
 func init() {
+	proto.RegisterType((*Annotation)(nil), "api.Annotation")
 	proto.RegisterType((*AnnotationsCreateRequest)(nil), "api.AnnotationsCreateRequest")
 	proto.RegisterType((*AnnotationsCreateResponse)(nil), "api.AnnotationsCreateResponse")
+	proto.RegisterType((*AnnotationsListRequest)(nil), "api.AnnotationsListRequest")
+	proto.RegisterType((*AnnotationsListResponse)(nil), "api.AnnotationsListResponse")
+	proto.RegisterType((*AnnotationsUpdateRequest)(nil), "api.AnnotationsUpdateRequest")
+	proto.RegisterType((*AnnotationsUpdateResponse)(nil), "api.AnnotationsUpdateResponse")
+	proto.RegisterType((*AnnotationsDeleteRequest)(nil), "api.AnnotationsDeleteRequest")
+	proto.RegisterType((*AnnotationsDeleteResponse)(nil), "api.AnnotationsDeleteResponse")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -125,7 +486,10 @@ const _ = grpc.SupportPackageIsVersion4
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type AnnotationsClient interface {
+	List(ctx context.Context, in *AnnotationsListRequest, opts ...grpc.CallOption) (*AnnotationsListResponse, error)
 	Create(ctx context.Context, in *AnnotationsCreateRequest, opts ...grpc.CallOption) (*AnnotationsCreateResponse, error)
+	Update(ctx context.Context, in *AnnotationsUpdateRequest, opts ...grpc.CallOption) (*AnnotationsUpdateResponse, error)
+	Delete(ctx context.Context, in *AnnotationsDeleteRequest, opts ...grpc.CallOption) (*AnnotationsDeleteResponse, error)
 }
 
 type annotationsClient struct {
@@ -136,6 +500,15 @@ func NewAnnotationsClient(cc *grpc.ClientConn) AnnotationsClient {
 	return &annotationsClient{cc}
 }
 
+func (c *annotationsClient) List(ctx context.Context, in *AnnotationsListRequest, opts ...grpc.CallOption) (*AnnotationsListResponse, error) {
+	out := new(AnnotationsListResponse)
+	err := c.cc.Invoke(ctx, "/api.Annotations/List", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *annotationsClient) Create(ctx context.Context, in *AnnotationsCreateRequest, opts ...grpc.CallOption) (*AnnotationsCreateResponse, error) {
 	out := new(AnnotationsCreateResponse)
 	err := c.cc.Invoke(ctx, "/api.Annotations/Create", in, out, opts...)
@@ -145,15 +518,54 @@ func (c *annotationsClient) Create(ctx context.Context, in *AnnotationsCreateReq
 	return out, nil
 }
 
+func (c *annotationsClient) Update(ctx context.Context, in *AnnotationsUpdateRequest, opts ...grpc.CallOption) (*AnnotationsUpdateResponse, error) {
+	out := new(AnnotationsUpdateResponse)
+	err := c.cc.Invoke(ctx, "/api.Annotations/Update", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *annotationsClient) Delete(ctx context.Context, in *AnnotationsDeleteRequest, opts ...grpc.CallOption) (*AnnotationsDeleteResponse, error) {
+	out := new(AnnotationsDeleteResponse)
+	err := c.cc.Invoke(ctx, "/api.Annotations/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AnnotationsServer is the server API for Annotations service.
 type AnnotationsServer interface {
+	List(context.Context, *AnnotationsListRequest) (*AnnotationsListResponse, error)
 	Create(context.Context, *AnnotationsCreateRequest) (*AnnotationsCreateResponse, error)
+	Update(context.Context, *AnnotationsUpdateRequest) (*AnnotationsUpdateResponse, error)
+	Delete(context.Context, *AnnotationsDeleteRequest) (*AnnotationsDeleteResponse, error)
 }
 
 func RegisterAnnotationsServer(s *grpc.Server, srv AnnotationsServer) {
 	s.RegisterService(&_Annotations_serviceDesc, srv)
 }
 
+func _Annotations_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnnotationsListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnnotationsServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Annotations/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnnotationsServer).List(ctx, req.(*AnnotationsListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Annotations_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AnnotationsCreateRequest)
 	if err := dec(in); err != nil {
@@ -172,34 +584,103 @@ func _Annotations_Create_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Annotations_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnnotationsUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnnotationsServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Annotations/Update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnnotationsServer).Update(ctx, req.(*AnnotationsUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Annotations_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AnnotationsDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AnnotationsServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Annotations/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AnnotationsServer).Delete(ctx, req.(*AnnotationsDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Annotations_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "api.Annotations",
 	HandlerType: (*AnnotationsServer)(nil),
 	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "List",
+			Handler:    _Annotations_List_Handler,
+		},
 		{
 			MethodName: "Create",
 			Handler:    _Annotations_Create_Handler,
 		},
+		{
+			MethodName: "Update",
+			Handler:    _Annotations_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _Annotations_Delete_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "annotations.proto",
 }
 
-func init() { proto.RegisterFile("annotations.proto", fileDescriptor_annotations_b308d9a339e5faed) }
-
-var fileDescriptor_annotations_b308d9a339e5faed = []byte{
-	// 195 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x4c, 0xcc, 0xcb, 0xcb,
-	0x2f, 0x49, 0x2c, 0xc9, 0xcc, 0xcf, 0x2b, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62, 0x4e,
-	0x2c, 0xc8, 0x94, 0x92, 0x49, 0xcf, 0xcf, 0x4f, 0xcf, 0x49, 0xd5, 0x4f, 0x2c, 0xc8, 0xd4, 0xc7,
-	0x50, 0xa2, 0xe4, 0xc4, 0x25, 0xe1, 0x88, 0x10, 0x74, 0x2e, 0x4a, 0x4d, 0x2c, 0x49, 0x0d, 0x4a,
-	0x2d, 0x2c, 0x4d, 0x2d, 0x2e, 0x11, 0x12, 0xe2, 0x62, 0x29, 0x49, 0x4c, 0x2f, 0x96, 0x60, 0x54,
-	0x60, 0xd6, 0xe0, 0x0c, 0x02, 0xb3, 0xc1, 0x62, 0xa9, 0x15, 0x25, 0x12, 0x4c, 0x0a, 0x8c, 0x60,
-	0xb1, 0xd4, 0x8a, 0x12, 0x25, 0x53, 0x2e, 0x49, 0x2c, 0x66, 0x14, 0x17, 0xe4, 0xe7, 0x15, 0xa7,
-	0x0a, 0x49, 0x70, 0xb1, 0xe7, 0xa6, 0x16, 0x17, 0x27, 0xa6, 0xa7, 0x4a, 0x30, 0x82, 0xf5, 0xc0,
-	0xb8, 0x46, 0x45, 0x5c, 0xdc, 0x48, 0xda, 0x84, 0x92, 0xb9, 0xd8, 0x20, 0x5a, 0x85, 0x64, 0xf5,
-	0x12, 0x0b, 0x32, 0xf5, 0x70, 0x39, 0x4b, 0x4a, 0x0e, 0x97, 0x34, 0xc4, 0x46, 0x25, 0xa9, 0xa6,
-	0xcb, 0x4f, 0x26, 0x33, 0x89, 0x28, 0xf1, 0xeb, 0x97, 0x19, 0x20, 0xfb, 0xd8, 0x8a, 0x51, 0x2b,
-	0x89, 0x0d, 0xec, 0x6b, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0xdb, 0x41, 0x1f, 0x8a, 0x2d,
-	0x01, 0x00, 0x00,
+func init() { proto.RegisterFile("annotations.proto", fileDescriptor_annotations_0e0a3e49782868a5) }
+
+var fileDescriptor_annotations_0e0a3e49782868a5 = []byte{
+	// 541 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0x96, 0x7f, 0xe2, 0x92, 0x89, 0xda, 0xaa, 0xab, 0xa8, 0x75, 0xdd, 0x34, 0x8d, 0x2c, 0x90,
+	0xa2, 0x1c, 0xd6, 0x10, 0xc4, 0x85, 0x1b, 0x82, 0x9e, 0xa8, 0x38, 0x58, 0x70, 0xe1, 0x52, 0x6d,
+	0xeb, 0x6d, 0xb4, 0x52, 0xbc, 0x6b, 0xb2, 0x5b, 0x54, 0x09, 0xf5, 0xc2, 0x2b, 0x70, 0xe7, 0x01,
+	0xe0, 0x71, 0x78, 0x05, 0xee, 0xbc, 0x02, 0xf2, 0xda, 0x8e, 0x7f, 0x03, 0x51, 0x4f, 0xc9, 0xce,
+	0x7c, 0xfb, 0x7d, 0x33, 0xdf, 0xcc, 0x1a, 0x0e, 0x08, 0xe7, 0x42, 0x11, 0xc5, 0x04, 0x97, 0x38,
+	0x59, 0x09, 0x25, 0x90, 0x45, 0x12, 0xe6, 0x8d, 0x16, 0x42, 0x2c, 0x96, 0x34, 0x20, 0x09, 0x0b,
+	0x5a, 0x10, 0xef, 0x2c, 0xcf, 0xea, 0xd3, 0xd5, 0xed, 0x4d, 0xa0, 0x58, 0x4c, 0xa5, 0x22, 0x71,
+	0x92, 0x01, 0xfc, 0x9f, 0x06, 0xc0, 0xab, 0xf5, 0x35, 0xb4, 0x07, 0x26, 0x8b, 0x5c, 0x63, 0x62,
+	0x4c, 0xad, 0xd0, 0x64, 0x11, 0xc2, 0x60, 0xa7, 0x37, 0x5c, 0x73, 0x62, 0x4c, 0x07, 0x73, 0x0f,
+	0x67, 0x74, 0xb8, 0xa0, 0xc3, 0xef, 0x0b, 0xba, 0x50, 0xe3, 0xd0, 0x0b, 0x78, 0x94, 0xfe, 0x5e,
+	0x52, 0x1e, 0xb9, 0xd6, 0x7f, 0xef, 0xec, 0xa4, 0xd8, 0x73, 0x1e, 0x21, 0x04, 0xb6, 0x22, 0x0b,
+	0xe9, 0xda, 0x13, 0x6b, 0xda, 0x0f, 0xf5, 0x7f, 0x1d, 0xa3, 0x77, 0xca, 0xed, 0x4d, 0x0c, 0x1d,
+	0xa3, 0x77, 0xca, 0xff, 0x63, 0x80, 0x5b, 0x56, 0x2b, 0x5f, 0xaf, 0x28, 0x51, 0x34, 0xa4, 0x9f,
+	0x6e, 0xa9, 0x54, 0x6b, 0x12, 0xa3, 0x83, 0xc4, 0x2c, 0x49, 0xd6, 0x3d, 0x59, 0x0f, 0xe8, 0xc9,
+	0xde, 0xbe, 0xa7, 0x27, 0xb0, 0xc7, 0xb8, 0x54, 0x84, 0x5f, 0xd3, 0x4b, 0x4e, 0x62, 0x2a, 0xdd,
+	0x9e, 0x2e, 0x6c, 0xb7, 0x88, 0xbe, 0x4b, 0x83, 0xe8, 0x14, 0x80, 0x8b, 0xa8, 0x80, 0x38, 0x1a,
+	0xd2, 0x4f, 0x23, 0x3a, 0xed, 0x9f, 0xc3, 0x71, 0x47, 0xc3, 0x32, 0x11, 0x5c, 0x52, 0xe4, 0xc2,
+	0x4e, 0x4c, 0xa5, 0x24, 0x0b, 0xaa, 0x47, 0xd6, 0x0f, 0x8b, 0x63, 0x3e, 0x47, 0xb3, 0x98, 0xa3,
+	0xff, 0xdd, 0x80, 0xc3, 0x0a, 0xcf, 0x05, 0x93, 0xea, 0x5f, 0xb6, 0x61, 0xb0, 0x6f, 0x56, 0x22,
+	0xde, 0x66, 0xec, 0x29, 0x0e, 0xcd, 0xc0, 0x54, 0x62, 0x0b, 0x43, 0x4d, 0x25, 0xd0, 0x10, 0x7a,
+	0x4b, 0x16, 0x33, 0xa5, 0xbd, 0xdc, 0x0d, 0xb3, 0x83, 0x7f, 0x01, 0x47, 0xad, 0xfa, 0xf2, 0x2e,
+	0x9f, 0xc1, 0xa0, 0xb2, 0xd8, 0xba, 0xce, 0xc1, 0x7c, 0x1f, 0x93, 0x84, 0xe1, 0xf2, 0x4a, 0x58,
+	0xc5, 0xf8, 0x6f, 0x6b, 0x6b, 0xf2, 0x21, 0x89, 0x2a, 0x6b, 0x12, 0x00, 0x94, 0x50, 0xed, 0x5b,
+	0x07, 0x5b, 0x05, 0xe2, 0x9f, 0xc0, 0x71, 0x07, 0x59, 0x56, 0x9c, 0x3f, 0xab, 0x29, 0xbd, 0xa1,
+	0x4b, 0x5a, 0x2a, 0x35, 0x1e, 0x53, 0x83, 0xa8, 0xc0, 0x66, 0x44, 0xf3, 0x1f, 0x16, 0x0c, 0x2a,
+	0x59, 0xf4, 0x11, 0xec, 0xd4, 0x05, 0x74, 0xd2, 0x28, 0xad, 0x3a, 0x3b, 0x6f, 0xd4, 0x9d, 0xcc,
+	0x6b, 0x3b, 0xfa, 0xfa, 0xeb, 0xf7, 0x37, 0xf3, 0x00, 0xed, 0x07, 0x9f, 0x9f, 0x56, 0xbf, 0x0d,
+	0xe8, 0x1a, 0x9c, 0x6c, 0x93, 0xd0, 0x69, 0x93, 0xa0, 0xf6, 0xa4, 0xbc, 0xf1, 0xa6, 0x74, 0xae,
+	0xe0, 0x69, 0x85, 0xa1, 0xdf, 0x54, 0x78, 0x69, 0xcc, 0x90, 0x04, 0x27, 0xf3, 0xaa, 0x2d, 0x52,
+	0x1b, 0x88, 0x37, 0xde, 0x94, 0x2e, 0x2c, 0xd6, 0x22, 0x8f, 0xbd, 0xb3, 0x86, 0x48, 0xf0, 0xa5,
+	0x3c, 0x60, 0x16, 0xdd, 0xa7, 0xa2, 0x14, 0x9c, 0xcc, 0xd7, 0xb6, 0x68, 0x6d, 0x36, 0xde, 0x78,
+	0x53, 0x3a, 0x17, 0x1d, 0x69, 0xd1, 0xc3, 0xd9, 0xb0, 0x25, 0xca, 0xa2, 0xfb, 0x2b, 0x47, 0xef,
+	0xf6, 0xf3, 0xbf, 0x03, 0x00, 0xd0, 0xcf, 0xac, 0x45, 0x95, 0x05, 0x00, 0x00,
 }
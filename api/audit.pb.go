@@ -0,0 +1,393 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: audit.proto
+
+package api
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import duration "github.com/golang/protobuf/ptypes/duration"
+import timestamp "github.com/golang/protobuf/ptypes/timestamp"
+import _ "google.golang.org/genproto/googleapis/api/annotations"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type AuditEntry struct {
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Time when call was made
+	Time *timestamp.Timestamp `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`
+	// Request ID, as in pmm-managed logs
+	RequestId string `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	// Full gRPC method name, e.g. /api.RDS/Add
+	Method string `protobuf:"bytes,4,opt,name=method,proto3" json:"method,omitempty"`
+	// Token name, username, or upstream user; anonymous if authentication is disabled
+	Caller string `protobuf:"bytes,5,opt,name=caller,proto3" json:"caller,omitempty"`
+	// Authentication method: token, basic, upstream, or empty if authentication is disabled
+	AuthMethod string `protobuf:"bytes,6,opt,name=auth_method,json=authMethod,proto3" json:"auth_method,omitempty"`
+	// Request in JSON format with secrets redacted
+	Request string `protobuf:"bytes,7,opt,name=request,proto3" json:"request,omitempty"`
+	// gRPC status code name, e.g. OK or InvalidArgument
+	Code string `protobuf:"bytes,8,opt,name=code,proto3" json:"code,omitempty"`
+	// Error message, if any
+	Error string `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`
+	// Call duration
+	Duration             *duration.Duration `protobuf:"bytes,10,opt,name=duration,proto3" json:"duration,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *AuditEntry) Reset()         { *m = AuditEntry{} }
+func (m *AuditEntry) String() string { return proto.CompactTextString(m) }
+func (*AuditEntry) ProtoMessage()    {}
+func (*AuditEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_audit_bb9e7756f0e896ff, []int{0}
+}
+func (m *AuditEntry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuditEntry.Unmarshal(m, b)
+}
+func (m *AuditEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuditEntry.Marshal(b, m, deterministic)
+}
+func (dst *AuditEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditEntry.Merge(dst, src)
+}
+func (m *AuditEntry) XXX_Size() int {
+	return xxx_messageInfo_AuditEntry.Size(m)
+}
+func (m *AuditEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditEntry proto.InternalMessageInfo
+
+func (m *AuditEntry) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *AuditEntry) GetTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.Time
+	}
+	return nil
+}
+
+func (m *AuditEntry) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
+	}
+	return ""
+}
+
+func (m *AuditEntry) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
+func (m *AuditEntry) GetCaller() string {
+	if m != nil {
+		return m.Caller
+	}
+	return ""
+}
+
+func (m *AuditEntry) GetAuthMethod() string {
+	if m != nil {
+		return m.AuthMethod
+	}
+	return ""
+}
+
+func (m *AuditEntry) GetRequest() string {
+	if m != nil {
+		return m.Request
+	}
+	return ""
+}
+
+func (m *AuditEntry) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+func (m *AuditEntry) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *AuditEntry) GetDuration() *duration.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return nil
+}
+
+type AuditListRequest struct {
+	// Return only entries after that time
+	Since *timestamp.Timestamp `protobuf:"bytes,1,opt,name=since,proto3" json:"since,omitempty"`
+	// Return only entries before that time
+	Until *timestamp.Timestamp `protobuf:"bytes,2,opt,name=until,proto3" json:"until,omitempty"`
+	// Return only entries for that full gRPC method name
+	Method string `protobuf:"bytes,3,opt,name=method,proto3" json:"method,omitempty"`
+	// Return only entries with that gRPC status code name
+	Code string `protobuf:"bytes,4,opt,name=code,proto3" json:"code,omitempty"`
+	// Maximum number of entries to return; 100 if not set, at most 1000
+	PageSize uint32 `protobuf:"varint,5,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// Return next page of entries; next_page_token value from the previous response
+	PageToken            string   `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AuditListRequest) Reset()         { *m = AuditListRequest{} }
+func (m *AuditListRequest) String() string { return proto.CompactTextString(m) }
+func (*AuditListRequest) ProtoMessage()    {}
+func (*AuditListRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_audit_bb9e7756f0e896ff, []int{1}
+}
+func (m *AuditListRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuditListRequest.Unmarshal(m, b)
+}
+func (m *AuditListRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuditListRequest.Marshal(b, m, deterministic)
+}
+func (dst *AuditListRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditListRequest.Merge(dst, src)
+}
+func (m *AuditListRequest) XXX_Size() int {
+	return xxx_messageInfo_AuditListRequest.Size(m)
+}
+func (m *AuditListRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditListRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditListRequest proto.InternalMessageInfo
+
+func (m *AuditListRequest) GetSince() *timestamp.Timestamp {
+	if m != nil {
+		return m.Since
+	}
+	return nil
+}
+
+func (m *AuditListRequest) GetUntil() *timestamp.Timestamp {
+	if m != nil {
+		return m.Until
+	}
+	return nil
+}
+
+func (m *AuditListRequest) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
+func (m *AuditListRequest) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+func (m *AuditListRequest) GetPageSize() uint32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *AuditListRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+type AuditListResponse struct {
+	// Entries from the newest to the oldest
+	Entries []*AuditEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	// Token for the next page; empty if there are no more entries
+	NextPageToken        string   `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AuditListResponse) Reset()         { *m = AuditListResponse{} }
+func (m *AuditListResponse) String() string { return proto.CompactTextString(m) }
+func (*AuditListResponse) ProtoMessage()    {}
+func (*AuditListResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_audit_bb9e7756f0e896ff, []int{2}
+}
+func (m *AuditListResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuditListResponse.Unmarshal(m, b)
+}
+func (m *AuditListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuditListResponse.Marshal(b, m, deterministic)
+}
+func (dst *AuditListResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditListResponse.Merge(dst, src)
+}
+func (m *AuditListResponse) XXX_Size() int {
+	return xxx_messageInfo_AuditListResponse.Size(m)
+}
+func (m *AuditListResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditListResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditListResponse proto.InternalMessageInfo
+
+func (m *AuditListResponse) GetEntries() []*AuditEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+func (m *AuditListResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*AuditEntry)(nil), "api.AuditEntry")
+	proto.RegisterType((*AuditListRequest)(nil), "api.AuditListRequest")
+	proto.RegisterType((*AuditListResponse)(nil), "api.AuditListResponse")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// AuditClient is the client API for Audit service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type AuditClient interface {
+	// List returns audit log entries of mutating API calls.
+	List(ctx context.Context, in *AuditListRequest, opts ...grpc.CallOption) (*AuditListResponse, error)
+}
+
+type auditClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewAuditClient(cc *grpc.ClientConn) AuditClient {
+	return &auditClient{cc}
+}
+
+func (c *auditClient) List(ctx context.Context, in *AuditListRequest, opts ...grpc.CallOption) (*AuditListResponse, error) {
+	out := new(AuditListResponse)
+	err := c.cc.Invoke(ctx, "/api.Audit/List", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AuditServer is the server API for Audit service.
+type AuditServer interface {
+	// List returns audit log entries of mutating API calls.
+	List(context.Context, *AuditListRequest) (*AuditListResponse, error)
+}
+
+func RegisterAuditServer(s *grpc.Server, srv AuditServer) {
+	s.RegisterService(&_Audit_serviceDesc, srv)
+}
+
+func _Audit_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuditListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuditServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Audit/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuditServer).List(ctx, req.(*AuditListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Audit_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "api.Audit",
+	HandlerType: (*AuditServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "List",
+			Handler:    _Audit_List_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "audit.proto",
+}
+
+func init() { proto.RegisterFile("audit.proto", fileDescriptor_audit_bb9e7756f0e896ff) }
+
+var fileDescriptor_audit_bb9e7756f0e896ff = []byte{
+	// 451 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xcd, 0x6e, 0x13, 0x31,
+	0x10, 0xc7, 0xb5, 0x1f, 0xf9, 0xd8, 0x89, 0x4a, 0xa9, 0x05, 0x95, 0x09, 0x1f, 0x8d, 0x72, 0x40,
+	0xe1, 0xb2, 0x5b, 0x05, 0xf1, 0x00, 0x48, 0x20, 0x81, 0x04, 0x12, 0x2c, 0xbd, 0x47, 0x6e, 0xec,
+	0xa6, 0x16, 0x89, 0xbd, 0xd8, 0x5e, 0x04, 0x3d, 0xf2, 0x0a, 0x3c, 0x0c, 0x0f, 0xc2, 0x9d, 0x13,
+	0x0f, 0x82, 0x3c, 0xf6, 0xd2, 0x25, 0x1c, 0xe0, 0x96, 0xf9, 0xff, 0xff, 0x33, 0x93, 0xf9, 0xed,
+	0x2e, 0x4c, 0x58, 0xcb, 0xa5, 0x2b, 0x1b, 0xa3, 0x9d, 0x26, 0x19, 0x6b, 0xe4, 0xf4, 0xde, 0x46,
+	0xeb, 0xcd, 0x56, 0x54, 0xac, 0x91, 0x15, 0x53, 0x4a, 0x3b, 0xe6, 0xa4, 0x56, 0x36, 0x44, 0xa6,
+	0x0f, 0xa2, 0x8b, 0xd5, 0x79, 0x7b, 0x51, 0xf1, 0xd6, 0x60, 0x20, 0xfa, 0x27, 0xfb, 0xbe, 0x93,
+	0x3b, 0x61, 0x1d, 0xdb, 0x35, 0x21, 0x30, 0xff, 0x96, 0x02, 0x3c, 0xf5, 0x3b, 0x9f, 0x2b, 0x67,
+	0x3e, 0x93, 0x1b, 0x90, 0x4a, 0x4e, 0x93, 0x59, 0xb2, 0xc8, 0xea, 0x54, 0x72, 0x52, 0x42, 0xee,
+	0x3b, 0x68, 0x3a, 0x4b, 0x16, 0x93, 0xe5, 0xb4, 0x0c, 0xe3, 0xca, 0x6e, 0x5c, 0x79, 0xd6, 0x8d,
+	0xab, 0x31, 0x47, 0xee, 0x03, 0x18, 0xf1, 0xa1, 0x15, 0xd6, 0xad, 0x24, 0xa7, 0xd9, 0x2c, 0x59,
+	0x14, 0x75, 0x11, 0x95, 0x97, 0x9c, 0x1c, 0xc3, 0x70, 0x27, 0xdc, 0xa5, 0xe6, 0x34, 0x47, 0x2b,
+	0x56, 0x5e, 0x5f, 0xb3, 0xed, 0x56, 0x18, 0x3a, 0x08, 0x7a, 0xa8, 0xc8, 0x89, 0x07, 0xe2, 0x2e,
+	0x57, 0xb1, 0x69, 0x88, 0x26, 0x78, 0xe9, 0x75, 0x68, 0xa4, 0x30, 0x8a, 0xd3, 0xe9, 0x08, 0xcd,
+	0xae, 0x24, 0x04, 0xf2, 0xb5, 0xe6, 0x82, 0x8e, 0x51, 0xc6, 0xdf, 0xe4, 0x16, 0x0c, 0x84, 0x31,
+	0xda, 0xd0, 0x02, 0xc5, 0x50, 0x90, 0x27, 0x30, 0xee, 0xa8, 0x51, 0xc0, 0x3b, 0xef, 0xfc, 0x75,
+	0xe7, 0xb3, 0x18, 0xa8, 0x7f, 0x47, 0xe7, 0x3f, 0x12, 0xb8, 0x89, 0xe4, 0x5e, 0x49, 0xeb, 0xea,
+	0xb8, 0xf5, 0x14, 0x06, 0x56, 0xaa, 0xb5, 0xa0, 0xc9, 0x3f, 0x81, 0x85, 0xa0, 0xef, 0x68, 0x95,
+	0x93, 0xdb, 0xff, 0x40, 0x1c, 0x82, 0x3d, 0x88, 0xd9, 0x1f, 0x10, 0xbb, 0x8b, 0xf3, 0xde, 0xc5,
+	0x77, 0xa1, 0x68, 0xd8, 0x46, 0xac, 0xac, 0xbc, 0x12, 0xc8, 0xf6, 0xa0, 0x1e, 0x7b, 0xe1, 0x9d,
+	0xbc, 0xc2, 0x87, 0x85, 0xa6, 0xd3, 0xef, 0x85, 0x8a, 0x70, 0x31, 0x7e, 0xe6, 0x85, 0xf9, 0x05,
+	0x1c, 0xf5, 0xee, 0xb3, 0x8d, 0x56, 0x56, 0x90, 0x47, 0x30, 0x12, 0xca, 0x19, 0x29, 0x2c, 0x4d,
+	0x66, 0xd9, 0x62, 0xb2, 0x3c, 0x2c, 0x59, 0x23, 0xcb, 0xeb, 0x57, 0xa8, 0xee, 0x7c, 0xf2, 0x10,
+	0x0e, 0x95, 0xf8, 0xe4, 0x56, 0xbd, 0x1d, 0x29, 0xee, 0x38, 0xf0, 0xf2, 0x9b, 0x6e, 0xcf, 0xf2,
+	0x2d, 0x0c, 0xb0, 0x9d, 0xbc, 0x80, 0xdc, 0xef, 0x22, 0xb7, 0xaf, 0x47, 0xf6, 0xd8, 0x4e, 0x8f,
+	0xf7, 0xe5, 0xf0, 0x97, 0xe6, 0x47, 0x5f, 0xbe, 0xff, 0xfc, 0x9a, 0x4e, 0x48, 0x51, 0x7d, 0x3c,
+	0xad, 0xf0, 0xfb, 0x39, 0x1f, 0x22, 0xbd, 0xc7, 0xbf, 0x06, 0x00, 0xf8, 0x1b, 0x39, 0x21, 0x4f,
+	0x03, 0x00, 0x00,
+}
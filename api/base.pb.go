@@ -6,6 +6,7 @@ package api
 import proto "github.com/golang/protobuf/proto"
 import fmt "fmt"
 import math "math"
+import timestamp "github.com/golang/protobuf/ptypes/timestamp"
 import _ "google.golang.org/genproto/googleapis/api/annotations"
 
 import (
@@ -34,7 +35,7 @@ func (m *BaseVersionRequest) Reset()         { *m = BaseVersionRequest{} }
 func (m *BaseVersionRequest) String() string { return proto.CompactTextString(m) }
 func (*BaseVersionRequest) ProtoMessage()    {}
 func (*BaseVersionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_base_fabe7fe911e46744, []int{0}
+	return fileDescriptor_base_aa6953d822392039, []int{0}
 }
 func (m *BaseVersionRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BaseVersionRequest.Unmarshal(m, b)
@@ -65,7 +66,7 @@ func (m *BaseVersionResponse) Reset()         { *m = BaseVersionResponse{} }
 func (m *BaseVersionResponse) String() string { return proto.CompactTextString(m) }
 func (*BaseVersionResponse) ProtoMessage()    {}
 func (*BaseVersionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_base_fabe7fe911e46744, []int{1}
+	return fileDescriptor_base_aa6953d822392039, []int{1}
 }
 func (m *BaseVersionResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BaseVersionResponse.Unmarshal(m, b)
@@ -92,9 +93,160 @@ func (m *BaseVersionResponse) GetVersion() string {
 	return ""
 }
 
+type SchemaMigration struct {
+	Version  int32  `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Checksum string `protobuf:"bytes,2,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	// Empty for dirty migration.
+	AppliedAt *timestamp.Timestamp `protobuf:"bytes,3,opt,name=applied_at,json=appliedAt,proto3" json:"applied_at,omitempty"`
+	// True if migration failed halfway.
+	Dirty                bool     `protobuf:"varint,4,opt,name=dirty,proto3" json:"dirty,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SchemaMigration) Reset()         { *m = SchemaMigration{} }
+func (m *SchemaMigration) String() string { return proto.CompactTextString(m) }
+func (*SchemaMigration) ProtoMessage()    {}
+func (*SchemaMigration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_base_aa6953d822392039, []int{2}
+}
+func (m *SchemaMigration) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SchemaMigration.Unmarshal(m, b)
+}
+func (m *SchemaMigration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SchemaMigration.Marshal(b, m, deterministic)
+}
+func (dst *SchemaMigration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SchemaMigration.Merge(dst, src)
+}
+func (m *SchemaMigration) XXX_Size() int {
+	return xxx_messageInfo_SchemaMigration.Size(m)
+}
+func (m *SchemaMigration) XXX_DiscardUnknown() {
+	xxx_messageInfo_SchemaMigration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SchemaMigration proto.InternalMessageInfo
+
+func (m *SchemaMigration) GetVersion() int32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *SchemaMigration) GetChecksum() string {
+	if m != nil {
+		return m.Checksum
+	}
+	return ""
+}
+
+func (m *SchemaMigration) GetAppliedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.AppliedAt
+	}
+	return nil
+}
+
+func (m *SchemaMigration) GetDirty() bool {
+	if m != nil {
+		return m.Dirty
+	}
+	return false
+}
+
+type BaseSchemaVersionRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BaseSchemaVersionRequest) Reset()         { *m = BaseSchemaVersionRequest{} }
+func (m *BaseSchemaVersionRequest) String() string { return proto.CompactTextString(m) }
+func (*BaseSchemaVersionRequest) ProtoMessage()    {}
+func (*BaseSchemaVersionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_base_aa6953d822392039, []int{3}
+}
+func (m *BaseSchemaVersionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BaseSchemaVersionRequest.Unmarshal(m, b)
+}
+func (m *BaseSchemaVersionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BaseSchemaVersionRequest.Marshal(b, m, deterministic)
+}
+func (dst *BaseSchemaVersionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BaseSchemaVersionRequest.Merge(dst, src)
+}
+func (m *BaseSchemaVersionRequest) XXX_Size() int {
+	return xxx_messageInfo_BaseSchemaVersionRequest.Size(m)
+}
+func (m *BaseSchemaVersionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BaseSchemaVersionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BaseSchemaVersionRequest proto.InternalMessageInfo
+
+type BaseSchemaVersionResponse struct {
+	CurrentVersion       int32              `protobuf:"varint,1,opt,name=current_version,json=currentVersion,proto3" json:"current_version,omitempty"`
+	LatestVersion        int32              `protobuf:"varint,2,opt,name=latest_version,json=latestVersion,proto3" json:"latest_version,omitempty"`
+	Migrations           []*SchemaMigration `protobuf:"bytes,3,rep,name=migrations,proto3" json:"migrations,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *BaseSchemaVersionResponse) Reset()         { *m = BaseSchemaVersionResponse{} }
+func (m *BaseSchemaVersionResponse) String() string { return proto.CompactTextString(m) }
+func (*BaseSchemaVersionResponse) ProtoMessage()    {}
+func (*BaseSchemaVersionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_base_aa6953d822392039, []int{4}
+}
+func (m *BaseSchemaVersionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BaseSchemaVersionResponse.Unmarshal(m, b)
+}
+func (m *BaseSchemaVersionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BaseSchemaVersionResponse.Marshal(b, m, deterministic)
+}
+func (dst *BaseSchemaVersionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BaseSchemaVersionResponse.Merge(dst, src)
+}
+func (m *BaseSchemaVersionResponse) XXX_Size() int {
+	return xxx_messageInfo_BaseSchemaVersionResponse.Size(m)
+}
+func (m *BaseSchemaVersionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BaseSchemaVersionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BaseSchemaVersionResponse proto.InternalMessageInfo
+
+func (m *BaseSchemaVersionResponse) GetCurrentVersion() int32 {
+	if m != nil {
+		return m.CurrentVersion
+	}
+	return 0
+}
+
+func (m *BaseSchemaVersionResponse) GetLatestVersion() int32 {
+	if m != nil {
+		return m.LatestVersion
+	}
+	return 0
+}
+
+func (m *BaseSchemaVersionResponse) GetMigrations() []*SchemaMigration {
+	if m != nil {
+		return m.Migrations
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*BaseVersionRequest)(nil), "api.BaseVersionRequest")
 	proto.RegisterType((*BaseVersionResponse)(nil), "api.BaseVersionResponse")
+	proto.RegisterType((*SchemaMigration)(nil), "api.SchemaMigration")
+	proto.RegisterType((*BaseSchemaVersionRequest)(nil), "api.BaseSchemaVersionRequest")
+	proto.RegisterType((*BaseSchemaVersionResponse)(nil), "api.BaseSchemaVersionResponse")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -110,6 +262,7 @@ const _ = grpc.SupportPackageIsVersion4
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type BaseClient interface {
 	Version(ctx context.Context, in *BaseVersionRequest, opts ...grpc.CallOption) (*BaseVersionResponse, error)
+	SchemaVersion(ctx context.Context, in *BaseSchemaVersionRequest, opts ...grpc.CallOption) (*BaseSchemaVersionResponse, error)
 }
 
 type baseClient struct {
@@ -129,9 +282,19 @@ func (c *baseClient) Version(ctx context.Context, in *BaseVersionRequest, opts .
 	return out, nil
 }
 
+func (c *baseClient) SchemaVersion(ctx context.Context, in *BaseSchemaVersionRequest, opts ...grpc.CallOption) (*BaseSchemaVersionResponse, error) {
+	out := new(BaseSchemaVersionResponse)
+	err := c.cc.Invoke(ctx, "/api.Base/SchemaVersion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // BaseServer is the server API for Base service.
 type BaseServer interface {
 	Version(context.Context, *BaseVersionRequest) (*BaseVersionResponse, error)
+	SchemaVersion(context.Context, *BaseSchemaVersionRequest) (*BaseSchemaVersionResponse, error)
 }
 
 func RegisterBaseServer(s *grpc.Server, srv BaseServer) {
@@ -156,6 +319,24 @@ func _Base_Version_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Base_SchemaVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BaseSchemaVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BaseServer).SchemaVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Base/SchemaVersion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BaseServer).SchemaVersion(ctx, req.(*BaseSchemaVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Base_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "api.Base",
 	HandlerType: (*BaseServer)(nil),
@@ -164,24 +345,41 @@ var _Base_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Version",
 			Handler:    _Base_Version_Handler,
 		},
+		{
+			MethodName: "SchemaVersion",
+			Handler:    _Base_SchemaVersion_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "base.proto",
 }
 
-func init() { proto.RegisterFile("base.proto", fileDescriptor_base_fabe7fe911e46744) }
-
-var fileDescriptor_base_fabe7fe911e46744 = []byte{
-	// 166 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0x4a, 0x4a, 0x2c, 0x4e,
-	0xd5, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62, 0x4e, 0x2c, 0xc8, 0x94, 0x92, 0x49, 0xcf, 0xcf,
-	0x4f, 0xcf, 0x49, 0xd5, 0x4f, 0x2c, 0xc8, 0xd4, 0x4f, 0xcc, 0xcb, 0xcb, 0x2f, 0x49, 0x2c, 0xc9,
-	0xcc, 0xcf, 0x2b, 0x86, 0x28, 0x51, 0x12, 0xe1, 0x12, 0x72, 0x4a, 0x2c, 0x4e, 0x0d, 0x4b, 0x2d,
-	0x2a, 0xce, 0xcc, 0xcf, 0x0b, 0x4a, 0x2d, 0x2c, 0x4d, 0x2d, 0x2e, 0x51, 0xd2, 0xe7, 0x12, 0x46,
-	0x11, 0x2d, 0x2e, 0xc8, 0xcf, 0x2b, 0x4e, 0x15, 0x92, 0xe0, 0x62, 0x2f, 0x83, 0x08, 0x49, 0x30,
-	0x2a, 0x30, 0x6a, 0x70, 0x06, 0xc1, 0xb8, 0x46, 0x91, 0x5c, 0x2c, 0x20, 0x0d, 0x42, 0x81, 0x5c,
-	0xec, 0x50, 0x4d, 0x42, 0xe2, 0x7a, 0x89, 0x05, 0x99, 0x7a, 0x98, 0x86, 0x4b, 0x49, 0x60, 0x4a,
-	0x40, 0xcc, 0x57, 0x12, 0x6e, 0xba, 0xfc, 0x64, 0x32, 0x13, 0xaf, 0x10, 0xb7, 0x7e, 0x99, 0xa1,
-	0x3e, 0xd4, 0xe8, 0x24, 0x36, 0xb0, 0x43, 0x8d, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0xf8, 0x3f,
-	0x46, 0xe5, 0xd9, 0x00, 0x00, 0x00,
+func init() { proto.RegisterFile("base.proto", fileDescriptor_base_aa6953d822392039) }
+
+var fileDescriptor_base_aa6953d822392039 = []byte{
+	// 377 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xcd, 0x4e, 0xe3, 0x30,
+	0x10, 0x80, 0x95, 0xa6, 0xdd, 0xb6, 0x53, 0xb5, 0x95, 0xdc, 0x48, 0xeb, 0xb5, 0xf6, 0x27, 0x8a,
+	0xb4, 0xda, 0x5c, 0x36, 0xd1, 0x76, 0xf7, 0xb2, 0x47, 0xb8, 0x73, 0x20, 0x20, 0xae, 0x95, 0x9b,
+	0x9a, 0xd6, 0xd0, 0xc4, 0x26, 0x76, 0x2a, 0x71, 0xe5, 0x15, 0xb8, 0x70, 0xe5, 0x61, 0x78, 0x02,
+	0x5e, 0x81, 0x07, 0x41, 0xb5, 0x93, 0x42, 0x7f, 0x38, 0xce, 0xcc, 0x67, 0xcf, 0x7c, 0x33, 0x00,
+	0x53, 0xaa, 0x58, 0x24, 0x0b, 0xa1, 0x05, 0x72, 0xa9, 0xe4, 0xe4, 0xeb, 0x5c, 0x88, 0xf9, 0x92,
+	0xc5, 0x54, 0xf2, 0x98, 0xe6, 0xb9, 0xd0, 0x54, 0x73, 0x91, 0x2b, 0x8b, 0x90, 0x1f, 0x55, 0xd5,
+	0x44, 0xd3, 0xf2, 0x32, 0xd6, 0x3c, 0x63, 0x4a, 0xd3, 0x4c, 0x5a, 0x20, 0xf0, 0x00, 0x1d, 0x53,
+	0xc5, 0x2e, 0x58, 0xa1, 0xb8, 0xc8, 0x13, 0x76, 0x53, 0x32, 0xa5, 0x83, 0x18, 0x46, 0x5b, 0x59,
+	0x25, 0x45, 0xae, 0x18, 0xc2, 0xd0, 0x5e, 0xd9, 0x14, 0x76, 0x7c, 0x27, 0xec, 0x26, 0x75, 0x18,
+	0x3c, 0x38, 0x30, 0x3c, 0x4b, 0x17, 0x2c, 0xa3, 0x27, 0x7c, 0x5e, 0x98, 0x11, 0x76, 0xe9, 0xd6,
+	0x86, 0x46, 0x04, 0x3a, 0xe9, 0x82, 0xa5, 0xd7, 0xaa, 0xcc, 0x70, 0xc3, 0x7c, 0xb4, 0x89, 0xd1,
+	0x7f, 0x00, 0x2a, 0xe5, 0x92, 0xb3, 0xd9, 0x84, 0x6a, 0xec, 0xfa, 0x4e, 0xd8, 0x1b, 0x93, 0xc8,
+	0x6a, 0x44, 0xb5, 0x46, 0x74, 0x5e, 0x6b, 0x24, 0xdd, 0x8a, 0x3e, 0xd2, 0xc8, 0x83, 0xd6, 0x8c,
+	0x17, 0xfa, 0x16, 0x37, 0x7d, 0x27, 0xec, 0x24, 0x36, 0x08, 0x08, 0xe0, 0xb5, 0x8b, 0x9d, 0x6e,
+	0xc7, 0xf3, 0xd1, 0x81, 0x2f, 0x07, 0x8a, 0x95, 0xee, 0x2f, 0x18, 0xa6, 0x65, 0x51, 0xb0, 0x5c,
+	0x4f, 0xb6, 0x45, 0x06, 0x55, 0xba, 0x7a, 0x80, 0x7e, 0xc2, 0x60, 0x49, 0x35, 0x53, 0x6f, 0x5c,
+	0xc3, 0x70, 0x7d, 0x9b, 0xad, 0xb1, 0x7f, 0x00, 0x59, 0xbd, 0x1d, 0x85, 0x5d, 0xdf, 0x0d, 0x7b,
+	0x63, 0x2f, 0xa2, 0x92, 0x47, 0x3b, 0xab, 0x4b, 0xde, 0x71, 0xe3, 0x27, 0x07, 0x9a, 0xeb, 0x19,
+	0xd1, 0x29, 0xb4, 0xeb, 0x9f, 0x3e, 0x9b, 0x57, 0xfb, 0x87, 0x23, 0x78, 0xbf, 0x60, 0x65, 0x82,
+	0xd1, 0xdd, 0xf3, 0xcb, 0x7d, 0xa3, 0x8f, 0x7a, 0xf1, 0xea, 0x4f, 0x5c, 0x1f, 0xe2, 0x0a, 0xfa,
+	0x5b, 0xea, 0xe8, 0xdb, 0xe6, 0xfd, 0xa1, 0x7d, 0x91, 0xef, 0x1f, 0x95, 0xab, 0x26, 0xc4, 0x34,
+	0xf1, 0x10, 0x5a, 0x37, 0x51, 0x06, 0xf9, 0x5d, 0xf5, 0x9a, 0x7e, 0x32, 0xc7, 0xfb, 0xfb, 0x3a,
+	0x00, 0x00, 0x30, 0x1c, 0x7d, 0xc2, 0x02, 0x00, 0x00,
 }
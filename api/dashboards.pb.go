@@ -0,0 +1,1038 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: dashboards.proto
+
+package api
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import _ "google.golang.org/genproto/googleapis/api/annotations"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type Dashboard struct {
+	Uid                  string   `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Title                string   `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Url                  string   `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	Tags                 []string `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	FolderUid            string   `protobuf:"bytes,5,opt,name=folder_uid,json=folderUid,proto3" json:"folder_uid,omitempty"`
+	FolderTitle          string   `protobuf:"bytes,6,opt,name=folder_title,json=folderTitle,proto3" json:"folder_title,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Dashboard) Reset()         { *m = Dashboard{} }
+func (m *Dashboard) String() string { return proto.CompactTextString(m) }
+func (*Dashboard) ProtoMessage()    {}
+func (*Dashboard) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dashboards_51abbe03c05c8706, []int{0}
+}
+func (m *Dashboard) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Dashboard.Unmarshal(m, b)
+}
+func (m *Dashboard) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Dashboard.Marshal(b, m, deterministic)
+}
+func (dst *Dashboard) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Dashboard.Merge(dst, src)
+}
+func (m *Dashboard) XXX_Size() int {
+	return xxx_messageInfo_Dashboard.Size(m)
+}
+func (m *Dashboard) XXX_DiscardUnknown() {
+	xxx_messageInfo_Dashboard.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Dashboard proto.InternalMessageInfo
+
+func (m *Dashboard) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *Dashboard) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *Dashboard) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *Dashboard) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *Dashboard) GetFolderUid() string {
+	if m != nil {
+		return m.FolderUid
+	}
+	return ""
+}
+
+func (m *Dashboard) GetFolderTitle() string {
+	if m != nil {
+		return m.FolderTitle
+	}
+	return ""
+}
+
+type DashboardFolder struct {
+	Uid                  string   `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Title                string   `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DashboardFolder) Reset()         { *m = DashboardFolder{} }
+func (m *DashboardFolder) String() string { return proto.CompactTextString(m) }
+func (*DashboardFolder) ProtoMessage()    {}
+func (*DashboardFolder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dashboards_51abbe03c05c8706, []int{1}
+}
+func (m *DashboardFolder) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DashboardFolder.Unmarshal(m, b)
+}
+func (m *DashboardFolder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DashboardFolder.Marshal(b, m, deterministic)
+}
+func (dst *DashboardFolder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DashboardFolder.Merge(dst, src)
+}
+func (m *DashboardFolder) XXX_Size() int {
+	return xxx_messageInfo_DashboardFolder.Size(m)
+}
+func (m *DashboardFolder) XXX_DiscardUnknown() {
+	xxx_messageInfo_DashboardFolder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DashboardFolder proto.InternalMessageInfo
+
+func (m *DashboardFolder) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *DashboardFolder) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+type DashboardsListRequest struct {
+	// List only dashboards in that folder; all dashboards are returned if empty.
+	FolderUid            string   `protobuf:"bytes,1,opt,name=folder_uid,json=folderUid,proto3" json:"folder_uid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DashboardsListRequest) Reset()         { *m = DashboardsListRequest{} }
+func (m *DashboardsListRequest) String() string { return proto.CompactTextString(m) }
+func (*DashboardsListRequest) ProtoMessage()    {}
+func (*DashboardsListRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dashboards_51abbe03c05c8706, []int{2}
+}
+func (m *DashboardsListRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DashboardsListRequest.Unmarshal(m, b)
+}
+func (m *DashboardsListRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DashboardsListRequest.Marshal(b, m, deterministic)
+}
+func (dst *DashboardsListRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DashboardsListRequest.Merge(dst, src)
+}
+func (m *DashboardsListRequest) XXX_Size() int {
+	return xxx_messageInfo_DashboardsListRequest.Size(m)
+}
+func (m *DashboardsListRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DashboardsListRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DashboardsListRequest proto.InternalMessageInfo
+
+func (m *DashboardsListRequest) GetFolderUid() string {
+	if m != nil {
+		return m.FolderUid
+	}
+	return ""
+}
+
+type DashboardsListResponse struct {
+	Dashboards           []*Dashboard `protobuf:"bytes,1,rep,name=dashboards,proto3" json:"dashboards,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *DashboardsListResponse) Reset()         { *m = DashboardsListResponse{} }
+func (m *DashboardsListResponse) String() string { return proto.CompactTextString(m) }
+func (*DashboardsListResponse) ProtoMessage()    {}
+func (*DashboardsListResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dashboards_51abbe03c05c8706, []int{3}
+}
+func (m *DashboardsListResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DashboardsListResponse.Unmarshal(m, b)
+}
+func (m *DashboardsListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DashboardsListResponse.Marshal(b, m, deterministic)
+}
+func (dst *DashboardsListResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DashboardsListResponse.Merge(dst, src)
+}
+func (m *DashboardsListResponse) XXX_Size() int {
+	return xxx_messageInfo_DashboardsListResponse.Size(m)
+}
+func (m *DashboardsListResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DashboardsListResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DashboardsListResponse proto.InternalMessageInfo
+
+func (m *DashboardsListResponse) GetDashboards() []*Dashboard {
+	if m != nil {
+		return m.Dashboards
+	}
+	return nil
+}
+
+type DashboardsExportRequest struct {
+	Uid                  string   `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DashboardsExportRequest) Reset()         { *m = DashboardsExportRequest{} }
+func (m *DashboardsExportRequest) String() string { return proto.CompactTextString(m) }
+func (*DashboardsExportRequest) ProtoMessage()    {}
+func (*DashboardsExportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dashboards_51abbe03c05c8706, []int{4}
+}
+func (m *DashboardsExportRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DashboardsExportRequest.Unmarshal(m, b)
+}
+func (m *DashboardsExportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DashboardsExportRequest.Marshal(b, m, deterministic)
+}
+func (dst *DashboardsExportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DashboardsExportRequest.Merge(dst, src)
+}
+func (m *DashboardsExportRequest) XXX_Size() int {
+	return xxx_messageInfo_DashboardsExportRequest.Size(m)
+}
+func (m *DashboardsExportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DashboardsExportRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DashboardsExportRequest proto.InternalMessageInfo
+
+func (m *DashboardsExportRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+type DashboardsExportResponse struct {
+	// Dashboard JSON model.
+	DashboardJson        string   `protobuf:"bytes,1,opt,name=dashboard_json,json=dashboardJson,proto3" json:"dashboard_json,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DashboardsExportResponse) Reset()         { *m = DashboardsExportResponse{} }
+func (m *DashboardsExportResponse) String() string { return proto.CompactTextString(m) }
+func (*DashboardsExportResponse) ProtoMessage()    {}
+func (*DashboardsExportResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dashboards_51abbe03c05c8706, []int{5}
+}
+func (m *DashboardsExportResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DashboardsExportResponse.Unmarshal(m, b)
+}
+func (m *DashboardsExportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DashboardsExportResponse.Marshal(b, m, deterministic)
+}
+func (dst *DashboardsExportResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DashboardsExportResponse.Merge(dst, src)
+}
+func (m *DashboardsExportResponse) XXX_Size() int {
+	return xxx_messageInfo_DashboardsExportResponse.Size(m)
+}
+func (m *DashboardsExportResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DashboardsExportResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DashboardsExportResponse proto.InternalMessageInfo
+
+func (m *DashboardsExportResponse) GetDashboardJson() string {
+	if m != nil {
+		return m.DashboardJson
+	}
+	return ""
+}
+
+type DashboardsImportRequest struct {
+	// Dashboard JSON model.
+	DashboardJson string `protobuf:"bytes,1,opt,name=dashboard_json,json=dashboardJson,proto3" json:"dashboard_json,omitempty"`
+	// Import dashboard into that folder; General folder is used if empty.
+	FolderUid string `protobuf:"bytes,2,opt,name=folder_uid,json=folderUid,proto3" json:"folder_uid,omitempty"`
+	// Overwrite existing dashboard with the same UID or title.
+	Overwrite            bool     `protobuf:"varint,3,opt,name=overwrite,proto3" json:"overwrite,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DashboardsImportRequest) Reset()         { *m = DashboardsImportRequest{} }
+func (m *DashboardsImportRequest) String() string { return proto.CompactTextString(m) }
+func (*DashboardsImportRequest) ProtoMessage()    {}
+func (*DashboardsImportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dashboards_51abbe03c05c8706, []int{6}
+}
+func (m *DashboardsImportRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DashboardsImportRequest.Unmarshal(m, b)
+}
+func (m *DashboardsImportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DashboardsImportRequest.Marshal(b, m, deterministic)
+}
+func (dst *DashboardsImportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DashboardsImportRequest.Merge(dst, src)
+}
+func (m *DashboardsImportRequest) XXX_Size() int {
+	return xxx_messageInfo_DashboardsImportRequest.Size(m)
+}
+func (m *DashboardsImportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DashboardsImportRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DashboardsImportRequest proto.InternalMessageInfo
+
+func (m *DashboardsImportRequest) GetDashboardJson() string {
+	if m != nil {
+		return m.DashboardJson
+	}
+	return ""
+}
+
+func (m *DashboardsImportRequest) GetFolderUid() string {
+	if m != nil {
+		return m.FolderUid
+	}
+	return ""
+}
+
+func (m *DashboardsImportRequest) GetOverwrite() bool {
+	if m != nil {
+		return m.Overwrite
+	}
+	return false
+}
+
+type DashboardsImportResponse struct {
+	Uid                  string   `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Url                  string   `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Version              int64    `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DashboardsImportResponse) Reset()         { *m = DashboardsImportResponse{} }
+func (m *DashboardsImportResponse) String() string { return proto.CompactTextString(m) }
+func (*DashboardsImportResponse) ProtoMessage()    {}
+func (*DashboardsImportResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dashboards_51abbe03c05c8706, []int{7}
+}
+func (m *DashboardsImportResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DashboardsImportResponse.Unmarshal(m, b)
+}
+func (m *DashboardsImportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DashboardsImportResponse.Marshal(b, m, deterministic)
+}
+func (dst *DashboardsImportResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DashboardsImportResponse.Merge(dst, src)
+}
+func (m *DashboardsImportResponse) XXX_Size() int {
+	return xxx_messageInfo_DashboardsImportResponse.Size(m)
+}
+func (m *DashboardsImportResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DashboardsImportResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DashboardsImportResponse proto.InternalMessageInfo
+
+func (m *DashboardsImportResponse) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *DashboardsImportResponse) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *DashboardsImportResponse) GetVersion() int64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+type DashboardsDeleteRequest struct {
+	Uid                  string   `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DashboardsDeleteRequest) Reset()         { *m = DashboardsDeleteRequest{} }
+func (m *DashboardsDeleteRequest) String() string { return proto.CompactTextString(m) }
+func (*DashboardsDeleteRequest) ProtoMessage()    {}
+func (*DashboardsDeleteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dashboards_51abbe03c05c8706, []int{8}
+}
+func (m *DashboardsDeleteRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DashboardsDeleteRequest.Unmarshal(m, b)
+}
+func (m *DashboardsDeleteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DashboardsDeleteRequest.Marshal(b, m, deterministic)
+}
+func (dst *DashboardsDeleteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DashboardsDeleteRequest.Merge(dst, src)
+}
+func (m *DashboardsDeleteRequest) XXX_Size() int {
+	return xxx_messageInfo_DashboardsDeleteRequest.Size(m)
+}
+func (m *DashboardsDeleteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DashboardsDeleteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DashboardsDeleteRequest proto.InternalMessageInfo
+
+func (m *DashboardsDeleteRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+type DashboardsDeleteResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DashboardsDeleteResponse) Reset()         { *m = DashboardsDeleteResponse{} }
+func (m *DashboardsDeleteResponse) String() string { return proto.CompactTextString(m) }
+func (*DashboardsDeleteResponse) ProtoMessage()    {}
+func (*DashboardsDeleteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dashboards_51abbe03c05c8706, []int{9}
+}
+func (m *DashboardsDeleteResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DashboardsDeleteResponse.Unmarshal(m, b)
+}
+func (m *DashboardsDeleteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DashboardsDeleteResponse.Marshal(b, m, deterministic)
+}
+func (dst *DashboardsDeleteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DashboardsDeleteResponse.Merge(dst, src)
+}
+func (m *DashboardsDeleteResponse) XXX_Size() int {
+	return xxx_messageInfo_DashboardsDeleteResponse.Size(m)
+}
+func (m *DashboardsDeleteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DashboardsDeleteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DashboardsDeleteResponse proto.InternalMessageInfo
+
+type DashboardsListFoldersRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DashboardsListFoldersRequest) Reset()         { *m = DashboardsListFoldersRequest{} }
+func (m *DashboardsListFoldersRequest) String() string { return proto.CompactTextString(m) }
+func (*DashboardsListFoldersRequest) ProtoMessage()    {}
+func (*DashboardsListFoldersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dashboards_51abbe03c05c8706, []int{10}
+}
+func (m *DashboardsListFoldersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DashboardsListFoldersRequest.Unmarshal(m, b)
+}
+func (m *DashboardsListFoldersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DashboardsListFoldersRequest.Marshal(b, m, deterministic)
+}
+func (dst *DashboardsListFoldersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DashboardsListFoldersRequest.Merge(dst, src)
+}
+func (m *DashboardsListFoldersRequest) XXX_Size() int {
+	return xxx_messageInfo_DashboardsListFoldersRequest.Size(m)
+}
+func (m *DashboardsListFoldersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DashboardsListFoldersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DashboardsListFoldersRequest proto.InternalMessageInfo
+
+type DashboardsListFoldersResponse struct {
+	Folders              []*DashboardFolder `protobuf:"bytes,1,rep,name=folders,proto3" json:"folders,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *DashboardsListFoldersResponse) Reset()         { *m = DashboardsListFoldersResponse{} }
+func (m *DashboardsListFoldersResponse) String() string { return proto.CompactTextString(m) }
+func (*DashboardsListFoldersResponse) ProtoMessage()    {}
+func (*DashboardsListFoldersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dashboards_51abbe03c05c8706, []int{11}
+}
+func (m *DashboardsListFoldersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DashboardsListFoldersResponse.Unmarshal(m, b)
+}
+func (m *DashboardsListFoldersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DashboardsListFoldersResponse.Marshal(b, m, deterministic)
+}
+func (dst *DashboardsListFoldersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DashboardsListFoldersResponse.Merge(dst, src)
+}
+func (m *DashboardsListFoldersResponse) XXX_Size() int {
+	return xxx_messageInfo_DashboardsListFoldersResponse.Size(m)
+}
+func (m *DashboardsListFoldersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DashboardsListFoldersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DashboardsListFoldersResponse proto.InternalMessageInfo
+
+func (m *DashboardsListFoldersResponse) GetFolders() []*DashboardFolder {
+	if m != nil {
+		return m.Folders
+	}
+	return nil
+}
+
+type DashboardsCreateFolderRequest struct {
+	// Folder UID; generated by Grafana if empty.
+	Uid                  string   `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Title                string   `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DashboardsCreateFolderRequest) Reset()         { *m = DashboardsCreateFolderRequest{} }
+func (m *DashboardsCreateFolderRequest) String() string { return proto.CompactTextString(m) }
+func (*DashboardsCreateFolderRequest) ProtoMessage()    {}
+func (*DashboardsCreateFolderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dashboards_51abbe03c05c8706, []int{12}
+}
+func (m *DashboardsCreateFolderRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DashboardsCreateFolderRequest.Unmarshal(m, b)
+}
+func (m *DashboardsCreateFolderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DashboardsCreateFolderRequest.Marshal(b, m, deterministic)
+}
+func (dst *DashboardsCreateFolderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DashboardsCreateFolderRequest.Merge(dst, src)
+}
+func (m *DashboardsCreateFolderRequest) XXX_Size() int {
+	return xxx_messageInfo_DashboardsCreateFolderRequest.Size(m)
+}
+func (m *DashboardsCreateFolderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DashboardsCreateFolderRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DashboardsCreateFolderRequest proto.InternalMessageInfo
+
+func (m *DashboardsCreateFolderRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+func (m *DashboardsCreateFolderRequest) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+type DashboardsCreateFolderResponse struct {
+	Folder               *DashboardFolder `protobuf:"bytes,1,opt,name=folder,proto3" json:"folder,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *DashboardsCreateFolderResponse) Reset()         { *m = DashboardsCreateFolderResponse{} }
+func (m *DashboardsCreateFolderResponse) String() string { return proto.CompactTextString(m) }
+func (*DashboardsCreateFolderResponse) ProtoMessage()    {}
+func (*DashboardsCreateFolderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dashboards_51abbe03c05c8706, []int{13}
+}
+func (m *DashboardsCreateFolderResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DashboardsCreateFolderResponse.Unmarshal(m, b)
+}
+func (m *DashboardsCreateFolderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DashboardsCreateFolderResponse.Marshal(b, m, deterministic)
+}
+func (dst *DashboardsCreateFolderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DashboardsCreateFolderResponse.Merge(dst, src)
+}
+func (m *DashboardsCreateFolderResponse) XXX_Size() int {
+	return xxx_messageInfo_DashboardsCreateFolderResponse.Size(m)
+}
+func (m *DashboardsCreateFolderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DashboardsCreateFolderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DashboardsCreateFolderResponse proto.InternalMessageInfo
+
+func (m *DashboardsCreateFolderResponse) GetFolder() *DashboardFolder {
+	if m != nil {
+		return m.Folder
+	}
+	return nil
+}
+
+type DashboardsDeleteFolderRequest struct {
+	Uid                  string   `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DashboardsDeleteFolderRequest) Reset()         { *m = DashboardsDeleteFolderRequest{} }
+func (m *DashboardsDeleteFolderRequest) String() string { return proto.CompactTextString(m) }
+func (*DashboardsDeleteFolderRequest) ProtoMessage()    {}
+func (*DashboardsDeleteFolderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dashboards_51abbe03c05c8706, []int{14}
+}
+func (m *DashboardsDeleteFolderRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DashboardsDeleteFolderRequest.Unmarshal(m, b)
+}
+func (m *DashboardsDeleteFolderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DashboardsDeleteFolderRequest.Marshal(b, m, deterministic)
+}
+func (dst *DashboardsDeleteFolderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DashboardsDeleteFolderRequest.Merge(dst, src)
+}
+func (m *DashboardsDeleteFolderRequest) XXX_Size() int {
+	return xxx_messageInfo_DashboardsDeleteFolderRequest.Size(m)
+}
+func (m *DashboardsDeleteFolderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DashboardsDeleteFolderRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DashboardsDeleteFolderRequest proto.InternalMessageInfo
+
+func (m *DashboardsDeleteFolderRequest) GetUid() string {
+	if m != nil {
+		return m.Uid
+	}
+	return ""
+}
+
+type DashboardsDeleteFolderResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DashboardsDeleteFolderResponse) Reset()         { *m = DashboardsDeleteFolderResponse{} }
+func (m *DashboardsDeleteFolderResponse) String() string { return proto.CompactTextString(m) }
+func (*DashboardsDeleteFolderResponse) ProtoMessage()    {}
+func (*DashboardsDeleteFolderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dashboards_51abbe03c05c8706, []int{15}
+}
+func (m *DashboardsDeleteFolderResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DashboardsDeleteFolderResponse.Unmarshal(m, b)
+}
+func (m *DashboardsDeleteFolderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DashboardsDeleteFolderResponse.Marshal(b, m, deterministic)
+}
+func (dst *DashboardsDeleteFolderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DashboardsDeleteFolderResponse.Merge(dst, src)
+}
+func (m *DashboardsDeleteFolderResponse) XXX_Size() int {
+	return xxx_messageInfo_DashboardsDeleteFolderResponse.Size(m)
+}
+func (m *DashboardsDeleteFolderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DashboardsDeleteFolderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DashboardsDeleteFolderResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*Dashboard)(nil), "api.Dashboard")
+	proto.RegisterType((*DashboardFolder)(nil), "api.DashboardFolder")
+	proto.RegisterType((*DashboardsListRequest)(nil), "api.DashboardsListRequest")
+	proto.RegisterType((*DashboardsListResponse)(nil), "api.DashboardsListResponse")
+	proto.RegisterType((*DashboardsExportRequest)(nil), "api.DashboardsExportRequest")
+	proto.RegisterType((*DashboardsExportResponse)(nil), "api.DashboardsExportResponse")
+	proto.RegisterType((*DashboardsImportRequest)(nil), "api.DashboardsImportRequest")
+	proto.RegisterType((*DashboardsImportResponse)(nil), "api.DashboardsImportResponse")
+	proto.RegisterType((*DashboardsDeleteRequest)(nil), "api.DashboardsDeleteRequest")
+	proto.RegisterType((*DashboardsDeleteResponse)(nil), "api.DashboardsDeleteResponse")
+	proto.RegisterType((*DashboardsListFoldersRequest)(nil), "api.DashboardsListFoldersRequest")
+	proto.RegisterType((*DashboardsListFoldersResponse)(nil), "api.DashboardsListFoldersResponse")
+	proto.RegisterType((*DashboardsCreateFolderRequest)(nil), "api.DashboardsCreateFolderRequest")
+	proto.RegisterType((*DashboardsCreateFolderResponse)(nil), "api.DashboardsCreateFolderResponse")
+	proto.RegisterType((*DashboardsDeleteFolderRequest)(nil), "api.DashboardsDeleteFolderRequest")
+	proto.RegisterType((*DashboardsDeleteFolderResponse)(nil), "api.DashboardsDeleteFolderResponse")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// DashboardsClient is the client API for Dashboards service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type DashboardsClient interface {
+	List(ctx context.Context, in *DashboardsListRequest, opts ...grpc.CallOption) (*DashboardsListResponse, error)
+	Export(ctx context.Context, in *DashboardsExportRequest, opts ...grpc.CallOption) (*DashboardsExportResponse, error)
+	Import(ctx context.Context, in *DashboardsImportRequest, opts ...grpc.CallOption) (*DashboardsImportResponse, error)
+	Delete(ctx context.Context, in *DashboardsDeleteRequest, opts ...grpc.CallOption) (*DashboardsDeleteResponse, error)
+	ListFolders(ctx context.Context, in *DashboardsListFoldersRequest, opts ...grpc.CallOption) (*DashboardsListFoldersResponse, error)
+	CreateFolder(ctx context.Context, in *DashboardsCreateFolderRequest, opts ...grpc.CallOption) (*DashboardsCreateFolderResponse, error)
+	DeleteFolder(ctx context.Context, in *DashboardsDeleteFolderRequest, opts ...grpc.CallOption) (*DashboardsDeleteFolderResponse, error)
+}
+
+type dashboardsClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewDashboardsClient(cc *grpc.ClientConn) DashboardsClient {
+	return &dashboardsClient{cc}
+}
+
+func (c *dashboardsClient) List(ctx context.Context, in *DashboardsListRequest, opts ...grpc.CallOption) (*DashboardsListResponse, error) {
+	out := new(DashboardsListResponse)
+	err := c.cc.Invoke(ctx, "/api.Dashboards/List", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dashboardsClient) Export(ctx context.Context, in *DashboardsExportRequest, opts ...grpc.CallOption) (*DashboardsExportResponse, error) {
+	out := new(DashboardsExportResponse)
+	err := c.cc.Invoke(ctx, "/api.Dashboards/Export", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dashboardsClient) Import(ctx context.Context, in *DashboardsImportRequest, opts ...grpc.CallOption) (*DashboardsImportResponse, error) {
+	out := new(DashboardsImportResponse)
+	err := c.cc.Invoke(ctx, "/api.Dashboards/Import", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dashboardsClient) Delete(ctx context.Context, in *DashboardsDeleteRequest, opts ...grpc.CallOption) (*DashboardsDeleteResponse, error) {
+	out := new(DashboardsDeleteResponse)
+	err := c.cc.Invoke(ctx, "/api.Dashboards/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dashboardsClient) ListFolders(ctx context.Context, in *DashboardsListFoldersRequest, opts ...grpc.CallOption) (*DashboardsListFoldersResponse, error) {
+	out := new(DashboardsListFoldersResponse)
+	err := c.cc.Invoke(ctx, "/api.Dashboards/ListFolders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dashboardsClient) CreateFolder(ctx context.Context, in *DashboardsCreateFolderRequest, opts ...grpc.CallOption) (*DashboardsCreateFolderResponse, error) {
+	out := new(DashboardsCreateFolderResponse)
+	err := c.cc.Invoke(ctx, "/api.Dashboards/CreateFolder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dashboardsClient) DeleteFolder(ctx context.Context, in *DashboardsDeleteFolderRequest, opts ...grpc.CallOption) (*DashboardsDeleteFolderResponse, error) {
+	out := new(DashboardsDeleteFolderResponse)
+	err := c.cc.Invoke(ctx, "/api.Dashboards/DeleteFolder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DashboardsServer is the server API for Dashboards service.
+type DashboardsServer interface {
+	List(context.Context, *DashboardsListRequest) (*DashboardsListResponse, error)
+	Export(context.Context, *DashboardsExportRequest) (*DashboardsExportResponse, error)
+	Import(context.Context, *DashboardsImportRequest) (*DashboardsImportResponse, error)
+	Delete(context.Context, *DashboardsDeleteRequest) (*DashboardsDeleteResponse, error)
+	ListFolders(context.Context, *DashboardsListFoldersRequest) (*DashboardsListFoldersResponse, error)
+	CreateFolder(context.Context, *DashboardsCreateFolderRequest) (*DashboardsCreateFolderResponse, error)
+	DeleteFolder(context.Context, *DashboardsDeleteFolderRequest) (*DashboardsDeleteFolderResponse, error)
+}
+
+func RegisterDashboardsServer(s *grpc.Server, srv DashboardsServer) {
+	s.RegisterService(&_Dashboards_serviceDesc, srv)
+}
+
+func _Dashboards_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DashboardsListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DashboardsServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Dashboards/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DashboardsServer).List(ctx, req.(*DashboardsListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dashboards_Export_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DashboardsExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DashboardsServer).Export(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Dashboards/Export",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DashboardsServer).Export(ctx, req.(*DashboardsExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dashboards_Import_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DashboardsImportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DashboardsServer).Import(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Dashboards/Import",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DashboardsServer).Import(ctx, req.(*DashboardsImportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dashboards_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DashboardsDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DashboardsServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Dashboards/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DashboardsServer).Delete(ctx, req.(*DashboardsDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dashboards_ListFolders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DashboardsListFoldersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DashboardsServer).ListFolders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Dashboards/ListFolders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DashboardsServer).ListFolders(ctx, req.(*DashboardsListFoldersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dashboards_CreateFolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DashboardsCreateFolderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DashboardsServer).CreateFolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Dashboards/CreateFolder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DashboardsServer).CreateFolder(ctx, req.(*DashboardsCreateFolderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Dashboards_DeleteFolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DashboardsDeleteFolderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DashboardsServer).DeleteFolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Dashboards/DeleteFolder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DashboardsServer).DeleteFolder(ctx, req.(*DashboardsDeleteFolderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Dashboards_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "api.Dashboards",
+	HandlerType: (*DashboardsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "List",
+			Handler:    _Dashboards_List_Handler,
+		},
+		{
+			MethodName: "Export",
+			Handler:    _Dashboards_Export_Handler,
+		},
+		{
+			MethodName: "Import",
+			Handler:    _Dashboards_Import_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _Dashboards_Delete_Handler,
+		},
+		{
+			MethodName: "ListFolders",
+			Handler:    _Dashboards_ListFolders_Handler,
+		},
+		{
+			MethodName: "CreateFolder",
+			Handler:    _Dashboards_CreateFolder_Handler,
+		},
+		{
+			MethodName: "DeleteFolder",
+			Handler:    _Dashboards_DeleteFolder_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "dashboards.proto",
+}
+
+func init() { proto.RegisterFile("dashboards.proto", fileDescriptor_dashboards_51abbe03c05c8706) }
+
+var fileDescriptor_dashboards_51abbe03c05c8706 = []byte{
+	// 634 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0x5d, 0x8f, 0xd2, 0x40,
+	0x14, 0x4d, 0xe9, 0x2e, 0x2b, 0x77, 0x11, 0xc9, 0xc8, 0xb2, 0xb5, 0x4b, 0x09, 0x3b, 0xc4, 0x84,
+	0xa0, 0x82, 0xae, 0x89, 0x89, 0xbe, 0x19, 0xd7, 0x0f, 0x8c, 0xd1, 0xa4, 0xd1, 0xe8, 0xdb, 0xda,
+	0xb5, 0x23, 0xd6, 0x94, 0x4e, 0xed, 0x14, 0xd4, 0xe8, 0xbe, 0xf8, 0x17, 0x7c, 0xf4, 0x67, 0xf9,
+	0x17, 0xfc, 0x1f, 0x9a, 0xce, 0x0c, 0xfd, 0x2e, 0xee, 0x1b, 0xbd, 0x73, 0xee, 0x39, 0x67, 0xee,
+	0x3d, 0x13, 0xa0, 0x6d, 0x5b, 0xec, 0xc3, 0x29, 0xb5, 0x02, 0x9b, 0x4d, 0xfc, 0x80, 0x86, 0x14,
+	0xa9, 0x96, 0xef, 0xe8, 0xbd, 0x39, 0xa5, 0x73, 0x97, 0x4c, 0x2d, 0xdf, 0x99, 0x5a, 0x9e, 0x47,
+	0x43, 0x2b, 0x74, 0xa8, 0x27, 0x21, 0xf8, 0x97, 0x02, 0x8d, 0xe3, 0x75, 0x1f, 0x6a, 0x83, 0xba,
+	0x74, 0x6c, 0x4d, 0x19, 0x28, 0xa3, 0x86, 0x19, 0xfd, 0x44, 0x1d, 0xd8, 0x0e, 0x9d, 0xd0, 0x25,
+	0x5a, 0x8d, 0xd7, 0xc4, 0x07, 0xc7, 0x05, 0xae, 0xa6, 0x4a, 0x5c, 0xe0, 0x22, 0x04, 0x5b, 0xa1,
+	0x35, 0x67, 0xda, 0xd6, 0x40, 0x1d, 0x35, 0x4c, 0xfe, 0x1b, 0x19, 0x00, 0xef, 0xa9, 0x6b, 0x93,
+	0xe0, 0x24, 0x22, 0xdd, 0xe6, 0xe0, 0x86, 0xa8, 0xbc, 0x72, 0x6c, 0x74, 0x08, 0x4d, 0x79, 0x2c,
+	0x14, 0xea, 0x1c, 0xb0, 0x2b, 0x6a, 0x2f, 0xa3, 0x12, 0xbe, 0x0b, 0x97, 0x62, 0x73, 0x8f, 0x78,
+	0xfd, 0xbc, 0x16, 0xf1, 0x1d, 0xd8, 0x8b, 0x5b, 0xd9, 0x33, 0x87, 0x85, 0x26, 0xf9, 0xb4, 0x24,
+	0x2c, 0xcc, 0xb9, 0x52, 0x72, 0xae, 0xf0, 0x13, 0xe8, 0xe6, 0xfb, 0x98, 0x4f, 0x3d, 0x46, 0xd0,
+	0x04, 0x20, 0x99, 0xb0, 0xa6, 0x0c, 0xd4, 0xd1, 0xee, 0x51, 0x6b, 0x62, 0xf9, 0xce, 0x24, 0x6e,
+	0x30, 0x53, 0x08, 0x7c, 0x0d, 0xf6, 0x13, 0xa6, 0x87, 0x5f, 0x7c, 0x1a, 0xc4, 0x1e, 0x0a, 0x97,
+	0xc0, 0xf7, 0x41, 0x2b, 0x82, 0xa5, 0xf0, 0x55, 0x68, 0xc5, 0xb4, 0x27, 0x1f, 0x19, 0xf5, 0x64,
+	0xe3, 0xc5, 0xb8, 0xfa, 0x94, 0x51, 0x0f, 0x9f, 0xa5, 0xf5, 0x66, 0x8b, 0xb4, 0xde, 0xf9, 0x18,
+	0x72, 0xa3, 0xa9, 0xe5, 0x17, 0xd6, 0x83, 0x06, 0x5d, 0x91, 0xe0, 0x73, 0xe0, 0x84, 0x84, 0xef,
+	0xfe, 0x82, 0x99, 0x14, 0xf0, 0x1b, 0xd0, 0x8a, 0xf2, 0xf2, 0x06, 0xc5, 0xa5, 0xc9, 0x04, 0xd5,
+	0x92, 0x04, 0x69, 0xb0, 0xb3, 0x22, 0x01, 0x73, 0xa8, 0xc7, 0xb9, 0x55, 0x73, 0xfd, 0x99, 0x1d,
+	0xe4, 0x31, 0x71, 0x49, 0x48, 0xaa, 0x07, 0xa9, 0x83, 0x56, 0x04, 0x0b, 0x1b, 0xb8, 0x0f, 0xbd,
+	0xec, 0x6e, 0x45, 0xa6, 0x98, 0x64, 0xc3, 0x2f, 0xc0, 0xa8, 0x38, 0x8f, 0x23, 0xb0, 0x23, 0xc6,
+	0xb1, 0xde, 0x7f, 0x27, 0xbb, 0x7f, 0x81, 0x37, 0xd7, 0x20, 0xfc, 0x38, 0x4d, 0xf8, 0x20, 0x20,
+	0x56, 0x48, 0x24, 0xa4, 0xca, 0x7f, 0x45, 0x9a, 0x9f, 0x43, 0xbf, 0x8a, 0x48, 0x5a, 0xbb, 0x0e,
+	0x75, 0xa1, 0xca, 0xc9, 0xaa, 0x9c, 0x49, 0x0c, 0xbe, 0x05, 0x46, 0x7e, 0x4a, 0xff, 0x31, 0x86,
+	0x07, 0xd0, 0xaf, 0x6a, 0x11, 0x16, 0x8e, 0xfe, 0x6e, 0x03, 0x24, 0x10, 0xf4, 0x1a, 0xb6, 0xa2,
+	0x19, 0x22, 0x3d, 0xeb, 0x24, 0xfd, 0x18, 0xf5, 0x83, 0xd2, 0x33, 0xb9, 0xae, 0xee, 0x8f, 0xdf,
+	0x7f, 0x7e, 0xd6, 0xda, 0xa8, 0x35, 0x5d, 0xdd, 0x9c, 0x26, 0x0f, 0x0b, 0xbd, 0x83, 0xba, 0x78,
+	0x21, 0xa8, 0x97, 0x6b, 0xcf, 0xbc, 0x32, 0xdd, 0xa8, 0x38, 0x95, 0xf4, 0x3d, 0x4e, 0xdf, 0x45,
+	0x9d, 0x2c, 0xfd, 0xf4, 0xdb, 0xd2, 0xb1, 0xcf, 0xd0, 0x5b, 0xa8, 0xcf, 0x16, 0xa5, 0x22, 0xb3,
+	0xc5, 0x26, 0x91, 0x6c, 0xf2, 0xf1, 0x15, 0x2e, 0x72, 0x19, 0xe7, 0xee, 0x70, 0x4f, 0x19, 0x47,
+	0xd7, 0x10, 0x63, 0x2c, 0x28, 0x64, 0x32, 0xae, 0x1b, 0x15, 0xa7, 0xd9, 0x6b, 0x8c, 0xcb, 0xaf,
+	0xc1, 0x60, 0x37, 0x15, 0x64, 0x74, 0x58, 0x32, 0xef, 0xec, 0x23, 0xd0, 0xf1, 0x26, 0x88, 0xd4,
+	0x34, 0xb8, 0xe6, 0x3e, 0xda, 0xcb, 0x68, 0xde, 0x90, 0xb1, 0x47, 0x5f, 0xa1, 0x99, 0xce, 0x28,
+	0xca, 0x53, 0x96, 0xbc, 0x04, 0x7d, 0xb8, 0x11, 0x23, 0x75, 0x07, 0x5c, 0x57, 0xc7, 0xe5, 0xba,
+	0xd1, 0x50, 0xbf, 0x43, 0x33, 0x9d, 0xcd, 0x82, 0x74, 0x49, 0xd6, 0xf5, 0xe1, 0x46, 0x8c, 0x94,
+	0x1e, 0x72, 0x69, 0x63, 0x7c, 0x50, 0x2a, 0x2d, 0xa6, 0x7d, 0x5a, 0xe7, 0x7f, 0xaa, 0xb7, 0xff,
+	0x0d, 0x00, 0x7f, 0xc6, 0x6c, 0x9b, 0x8b, 0x07, 0x00, 0x00,
+}
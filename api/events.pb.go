@@ -0,0 +1,477 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: events.proto
+
+package api
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import timestamp "github.com/golang/protobuf/ptypes/timestamp"
+import _ "google.golang.org/genproto/googleapis/api/annotations"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type Event_Type int32
+
+const (
+	Event_UNKNOWN         Event_Type = 0
+	Event_NODE_ADDED      Event_Type = 1
+	Event_NODE_REMOVED    Event_Type = 2
+	Event_SERVICE_ADDED   Event_Type = 3
+	Event_SERVICE_REMOVED Event_Type = 4
+	Event_AGENT_ADDED     Event_Type = 5
+	Event_AGENT_REMOVED   Event_Type = 6
+	// Agent's supervisor process state changed, see agent_state.
+	Event_AGENT_STATE_CHANGED   Event_Type = 7
+	Event_SCRAPE_CONFIG_CREATED Event_Type = 8
+	Event_SCRAPE_CONFIG_UPDATED Event_Type = 9
+	Event_SCRAPE_CONFIG_DELETED Event_Type = 10
+	Event_PROMETHEUS_RELOADED   Event_Type = 11
+	// Managed scrape target health changed to down, see job_name and instance.
+	Event_TARGET_DOWN Event_Type = 12
+)
+
+var Event_Type_name = map[int32]string{
+	0:  "UNKNOWN",
+	1:  "NODE_ADDED",
+	2:  "NODE_REMOVED",
+	3:  "SERVICE_ADDED",
+	4:  "SERVICE_REMOVED",
+	5:  "AGENT_ADDED",
+	6:  "AGENT_REMOVED",
+	7:  "AGENT_STATE_CHANGED",
+	8:  "SCRAPE_CONFIG_CREATED",
+	9:  "SCRAPE_CONFIG_UPDATED",
+	10: "SCRAPE_CONFIG_DELETED",
+	11: "PROMETHEUS_RELOADED",
+	12: "TARGET_DOWN",
+}
+var Event_Type_value = map[string]int32{
+	"UNKNOWN":               0,
+	"NODE_ADDED":            1,
+	"NODE_REMOVED":          2,
+	"SERVICE_ADDED":         3,
+	"SERVICE_REMOVED":       4,
+	"AGENT_ADDED":           5,
+	"AGENT_REMOVED":         6,
+	"AGENT_STATE_CHANGED":   7,
+	"SCRAPE_CONFIG_CREATED": 8,
+	"SCRAPE_CONFIG_UPDATED": 9,
+	"SCRAPE_CONFIG_DELETED": 10,
+	"PROMETHEUS_RELOADED":   11,
+	"TARGET_DOWN":           12,
+}
+
+func (x Event_Type) String() string {
+	return proto.EnumName(Event_Type_name, int32(x))
+}
+func (Event_Type) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_events_d828a50b1176ca37, []int{0, 0}
+}
+
+type Event struct {
+	// Sequence number; pass the last received one with stream_id to Subscribe to resume after reconnect
+	Seq uint64 `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
+	// Event stream ID; it changes when pmm-managed is restarted and sequence numbers start over
+	StreamId string `protobuf:"bytes,14,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	// Time when event was published
+	Time *timestamp.Timestamp `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`
+	Type Event_Type           `protobuf:"varint,3,opt,name=type,proto3,enum=api.Event_Type" json:"type,omitempty"`
+	// Node and service of added or removed instance; set for node, service and agent add/remove events
+	NodeId      int32  `protobuf:"varint,4,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	NodeType    string `protobuf:"bytes,5,opt,name=node_type,json=nodeType,proto3" json:"node_type,omitempty"`
+	NodeName    string `protobuf:"bytes,6,opt,name=node_name,json=nodeName,proto3" json:"node_name,omitempty"`
+	ServiceId   int32  `protobuf:"varint,7,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	ServiceType string `protobuf:"bytes,8,opt,name=service_type,json=serviceType,proto3" json:"service_type,omitempty"`
+	// Set for agent events
+	AgentId   int32  `protobuf:"varint,9,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
+	AgentType string `protobuf:"bytes,10,opt,name=agent_type,json=agentType,proto3" json:"agent_type,omitempty"`
+	// New process state; set for AGENT_STATE_CHANGED events
+	AgentState AgentStatus_State `protobuf:"varint,11,opt,name=agent_state,json=agentState,proto3,enum=api.AgentStatus_State" json:"agent_state,omitempty"`
+	// Scrape config job name; set for scrape config and TARGET_DOWN events
+	JobName string `protobuf:"bytes,12,opt,name=job_name,json=jobName,proto3" json:"job_name,omitempty"`
+	// Scrape target instance label value; set for TARGET_DOWN events
+	Instance             string   `protobuf:"bytes,13,opt,name=instance,proto3" json:"instance,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Event) Reset()         { *m = Event{} }
+func (m *Event) String() string { return proto.CompactTextString(m) }
+func (*Event) ProtoMessage()    {}
+func (*Event) Descriptor() ([]byte, []int) {
+	return fileDescriptor_events_d828a50b1176ca37, []int{0}
+}
+func (m *Event) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Event.Unmarshal(m, b)
+}
+func (m *Event) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Event.Marshal(b, m, deterministic)
+}
+func (dst *Event) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Event.Merge(dst, src)
+}
+func (m *Event) XXX_Size() int {
+	return xxx_messageInfo_Event.Size(m)
+}
+func (m *Event) XXX_DiscardUnknown() {
+	xxx_messageInfo_Event.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Event proto.InternalMessageInfo
+
+func (m *Event) GetSeq() uint64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+func (m *Event) GetStreamId() string {
+	if m != nil {
+		return m.StreamId
+	}
+	return ""
+}
+
+func (m *Event) GetTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.Time
+	}
+	return nil
+}
+
+func (m *Event) GetType() Event_Type {
+	if m != nil {
+		return m.Type
+	}
+	return Event_UNKNOWN
+}
+
+func (m *Event) GetNodeId() int32 {
+	if m != nil {
+		return m.NodeId
+	}
+	return 0
+}
+
+func (m *Event) GetNodeType() string {
+	if m != nil {
+		return m.NodeType
+	}
+	return ""
+}
+
+func (m *Event) GetNodeName() string {
+	if m != nil {
+		return m.NodeName
+	}
+	return ""
+}
+
+func (m *Event) GetServiceId() int32 {
+	if m != nil {
+		return m.ServiceId
+	}
+	return 0
+}
+
+func (m *Event) GetServiceType() string {
+	if m != nil {
+		return m.ServiceType
+	}
+	return ""
+}
+
+func (m *Event) GetAgentId() int32 {
+	if m != nil {
+		return m.AgentId
+	}
+	return 0
+}
+
+func (m *Event) GetAgentType() string {
+	if m != nil {
+		return m.AgentType
+	}
+	return ""
+}
+
+func (m *Event) GetAgentState() AgentStatus_State {
+	if m != nil {
+		return m.AgentState
+	}
+	return AgentStatus_UNKNOWN
+}
+
+func (m *Event) GetJobName() string {
+	if m != nil {
+		return m.JobName
+	}
+	return ""
+}
+
+func (m *Event) GetInstance() string {
+	if m != nil {
+		return m.Instance
+	}
+	return ""
+}
+
+type EventsSubscribeRequest struct {
+	// Return events after that sequence number first (typically, the last received one);
+	// only new events are returned if not set.
+	// OutOfRange error is returned if some of those events are not available, and client should resynchronize its state.
+	SinceSeq uint64 `protobuf:"varint,1,opt,name=since_seq,json=sinceSeq,proto3" json:"since_seq,omitempty"`
+	// Event stream ID of the last received event; required if since_seq is set.
+	// Aborted error is returned if it does not match the current stream (pmm-managed was restarted),
+	// and client should resynchronize its state.
+	StreamId             string   `protobuf:"bytes,2,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EventsSubscribeRequest) Reset()         { *m = EventsSubscribeRequest{} }
+func (m *EventsSubscribeRequest) String() string { return proto.CompactTextString(m) }
+func (*EventsSubscribeRequest) ProtoMessage()    {}
+func (*EventsSubscribeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_events_d828a50b1176ca37, []int{1}
+}
+func (m *EventsSubscribeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EventsSubscribeRequest.Unmarshal(m, b)
+}
+func (m *EventsSubscribeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EventsSubscribeRequest.Marshal(b, m, deterministic)
+}
+func (dst *EventsSubscribeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventsSubscribeRequest.Merge(dst, src)
+}
+func (m *EventsSubscribeRequest) XXX_Size() int {
+	return xxx_messageInfo_EventsSubscribeRequest.Size(m)
+}
+func (m *EventsSubscribeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventsSubscribeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventsSubscribeRequest proto.InternalMessageInfo
+
+func (m *EventsSubscribeRequest) GetSinceSeq() uint64 {
+	if m != nil {
+		return m.SinceSeq
+	}
+	return 0
+}
+
+func (m *EventsSubscribeRequest) GetStreamId() string {
+	if m != nil {
+		return m.StreamId
+	}
+	return ""
+}
+
+type EventsSubscribeResponse struct {
+	Event                *Event   `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EventsSubscribeResponse) Reset()         { *m = EventsSubscribeResponse{} }
+func (m *EventsSubscribeResponse) String() string { return proto.CompactTextString(m) }
+func (*EventsSubscribeResponse) ProtoMessage()    {}
+func (*EventsSubscribeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_events_d828a50b1176ca37, []int{2}
+}
+func (m *EventsSubscribeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EventsSubscribeResponse.Unmarshal(m, b)
+}
+func (m *EventsSubscribeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EventsSubscribeResponse.Marshal(b, m, deterministic)
+}
+func (dst *EventsSubscribeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventsSubscribeResponse.Merge(dst, src)
+}
+func (m *EventsSubscribeResponse) XXX_Size() int {
+	return xxx_messageInfo_EventsSubscribeResponse.Size(m)
+}
+func (m *EventsSubscribeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventsSubscribeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventsSubscribeResponse proto.InternalMessageInfo
+
+func (m *EventsSubscribeResponse) GetEvent() *Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Event)(nil), "api.Event")
+	proto.RegisterType((*EventsSubscribeRequest)(nil), "api.EventsSubscribeRequest")
+	proto.RegisterType((*EventsSubscribeResponse)(nil), "api.EventsSubscribeResponse")
+	proto.RegisterEnum("api.Event_Type", Event_Type_name, Event_Type_value)
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// EventsClient is the client API for Events service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type EventsClient interface {
+	// Subscribe streams inventory and configuration change events.
+	Subscribe(ctx context.Context, in *EventsSubscribeRequest, opts ...grpc.CallOption) (Events_SubscribeClient, error)
+}
+
+type eventsClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewEventsClient(cc *grpc.ClientConn) EventsClient {
+	return &eventsClient{cc}
+}
+
+func (c *eventsClient) Subscribe(ctx context.Context, in *EventsSubscribeRequest, opts ...grpc.CallOption) (Events_SubscribeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Events_serviceDesc.Streams[0], "/api.Events/Subscribe", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &eventsSubscribeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Events_SubscribeClient interface {
+	Recv() (*EventsSubscribeResponse, error)
+	grpc.ClientStream
+}
+
+type eventsSubscribeClient struct {
+	grpc.ClientStream
+}
+
+func (x *eventsSubscribeClient) Recv() (*EventsSubscribeResponse, error) {
+	m := new(EventsSubscribeResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// EventsServer is the server API for Events service.
+type EventsServer interface {
+	// Subscribe streams inventory and configuration change events.
+	Subscribe(*EventsSubscribeRequest, Events_SubscribeServer) error
+}
+
+func RegisterEventsServer(s *grpc.Server, srv EventsServer) {
+	s.RegisterService(&_Events_serviceDesc, srv)
+}
+
+func _Events_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(EventsSubscribeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(EventsServer).Subscribe(m, &eventsSubscribeServer{stream})
+}
+
+type Events_SubscribeServer interface {
+	Send(*EventsSubscribeResponse) error
+	grpc.ServerStream
+}
+
+type eventsSubscribeServer struct {
+	grpc.ServerStream
+}
+
+func (x *eventsSubscribeServer) Send(m *EventsSubscribeResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _Events_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "api.Events",
+	HandlerType: (*EventsServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Subscribe",
+			Handler:       _Events_Subscribe_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "events.proto",
+}
+
+func init() { proto.RegisterFile("events.proto", fileDescriptor_events_d828a50b1176ca37) }
+
+var fileDescriptor_events_d828a50b1176ca37 = []byte{
+	// 623 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x54, 0x4f, 0x6f, 0xd3, 0x4e,
+	0x10, 0xfd, 0x39, 0xff, 0x3d, 0x76, 0x5b, 0xff, 0xb6, 0xa2, 0x75, 0xd3, 0x22, 0x42, 0xb8, 0xe4,
+	0xe4, 0x54, 0xe1, 0xc0, 0x81, 0x93, 0x15, 0x2f, 0x69, 0x44, 0xeb, 0x54, 0x6b, 0xb7, 0x5c, 0x90,
+	0x2c, 0x27, 0xd9, 0x56, 0xae, 0x88, 0xed, 0xc6, 0x9b, 0x48, 0xbd, 0xf2, 0x15, 0x10, 0x9f, 0x8c,
+	0x6f, 0x80, 0xf8, 0x20, 0x68, 0x67, 0xe3, 0x46, 0x10, 0x4e, 0xf1, 0xbc, 0x37, 0xef, 0xbd, 0x19,
+	0x6b, 0x1c, 0x30, 0xf9, 0x9a, 0xa7, 0xa2, 0x70, 0xf2, 0x65, 0x26, 0x32, 0x52, 0x8d, 0xf3, 0xa4,
+	0x6d, 0xc6, 0xf7, 0x5b, 0xa8, 0x7d, 0x76, 0x9f, 0x65, 0xf7, 0x5f, 0x78, 0x3f, 0xce, 0x93, 0x7e,
+	0x9c, 0xa6, 0x99, 0x88, 0x45, 0x92, 0xa5, 0x25, 0xfb, 0x6a, 0xc3, 0x62, 0x35, 0x5d, 0xdd, 0xf5,
+	0x45, 0xb2, 0xe0, 0x85, 0x88, 0x17, 0xb9, 0x6a, 0xe8, 0xfe, 0xac, 0x43, 0x9d, 0xca, 0x08, 0x62,
+	0x41, 0xb5, 0xe0, 0x8f, 0xb6, 0xd6, 0xd1, 0x7a, 0x35, 0x26, 0x1f, 0xc9, 0x29, 0xe8, 0x85, 0x58,
+	0xf2, 0x78, 0x11, 0x25, 0x73, 0x7b, 0xbf, 0xa3, 0xf5, 0x74, 0xd6, 0x52, 0xc0, 0x78, 0x4e, 0x1c,
+	0xa8, 0x49, 0x2f, 0xbb, 0xd2, 0xd1, 0x7a, 0xc6, 0xa0, 0xed, 0xa8, 0x20, 0xa7, 0x0c, 0x72, 0xc2,
+	0x32, 0x88, 0x61, 0x1f, 0x79, 0x03, 0x35, 0xf1, 0x94, 0x73, 0xbb, 0xda, 0xd1, 0x7a, 0xfb, 0x83,
+	0x03, 0x27, 0xce, 0x13, 0x07, 0x83, 0x9d, 0xf0, 0x29, 0xe7, 0x0c, 0x49, 0x72, 0x0c, 0xcd, 0x34,
+	0x9b, 0x73, 0x99, 0x57, 0xeb, 0x68, 0xbd, 0x3a, 0x6b, 0xc8, 0x72, 0x3c, 0x97, 0xa3, 0x20, 0x81,
+	0x16, 0x75, 0x35, 0x8a, 0x04, 0xa4, 0xf6, 0x99, 0x4c, 0xe3, 0x05, 0xb7, 0x1b, 0x5b, 0xd2, 0x8f,
+	0x17, 0x9c, 0xbc, 0x04, 0x28, 0xf8, 0x72, 0x9d, 0xcc, 0xd0, 0xb5, 0x89, 0xae, 0xfa, 0x06, 0x19,
+	0xcf, 0xc9, 0x6b, 0x30, 0x4b, 0x1a, 0xbd, 0x5b, 0x28, 0x37, 0x36, 0x18, 0xda, 0x9f, 0x40, 0x0b,
+	0xdf, 0xb8, 0xd4, 0xeb, 0xa8, 0x6f, 0x62, 0x3d, 0x9e, 0x4b, 0x73, 0x45, 0xa1, 0x16, 0x50, 0xab,
+	0x23, 0x82, 0xca, 0x77, 0x60, 0x28, 0xba, 0x10, 0xb1, 0xe0, 0xb6, 0x81, 0xab, 0x1f, 0xe1, 0xea,
+	0xae, 0xc4, 0x03, 0x11, 0x8b, 0x55, 0xe1, 0xc8, 0x1f, 0xce, 0x94, 0x13, 0x3e, 0xcb, 0xc8, 0x87,
+	0x6c, 0xaa, 0x16, 0x32, 0xd1, 0xb5, 0xf9, 0x90, 0x4d, 0x71, 0x9f, 0x36, 0xb4, 0x92, 0xb4, 0x10,
+	0x71, 0x3a, 0xe3, 0xf6, 0x9e, 0xda, 0xb5, 0xac, 0xbb, 0xdf, 0x2b, 0x50, 0xc3, 0x60, 0x03, 0x9a,
+	0x37, 0xfe, 0x47, 0x7f, 0xf2, 0xc9, 0xb7, 0xfe, 0x23, 0xfb, 0x00, 0xfe, 0xc4, 0xa3, 0x91, 0xeb,
+	0x79, 0xd4, 0xb3, 0x34, 0x62, 0x81, 0x89, 0x35, 0xa3, 0x57, 0x93, 0x5b, 0xea, 0x59, 0x15, 0xf2,
+	0x3f, 0xec, 0x05, 0x94, 0xdd, 0x8e, 0x87, 0x65, 0x53, 0x95, 0x1c, 0xc2, 0x41, 0x09, 0x95, 0x7d,
+	0x35, 0x72, 0x00, 0x86, 0x3b, 0xa2, 0x7e, 0xb8, 0xe9, 0xaa, 0x4b, 0xa1, 0x02, 0xca, 0x9e, 0x06,
+	0x39, 0x86, 0x43, 0x05, 0x05, 0xa1, 0x1b, 0xd2, 0x68, 0x78, 0xe1, 0xfa, 0x23, 0xea, 0x59, 0x4d,
+	0x72, 0x02, 0x2f, 0x82, 0x21, 0x73, 0xaf, 0x69, 0x34, 0x9c, 0xf8, 0x1f, 0xc6, 0xa3, 0x68, 0xc8,
+	0xa8, 0x1b, 0x52, 0xcf, 0x6a, 0xed, 0x52, 0x37, 0xd7, 0x1e, 0x52, 0xfa, 0x2e, 0xe5, 0xd1, 0x4b,
+	0x2a, 0x29, 0x90, 0x49, 0xd7, 0x6c, 0x72, 0x45, 0xc3, 0x0b, 0x7a, 0x13, 0x44, 0x8c, 0x5e, 0x4e,
+	0x5c, 0x39, 0x95, 0x21, 0xc7, 0x0c, 0x5d, 0x36, 0xa2, 0x61, 0xe4, 0xc9, 0x37, 0x60, 0x76, 0x19,
+	0x1c, 0xe1, 0xa9, 0x15, 0xc1, 0x6a, 0x5a, 0xcc, 0x96, 0xc9, 0x94, 0x33, 0xfe, 0xb8, 0xe2, 0x85,
+	0xc0, 0x13, 0x4f, 0xd2, 0x19, 0x8f, 0xb6, 0xa7, 0xdf, 0x42, 0x20, 0xf8, 0xfb, 0xfe, 0x2b, 0x7f,
+	0xde, 0x7f, 0xf7, 0x3d, 0x1c, 0xef, 0x78, 0x16, 0x79, 0x96, 0x16, 0x9c, 0x74, 0xa0, 0x8e, 0x5f,
+	0x2d, 0x1a, 0x1a, 0x03, 0xd8, 0xde, 0x3a, 0x53, 0xc4, 0xe0, 0x0e, 0x1a, 0x4a, 0x4c, 0x3e, 0x83,
+	0xfe, 0x6c, 0x40, 0x4e, 0xb7, 0x9d, 0x3b, 0xa3, 0xb6, 0xcf, 0xfe, 0x4d, 0xaa, 0xcc, 0x2e, 0xf9,
+	0xfa, 0xe3, 0xd7, 0xb7, 0x8a, 0x49, 0xa0, 0xbf, 0x3e, 0xef, 0xab, 0xff, 0x8c, 0x73, 0x6d, 0xda,
+	0xc0, 0xcf, 0xf1, 0xed, 0xef, 0x01, 0x00, 0xb2, 0x2b, 0xf1, 0x5b, 0x46, 0x04, 0x00, 0x00,
+}
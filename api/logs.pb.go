@@ -6,6 +6,8 @@ package api
 import proto "github.com/golang/protobuf/proto"
 import fmt "fmt"
 import math "math"
+import duration "github.com/golang/protobuf/ptypes/duration"
+import timestamp "github.com/golang/protobuf/ptypes/timestamp"
 import _ "google.golang.org/genproto/googleapis/api/annotations"
 
 import (
@@ -26,7 +28,11 @@ const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
 type Log struct {
 	// Last lines of log file
-	Lines                []string `protobuf:"bytes,1,rep,name=lines,proto3" json:"lines,omitempty"`
+	Lines []string `protobuf:"bytes,1,rep,name=lines,proto3" json:"lines,omitempty"`
+	// True if some lines were dropped due to timeout
+	Truncated bool `protobuf:"varint,2,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	// Collection error, if any
+	Error                string   `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -36,7 +42,7 @@ func (m *Log) Reset()         { *m = Log{} }
 func (m *Log) String() string { return proto.CompactTextString(m) }
 func (*Log) ProtoMessage()    {}
 func (*Log) Descriptor() ([]byte, []int) {
-	return fileDescriptor_logs_d10e52a3d0677415, []int{0}
+	return fileDescriptor_logs_3fedbd4ccaad095a, []int{0}
 }
 func (m *Log) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Log.Unmarshal(m, b)
@@ -63,6 +69,20 @@ func (m *Log) GetLines() []string {
 	return nil
 }
 
+func (m *Log) GetTruncated() bool {
+	if m != nil {
+		return m.Truncated
+	}
+	return false
+}
+
+func (m *Log) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
 type LogsAllRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -73,7 +93,7 @@ func (m *LogsAllRequest) Reset()         { *m = LogsAllRequest{} }
 func (m *LogsAllRequest) String() string { return proto.CompactTextString(m) }
 func (*LogsAllRequest) ProtoMessage()    {}
 func (*LogsAllRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_logs_d10e52a3d0677415, []int{1}
+	return fileDescriptor_logs_3fedbd4ccaad095a, []int{1}
 }
 func (m *LogsAllRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LogsAllRequest.Unmarshal(m, b)
@@ -105,7 +125,7 @@ func (m *LogsAllResponse) Reset()         { *m = LogsAllResponse{} }
 func (m *LogsAllResponse) String() string { return proto.CompactTextString(m) }
 func (*LogsAllResponse) ProtoMessage()    {}
 func (*LogsAllResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_logs_d10e52a3d0677415, []int{2}
+	return fileDescriptor_logs_3fedbd4ccaad095a, []int{2}
 }
 func (m *LogsAllResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LogsAllResponse.Unmarshal(m, b)
@@ -132,11 +152,329 @@ func (m *LogsAllResponse) GetLogs() map[string]*Log {
 	return nil
 }
 
+type LogsTailRequest struct {
+	// Log file name (as returned by All), systemd unit name, or Agent's supervisor name
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Stream new lines as they arrive until request is canceled
+	Follow bool `protobuf:"varint,2,opt,name=follow,proto3" json:"follow,omitempty"`
+	// Return only lines after that time
+	Since *timestamp.Timestamp `protobuf:"bytes,3,opt,name=since,proto3" json:"since,omitempty"`
+	// Return only lines matching that regular expression (RE2 syntax)
+	Filter string `protobuf:"bytes,4,opt,name=filter,proto3" json:"filter,omitempty"`
+	// Number of last lines to return first; 1000 if not set and since is not set, all lines otherwise
+	Lines                uint32   `protobuf:"varint,5,opt,name=lines,proto3" json:"lines,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LogsTailRequest) Reset()         { *m = LogsTailRequest{} }
+func (m *LogsTailRequest) String() string { return proto.CompactTextString(m) }
+func (*LogsTailRequest) ProtoMessage()    {}
+func (*LogsTailRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_logs_3fedbd4ccaad095a, []int{3}
+}
+func (m *LogsTailRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LogsTailRequest.Unmarshal(m, b)
+}
+func (m *LogsTailRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LogsTailRequest.Marshal(b, m, deterministic)
+}
+func (dst *LogsTailRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogsTailRequest.Merge(dst, src)
+}
+func (m *LogsTailRequest) XXX_Size() int {
+	return xxx_messageInfo_LogsTailRequest.Size(m)
+}
+func (m *LogsTailRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogsTailRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogsTailRequest proto.InternalMessageInfo
+
+func (m *LogsTailRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *LogsTailRequest) GetFollow() bool {
+	if m != nil {
+		return m.Follow
+	}
+	return false
+}
+
+func (m *LogsTailRequest) GetSince() *timestamp.Timestamp {
+	if m != nil {
+		return m.Since
+	}
+	return nil
+}
+
+func (m *LogsTailRequest) GetFilter() string {
+	if m != nil {
+		return m.Filter
+	}
+	return ""
+}
+
+func (m *LogsTailRequest) GetLines() uint32 {
+	if m != nil {
+		return m.Lines
+	}
+	return 0
+}
+
+type LogsTailResponse struct {
+	// Next log lines
+	Lines                []string `protobuf:"bytes,1,rep,name=lines,proto3" json:"lines,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LogsTailResponse) Reset()         { *m = LogsTailResponse{} }
+func (m *LogsTailResponse) String() string { return proto.CompactTextString(m) }
+func (*LogsTailResponse) ProtoMessage()    {}
+func (*LogsTailResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_logs_3fedbd4ccaad095a, []int{4}
+}
+func (m *LogsTailResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LogsTailResponse.Unmarshal(m, b)
+}
+func (m *LogsTailResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LogsTailResponse.Marshal(b, m, deterministic)
+}
+func (dst *LogsTailResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogsTailResponse.Merge(dst, src)
+}
+func (m *LogsTailResponse) XXX_Size() int {
+	return xxx_messageInfo_LogsTailResponse.Size(m)
+}
+func (m *LogsTailResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogsTailResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogsTailResponse proto.InternalMessageInfo
+
+func (m *LogsTailResponse) GetLines() []string {
+	if m != nil {
+		return m.Lines
+	}
+	return nil
+}
+
+type LogsBundleRequest struct {
+	// Names of sources to include (log file names, systemd unit names, Agent's supervisor names); all if empty
+	Sources []string `protobuf:"bytes,1,rep,name=sources,proto3" json:"sources,omitempty"`
+	// Include only log lines after that time
+	Since *timestamp.Timestamp `protobuf:"bytes,2,opt,name=since,proto3" json:"since,omitempty"`
+	// Include only log lines before that time
+	Until *timestamp.Timestamp `protobuf:"bytes,3,opt,name=until,proto3" json:"until,omitempty"`
+	// Maximum number of last lines for each log; 1000 if not set
+	Lines uint32 `protobuf:"varint,4,opt,name=lines,proto3" json:"lines,omitempty"`
+	// Timeout for each source; 10s if not set
+	Timeout              *duration.Duration `protobuf:"bytes,5,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *LogsBundleRequest) Reset()         { *m = LogsBundleRequest{} }
+func (m *LogsBundleRequest) String() string { return proto.CompactTextString(m) }
+func (*LogsBundleRequest) ProtoMessage()    {}
+func (*LogsBundleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_logs_3fedbd4ccaad095a, []int{5}
+}
+func (m *LogsBundleRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LogsBundleRequest.Unmarshal(m, b)
+}
+func (m *LogsBundleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LogsBundleRequest.Marshal(b, m, deterministic)
+}
+func (dst *LogsBundleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogsBundleRequest.Merge(dst, src)
+}
+func (m *LogsBundleRequest) XXX_Size() int {
+	return xxx_messageInfo_LogsBundleRequest.Size(m)
+}
+func (m *LogsBundleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogsBundleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogsBundleRequest proto.InternalMessageInfo
+
+func (m *LogsBundleRequest) GetSources() []string {
+	if m != nil {
+		return m.Sources
+	}
+	return nil
+}
+
+func (m *LogsBundleRequest) GetSince() *timestamp.Timestamp {
+	if m != nil {
+		return m.Since
+	}
+	return nil
+}
+
+func (m *LogsBundleRequest) GetUntil() *timestamp.Timestamp {
+	if m != nil {
+		return m.Until
+	}
+	return nil
+}
+
+func (m *LogsBundleRequest) GetLines() uint32 {
+	if m != nil {
+		return m.Lines
+	}
+	return 0
+}
+
+func (m *LogsBundleRequest) GetTimeout() *duration.Duration {
+	if m != nil {
+		return m.Timeout
+	}
+	return nil
+}
+
+type LogsBundleSource struct {
+	// Source name
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Time spent collecting source
+	Duration *duration.Duration `protobuf:"bytes,2,opt,name=duration,proto3" json:"duration,omitempty"`
+	// Number of collected lines
+	Lines uint32 `protobuf:"varint,3,opt,name=lines,proto3" json:"lines,omitempty"`
+	// True if some lines were dropped due to line limit or timeout
+	Truncated bool `protobuf:"varint,4,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	// Collection error, if any
+	Error                string   `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LogsBundleSource) Reset()         { *m = LogsBundleSource{} }
+func (m *LogsBundleSource) String() string { return proto.CompactTextString(m) }
+func (*LogsBundleSource) ProtoMessage()    {}
+func (*LogsBundleSource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_logs_3fedbd4ccaad095a, []int{6}
+}
+func (m *LogsBundleSource) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LogsBundleSource.Unmarshal(m, b)
+}
+func (m *LogsBundleSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LogsBundleSource.Marshal(b, m, deterministic)
+}
+func (dst *LogsBundleSource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogsBundleSource.Merge(dst, src)
+}
+func (m *LogsBundleSource) XXX_Size() int {
+	return xxx_messageInfo_LogsBundleSource.Size(m)
+}
+func (m *LogsBundleSource) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogsBundleSource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogsBundleSource proto.InternalMessageInfo
+
+func (m *LogsBundleSource) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *LogsBundleSource) GetDuration() *duration.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return nil
+}
+
+func (m *LogsBundleSource) GetLines() uint32 {
+	if m != nil {
+		return m.Lines
+	}
+	return 0
+}
+
+func (m *LogsBundleSource) GetTruncated() bool {
+	if m != nil {
+		return m.Truncated
+	}
+	return false
+}
+
+func (m *LogsBundleSource) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type LogsBundleResponse struct {
+	// Next chunk of .zip archive with logs, summary.json and redaction_manifest.json;
+	// chunks of all messages should be concatenated
+	Zip []byte `protobuf:"bytes,1,opt,name=zip,proto3" json:"zip,omitempty"`
+	// Collection summary for each source; set only in the last message
+	Sources              []*LogsBundleSource `protobuf:"bytes,2,rep,name=sources,proto3" json:"sources,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *LogsBundleResponse) Reset()         { *m = LogsBundleResponse{} }
+func (m *LogsBundleResponse) String() string { return proto.CompactTextString(m) }
+func (*LogsBundleResponse) ProtoMessage()    {}
+func (*LogsBundleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_logs_3fedbd4ccaad095a, []int{7}
+}
+func (m *LogsBundleResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LogsBundleResponse.Unmarshal(m, b)
+}
+func (m *LogsBundleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LogsBundleResponse.Marshal(b, m, deterministic)
+}
+func (dst *LogsBundleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogsBundleResponse.Merge(dst, src)
+}
+func (m *LogsBundleResponse) XXX_Size() int {
+	return xxx_messageInfo_LogsBundleResponse.Size(m)
+}
+func (m *LogsBundleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogsBundleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogsBundleResponse proto.InternalMessageInfo
+
+func (m *LogsBundleResponse) GetZip() []byte {
+	if m != nil {
+		return m.Zip
+	}
+	return nil
+}
+
+func (m *LogsBundleResponse) GetSources() []*LogsBundleSource {
+	if m != nil {
+		return m.Sources
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Log)(nil), "api.Log")
 	proto.RegisterType((*LogsAllRequest)(nil), "api.LogsAllRequest")
 	proto.RegisterType((*LogsAllResponse)(nil), "api.LogsAllResponse")
 	proto.RegisterMapType((map[string]*Log)(nil), "api.LogsAllResponse.LogsEntry")
+	proto.RegisterType((*LogsTailRequest)(nil), "api.LogsTailRequest")
+	proto.RegisterType((*LogsTailResponse)(nil), "api.LogsTailResponse")
+	proto.RegisterType((*LogsBundleRequest)(nil), "api.LogsBundleRequest")
+	proto.RegisterType((*LogsBundleSource)(nil), "api.LogsBundleSource")
+	proto.RegisterType((*LogsBundleResponse)(nil), "api.LogsBundleResponse")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -153,6 +491,10 @@ const _ = grpc.SupportPackageIsVersion4
 type LogsClient interface {
 	// All returns last lines of all log files.
 	All(ctx context.Context, in *LogsAllRequest, opts ...grpc.CallOption) (*LogsAllResponse, error)
+	// Tail streams log lines, optionally following log.
+	Tail(ctx context.Context, in *LogsTailRequest, opts ...grpc.CallOption) (Logs_TailClient, error)
+	// Bundle streams .zip archive with selected logs in chunks.
+	Bundle(ctx context.Context, in *LogsBundleRequest, opts ...grpc.CallOption) (Logs_BundleClient, error)
 }
 
 type logsClient struct {
@@ -172,10 +514,78 @@ func (c *logsClient) All(ctx context.Context, in *LogsAllRequest, opts ...grpc.C
 	return out, nil
 }
 
+func (c *logsClient) Tail(ctx context.Context, in *LogsTailRequest, opts ...grpc.CallOption) (Logs_TailClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Logs_serviceDesc.Streams[0], "/api.Logs/Tail", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &logsTailClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Logs_TailClient interface {
+	Recv() (*LogsTailResponse, error)
+	grpc.ClientStream
+}
+
+type logsTailClient struct {
+	grpc.ClientStream
+}
+
+func (x *logsTailClient) Recv() (*LogsTailResponse, error) {
+	m := new(LogsTailResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *logsClient) Bundle(ctx context.Context, in *LogsBundleRequest, opts ...grpc.CallOption) (Logs_BundleClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Logs_serviceDesc.Streams[1], "/api.Logs/Bundle", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &logsBundleClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Logs_BundleClient interface {
+	Recv() (*LogsBundleResponse, error)
+	grpc.ClientStream
+}
+
+type logsBundleClient struct {
+	grpc.ClientStream
+}
+
+func (x *logsBundleClient) Recv() (*LogsBundleResponse, error) {
+	m := new(LogsBundleResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // LogsServer is the server API for Logs service.
 type LogsServer interface {
 	// All returns last lines of all log files.
 	All(context.Context, *LogsAllRequest) (*LogsAllResponse, error)
+	// Tail streams log lines, optionally following log.
+	Tail(*LogsTailRequest, Logs_TailServer) error
+	// Bundle streams .zip archive with selected logs in chunks.
+	Bundle(*LogsBundleRequest, Logs_BundleServer) error
 }
 
 func RegisterLogsServer(s *grpc.Server, srv LogsServer) {
@@ -200,6 +610,48 @@ func _Logs_All_Handler(srv interface{}, ctx context.Context, dec func(interface{
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Logs_Tail_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(LogsTailRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LogsServer).Tail(m, &logsTailServer{stream})
+}
+
+type Logs_TailServer interface {
+	Send(*LogsTailResponse) error
+	grpc.ServerStream
+}
+
+type logsTailServer struct {
+	grpc.ServerStream
+}
+
+func (x *logsTailServer) Send(m *LogsTailResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Logs_Bundle_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(LogsBundleRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LogsServer).Bundle(m, &logsBundleServer{stream})
+}
+
+type Logs_BundleServer interface {
+	Send(*LogsBundleResponse) error
+	grpc.ServerStream
+}
+
+type logsBundleServer struct {
+	grpc.ServerStream
+}
+
+func (x *logsBundleServer) Send(m *LogsBundleResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _Logs_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "api.Logs",
 	HandlerType: (*LogsServer)(nil),
@@ -209,28 +661,59 @@ var _Logs_serviceDesc = grpc.ServiceDesc{
 			Handler:    _Logs_All_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Tail",
+			Handler:       _Logs_Tail_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Bundle",
+			Handler:       _Logs_Bundle_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "logs.proto",
 }
 
-func init() { proto.RegisterFile("logs.proto", fileDescriptor_logs_d10e52a3d0677415) }
-
-var fileDescriptor_logs_d10e52a3d0677415 = []byte{
-	// 243 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0xca, 0xc9, 0x4f, 0x2f,
-	0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62, 0x4e, 0x2c, 0xc8, 0x94, 0x92, 0x49, 0xcf, 0xcf,
-	0x4f, 0xcf, 0x49, 0xd5, 0x4f, 0x2c, 0xc8, 0xd4, 0x4f, 0xcc, 0xcb, 0xcb, 0x2f, 0x49, 0x2c, 0xc9,
-	0xcc, 0xcf, 0x83, 0x2a, 0x51, 0x92, 0xe6, 0x62, 0xf6, 0xc9, 0x4f, 0x17, 0x12, 0xe1, 0x62, 0xcd,
-	0xc9, 0xcc, 0x4b, 0x2d, 0x96, 0x60, 0x54, 0x60, 0xd6, 0xe0, 0x0c, 0x82, 0x70, 0x94, 0x04, 0xb8,
-	0xf8, 0x7c, 0xf2, 0xd3, 0x8b, 0x1d, 0x73, 0x72, 0x82, 0x52, 0x0b, 0x4b, 0x53, 0x8b, 0x4b, 0x94,
-	0x3a, 0x18, 0xb9, 0xf8, 0xe1, 0x42, 0xc5, 0x05, 0xf9, 0x79, 0xc5, 0xa9, 0x42, 0x46, 0x5c, 0x2c,
-	0x20, 0x3b, 0xc1, 0x5a, 0xb9, 0x8d, 0xe4, 0xf4, 0x12, 0x0b, 0x32, 0xf5, 0xd0, 0xd4, 0x80, 0xf9,
-	0xae, 0x79, 0x25, 0x45, 0x95, 0x41, 0x60, 0xb5, 0x52, 0x8e, 0x5c, 0x9c, 0x70, 0x21, 0x21, 0x01,
-	0x2e, 0xe6, 0xec, 0xd4, 0x4a, 0x09, 0x46, 0x05, 0x46, 0x0d, 0xce, 0x20, 0x10, 0x53, 0x48, 0x8e,
-	0x8b, 0xb5, 0x2c, 0x31, 0xa7, 0x34, 0x55, 0x82, 0x49, 0x81, 0x51, 0x83, 0xdb, 0x88, 0x03, 0x66,
-	0x66, 0x10, 0x44, 0xd8, 0x8a, 0xc9, 0x82, 0xd1, 0xc8, 0x8b, 0x8b, 0x05, 0x64, 0x84, 0x90, 0x13,
-	0x17, 0xb3, 0x63, 0x4e, 0x8e, 0x90, 0x30, 0xaa, 0xbd, 0x60, 0xe7, 0x4a, 0x89, 0x60, 0x73, 0x8c,
-	0x92, 0x40, 0xd3, 0xe5, 0x27, 0x93, 0x99, 0xb8, 0x84, 0x38, 0xf4, 0xcb, 0x0c, 0xf4, 0x41, 0xce,
-	0x49, 0x62, 0x03, 0x07, 0x86, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0x14, 0x0d, 0xbe, 0xd7, 0x3d,
-	0x01, 0x00, 0x00,
+func init() { proto.RegisterFile("logs.proto", fileDescriptor_logs_3fedbd4ccaad095a) }
+
+var fileDescriptor_logs_3fedbd4ccaad095a = []byte{
+	// 571 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x4d, 0x6f, 0xd3, 0x40,
+	0x10, 0xd5, 0xfa, 0xa3, 0x4d, 0x27, 0x94, 0x98, 0x25, 0x29, 0xc6, 0xaa, 0x42, 0xe4, 0x53, 0xc4,
+	0xc1, 0x8e, 0x5c, 0x21, 0xa1, 0xde, 0x52, 0xc1, 0x2d, 0x12, 0x92, 0xa9, 0xd4, 0xb3, 0x93, 0x6c,
+	0xa3, 0x15, 0x9b, 0x5d, 0x63, 0xaf, 0x8b, 0xca, 0x91, 0x13, 0x77, 0xce, 0x9c, 0xf9, 0x41, 0x5c,
+	0x39, 0xf2, 0x07, 0xf8, 0x07, 0xc8, 0xeb, 0xf5, 0x47, 0xd3, 0x20, 0x7a, 0xdb, 0x99, 0x7d, 0x7e,
+	0x7e, 0x6f, 0xe6, 0x2d, 0x00, 0x13, 0x9b, 0x3c, 0x48, 0x33, 0x21, 0x05, 0x36, 0x93, 0x94, 0x7a,
+	0xa7, 0x1b, 0x21, 0x36, 0x8c, 0x84, 0x49, 0x4a, 0xc3, 0x84, 0x73, 0x21, 0x13, 0x49, 0x05, 0xd7,
+	0x10, 0x6f, 0xac, 0x6f, 0x55, 0xb5, 0x2c, 0xae, 0xc3, 0x75, 0x91, 0x29, 0x80, 0xbe, 0x7f, 0xb1,
+	0x7b, 0x2f, 0xe9, 0x96, 0xe4, 0x32, 0xd9, 0xa6, 0x15, 0xc0, 0x7f, 0x07, 0xe6, 0x42, 0x6c, 0xf0,
+	0x10, 0x6c, 0x46, 0x39, 0xc9, 0x5d, 0x34, 0x31, 0xa7, 0x47, 0x71, 0x55, 0xe0, 0x53, 0x38, 0x92,
+	0x59, 0xc1, 0x57, 0x89, 0x24, 0x6b, 0xd7, 0x98, 0xa0, 0x69, 0x2f, 0x6e, 0x1b, 0xe5, 0x37, 0x24,
+	0xcb, 0x44, 0xe6, 0x9a, 0x13, 0x54, 0x7e, 0xa3, 0x0a, 0xdf, 0x81, 0xc7, 0x0b, 0xb1, 0xc9, 0xe7,
+	0x8c, 0xc5, 0xe4, 0x63, 0x41, 0x72, 0xe9, 0x7f, 0x45, 0x30, 0x68, 0x5a, 0x79, 0x2a, 0x78, 0x4e,
+	0x70, 0x04, 0x56, 0x69, 0x54, 0xfd, 0xae, 0x1f, 0x8d, 0x83, 0x24, 0xa5, 0xc1, 0x0e, 0x46, 0xd5,
+	0x6f, 0xb9, 0xcc, 0x6e, 0x63, 0x85, 0xf5, 0xe6, 0x70, 0xd4, 0xb4, 0xb0, 0x03, 0xe6, 0x07, 0x72,
+	0xeb, 0x22, 0xf5, 0xeb, 0xf2, 0x88, 0xc7, 0x60, 0xdf, 0x24, 0xac, 0x20, 0x4a, 0x68, 0x3f, 0xea,
+	0xd5, 0x9c, 0x71, 0xd5, 0x3e, 0x37, 0x5e, 0x23, 0xff, 0xbb, 0x96, 0x72, 0x99, 0xd0, 0x5a, 0x1e,
+	0xc6, 0x60, 0xf1, 0x64, 0x4b, 0x34, 0x95, 0x3a, 0xe3, 0x13, 0x38, 0xb8, 0x16, 0x8c, 0x89, 0x4f,
+	0xda, 0xb5, 0xae, 0xf0, 0x0c, 0xec, 0x9c, 0xf2, 0x15, 0x51, 0x96, 0xfb, 0x91, 0x17, 0x54, 0xe3,
+	0x0d, 0xea, 0xf1, 0x06, 0x97, 0xf5, 0x78, 0xe3, 0x0a, 0xa8, 0x98, 0x28, 0x93, 0x24, 0x73, 0x2d,
+	0xc5, 0xaf, 0xab, 0x76, 0xe0, 0xf6, 0x04, 0x4d, 0x8f, 0xf5, 0xc0, 0xfd, 0x29, 0x38, 0xad, 0x3c,
+	0x3d, 0xaa, 0xbd, 0xab, 0xf1, 0x7f, 0x21, 0x78, 0x52, 0x42, 0x2f, 0x0a, 0xbe, 0x66, 0xa4, 0xf6,
+	0xe2, 0xc2, 0x61, 0x2e, 0x8a, 0x6c, 0xd5, 0xa0, 0xeb, 0xb2, 0x55, 0x6e, 0x3c, 0x54, 0xf9, 0x0c,
+	0xec, 0x82, 0x4b, 0xca, 0x1e, 0xe2, 0x55, 0x01, 0x5b, 0xa5, 0x56, 0xc7, 0x13, 0x3e, 0x83, 0xc3,
+	0x32, 0x74, 0xa2, 0x90, 0xca, 0x6b, 0x3f, 0x7a, 0x7e, 0x8f, 0xe9, 0x8d, 0x0e, 0x6d, 0x5c, 0x23,
+	0xfd, 0x1f, 0x08, 0x9c, 0xd6, 0xde, 0x7b, 0x65, 0x62, 0xef, 0xa6, 0x5e, 0x41, 0xaf, 0x8e, 0xbc,
+	0x6b, 0xfc, 0x8f, 0xbe, 0x81, 0xb6, 0x52, 0xcd, 0xae, 0xd4, 0x3b, 0x79, 0xb7, 0xfe, 0x99, 0x77,
+	0xbb, 0x9b, 0xf7, 0x2b, 0xc0, 0xdd, 0x3d, 0xe8, 0xa5, 0x39, 0x60, 0x7e, 0xa6, 0xa9, 0x52, 0xfa,
+	0x28, 0x2e, 0x8f, 0x38, 0x6c, 0x57, 0x63, 0xa8, 0xd0, 0x8f, 0x9a, 0xd0, 0x77, 0x4d, 0x36, 0x1b,
+	0x8b, 0xfe, 0x20, 0xb0, 0xca, 0x5b, 0x7c, 0x01, 0xe6, 0x9c, 0x31, 0xfc, 0xf4, 0xee, 0x23, 0x51,
+	0x0b, 0xf7, 0x86, 0xfb, 0x5e, 0x8e, 0xef, 0x7c, 0xf9, 0xf9, 0xfb, 0x9b, 0x01, 0xb8, 0x17, 0xde,
+	0xcc, 0xc2, 0xf2, 0xed, 0xe0, 0x05, 0x58, 0x65, 0xa8, 0x70, 0x8b, 0xef, 0x3c, 0x01, 0x6f, 0xb4,
+	0xd3, 0xd5, 0x34, 0x23, 0x45, 0x33, 0xc0, 0xc7, 0x35, 0x4d, 0x28, 0x13, 0xca, 0x66, 0x08, 0x5f,
+	0xc1, 0x41, 0xa5, 0x19, 0x9f, 0xec, 0x98, 0xa8, 0x19, 0x9f, 0xdd, 0xeb, 0x6b, 0x4e, 0x4f, 0x71,
+	0x0e, 0xfd, 0x41, 0xc3, 0xb9, 0x54, 0x80, 0x73, 0xf4, 0x72, 0x86, 0x96, 0x07, 0x6a, 0x67, 0x67,
+	0x7f, 0x07, 0x00, 0x14, 0xd7, 0xd4, 0x50, 0x06, 0x05, 0x00, 0x00,
 }
@@ -35,7 +35,7 @@ func (m *MySQLNode) Reset()         { *m = MySQLNode{} }
 func (m *MySQLNode) String() string { return proto.CompactTextString(m) }
 func (*MySQLNode) ProtoMessage()    {}
 func (*MySQLNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_mysql_52b8f1c6fff1bd63, []int{0}
+	return fileDescriptor_mysql_68e61c529cd7512a, []int{0}
 }
 func (m *MySQLNode) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MySQLNode.Unmarshal(m, b)
@@ -76,7 +76,7 @@ func (m *MySQLService) Reset()         { *m = MySQLService{} }
 func (m *MySQLService) String() string { return proto.CompactTextString(m) }
 func (*MySQLService) ProtoMessage()    {}
 func (*MySQLService) Descriptor() ([]byte, []int) {
-	return fileDescriptor_mysql_52b8f1c6fff1bd63, []int{1}
+	return fileDescriptor_mysql_68e61c529cd7512a, []int{1}
 }
 func (m *MySQLService) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MySQLService.Unmarshal(m, b)
@@ -127,6 +127,7 @@ func (m *MySQLService) GetEngineVersion() string {
 type MySQLInstance struct {
 	Node                 *MySQLNode    `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
 	Service              *MySQLService `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty"`
+	Health               AgentsHealth  `protobuf:"varint,3,opt,name=health,proto3,enum=api.AgentsHealth" json:"health,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
 	XXX_unrecognized     []byte        `json:"-"`
 	XXX_sizecache        int32         `json:"-"`
@@ -136,7 +137,7 @@ func (m *MySQLInstance) Reset()         { *m = MySQLInstance{} }
 func (m *MySQLInstance) String() string { return proto.CompactTextString(m) }
 func (*MySQLInstance) ProtoMessage()    {}
 func (*MySQLInstance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_mysql_52b8f1c6fff1bd63, []int{2}
+	return fileDescriptor_mysql_68e61c529cd7512a, []int{2}
 }
 func (m *MySQLInstance) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MySQLInstance.Unmarshal(m, b)
@@ -170,6 +171,13 @@ func (m *MySQLInstance) GetService() *MySQLService {
 	return nil
 }
 
+func (m *MySQLInstance) GetHealth() AgentsHealth {
+	if m != nil {
+		return m.Health
+	}
+	return AgentsHealth_AGENTS_HEALTH_UNKNOWN
+}
+
 type MySQLListRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -180,7 +188,7 @@ func (m *MySQLListRequest) Reset()         { *m = MySQLListRequest{} }
 func (m *MySQLListRequest) String() string { return proto.CompactTextString(m) }
 func (*MySQLListRequest) ProtoMessage()    {}
 func (*MySQLListRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_mysql_52b8f1c6fff1bd63, []int{3}
+	return fileDescriptor_mysql_68e61c529cd7512a, []int{3}
 }
 func (m *MySQLListRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MySQLListRequest.Unmarshal(m, b)
@@ -211,7 +219,7 @@ func (m *MySQLListResponse) Reset()         { *m = MySQLListResponse{} }
 func (m *MySQLListResponse) String() string { return proto.CompactTextString(m) }
 func (*MySQLListResponse) ProtoMessage()    {}
 func (*MySQLListResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_mysql_52b8f1c6fff1bd63, []int{4}
+	return fileDescriptor_mysql_68e61c529cd7512a, []int{4}
 }
 func (m *MySQLListResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MySQLListResponse.Unmarshal(m, b)
@@ -253,7 +261,7 @@ func (m *MySQLAddRequest) Reset()         { *m = MySQLAddRequest{} }
 func (m *MySQLAddRequest) String() string { return proto.CompactTextString(m) }
 func (*MySQLAddRequest) ProtoMessage()    {}
 func (*MySQLAddRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_mysql_52b8f1c6fff1bd63, []int{5}
+	return fileDescriptor_mysql_68e61c529cd7512a, []int{5}
 }
 func (m *MySQLAddRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MySQLAddRequest.Unmarshal(m, b)
@@ -319,7 +327,7 @@ func (m *MySQLAddResponse) Reset()         { *m = MySQLAddResponse{} }
 func (m *MySQLAddResponse) String() string { return proto.CompactTextString(m) }
 func (*MySQLAddResponse) ProtoMessage()    {}
 func (*MySQLAddResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_mysql_52b8f1c6fff1bd63, []int{6}
+	return fileDescriptor_mysql_68e61c529cd7512a, []int{6}
 }
 func (m *MySQLAddResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MySQLAddResponse.Unmarshal(m, b)
@@ -357,7 +365,7 @@ func (m *MySQLRemoveRequest) Reset()         { *m = MySQLRemoveRequest{} }
 func (m *MySQLRemoveRequest) String() string { return proto.CompactTextString(m) }
 func (*MySQLRemoveRequest) ProtoMessage()    {}
 func (*MySQLRemoveRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_mysql_52b8f1c6fff1bd63, []int{7}
+	return fileDescriptor_mysql_68e61c529cd7512a, []int{7}
 }
 func (m *MySQLRemoveRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MySQLRemoveRequest.Unmarshal(m, b)
@@ -394,7 +402,7 @@ func (m *MySQLRemoveResponse) Reset()         { *m = MySQLRemoveResponse{} }
 func (m *MySQLRemoveResponse) String() string { return proto.CompactTextString(m) }
 func (*MySQLRemoveResponse) ProtoMessage()    {}
 func (*MySQLRemoveResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_mysql_52b8f1c6fff1bd63, []int{8}
+	return fileDescriptor_mysql_68e61c529cd7512a, []int{8}
 }
 func (m *MySQLRemoveResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_MySQLRemoveResponse.Unmarshal(m, b)
@@ -564,39 +572,40 @@ var _MySQL_serviceDesc = grpc.ServiceDesc{
 	Metadata: "mysql.proto",
 }
 
-func init() { proto.RegisterFile("mysql.proto", fileDescriptor_mysql_52b8f1c6fff1bd63) }
-
-var fileDescriptor_mysql_52b8f1c6fff1bd63 = []byte{
-	// 487 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x53, 0x4d, 0x6f, 0xd3, 0x40,
-	0x10, 0xd5, 0xda, 0xce, 0x87, 0x27, 0xc4, 0x38, 0x4b, 0x13, 0x56, 0x16, 0x87, 0x68, 0x05, 0x52,
-	0xd4, 0x4a, 0x71, 0x15, 0x6e, 0xdc, 0x7a, 0x40, 0x2a, 0x51, 0x41, 0xc2, 0x91, 0xb8, 0x82, 0xe9,
-	0xae, 0xa2, 0x95, 0x9a, 0x5d, 0xd7, 0xeb, 0x06, 0x55, 0x88, 0x0b, 0x57, 0xc4, 0x89, 0x9f, 0xc6,
-	0x5f, 0xe0, 0x07, 0xf0, 0x13, 0x50, 0xc6, 0x8e, 0xed, 0x84, 0xde, 0x76, 0xe6, 0xbd, 0x79, 0x7a,
-	0xf3, 0xc6, 0x86, 0xc1, 0xe6, 0xde, 0xde, 0xde, 0xcc, 0xb3, 0xdc, 0x14, 0x86, 0xba, 0x69, 0xa6,
-	0xa2, 0x67, 0x6b, 0x63, 0xd6, 0x37, 0x32, 0x4e, 0x33, 0x15, 0xa7, 0x5a, 0x9b, 0x22, 0x2d, 0x94,
-	0xd1, 0xb6, 0xa4, 0xf0, 0x33, 0xf0, 0xdf, 0xde, 0xaf, 0xde, 0x5f, 0xbd, 0x33, 0x42, 0x52, 0x0a,
-	0x9e, 0x4e, 0x37, 0x92, 0xb9, 0x53, 0x32, 0xf3, 0x13, 0x7c, 0x2f, 0xbd, 0x3e, 0x09, 0x9d, 0xa5,
-	0xd7, 0x77, 0x42, 0x97, 0xff, 0x24, 0xf0, 0x08, 0xd9, 0x2b, 0x99, 0x6f, 0xd5, 0xb5, 0xa4, 0x0c,
-	0x7a, 0xa9, 0x10, 0xb9, 0xb4, 0x96, 0x79, 0x38, 0xb3, 0x2f, 0x77, 0x52, 0x99, 0xc9, 0x0b, 0xd6,
-	0x99, 0x92, 0xd9, 0x30, 0xc1, 0x37, 0x9d, 0x40, 0x57, 0xea, 0xb5, 0xd2, 0x92, 0x75, 0x91, 0x5c,
-	0x55, 0xf4, 0x05, 0x04, 0xe5, 0xeb, 0xe3, 0x56, 0xe6, 0x56, 0x19, 0xcd, 0x7a, 0x88, 0x0f, 0xcb,
-	0xee, 0x87, 0xb2, 0xd9, 0x76, 0xb2, 0xf4, 0xfa, 0x6e, 0xe8, 0xf1, 0x4f, 0x30, 0x44, 0x3b, 0x6f,
-	0xb4, 0x2d, 0x52, 0x7d, 0x2d, 0x29, 0x07, 0x4f, 0x1b, 0x21, 0x19, 0x99, 0x92, 0xd9, 0x60, 0x11,
-	0xcc, 0xd3, 0x4c, 0xcd, 0xeb, 0xf5, 0x12, 0xc4, 0xe8, 0x19, 0xf4, 0x6c, 0x69, 0x9f, 0x39, 0x48,
-	0x1b, 0x35, 0xb4, 0x6a, 0xaf, 0x64, 0xcf, 0xe0, 0x14, 0x42, 0x04, 0xae, 0x94, 0x2d, 0x12, 0x79,
-	0x7b, 0x27, 0x6d, 0xc1, 0x5f, 0xc3, 0xa8, 0xd5, 0xb3, 0x99, 0xd1, 0x56, 0xd2, 0x73, 0xf0, 0x55,
-	0xe5, 0xc2, 0x32, 0x32, 0x75, 0x67, 0x83, 0x05, 0x6d, 0x74, 0xf7, 0x06, 0x93, 0x86, 0xc4, 0x7f,
-	0x10, 0x78, 0x8c, 0xe0, 0x85, 0x10, 0x95, 0x74, 0x7d, 0x00, 0xd2, 0x1c, 0xa0, 0x9d, 0xb1, 0xf3,
-	0x70, 0xc6, 0x6e, 0x2b, 0xe3, 0x08, 0xfa, 0x77, 0x56, 0xe6, 0xa8, 0x52, 0x9e, 0xa4, 0xae, 0x77,
-	0x58, 0x96, 0x5a, 0xfb, 0xc5, 0xe4, 0x02, 0xef, 0xe2, 0x27, 0x75, 0xcd, 0x79, 0xb5, 0x28, 0x9a,
-	0xa9, 0x76, 0x0a, 0xc0, 0x51, 0x02, 0xbd, 0x74, 0x12, 0x47, 0x09, 0xfe, 0x1c, 0x28, 0x72, 0x12,
-	0xb9, 0x31, 0x5b, 0xb9, 0xf7, 0x7c, 0xcc, 0x1a, 0xc3, 0x93, 0x03, 0x56, 0x29, 0xb6, 0xf8, 0x4b,
-	0xa0, 0x83, 0x7d, 0x7a, 0x09, 0xde, 0x2e, 0x3a, 0x3a, 0x6e, 0xf2, 0x69, 0xc5, 0x1b, 0x4d, 0x8e,
-	0xdb, 0xa5, 0x00, 0x1f, 0x7d, 0xff, 0xfd, 0xe7, 0x97, 0x33, 0xa0, 0x7e, 0xbc, 0x3d, 0x8f, 0xf1,
-	0x2b, 0xa7, 0x97, 0xe0, 0x5e, 0x08, 0x41, 0x4f, 0x9a, 0x89, 0x26, 0xcb, 0x68, 0x7c, 0xd4, 0xad,
-	0x64, 0x4e, 0x50, 0x26, 0xe0, 0x8d, 0xcc, 0x2b, 0x72, 0x4a, 0x57, 0xd0, 0x2d, 0xfd, 0xd2, 0xa7,
-	0xcd, 0xd8, 0xc1, 0x9e, 0x11, 0xfb, 0x1f, 0xa8, 0x24, 0x27, 0x28, 0x19, 0x9e, 0x06, 0xb5, 0x64,
-	0xfc, 0x55, 0x89, 0x6f, 0x9f, 0xbb, 0xf8, 0x8b, 0xbd, 0xfc, 0x17, 0x00, 0x00, 0xff, 0xff, 0x50,
-	0x32, 0x07, 0x56, 0x94, 0x03, 0x00, 0x00,
+func init() { proto.RegisterFile("mysql.proto", fileDescriptor_mysql_68e61c529cd7512a) }
+
+var fileDescriptor_mysql_68e61c529cd7512a = []byte{
+	// 511 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x53, 0x4d, 0x8b, 0x13, 0x41,
+	0x10, 0xa5, 0x67, 0x66, 0xf3, 0x51, 0xd9, 0x8c, 0x93, 0x76, 0x13, 0x87, 0xc1, 0x43, 0x68, 0x14,
+	0xe2, 0x2e, 0x24, 0x4b, 0xbc, 0x79, 0xcb, 0x41, 0x88, 0x61, 0x15, 0x9c, 0x80, 0x57, 0x69, 0xb7,
+	0x9b, 0x6c, 0x43, 0xd2, 0x3d, 0x3b, 0x3d, 0x1b, 0x59, 0xc4, 0x8b, 0x57, 0xf1, 0xe4, 0x4f, 0xf3,
+	0x2f, 0xf8, 0x03, 0xfc, 0x09, 0x92, 0x9a, 0x49, 0x7a, 0x36, 0x7a, 0xeb, 0xaa, 0x7a, 0xf5, 0x78,
+	0xf5, 0x1e, 0x0d, 0x9d, 0xcd, 0xbd, 0xbd, 0x5d, 0x8f, 0xb3, 0xdc, 0x14, 0x86, 0xfa, 0x3c, 0x53,
+	0xc9, 0xd3, 0x95, 0x31, 0xab, 0xb5, 0x9c, 0xf0, 0x4c, 0x4d, 0xb8, 0xd6, 0xa6, 0xe0, 0x85, 0x32,
+	0xda, 0x96, 0x90, 0xe4, 0x94, 0xaf, 0xa4, 0x2e, 0xaa, 0x8a, 0x5d, 0x40, 0xfb, 0xed, 0xfd, 0xf2,
+	0xfd, 0xd5, 0x3b, 0x23, 0x24, 0xa5, 0x10, 0x68, 0xbe, 0x91, 0xb1, 0x3f, 0x24, 0xa3, 0x76, 0x8a,
+	0xef, 0x45, 0xd0, 0x22, 0x91, 0xb7, 0x08, 0x5a, 0x5e, 0xe4, 0xb3, 0x1f, 0x04, 0x4e, 0x11, 0xbd,
+	0x94, 0xf9, 0x56, 0x5d, 0x4b, 0x1a, 0x43, 0x93, 0x0b, 0x91, 0x4b, 0x6b, 0xe3, 0x00, 0x77, 0xf6,
+	0xe5, 0x8e, 0x2a, 0x33, 0x79, 0x11, 0x9f, 0x0c, 0xc9, 0xa8, 0x9b, 0xe2, 0x9b, 0x0e, 0xa0, 0x21,
+	0xf5, 0x4a, 0x69, 0x19, 0x37, 0x10, 0x5c, 0x55, 0xf4, 0x39, 0x84, 0xe5, 0xeb, 0xe3, 0x56, 0xe6,
+	0x56, 0x19, 0x1d, 0x37, 0x71, 0xde, 0x2d, 0xbb, 0x1f, 0xca, 0x66, 0x5d, 0xc9, 0x22, 0x68, 0xf9,
+	0x51, 0xc0, 0xbe, 0x13, 0xe8, 0xa2, 0x9e, 0x37, 0xda, 0x16, 0x5c, 0x5f, 0x4b, 0xca, 0x20, 0xd0,
+	0x46, 0xc8, 0x98, 0x0c, 0xc9, 0xa8, 0x33, 0x0d, 0xc7, 0x3c, 0x53, 0xe3, 0xc3, 0x7d, 0x29, 0xce,
+	0xe8, 0x05, 0x34, 0x6d, 0xa9, 0x3f, 0xf6, 0x10, 0xd6, 0x73, 0xb0, 0xea, 0xb0, 0x74, 0x8f, 0xa0,
+	0x2f, 0xa0, 0x71, 0x23, 0xf9, 0xba, 0xb8, 0x41, 0x53, 0xc2, 0x0a, 0x3b, 0x43, 0x0b, 0xe7, 0x38,
+	0x48, 0x2b, 0x00, 0xa3, 0x10, 0x21, 0xc7, 0x95, 0xb2, 0x45, 0x2a, 0x6f, 0xef, 0xa4, 0x2d, 0xd8,
+	0x6b, 0xe8, 0xd5, 0x7a, 0x36, 0x33, 0xda, 0x4a, 0x7a, 0x09, 0x6d, 0x55, 0x09, 0xb6, 0x31, 0x19,
+	0xfa, 0xa3, 0xce, 0x94, 0x3a, 0x09, 0xfb, 0x5b, 0x52, 0x07, 0xda, 0x1d, 0xfa, 0x08, 0x87, 0x33,
+	0x21, 0x2a, 0xea, 0x43, 0x58, 0xc4, 0x85, 0x55, 0xcf, 0xc3, 0xfb, 0x7f, 0x1e, 0x7e, 0x2d, 0x8f,
+	0x04, 0x5a, 0x77, 0x56, 0xe6, 0xc8, 0x52, 0xc6, 0x77, 0xa8, 0x77, 0xb3, 0x8c, 0x5b, 0xfb, 0xd9,
+	0xe4, 0x02, 0x33, 0x6c, 0xa7, 0x87, 0x9a, 0x31, 0x88, 0x9c, 0x98, 0xea, 0xa6, 0x10, 0x3c, 0x25,
+	0x50, 0xcb, 0x49, 0xea, 0x29, 0xc1, 0x9e, 0x01, 0x45, 0x4c, 0x2a, 0x37, 0x66, 0x2b, 0xf7, 0x9a,
+	0x8f, 0x51, 0x7d, 0x78, 0xfc, 0x00, 0x55, 0x92, 0x4d, 0xff, 0x10, 0x38, 0xc1, 0x3e, 0x9d, 0x43,
+	0xb0, 0xb3, 0x8e, 0xf6, 0x9d, 0x3f, 0x35, 0x7b, 0x93, 0xc1, 0x71, 0xbb, 0x24, 0x60, 0xbd, 0x6f,
+	0xbf, 0x7e, 0xff, 0xf4, 0x3a, 0xb4, 0x3d, 0xd9, 0x5e, 0x4e, 0xf0, 0x7f, 0xd0, 0x39, 0xf8, 0x33,
+	0x21, 0xe8, 0x99, 0xdb, 0x70, 0x5e, 0x26, 0xfd, 0xa3, 0x6e, 0x45, 0x73, 0x86, 0x34, 0x21, 0x73,
+	0x34, 0xaf, 0xc8, 0x39, 0x5d, 0x42, 0xa3, 0xd4, 0x4b, 0x9f, 0xb8, 0xb5, 0x07, 0x77, 0x26, 0xf1,
+	0xbf, 0x83, 0x8a, 0x72, 0x80, 0x94, 0xd1, 0x79, 0x78, 0xa0, 0x9c, 0x7c, 0x51, 0xe2, 0xeb, 0xa7,
+	0x06, 0x7e, 0xc7, 0x97, 0x7f, 0x07, 0x00, 0xf8, 0xa2, 0xaa, 0x53, 0xce, 0x03, 0x00, 0x00,
 }
@@ -35,7 +35,7 @@ func (m *PostgreSQLNode) Reset()         { *m = PostgreSQLNode{} }
 func (m *PostgreSQLNode) String() string { return proto.CompactTextString(m) }
 func (*PostgreSQLNode) ProtoMessage()    {}
 func (*PostgreSQLNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_postgresql_1f3feebc0db14cd3, []int{0}
+	return fileDescriptor_postgresql_e5145b21d701e472, []int{0}
 }
 func (m *PostgreSQLNode) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PostgreSQLNode.Unmarshal(m, b)
@@ -76,7 +76,7 @@ func (m *PostgreSQLService) Reset()         { *m = PostgreSQLService{} }
 func (m *PostgreSQLService) String() string { return proto.CompactTextString(m) }
 func (*PostgreSQLService) ProtoMessage()    {}
 func (*PostgreSQLService) Descriptor() ([]byte, []int) {
-	return fileDescriptor_postgresql_1f3feebc0db14cd3, []int{1}
+	return fileDescriptor_postgresql_e5145b21d701e472, []int{1}
 }
 func (m *PostgreSQLService) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PostgreSQLService.Unmarshal(m, b)
@@ -127,6 +127,7 @@ func (m *PostgreSQLService) GetEngineVersion() string {
 type PostgreSQLInstance struct {
 	Node                 *PostgreSQLNode    `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
 	Service              *PostgreSQLService `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty"`
+	Health               AgentsHealth       `protobuf:"varint,3,opt,name=health,proto3,enum=api.AgentsHealth" json:"health,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
 	XXX_unrecognized     []byte             `json:"-"`
 	XXX_sizecache        int32              `json:"-"`
@@ -136,7 +137,7 @@ func (m *PostgreSQLInstance) Reset()         { *m = PostgreSQLInstance{} }
 func (m *PostgreSQLInstance) String() string { return proto.CompactTextString(m) }
 func (*PostgreSQLInstance) ProtoMessage()    {}
 func (*PostgreSQLInstance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_postgresql_1f3feebc0db14cd3, []int{2}
+	return fileDescriptor_postgresql_e5145b21d701e472, []int{2}
 }
 func (m *PostgreSQLInstance) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PostgreSQLInstance.Unmarshal(m, b)
@@ -170,6 +171,13 @@ func (m *PostgreSQLInstance) GetService() *PostgreSQLService {
 	return nil
 }
 
+func (m *PostgreSQLInstance) GetHealth() AgentsHealth {
+	if m != nil {
+		return m.Health
+	}
+	return AgentsHealth_AGENTS_HEALTH_UNKNOWN
+}
+
 type PostgreSQLListRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -180,7 +188,7 @@ func (m *PostgreSQLListRequest) Reset()         { *m = PostgreSQLListRequest{} }
 func (m *PostgreSQLListRequest) String() string { return proto.CompactTextString(m) }
 func (*PostgreSQLListRequest) ProtoMessage()    {}
 func (*PostgreSQLListRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_postgresql_1f3feebc0db14cd3, []int{3}
+	return fileDescriptor_postgresql_e5145b21d701e472, []int{3}
 }
 func (m *PostgreSQLListRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PostgreSQLListRequest.Unmarshal(m, b)
@@ -211,7 +219,7 @@ func (m *PostgreSQLListResponse) Reset()         { *m = PostgreSQLListResponse{}
 func (m *PostgreSQLListResponse) String() string { return proto.CompactTextString(m) }
 func (*PostgreSQLListResponse) ProtoMessage()    {}
 func (*PostgreSQLListResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_postgresql_1f3feebc0db14cd3, []int{4}
+	return fileDescriptor_postgresql_e5145b21d701e472, []int{4}
 }
 func (m *PostgreSQLListResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PostgreSQLListResponse.Unmarshal(m, b)
@@ -253,7 +261,7 @@ func (m *PostgreSQLAddRequest) Reset()         { *m = PostgreSQLAddRequest{} }
 func (m *PostgreSQLAddRequest) String() string { return proto.CompactTextString(m) }
 func (*PostgreSQLAddRequest) ProtoMessage()    {}
 func (*PostgreSQLAddRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_postgresql_1f3feebc0db14cd3, []int{5}
+	return fileDescriptor_postgresql_e5145b21d701e472, []int{5}
 }
 func (m *PostgreSQLAddRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PostgreSQLAddRequest.Unmarshal(m, b)
@@ -319,7 +327,7 @@ func (m *PostgreSQLAddResponse) Reset()         { *m = PostgreSQLAddResponse{} }
 func (m *PostgreSQLAddResponse) String() string { return proto.CompactTextString(m) }
 func (*PostgreSQLAddResponse) ProtoMessage()    {}
 func (*PostgreSQLAddResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_postgresql_1f3feebc0db14cd3, []int{6}
+	return fileDescriptor_postgresql_e5145b21d701e472, []int{6}
 }
 func (m *PostgreSQLAddResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PostgreSQLAddResponse.Unmarshal(m, b)
@@ -357,7 +365,7 @@ func (m *PostgreSQLRemoveRequest) Reset()         { *m = PostgreSQLRemoveRequest
 func (m *PostgreSQLRemoveRequest) String() string { return proto.CompactTextString(m) }
 func (*PostgreSQLRemoveRequest) ProtoMessage()    {}
 func (*PostgreSQLRemoveRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_postgresql_1f3feebc0db14cd3, []int{7}
+	return fileDescriptor_postgresql_e5145b21d701e472, []int{7}
 }
 func (m *PostgreSQLRemoveRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PostgreSQLRemoveRequest.Unmarshal(m, b)
@@ -394,7 +402,7 @@ func (m *PostgreSQLRemoveResponse) Reset()         { *m = PostgreSQLRemoveRespon
 func (m *PostgreSQLRemoveResponse) String() string { return proto.CompactTextString(m) }
 func (*PostgreSQLRemoveResponse) ProtoMessage()    {}
 func (*PostgreSQLRemoveResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_postgresql_1f3feebc0db14cd3, []int{8}
+	return fileDescriptor_postgresql_e5145b21d701e472, []int{8}
 }
 func (m *PostgreSQLRemoveResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PostgreSQLRemoveResponse.Unmarshal(m, b)
@@ -564,40 +572,41 @@ var _PostgreSQL_serviceDesc = grpc.ServiceDesc{
 	Metadata: "postgresql.proto",
 }
 
-func init() { proto.RegisterFile("postgresql.proto", fileDescriptor_postgresql_1f3feebc0db14cd3) }
-
-var fileDescriptor_postgresql_1f3feebc0db14cd3 = []byte{
-	// 497 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x53, 0x4b, 0x6e, 0xdb, 0x30,
-	0x10, 0x05, 0x25, 0xc5, 0x9f, 0x09, 0x62, 0xa8, 0x74, 0x63, 0x33, 0x4a, 0x0a, 0x18, 0x04, 0x8a,
-	0xb8, 0x59, 0x58, 0x86, 0x8b, 0x6e, 0xba, 0xcb, 0xb2, 0x46, 0xd0, 0x8f, 0x02, 0x34, 0xcb, 0x82,
-	0x09, 0x09, 0x83, 0x40, 0x42, 0x2a, 0xa2, 0xec, 0x2e, 0x8a, 0x6e, 0x7a, 0x82, 0xa2, 0xbd, 0x41,
-	0xaf, 0xd4, 0x2b, 0xf4, 0x20, 0x85, 0x49, 0xd9, 0x94, 0x55, 0x77, 0xc7, 0xd1, 0x7b, 0xf3, 0xe6,
-	0xcd, 0x3c, 0x08, 0xe2, 0x5c, 0x9b, 0x72, 0x51, 0x08, 0xf3, 0x78, 0x3f, 0xc9, 0x0b, 0x5d, 0x6a,
-	0x1c, 0xb2, 0x5c, 0x26, 0x67, 0x0b, 0xad, 0x17, 0xf7, 0x22, 0x65, 0xb9, 0x4c, 0x99, 0x52, 0xba,
-	0x64, 0xa5, 0xd4, 0xca, 0x38, 0x0a, 0x9d, 0x42, 0xef, 0xbd, 0x6b, 0xbb, 0xfe, 0x70, 0xf5, 0x56,
-	0x73, 0x81, 0x31, 0x44, 0x8a, 0x3d, 0x08, 0x12, 0x8e, 0xd0, 0xb8, 0x9b, 0xd9, 0xf7, 0x3c, 0xea,
-	0xa0, 0x38, 0x98, 0x47, 0x9d, 0x20, 0x0e, 0xe9, 0x0f, 0x04, 0x4f, 0x7c, 0xcb, 0xb5, 0x28, 0x56,
-	0xf2, 0x4e, 0x60, 0x02, 0x6d, 0xc6, 0x79, 0x21, 0x8c, 0x21, 0x91, 0x6d, 0xdc, 0x94, 0x6b, 0xbd,
-	0x5c, 0x17, 0x25, 0x39, 0x18, 0xa1, 0xf1, 0x51, 0x66, 0xdf, 0x78, 0x00, 0x2d, 0xa1, 0x16, 0x52,
-	0x09, 0xd2, 0xb2, 0xe4, 0xaa, 0xc2, 0xcf, 0xa1, 0xe7, 0x5e, 0x9f, 0x56, 0xa2, 0x30, 0x52, 0x2b,
-	0xd2, 0xb6, 0xf8, 0x91, 0xfb, 0xfa, 0xd1, 0x7d, 0xac, 0xdb, 0x99, 0x47, 0x9d, 0x30, 0x8e, 0xa8,
-	0x06, 0xec, 0x3d, 0xbd, 0x51, 0xa6, 0x64, 0xea, 0x4e, 0xe0, 0x73, 0x88, 0x94, 0xe6, 0x82, 0xa0,
-	0x11, 0x1a, 0x1f, 0xce, 0xfa, 0x13, 0x96, 0xcb, 0xc9, 0xee, 0xb6, 0x99, 0x25, 0xe0, 0x29, 0xb4,
-	0x8d, 0x5b, 0x84, 0x04, 0x96, 0x3b, 0x68, 0x70, 0xab, 0x35, 0xb3, 0x0d, 0x8d, 0x0e, 0xe1, 0xd8,
-	0xa3, 0x57, 0xd2, 0x94, 0x99, 0x78, 0x5c, 0x0a, 0x53, 0xd2, 0x77, 0x30, 0x68, 0x02, 0x26, 0xd7,
-	0xca, 0x08, 0xfc, 0x0a, 0xba, 0xb2, 0x72, 0x66, 0x08, 0x1a, 0x85, 0xe3, 0xc3, 0xd9, 0xb0, 0x31,
-	0x66, 0xe3, 0x3c, 0xf3, 0x4c, 0xfa, 0x1d, 0xc1, 0x53, 0xcf, 0xb8, 0xe4, 0xbc, 0x9a, 0xb4, 0x0d,
-	0x0a, 0xf9, 0xa0, 0xea, 0x31, 0x04, 0xfb, 0x63, 0x08, 0x6b, 0x31, 0x24, 0xd0, 0x59, 0x1a, 0x51,
-	0x58, 0x15, 0x97, 0xda, 0xb6, 0x5e, 0x63, 0x39, 0x33, 0xe6, 0xb3, 0x2e, 0xb8, 0x8d, 0xae, 0x9b,
-	0x6d, 0x6b, 0x7a, 0x5e, 0x5f, 0xde, 0x3a, 0xaa, 0x56, 0xec, 0x41, 0x20, 0xb9, 0x35, 0x74, 0x90,
-	0x05, 0x92, 0xd3, 0x17, 0x30, 0xf4, 0xc4, 0x4c, 0x3c, 0xe8, 0x95, 0xd8, 0xb8, 0x6f, 0x52, 0x13,
-	0x20, 0xff, 0x52, 0x9d, 0xec, 0xec, 0x57, 0x00, 0xe0, 0x41, 0x7c, 0x03, 0xd1, 0xfa, 0xb0, 0x38,
-	0x69, 0x5c, 0xaf, 0x16, 0x43, 0x72, 0xba, 0x17, 0x73, 0x7a, 0x74, 0xf0, 0xed, 0xf7, 0x9f, 0x9f,
-	0x41, 0x8c, 0x7b, 0xe9, 0x6a, 0x9a, 0xfa, 0xbf, 0x06, 0xdf, 0x40, 0x78, 0xc9, 0x39, 0x3e, 0x69,
-	0xf4, 0xfa, 0x9b, 0x27, 0xc9, 0x3e, 0xa8, 0x52, 0x3d, 0xb1, 0xaa, 0x7d, 0xda, 0x50, 0x7d, 0x8d,
-	0x2e, 0xf0, 0x2d, 0xb4, 0xdc, 0x4a, 0xf8, 0xac, 0x21, 0xb0, 0x73, 0x94, 0xe4, 0xd9, 0x7f, 0xd0,
-	0x6a, 0xc2, 0xa9, 0x9d, 0x70, 0x7c, 0xd1, 0xdf, 0x9d, 0x90, 0x7e, 0x91, 0xfc, 0xeb, 0x6d, 0xcb,
-	0xfe, 0xd0, 0x2f, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0xcc, 0x66, 0x44, 0x32, 0x07, 0x04, 0x00,
-	0x00,
+func init() { proto.RegisterFile("postgresql.proto", fileDescriptor_postgresql_e5145b21d701e472) }
+
+var fileDescriptor_postgresql_e5145b21d701e472 = []byte{
+	// 526 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x53, 0x4d, 0x6f, 0xd3, 0x40,
+	0x10, 0xd5, 0xda, 0x6e, 0x3e, 0xa6, 0x34, 0x4a, 0x37, 0x24, 0x71, 0xdd, 0x22, 0x45, 0x96, 0x50,
+	0xd3, 0x1e, 0x92, 0x28, 0x88, 0x0b, 0xb7, 0xdc, 0x20, 0xaa, 0xf8, 0x70, 0x25, 0x7a, 0x44, 0xdb,
+	0xee, 0x28, 0x5d, 0x29, 0xdd, 0x75, 0xbd, 0x6e, 0x38, 0x20, 0x2e, 0xfc, 0x02, 0x04, 0x37, 0x8e,
+	0xfc, 0x25, 0xfe, 0x02, 0x3f, 0x04, 0x65, 0xd7, 0xc9, 0x26, 0x26, 0xbd, 0xed, 0xf8, 0xbd, 0x79,
+	0x33, 0x6f, 0x9e, 0x0c, 0xcd, 0x54, 0xe9, 0x7c, 0x96, 0xa1, 0xbe, 0x9f, 0x0f, 0xd2, 0x4c, 0xe5,
+	0x8a, 0xfa, 0x2c, 0x15, 0xd1, 0xc9, 0x4c, 0xa9, 0xd9, 0x1c, 0x87, 0x2c, 0x15, 0x43, 0x26, 0xa5,
+	0xca, 0x59, 0x2e, 0x94, 0xd4, 0x96, 0x12, 0x3d, 0x61, 0x33, 0x94, 0x79, 0x51, 0xc5, 0x23, 0x68,
+	0xbc, 0xb7, 0x22, 0x97, 0x1f, 0x2e, 0xde, 0x2a, 0x8e, 0x94, 0x42, 0x20, 0xd9, 0x1d, 0x86, 0x7e,
+	0x8f, 0xf4, 0xeb, 0x89, 0x79, 0x4f, 0x83, 0x1a, 0x69, 0x7a, 0xd3, 0xa0, 0xe6, 0x35, 0xfd, 0xf8,
+	0x07, 0x81, 0x43, 0xd7, 0x72, 0x89, 0xd9, 0x42, 0xdc, 0x20, 0x0d, 0xa1, 0xca, 0x38, 0xcf, 0x50,
+	0xeb, 0x30, 0x30, 0x8d, 0xab, 0x72, 0xa9, 0x97, 0xaa, 0x2c, 0x0f, 0xf7, 0x7a, 0xa4, 0x7f, 0x90,
+	0x98, 0x37, 0xed, 0x40, 0x05, 0xe5, 0x4c, 0x48, 0x0c, 0x2b, 0x86, 0x5c, 0x54, 0xf4, 0x39, 0x34,
+	0xec, 0xeb, 0xd3, 0x02, 0x33, 0x2d, 0x94, 0x0c, 0xab, 0x06, 0x3f, 0xb0, 0x5f, 0x3f, 0xda, 0x8f,
+	0x9b, 0xeb, 0x4c, 0x83, 0x9a, 0xdf, 0x0c, 0xe2, 0x5f, 0x04, 0xa8, 0x5b, 0xea, 0x8d, 0xd4, 0x39,
+	0x93, 0x37, 0x48, 0x4f, 0x21, 0x90, 0x8a, 0x63, 0x48, 0x7a, 0xa4, 0xbf, 0x3f, 0x6e, 0x0d, 0x58,
+	0x2a, 0x06, 0xdb, 0x76, 0x13, 0x43, 0xa0, 0x23, 0xa8, 0x6a, 0xeb, 0x24, 0xf4, 0x0c, 0xb7, 0x53,
+	0xe2, 0x16, 0x3e, 0x93, 0x15, 0x8d, 0x9e, 0x41, 0xe5, 0x16, 0xd9, 0x3c, 0xbf, 0x35, 0x87, 0x6a,
+	0x8c, 0x0f, 0x4d, 0xc3, 0xc4, 0xdc, 0xf6, 0xb5, 0x01, 0x92, 0x82, 0x10, 0x77, 0xa1, 0xed, 0x84,
+	0x2e, 0x84, 0xce, 0x13, 0xbc, 0x7f, 0x40, 0x9d, 0xc7, 0xef, 0xa0, 0x53, 0x06, 0x74, 0xaa, 0xa4,
+	0x46, 0xfa, 0x12, 0xea, 0xa2, 0x30, 0xa1, 0x43, 0xd2, 0xf3, 0xfb, 0xfb, 0xe3, 0x6e, 0x69, 0xa3,
+	0x95, 0xc9, 0xc4, 0x31, 0xe3, 0xef, 0x04, 0x9e, 0x3a, 0xc6, 0x84, 0xf3, 0x62, 0xd2, 0x3a, 0x54,
+	0xe2, 0x42, 0xdd, 0x8c, 0xcc, 0xdb, 0x1d, 0x99, 0xbf, 0x11, 0x59, 0x04, 0xb5, 0x07, 0x8d, 0x99,
+	0x51, 0xb1, 0x09, 0xaf, 0xeb, 0x25, 0x96, 0x32, 0xad, 0x3f, 0xab, 0x8c, 0x9b, 0x98, 0xeb, 0xc9,
+	0xba, 0x8e, 0x4f, 0xa1, 0x5d, 0xda, 0xa8, 0xb0, 0xd8, 0x00, 0x4f, 0x70, 0xb3, 0xd0, 0x5e, 0xe2,
+	0x09, 0x1e, 0x9f, 0x41, 0xd7, 0x11, 0x13, 0xbc, 0x53, 0x0b, 0x5c, 0x6d, 0x5f, 0xa6, 0x46, 0x10,
+	0xfe, 0x4f, 0xb5, 0xb2, 0xe3, 0xdf, 0x1e, 0x80, 0x03, 0xe9, 0x15, 0x04, 0xcb, 0xc3, 0xd2, 0xa8,
+	0x74, 0xbd, 0x8d, 0x18, 0xa2, 0xe3, 0x9d, 0x98, 0xd5, 0x8b, 0x3b, 0xdf, 0xfe, 0xfc, 0xfd, 0xe9,
+	0x35, 0x69, 0x63, 0xb8, 0x18, 0x0d, 0xdd, 0xff, 0x46, 0xaf, 0xc0, 0x9f, 0x70, 0x4e, 0x8f, 0x4a,
+	0xbd, 0xee, 0xe6, 0x51, 0xb4, 0x0b, 0x2a, 0x54, 0x8f, 0x8c, 0x6a, 0x2b, 0x2e, 0xa9, 0xbe, 0x22,
+	0xe7, 0xf4, 0x1a, 0x2a, 0xd6, 0x12, 0x3d, 0x29, 0x09, 0x6c, 0x1d, 0x25, 0x7a, 0xf6, 0x08, 0x5a,
+	0x4c, 0x38, 0x36, 0x13, 0xda, 0xe7, 0xad, 0xed, 0x09, 0xc3, 0x2f, 0x82, 0x7f, 0xbd, 0xae, 0x98,
+	0x9f, 0xff, 0xc5, 0xbf, 0x01, 0x00, 0x51, 0xa2, 0xd7, 0x02, 0x41, 0x04, 0x00, 0x00,
 }
@@ -36,7 +36,7 @@ func (m *RDSNode) Reset()         { *m = RDSNode{} }
 func (m *RDSNode) String() string { return proto.CompactTextString(m) }
 func (*RDSNode) ProtoMessage()    {}
 func (*RDSNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rds_b2bbb8c123f60b23, []int{0}
+	return fileDescriptor_rds_d96cdc31be070b01, []int{0}
 }
 func (m *RDSNode) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RDSNode.Unmarshal(m, b)
@@ -84,7 +84,7 @@ func (m *RDSService) Reset()         { *m = RDSService{} }
 func (m *RDSService) String() string { return proto.CompactTextString(m) }
 func (*RDSService) ProtoMessage()    {}
 func (*RDSService) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rds_b2bbb8c123f60b23, []int{1}
+	return fileDescriptor_rds_d96cdc31be070b01, []int{1}
 }
 func (m *RDSService) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RDSService.Unmarshal(m, b)
@@ -144,7 +144,7 @@ func (m *RDSInstanceID) Reset()         { *m = RDSInstanceID{} }
 func (m *RDSInstanceID) String() string { return proto.CompactTextString(m) }
 func (*RDSInstanceID) ProtoMessage()    {}
 func (*RDSInstanceID) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rds_b2bbb8c123f60b23, []int{2}
+	return fileDescriptor_rds_d96cdc31be070b01, []int{2}
 }
 func (m *RDSInstanceID) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RDSInstanceID.Unmarshal(m, b)
@@ -179,18 +179,19 @@ func (m *RDSInstanceID) GetName() string {
 }
 
 type RDSInstance struct {
-	Node                 *RDSNode    `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
-	Service              *RDSService `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+	Node                 *RDSNode     `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	Service              *RDSService  `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty"`
+	Health               AgentsHealth `protobuf:"varint,3,opt,name=health,proto3,enum=api.AgentsHealth" json:"health,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
 func (m *RDSInstance) Reset()         { *m = RDSInstance{} }
 func (m *RDSInstance) String() string { return proto.CompactTextString(m) }
 func (*RDSInstance) ProtoMessage()    {}
 func (*RDSInstance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rds_b2bbb8c123f60b23, []int{3}
+	return fileDescriptor_rds_d96cdc31be070b01, []int{3}
 }
 func (m *RDSInstance) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RDSInstance.Unmarshal(m, b)
@@ -224,6 +225,13 @@ func (m *RDSInstance) GetService() *RDSService {
 	return nil
 }
 
+func (m *RDSInstance) GetHealth() AgentsHealth {
+	if m != nil {
+		return m.Health
+	}
+	return AgentsHealth_AGENTS_HEALTH_UNKNOWN
+}
+
 type RDSDiscoverRequest struct {
 	AwsAccessKeyId       string   `protobuf:"bytes,1,opt,name=aws_access_key_id,json=awsAccessKeyId,proto3" json:"aws_access_key_id,omitempty"`
 	AwsSecretAccessKey   string   `protobuf:"bytes,2,opt,name=aws_secret_access_key,json=awsSecretAccessKey,proto3" json:"aws_secret_access_key,omitempty"`
@@ -236,7 +244,7 @@ func (m *RDSDiscoverRequest) Reset()         { *m = RDSDiscoverRequest{} }
 func (m *RDSDiscoverRequest) String() string { return proto.CompactTextString(m) }
 func (*RDSDiscoverRequest) ProtoMessage()    {}
 func (*RDSDiscoverRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rds_b2bbb8c123f60b23, []int{4}
+	return fileDescriptor_rds_d96cdc31be070b01, []int{4}
 }
 func (m *RDSDiscoverRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RDSDiscoverRequest.Unmarshal(m, b)
@@ -281,7 +289,7 @@ func (m *RDSDiscoverResponse) Reset()         { *m = RDSDiscoverResponse{} }
 func (m *RDSDiscoverResponse) String() string { return proto.CompactTextString(m) }
 func (*RDSDiscoverResponse) ProtoMessage()    {}
 func (*RDSDiscoverResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rds_b2bbb8c123f60b23, []int{5}
+	return fileDescriptor_rds_d96cdc31be070b01, []int{5}
 }
 func (m *RDSDiscoverResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RDSDiscoverResponse.Unmarshal(m, b)
@@ -318,7 +326,7 @@ func (m *RDSListRequest) Reset()         { *m = RDSListRequest{} }
 func (m *RDSListRequest) String() string { return proto.CompactTextString(m) }
 func (*RDSListRequest) ProtoMessage()    {}
 func (*RDSListRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rds_b2bbb8c123f60b23, []int{6}
+	return fileDescriptor_rds_d96cdc31be070b01, []int{6}
 }
 func (m *RDSListRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RDSListRequest.Unmarshal(m, b)
@@ -349,7 +357,7 @@ func (m *RDSListResponse) Reset()         { *m = RDSListResponse{} }
 func (m *RDSListResponse) String() string { return proto.CompactTextString(m) }
 func (*RDSListResponse) ProtoMessage()    {}
 func (*RDSListResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rds_b2bbb8c123f60b23, []int{7}
+	return fileDescriptor_rds_d96cdc31be070b01, []int{7}
 }
 func (m *RDSListResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RDSListResponse.Unmarshal(m, b)
@@ -391,7 +399,7 @@ func (m *RDSAddRequest) Reset()         { *m = RDSAddRequest{} }
 func (m *RDSAddRequest) String() string { return proto.CompactTextString(m) }
 func (*RDSAddRequest) ProtoMessage()    {}
 func (*RDSAddRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rds_b2bbb8c123f60b23, []int{8}
+	return fileDescriptor_rds_d96cdc31be070b01, []int{8}
 }
 func (m *RDSAddRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RDSAddRequest.Unmarshal(m, b)
@@ -456,7 +464,7 @@ func (m *RDSAddResponse) Reset()         { *m = RDSAddResponse{} }
 func (m *RDSAddResponse) String() string { return proto.CompactTextString(m) }
 func (*RDSAddResponse) ProtoMessage()    {}
 func (*RDSAddResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rds_b2bbb8c123f60b23, []int{9}
+	return fileDescriptor_rds_d96cdc31be070b01, []int{9}
 }
 func (m *RDSAddResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RDSAddResponse.Unmarshal(m, b)
@@ -487,7 +495,7 @@ func (m *RDSRemoveRequest) Reset()         { *m = RDSRemoveRequest{} }
 func (m *RDSRemoveRequest) String() string { return proto.CompactTextString(m) }
 func (*RDSRemoveRequest) ProtoMessage()    {}
 func (*RDSRemoveRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rds_b2bbb8c123f60b23, []int{10}
+	return fileDescriptor_rds_d96cdc31be070b01, []int{10}
 }
 func (m *RDSRemoveRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RDSRemoveRequest.Unmarshal(m, b)
@@ -524,7 +532,7 @@ func (m *RDSRemoveResponse) Reset()         { *m = RDSRemoveResponse{} }
 func (m *RDSRemoveResponse) String() string { return proto.CompactTextString(m) }
 func (*RDSRemoveResponse) ProtoMessage()    {}
 func (*RDSRemoveResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_rds_b2bbb8c123f60b23, []int{11}
+	return fileDescriptor_rds_d96cdc31be070b01, []int{11}
 }
 func (m *RDSRemoveResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RDSRemoveResponse.Unmarshal(m, b)
@@ -730,46 +738,47 @@ var _RDS_serviceDesc = grpc.ServiceDesc{
 	Metadata: "rds.proto",
 }
 
-func init() { proto.RegisterFile("rds.proto", fileDescriptor_rds_b2bbb8c123f60b23) }
-
-var fileDescriptor_rds_b2bbb8c123f60b23 = []byte{
-	// 594 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0xbb, 0x6e, 0x13, 0x4d,
-	0x14, 0xd6, 0x5e, 0x7e, 0x5f, 0x8e, 0x73, 0xd9, 0x1c, 0xff, 0x09, 0x2b, 0x43, 0x61, 0x8d, 0x84,
-	0x94, 0xa4, 0xb0, 0xc1, 0x48, 0x14, 0x50, 0x39, 0x5a, 0x0a, 0x27, 0x88, 0x62, 0x56, 0xa2, 0x48,
-	0x63, 0x0d, 0x9e, 0x91, 0x35, 0x82, 0xec, 0x2c, 0x33, 0x1b, 0x5b, 0x69, 0x69, 0x29, 0x79, 0x17,
-	0xde, 0x81, 0x9a, 0x57, 0xe0, 0x41, 0xd0, 0xce, 0xce, 0x3a, 0x6b, 0x83, 0x28, 0x28, 0xe8, 0xf6,
-	0x5c, 0xbe, 0x6f, 0xbe, 0xef, 0x9c, 0xa3, 0x85, 0xae, 0xe6, 0x66, 0x94, 0x6b, 0x55, 0x28, 0x0c,
-	0x58, 0x2e, 0x07, 0x8f, 0x96, 0x4a, 0x2d, 0x3f, 0x88, 0x31, 0xcb, 0xe5, 0x98, 0x65, 0x99, 0x2a,
-	0x58, 0x21, 0x55, 0xe6, 0x5a, 0xc8, 0x14, 0xda, 0x34, 0x49, 0xdf, 0x28, 0x2e, 0xf0, 0x04, 0x5a,
-	0x5a, 0x2c, 0xa5, 0xca, 0xe2, 0x60, 0xe8, 0x9d, 0x76, 0xa9, 0x8b, 0x10, 0x21, 0xcc, 0xd8, 0x8d,
-	0x88, 0x43, 0x9b, 0xb5, 0xdf, 0x97, 0x61, 0xc7, 0x8b, 0xfc, 0xcb, 0xb0, 0xe3, 0x47, 0x01, 0xf9,
-	0xec, 0x01, 0xd0, 0x24, 0x4d, 0x85, 0x5e, 0xc9, 0x85, 0xc0, 0x18, 0xda, 0x8c, 0x73, 0x2d, 0x8c,
-	0x71, 0x88, 0x3a, 0x2c, 0x89, 0x72, 0xa5, 0x8b, 0xf8, 0xbf, 0xa1, 0x77, 0xba, 0x4f, 0xed, 0x77,
-	0xf9, 0xa8, 0xc8, 0x96, 0x32, 0x13, 0x71, 0xab, 0x7a, 0xb4, 0x8a, 0xf0, 0x31, 0x1c, 0x54, 0x5f,
-	0xf3, 0x95, 0xd0, 0xa6, 0x14, 0xd5, 0xb6, 0xf5, 0xfd, 0x2a, 0xfb, 0xb6, 0x4a, 0x36, 0x75, 0x5c,
-	0x86, 0x9d, 0x20, 0x0a, 0xc9, 0x4b, 0xd8, 0xa7, 0x49, 0x3a, 0xcb, 0x4c, 0xc1, 0xb2, 0x85, 0x98,
-	0x25, 0x0d, 0x5b, 0xde, 0x6f, 0x6d, 0xf9, 0xf7, 0xb6, 0xc8, 0x35, 0xf4, 0x1a, 0x60, 0x1c, 0x42,
-	0x98, 0x29, 0x2e, 0x2c, 0xb0, 0x37, 0xd9, 0x1b, 0xb1, 0x5c, 0x8e, 0xdc, 0xb4, 0xa8, 0xad, 0xe0,
-	0x19, 0xb4, 0x4d, 0xe5, 0xdb, 0xf2, 0xf4, 0x26, 0x87, 0x75, 0x93, 0x1b, 0x07, 0xad, 0xeb, 0x44,
-	0x03, 0xd2, 0x24, 0x4d, 0xa4, 0x59, 0xa8, 0x95, 0xd0, 0x54, 0x7c, 0xbc, 0x15, 0xa6, 0xc0, 0x33,
-	0x38, 0x62, 0x6b, 0x33, 0x67, 0x8b, 0x85, 0x30, 0x66, 0xfe, 0x5e, 0xdc, 0xcd, 0x25, 0x77, 0x42,
-	0x0f, 0xd8, 0xda, 0x4c, 0x6d, 0xfe, 0x4a, 0xdc, 0xcd, 0x38, 0x3e, 0x85, 0xe3, 0xb2, 0xd5, 0x88,
-	0x85, 0x16, 0x45, 0x03, 0xe1, 0x1c, 0x20, 0x5b, 0x9b, 0xd4, 0xd6, 0x36, 0x20, 0xf2, 0x0a, 0xfa,
-	0x5b, 0x6f, 0x9a, 0x5c, 0x65, 0x46, 0xe0, 0x08, 0xba, 0xd2, 0x79, 0x34, 0xb1, 0x37, 0x0c, 0x4e,
-	0x7b, 0x93, 0xa8, 0xd6, 0x5d, 0x9b, 0xa7, 0xf7, 0x2d, 0x24, 0x82, 0x03, 0x9a, 0xa4, 0xaf, 0xa5,
-	0x29, 0x9c, 0x6c, 0x32, 0x85, 0xc3, 0x4d, 0xe6, 0x2f, 0x49, 0xbf, 0x79, 0x76, 0x53, 0x53, 0xce,
-	0xff, 0xc9, 0x2c, 0x90, 0x80, 0x2f, 0xb9, 0x3d, 0xed, 0xde, 0x04, 0x77, 0x85, 0xcd, 0x12, 0xea,
-	0x4b, 0x8e, 0x03, 0xe8, 0xdc, 0x1a, 0xa1, 0x1b, 0xe7, 0xbe, 0x89, 0xcb, 0x5a, 0xce, 0x8c, 0x59,
-	0x2b, 0xcd, 0xed, 0x05, 0x77, 0xe9, 0x26, 0x76, 0x03, 0xb2, 0x56, 0xaa, 0x69, 0x90, 0xe7, 0x10,
-	0xd1, 0x24, 0xa5, 0xe2, 0x46, 0xad, 0x44, 0xed, 0xaf, 0x52, 0xe0, 0xfd, 0x49, 0x01, 0xe9, 0xc3,
-	0x51, 0x03, 0x57, 0x91, 0x4d, 0xbe, 0xfa, 0x10, 0xd0, 0x24, 0xc5, 0x6b, 0xe8, 0xd4, 0xbb, 0xc4,
-	0x07, 0x35, 0xc1, 0xce, 0x45, 0x0d, 0xe2, 0x5f, 0x0b, 0x4e, 0xd3, 0xc3, 0x4f, 0xdf, 0x7f, 0x7c,
-	0xf1, 0x8f, 0x49, 0x34, 0x5e, 0x3d, 0x19, 0x6b, 0x6e, 0xc6, 0xdc, 0x75, 0xbc, 0xf0, 0xce, 0xf1,
-	0x02, 0xc2, 0x72, 0x9d, 0xd8, 0xaf, 0xe1, 0x8d, 0x75, 0x0f, 0xfe, 0xdf, 0x4e, 0x3a, 0xbe, 0x43,
-	0xcb, 0xd7, 0xc5, 0xb6, 0xe3, 0xc3, 0x0b, 0x08, 0xa6, 0x9c, 0xe3, 0xc6, 0xdb, 0xfd, 0x6e, 0x07,
-	0xfd, 0xad, 0x9c, 0x23, 0x40, 0x4b, 0xb0, 0x47, 0x6a, 0x82, 0x52, 0xc7, 0x15, 0xb4, 0x2a, 0xf7,
-	0x78, 0x5c, 0x43, 0xb6, 0xa6, 0x38, 0x38, 0xd9, 0x4d, 0x6f, 0x93, 0x9d, 0x37, 0xc8, 0xde, 0xb5,
-	0xec, 0x4f, 0xee, 0xd9, 0xcf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xda, 0xdd, 0x47, 0x2b, 0x14, 0x05,
-	0x00, 0x00,
+func init() { proto.RegisterFile("rds.proto", fileDescriptor_rds_d96cdc31be070b01) }
+
+var fileDescriptor_rds_d96cdc31be070b01 = []byte{
+	// 622 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0xcd, 0x6e, 0xd3, 0x4c,
+	0x14, 0x95, 0x7f, 0xbe, 0xfc, 0xdc, 0xb4, 0xa9, 0x7b, 0xf3, 0xb5, 0x58, 0x86, 0x45, 0x64, 0x09,
+	0xa9, 0xed, 0x22, 0x81, 0x20, 0xb1, 0x80, 0x55, 0x2a, 0x23, 0x91, 0x16, 0xb1, 0x18, 0x4b, 0x2c,
+	0xd8, 0x44, 0x43, 0xe6, 0x2a, 0xb5, 0x68, 0x3d, 0xc6, 0xe3, 0x26, 0xea, 0x16, 0x89, 0x15, 0x4b,
+	0xde, 0x85, 0x77, 0x60, 0xcd, 0x2b, 0xf0, 0x20, 0xc8, 0xe3, 0x71, 0xea, 0x14, 0xc4, 0x82, 0x05,
+	0xbb, 0xb9, 0x7f, 0x67, 0xce, 0x39, 0x77, 0x6c, 0xe8, 0xe6, 0x42, 0x8d, 0xb2, 0x5c, 0x16, 0x12,
+	0x1d, 0x9e, 0x25, 0xc1, 0x83, 0xa5, 0x94, 0xcb, 0x4b, 0x1a, 0xf3, 0x2c, 0x19, 0xf3, 0x34, 0x95,
+	0x05, 0x2f, 0x12, 0x99, 0x9a, 0x96, 0x60, 0x87, 0x2f, 0x29, 0x2d, 0x4c, 0x14, 0x4e, 0xa1, 0xcd,
+	0xa2, 0xf8, 0xb5, 0x14, 0x84, 0x87, 0xd0, 0xca, 0x69, 0x99, 0xc8, 0xd4, 0x77, 0x86, 0xd6, 0x51,
+	0x97, 0x99, 0x08, 0x11, 0xdc, 0x94, 0x5f, 0x91, 0xef, 0xea, 0xac, 0x3e, 0x9f, 0xb9, 0x1d, 0xcb,
+	0xb3, 0xcf, 0xdc, 0x8e, 0xed, 0x39, 0xe1, 0x67, 0x0b, 0x80, 0x45, 0x71, 0x4c, 0xf9, 0x2a, 0x59,
+	0x10, 0xfa, 0xd0, 0xe6, 0x42, 0xe4, 0xa4, 0x94, 0x99, 0xa8, 0xc3, 0x12, 0x28, 0x93, 0x79, 0xe1,
+	0xff, 0x37, 0xb4, 0x8e, 0x76, 0x99, 0x3e, 0x97, 0x97, 0x52, 0xba, 0x4c, 0x52, 0xf2, 0x5b, 0xd5,
+	0xa5, 0x55, 0x84, 0x0f, 0xa1, 0x5f, 0x9d, 0xe6, 0x2b, 0xca, 0x55, 0x49, 0xaa, 0xad, 0xeb, 0xbb,
+	0x55, 0xf6, 0x4d, 0x95, 0x6c, 0xf2, 0x38, 0x73, 0x3b, 0x8e, 0xe7, 0x86, 0xcf, 0x61, 0x97, 0x45,
+	0xf1, 0x2c, 0x55, 0x05, 0x4f, 0x17, 0x34, 0x8b, 0x1a, 0xb2, 0xac, 0xdf, 0xca, 0xb2, 0x6f, 0x65,
+	0x85, 0x9f, 0x2c, 0xe8, 0x35, 0xa6, 0x71, 0x08, 0x6e, 0x2a, 0x05, 0xe9, 0xc9, 0xde, 0x64, 0x67,
+	0xc4, 0xb3, 0x64, 0x64, 0xec, 0x62, 0xba, 0x82, 0xc7, 0xd0, 0x56, 0x95, 0x70, 0x0d, 0xd4, 0x9b,
+	0xec, 0xd5, 0x4d, 0xc6, 0x0f, 0x56, 0xd7, 0xf1, 0x18, 0x5a, 0x17, 0xc4, 0x2f, 0x8b, 0x0b, 0xed,
+	0x6f, 0x7f, 0xb2, 0xaf, 0x3b, 0xa7, 0x7a, 0x1b, 0x2f, 0x75, 0x81, 0x99, 0x86, 0x30, 0x07, 0x64,
+	0x51, 0x1c, 0x25, 0x6a, 0x21, 0x57, 0x94, 0x33, 0xfa, 0x70, 0x4d, 0xaa, 0xc0, 0x63, 0xd8, 0xe7,
+	0x6b, 0x35, 0xe7, 0x8b, 0x05, 0x29, 0x35, 0x7f, 0x4f, 0x37, 0xf3, 0x44, 0x18, 0x51, 0x7d, 0xbe,
+	0x56, 0x53, 0x9d, 0x3f, 0xa7, 0x9b, 0x99, 0xc0, 0xc7, 0x70, 0x50, 0xb6, 0x2a, 0x5a, 0xe4, 0x54,
+	0x34, 0x26, 0x8c, 0x5a, 0xe4, 0x6b, 0x15, 0xeb, 0xda, 0x66, 0x28, 0x7c, 0x01, 0x83, 0xad, 0x3b,
+	0x55, 0x26, 0x53, 0x45, 0x38, 0x82, 0x6e, 0x62, 0xec, 0x50, 0xbe, 0x35, 0x74, 0x8e, 0x7a, 0x13,
+	0xaf, 0x96, 0x58, 0xfb, 0xc4, 0x6e, 0x5b, 0x42, 0x0f, 0xfa, 0x2c, 0x8a, 0x5f, 0x25, 0xaa, 0x30,
+	0xb4, 0xc3, 0x29, 0xec, 0x6d, 0x32, 0x7f, 0x09, 0xfa, 0xcd, 0xd2, 0x5b, 0x9d, 0x0a, 0xf1, 0x4f,
+	0xbc, 0xc0, 0x10, 0xec, 0x44, 0xe8, 0x35, 0xf5, 0x26, 0x78, 0x97, 0xd8, 0x2c, 0x62, 0x76, 0x22,
+	0x30, 0x80, 0xce, 0xb5, 0xa2, 0xbc, 0xf1, 0x69, 0x6c, 0xe2, 0xb2, 0x96, 0x71, 0xa5, 0xd6, 0x32,
+	0x17, 0xfa, 0xb5, 0x77, 0xd9, 0x26, 0x36, 0x06, 0x69, 0x29, 0x95, 0x1b, 0xe1, 0x53, 0xf0, 0x58,
+	0x14, 0x33, 0xba, 0x92, 0x2b, 0xaa, 0xf5, 0x55, 0x0c, 0xac, 0x3f, 0x31, 0x08, 0x07, 0xb0, 0xdf,
+	0x98, 0xab, 0xc0, 0x26, 0x5f, 0x6d, 0x70, 0x58, 0x14, 0xe3, 0x5b, 0xe8, 0xd4, 0xbb, 0xc4, 0x7b,
+	0x35, 0xc0, 0x9d, 0x17, 0x15, 0xf8, 0xbf, 0x16, 0x0c, 0xa7, 0xfb, 0x1f, 0xbf, 0xff, 0xf8, 0x62,
+	0x1f, 0x84, 0xde, 0x78, 0xf5, 0x68, 0x9c, 0x0b, 0x35, 0x16, 0xa6, 0xe3, 0x99, 0x75, 0x82, 0xa7,
+	0xe0, 0x96, 0xeb, 0xc4, 0x41, 0x3d, 0xde, 0x58, 0x77, 0xf0, 0xff, 0x76, 0xd2, 0xe0, 0xed, 0x69,
+	0xbc, 0x2e, 0xb6, 0x0d, 0x1e, 0x9e, 0x82, 0x33, 0x15, 0x02, 0x37, 0xda, 0x6e, 0x77, 0x1b, 0x0c,
+	0xb6, 0x72, 0x06, 0x00, 0x35, 0xc0, 0x4e, 0x58, 0x03, 0x94, 0x3c, 0xce, 0xa1, 0x55, 0xa9, 0xc7,
+	0x83, 0x7a, 0x64, 0xcb, 0xc5, 0xe0, 0xf0, 0x6e, 0x7a, 0x1b, 0xec, 0xa4, 0x01, 0xf6, 0xae, 0xa5,
+	0x7f, 0x88, 0x4f, 0x7e, 0x0e, 0x00, 0xb0, 0x0f, 0x9c, 0x45, 0x4e, 0x05, 0x00, 0x00,
 }
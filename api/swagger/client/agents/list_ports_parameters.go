@@ -0,0 +1,114 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package agents
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+// NewListPortsParams creates a new ListPortsParams object
+// with the default values initialized.
+func NewListPortsParams() *ListPortsParams {
+
+	return &ListPortsParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewListPortsParamsWithTimeout creates a new ListPortsParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewListPortsParamsWithTimeout(timeout time.Duration) *ListPortsParams {
+
+	return &ListPortsParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewListPortsParamsWithContext creates a new ListPortsParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewListPortsParamsWithContext(ctx context.Context) *ListPortsParams {
+
+	return &ListPortsParams{
+
+		Context: ctx,
+	}
+}
+
+// NewListPortsParamsWithHTTPClient creates a new ListPortsParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewListPortsParamsWithHTTPClient(client *http.Client) *ListPortsParams {
+
+	return &ListPortsParams{
+		HTTPClient: client,
+	}
+}
+
+/*ListPortsParams contains all the parameters to send to the API endpoint
+for the list ports operation typically these are written to a http.Request
+*/
+type ListPortsParams struct {
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the list ports params
+func (o *ListPortsParams) WithTimeout(timeout time.Duration) *ListPortsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the list ports params
+func (o *ListPortsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the list ports params
+func (o *ListPortsParams) WithContext(ctx context.Context) *ListPortsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the list ports params
+func (o *ListPortsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the list ports params
+func (o *ListPortsParams) WithHTTPClient(client *http.Client) *ListPortsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the list ports params
+func (o *ListPortsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ListPortsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
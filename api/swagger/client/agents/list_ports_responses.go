@@ -0,0 +1,67 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package agents
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	models "github.com/percona/pmm-managed/api/swagger/models"
+)
+
+// ListPortsReader is a Reader for the ListPorts structure.
+type ListPortsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ListPortsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+
+	case 200:
+		result := NewListPortsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+
+	default:
+		return nil, runtime.NewAPIError("unknown error", response, response.Code())
+	}
+}
+
+// NewListPortsOK creates a ListPortsOK with default headers values
+func NewListPortsOK() *ListPortsOK {
+	return &ListPortsOK{}
+}
+
+/*ListPortsOK handles this case with default header values.
+
+(empty)
+*/
+type ListPortsOK struct {
+	Payload *models.APIAgentsListPortsResponse
+}
+
+func (o *ListPortsOK) Error() string {
+	return fmt.Sprintf("[GET /v0/agents/ports][%d] listPortsOK  %+v", 200, o.Payload)
+}
+
+func (o *ListPortsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIAgentsListPortsResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,67 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package agents
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	models "github.com/percona/pmm-managed/api/swagger/models"
+)
+
+// StatusReader is a Reader for the Status structure.
+type StatusReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *StatusReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+
+	case 200:
+		result := NewStatusOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+
+	default:
+		return nil, runtime.NewAPIError("unknown error", response, response.Code())
+	}
+}
+
+// NewStatusOK creates a StatusOK with default headers values
+func NewStatusOK() *StatusOK {
+	return &StatusOK{}
+}
+
+/*StatusOK handles this case with default header values.
+
+(empty)
+*/
+type StatusOK struct {
+	Payload *models.APIAgentsStatusResponse
+}
+
+func (o *StatusOK) Error() string {
+	return fmt.Sprintf("[GET /v0/agents/status][%d] statusOK  %+v", 200, o.Payload)
+}
+
+func (o *StatusOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIAgentsStatusResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -52,6 +52,90 @@ func (a *Client) Create(params *CreateParams) (*CreateOK, error) {
 
 }
 
+/*
+Delete delete API
+*/
+func (a *Client) Delete(params *DeleteParams) (*DeleteOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewDeleteParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "Delete",
+		Method:             "DELETE",
+		PathPattern:        "/v0/annotations/{id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &DeleteReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*DeleteOK), nil
+
+}
+
+/*
+List list API
+*/
+func (a *Client) List(params *ListParams) (*ListOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewListParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "List",
+		Method:             "GET",
+		PathPattern:        "/v0/annotations",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &ListReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*ListOK), nil
+
+}
+
+/*
+Update update API
+*/
+func (a *Client) Update(params *UpdateParams) (*UpdateOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewUpdateParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "Update",
+		Method:             "PUT",
+		PathPattern:        "/v0/annotations/{annotation.id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &UpdateReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*UpdateOK), nil
+
+}
+
 // SetTransport changes the transport on the client
 func (a *Client) SetTransport(transport runtime.ClientTransport) {
 	a.transport = transport
@@ -1,6 +1,6 @@
 // Code generated by go-swagger; DO NOT EDIT.
 
-package scrape_configs
+package annotations
 
 // This file was generated by the swagger tool.
 // Editing this file might prove futile when you re-run the swagger generate command
@@ -62,8 +62,8 @@ for the delete operation typically these are written to a http.Request
 */
 type DeleteParams struct {
 
-	/*JobName*/
-	JobName string
+	/*ID*/
+	ID string
 
 	timeout    time.Duration
 	Context    context.Context
@@ -103,15 +103,15 @@ func (o *DeleteParams) SetHTTPClient(client *http.Client) {
 	o.HTTPClient = client
 }
 
-// WithJobName adds the jobName to the delete params
-func (o *DeleteParams) WithJobName(jobName string) *DeleteParams {
-	o.SetJobName(jobName)
+// WithID adds the id to the delete params
+func (o *DeleteParams) WithID(id string) *DeleteParams {
+	o.SetID(id)
 	return o
 }
 
-// SetJobName adds the jobName to the delete params
-func (o *DeleteParams) SetJobName(jobName string) {
-	o.JobName = jobName
+// SetID adds the id to the delete params
+func (o *DeleteParams) SetID(id string) {
+	o.ID = id
 }
 
 // WriteToRequest writes these params to a swagger request
@@ -122,8 +122,8 @@ func (o *DeleteParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Regist
 	}
 	var res []error
 
-	// path param job_name
-	if err := r.SetPathParam("job_name", o.JobName); err != nil {
+	// path param id
+	if err := r.SetPathParam("id", o.ID); err != nil {
 		return err
 	}
 
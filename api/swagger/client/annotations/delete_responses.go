@@ -1,6 +1,6 @@
 // Code generated by go-swagger; DO NOT EDIT.
 
-package scrape_configs
+package annotations
 
 // This file was generated by the swagger tool.
 // Editing this file might prove futile when you re-run the swagger generate command
@@ -47,11 +47,11 @@ func NewDeleteOK() *DeleteOK {
 (empty)
 */
 type DeleteOK struct {
-	Payload models.APIScrapeConfigsDeleteResponse
+	Payload models.APIAnnotationsDeleteResponse
 }
 
 func (o *DeleteOK) Error() string {
-	return fmt.Sprintf("[DELETE /v0/scrape-configs/{job_name}][%d] deleteOK  %+v", 200, o.Payload)
+	return fmt.Sprintf("[DELETE /v0/annotations/{id}][%d] deleteOK  %+v", 200, o.Payload)
 }
 
 func (o *DeleteOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
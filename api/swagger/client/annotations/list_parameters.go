@@ -1,6 +1,6 @@
 // Code generated by go-swagger; DO NOT EDIT.
 
-package my_sql
+package annotations
 
 // This file was generated by the swagger tool.
 // Editing this file might prove futile when you re-run the swagger generate command
@@ -14,6 +14,7 @@ import (
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/runtime"
 	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/swag"
 
 	strfmt "github.com/go-openapi/strfmt"
 )
@@ -21,7 +22,7 @@ import (
 // NewListParams creates a new ListParams object
 // with the default values initialized.
 func NewListParams() *ListParams {
-
+	var ()
 	return &ListParams{
 
 		timeout: cr.DefaultTimeout,
@@ -31,7 +32,7 @@ func NewListParams() *ListParams {
 // NewListParamsWithTimeout creates a new ListParams object
 // with the default values initialized, and the ability to set a timeout on a request
 func NewListParamsWithTimeout(timeout time.Duration) *ListParams {
-
+	var ()
 	return &ListParams{
 
 		timeout: timeout,
@@ -41,7 +42,7 @@ func NewListParamsWithTimeout(timeout time.Duration) *ListParams {
 // NewListParamsWithContext creates a new ListParams object
 // with the default values initialized, and the ability to set a context for a request
 func NewListParamsWithContext(ctx context.Context) *ListParams {
-
+	var ()
 	return &ListParams{
 
 		Context: ctx,
@@ -51,7 +52,7 @@ func NewListParamsWithContext(ctx context.Context) *ListParams {
 // NewListParamsWithHTTPClient creates a new ListParams object
 // with the default values initialized, and the ability to set a custom HTTPClient for a request
 func NewListParamsWithHTTPClient(client *http.Client) *ListParams {
-
+	var ()
 	return &ListParams{
 		HTTPClient: client,
 	}
@@ -61,6 +62,19 @@ func NewListParamsWithHTTPClient(client *http.Client) *ListParams {
 for the list operation typically these are written to a http.Request
 */
 type ListParams struct {
+
+	/*From*/
+	From *strfmt.DateTime
+	/*Limit*/
+	Limit *int64
+	/*Tags
+	  Annotations should have all of those tags.
+
+	*/
+	Tags []string
+	/*To*/
+	To *strfmt.DateTime
+
 	timeout    time.Duration
 	Context    context.Context
 	HTTPClient *http.Client
@@ -99,6 +113,50 @@ func (o *ListParams) SetHTTPClient(client *http.Client) {
 	o.HTTPClient = client
 }
 
+// WithFrom adds the from to the list params
+func (o *ListParams) WithFrom(from *strfmt.DateTime) *ListParams {
+	o.SetFrom(from)
+	return o
+}
+
+// SetFrom adds the from to the list params
+func (o *ListParams) SetFrom(from *strfmt.DateTime) {
+	o.From = from
+}
+
+// WithLimit adds the limit to the list params
+func (o *ListParams) WithLimit(limit *int64) *ListParams {
+	o.SetLimit(limit)
+	return o
+}
+
+// SetLimit adds the limit to the list params
+func (o *ListParams) SetLimit(limit *int64) {
+	o.Limit = limit
+}
+
+// WithTags adds the tags to the list params
+func (o *ListParams) WithTags(tags []string) *ListParams {
+	o.SetTags(tags)
+	return o
+}
+
+// SetTags adds the tags to the list params
+func (o *ListParams) SetTags(tags []string) {
+	o.Tags = tags
+}
+
+// WithTo adds the to to the list params
+func (o *ListParams) WithTo(to *strfmt.DateTime) *ListParams {
+	o.SetTo(to)
+	return o
+}
+
+// SetTo adds the to to the list params
+func (o *ListParams) SetTo(to *strfmt.DateTime) {
+	o.To = to
+}
+
 // WriteToRequest writes these params to a swagger request
 func (o *ListParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
 
@@ -107,6 +165,62 @@ func (o *ListParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry
 	}
 	var res []error
 
+	if o.From != nil {
+
+		// query param from
+		var qrFrom strfmt.DateTime
+		if o.From != nil {
+			qrFrom = *o.From
+		}
+		qFrom := qrFrom.String()
+		if qFrom != "" {
+			if err := r.SetQueryParam("from", qFrom); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.Limit != nil {
+
+		// query param limit
+		var qrLimit int64
+		if o.Limit != nil {
+			qrLimit = *o.Limit
+		}
+		qLimit := swag.FormatInt64(qrLimit)
+		if qLimit != "" {
+			if err := r.SetQueryParam("limit", qLimit); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	valuesTags := o.Tags
+
+	joinedTags := swag.JoinByFormat(valuesTags, "")
+	// query array param tags
+	if err := r.SetQueryParam("tags", joinedTags...); err != nil {
+		return err
+	}
+
+	if o.To != nil {
+
+		// query param to
+		var qrTo strfmt.DateTime
+		if o.To != nil {
+			qrTo = *o.To
+		}
+		qTo := qrTo.String()
+		if qTo != "" {
+			if err := r.SetQueryParam("to", qTo); err != nil {
+				return err
+			}
+		}
+
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -1,6 +1,6 @@
 // Code generated by go-swagger; DO NOT EDIT.
 
-package my_sql
+package annotations
 
 // This file was generated by the swagger tool.
 // Editing this file might prove futile when you re-run the swagger generate command
@@ -47,16 +47,16 @@ func NewListOK() *ListOK {
 (empty)
 */
 type ListOK struct {
-	Payload *models.APIMySQLListResponse
+	Payload *models.APIAnnotationsListResponse
 }
 
 func (o *ListOK) Error() string {
-	return fmt.Sprintf("[GET /v0/mysql][%d] listOK  %+v", 200, o.Payload)
+	return fmt.Sprintf("[GET /v0/annotations][%d] listOK  %+v", 200, o.Payload)
 }
 
 func (o *ListOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
 
-	o.Payload = new(models.APIMySQLListResponse)
+	o.Payload = new(models.APIAnnotationsListResponse)
 
 	// response payload
 	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
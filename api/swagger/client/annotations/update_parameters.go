@@ -1,6 +1,6 @@
 // Code generated by go-swagger; DO NOT EDIT.
 
-package scrape_configs
+package annotations
 
 // This file was generated by the swagger tool.
 // Editing this file might prove futile when you re-run the swagger generate command
@@ -64,13 +64,10 @@ for the update operation typically these are written to a http.Request
 */
 type UpdateParams struct {
 
+	/*AnnotationID*/
+	AnnotationID string
 	/*Body*/
-	Body *models.APIScrapeConfigsUpdateRequest
-	/*ScrapeConfigJobName
-	  The job name assigned to scraped metrics by default: "example-job" (required)
-
-	*/
-	ScrapeConfigJobName string
+	Body *models.APIAnnotationsUpdateRequest
 
 	timeout    time.Duration
 	Context    context.Context
@@ -110,26 +107,26 @@ func (o *UpdateParams) SetHTTPClient(client *http.Client) {
 	o.HTTPClient = client
 }
 
-// WithBody adds the body to the update params
-func (o *UpdateParams) WithBody(body *models.APIScrapeConfigsUpdateRequest) *UpdateParams {
-	o.SetBody(body)
+// WithAnnotationID adds the annotationID to the update params
+func (o *UpdateParams) WithAnnotationID(annotationID string) *UpdateParams {
+	o.SetAnnotationID(annotationID)
 	return o
 }
 
-// SetBody adds the body to the update params
-func (o *UpdateParams) SetBody(body *models.APIScrapeConfigsUpdateRequest) {
-	o.Body = body
+// SetAnnotationID adds the annotationId to the update params
+func (o *UpdateParams) SetAnnotationID(annotationID string) {
+	o.AnnotationID = annotationID
 }
 
-// WithScrapeConfigJobName adds the scrapeConfigJobName to the update params
-func (o *UpdateParams) WithScrapeConfigJobName(scrapeConfigJobName string) *UpdateParams {
-	o.SetScrapeConfigJobName(scrapeConfigJobName)
+// WithBody adds the body to the update params
+func (o *UpdateParams) WithBody(body *models.APIAnnotationsUpdateRequest) *UpdateParams {
+	o.SetBody(body)
 	return o
 }
 
-// SetScrapeConfigJobName adds the scrapeConfigJobName to the update params
-func (o *UpdateParams) SetScrapeConfigJobName(scrapeConfigJobName string) {
-	o.ScrapeConfigJobName = scrapeConfigJobName
+// SetBody adds the body to the update params
+func (o *UpdateParams) SetBody(body *models.APIAnnotationsUpdateRequest) {
+	o.Body = body
 }
 
 // WriteToRequest writes these params to a swagger request
@@ -140,17 +137,17 @@ func (o *UpdateParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Regist
 	}
 	var res []error
 
+	// path param annotation.id
+	if err := r.SetPathParam("annotation.id", o.AnnotationID); err != nil {
+		return err
+	}
+
 	if o.Body != nil {
 		if err := r.SetBodyParam(o.Body); err != nil {
 			return err
 		}
 	}
 
-	// path param scrape_config.job_name
-	if err := r.SetPathParam("scrape_config.job_name", o.ScrapeConfigJobName); err != nil {
-		return err
-	}
-
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
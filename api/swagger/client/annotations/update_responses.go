@@ -1,6 +1,6 @@
 // Code generated by go-swagger; DO NOT EDIT.
 
-package scrape_configs
+package annotations
 
 // This file was generated by the swagger tool.
 // Editing this file might prove futile when you re-run the swagger generate command
@@ -47,11 +47,11 @@ func NewUpdateOK() *UpdateOK {
 (empty)
 */
 type UpdateOK struct {
-	Payload models.APIScrapeConfigsUpdateResponse
+	Payload models.APIAnnotationsUpdateResponse
 }
 
 func (o *UpdateOK) Error() string {
-	return fmt.Sprintf("[PUT /v0/scrape-configs/{scrape_config.job_name}][%d] updateOK  %+v", 200, o.Payload)
+	return fmt.Sprintf("[PUT /v0/annotations/{annotation.id}][%d] updateOK  %+v", 200, o.Payload)
 }
 
 func (o *UpdateOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
@@ -0,0 +1,309 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package audit
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/swag"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+// NewListMixin1Params creates a new ListMixin1Params object
+// with the default values initialized.
+func NewListMixin1Params() *ListMixin1Params {
+	var ()
+	return &ListMixin1Params{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewListMixin1ParamsWithTimeout creates a new ListMixin1Params object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewListMixin1ParamsWithTimeout(timeout time.Duration) *ListMixin1Params {
+	var ()
+	return &ListMixin1Params{
+
+		timeout: timeout,
+	}
+}
+
+// NewListMixin1ParamsWithContext creates a new ListMixin1Params object
+// with the default values initialized, and the ability to set a context for a request
+func NewListMixin1ParamsWithContext(ctx context.Context) *ListMixin1Params {
+	var ()
+	return &ListMixin1Params{
+
+		Context: ctx,
+	}
+}
+
+// NewListMixin1ParamsWithHTTPClient creates a new ListMixin1Params object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewListMixin1ParamsWithHTTPClient(client *http.Client) *ListMixin1Params {
+	var ()
+	return &ListMixin1Params{
+		HTTPClient: client,
+	}
+}
+
+/*ListMixin1Params contains all the parameters to send to the API endpoint
+for the list mixin1 operation typically these are written to a http.Request
+*/
+type ListMixin1Params struct {
+
+	/*Code
+	  Return only entries with that gRPC status code name.
+
+	*/
+	Code *string
+	/*Method
+	  Return only entries for that full gRPC method name.
+
+	*/
+	Method *string
+	/*PageSize
+	  Maximum number of entries to return; 100 if not set, at most 1000.
+
+	*/
+	PageSize *int64
+	/*PageToken
+	  Return next page of entries; next_page_token value from the previous response.
+
+	*/
+	PageToken *string
+	/*Since
+	  Return only entries after that time.
+
+	*/
+	Since *strfmt.DateTime
+	/*Until
+	  Return only entries before that time.
+
+	*/
+	Until *strfmt.DateTime
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the list mixin1 params
+func (o *ListMixin1Params) WithTimeout(timeout time.Duration) *ListMixin1Params {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the list mixin1 params
+func (o *ListMixin1Params) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the list mixin1 params
+func (o *ListMixin1Params) WithContext(ctx context.Context) *ListMixin1Params {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the list mixin1 params
+func (o *ListMixin1Params) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the list mixin1 params
+func (o *ListMixin1Params) WithHTTPClient(client *http.Client) *ListMixin1Params {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the list mixin1 params
+func (o *ListMixin1Params) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithCode adds the code to the list mixin1 params
+func (o *ListMixin1Params) WithCode(code *string) *ListMixin1Params {
+	o.SetCode(code)
+	return o
+}
+
+// SetCode adds the code to the list mixin1 params
+func (o *ListMixin1Params) SetCode(code *string) {
+	o.Code = code
+}
+
+// WithMethod adds the method to the list mixin1 params
+func (o *ListMixin1Params) WithMethod(method *string) *ListMixin1Params {
+	o.SetMethod(method)
+	return o
+}
+
+// SetMethod adds the method to the list mixin1 params
+func (o *ListMixin1Params) SetMethod(method *string) {
+	o.Method = method
+}
+
+// WithPageSize adds the pageSize to the list mixin1 params
+func (o *ListMixin1Params) WithPageSize(pageSize *int64) *ListMixin1Params {
+	o.SetPageSize(pageSize)
+	return o
+}
+
+// SetPageSize adds the pageSize to the list mixin1 params
+func (o *ListMixin1Params) SetPageSize(pageSize *int64) {
+	o.PageSize = pageSize
+}
+
+// WithPageToken adds the pageToken to the list mixin1 params
+func (o *ListMixin1Params) WithPageToken(pageToken *string) *ListMixin1Params {
+	o.SetPageToken(pageToken)
+	return o
+}
+
+// SetPageToken adds the pageToken to the list mixin1 params
+func (o *ListMixin1Params) SetPageToken(pageToken *string) {
+	o.PageToken = pageToken
+}
+
+// WithSince adds the since to the list mixin1 params
+func (o *ListMixin1Params) WithSince(since *strfmt.DateTime) *ListMixin1Params {
+	o.SetSince(since)
+	return o
+}
+
+// SetSince adds the since to the list mixin1 params
+func (o *ListMixin1Params) SetSince(since *strfmt.DateTime) {
+	o.Since = since
+}
+
+// WithUntil adds the until to the list mixin1 params
+func (o *ListMixin1Params) WithUntil(until *strfmt.DateTime) *ListMixin1Params {
+	o.SetUntil(until)
+	return o
+}
+
+// SetUntil adds the until to the list mixin1 params
+func (o *ListMixin1Params) SetUntil(until *strfmt.DateTime) {
+	o.Until = until
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ListMixin1Params) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Code != nil {
+
+		// query param code
+		var qrCode string
+		if o.Code != nil {
+			qrCode = *o.Code
+		}
+		qCode := qrCode
+		if qCode != "" {
+			if err := r.SetQueryParam("code", qCode); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.Method != nil {
+
+		// query param method
+		var qrMethod string
+		if o.Method != nil {
+			qrMethod = *o.Method
+		}
+		qMethod := qrMethod
+		if qMethod != "" {
+			if err := r.SetQueryParam("method", qMethod); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.PageSize != nil {
+
+		// query param page_size
+		var qrPageSize int64
+		if o.PageSize != nil {
+			qrPageSize = *o.PageSize
+		}
+		qPageSize := swag.FormatInt64(qrPageSize)
+		if qPageSize != "" {
+			if err := r.SetQueryParam("page_size", qPageSize); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.PageToken != nil {
+
+		// query param page_token
+		var qrPageToken string
+		if o.PageToken != nil {
+			qrPageToken = *o.PageToken
+		}
+		qPageToken := qrPageToken
+		if qPageToken != "" {
+			if err := r.SetQueryParam("page_token", qPageToken); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.Since != nil {
+
+		// query param since
+		var qrSince strfmt.DateTime
+		if o.Since != nil {
+			qrSince = *o.Since
+		}
+		qSince := qrSince.String()
+		if qSince != "" {
+			if err := r.SetQueryParam("since", qSince); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.Until != nil {
+
+		// query param until
+		var qrUntil strfmt.DateTime
+		if o.Until != nil {
+			qrUntil = *o.Until
+		}
+		qUntil := qrUntil.String()
+		if qUntil != "" {
+			if err := r.SetQueryParam("until", qUntil); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -1,6 +1,6 @@
 // Code generated by go-swagger; DO NOT EDIT.
 
-package remote
+package audit
 
 // This file was generated by the swagger tool.
 // Editing this file might prove futile when you re-run the swagger generate command
@@ -16,17 +16,17 @@ import (
 	models "github.com/percona/pmm-managed/api/swagger/models"
 )
 
-// ListMixin6Reader is a Reader for the ListMixin6 structure.
-type ListMixin6Reader struct {
+// ListMixin1Reader is a Reader for the ListMixin1 structure.
+type ListMixin1Reader struct {
 	formats strfmt.Registry
 }
 
 // ReadResponse reads a server response into the received o.
-func (o *ListMixin6Reader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+func (o *ListMixin1Reader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
 	switch response.Code() {
 
 	case 200:
-		result := NewListMixin6OK()
+		result := NewListMixin1OK()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
 			return nil, err
 		}
@@ -37,26 +37,26 @@ func (o *ListMixin6Reader) ReadResponse(response runtime.ClientResponse, consume
 	}
 }
 
-// NewListMixin6OK creates a ListMixin6OK with default headers values
-func NewListMixin6OK() *ListMixin6OK {
-	return &ListMixin6OK{}
+// NewListMixin1OK creates a ListMixin1OK with default headers values
+func NewListMixin1OK() *ListMixin1OK {
+	return &ListMixin1OK{}
 }
 
-/*ListMixin6OK handles this case with default header values.
+/*ListMixin1OK handles this case with default header values.
 
 (empty)
 */
-type ListMixin6OK struct {
-	Payload *models.APIRemoteListResponse
+type ListMixin1OK struct {
+	Payload *models.APIAuditListResponse
 }
 
-func (o *ListMixin6OK) Error() string {
-	return fmt.Sprintf("[GET /v0/remote][%d] listMixin6OK  %+v", 200, o.Payload)
+func (o *ListMixin1OK) Error() string {
+	return fmt.Sprintf("[GET /v0/audit][%d] listMixin1OK  %+v", 200, o.Payload)
 }
 
-func (o *ListMixin6OK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+func (o *ListMixin1OK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
 
-	o.Payload = new(models.APIRemoteListResponse)
+	o.Payload = new(models.APIAuditListResponse)
 
 	// response payload
 	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
@@ -24,6 +24,34 @@ type Client struct {
 	formats   strfmt.Registry
 }
 
+/*
+SchemaVersion schema version API
+*/
+func (a *Client) SchemaVersion(params *SchemaVersionParams) (*SchemaVersionOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaVersionParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "SchemaVersion",
+		Method:             "GET",
+		PathPattern:        "/v1/schema-version",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &SchemaVersionReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*SchemaVersionOK), nil
+
+}
+
 /*
 Version version API
 */
@@ -0,0 +1,114 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package base
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+// NewSchemaVersionParams creates a new SchemaVersionParams object
+// with the default values initialized.
+func NewSchemaVersionParams() *SchemaVersionParams {
+
+	return &SchemaVersionParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewSchemaVersionParamsWithTimeout creates a new SchemaVersionParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewSchemaVersionParamsWithTimeout(timeout time.Duration) *SchemaVersionParams {
+
+	return &SchemaVersionParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewSchemaVersionParamsWithContext creates a new SchemaVersionParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewSchemaVersionParamsWithContext(ctx context.Context) *SchemaVersionParams {
+
+	return &SchemaVersionParams{
+
+		Context: ctx,
+	}
+}
+
+// NewSchemaVersionParamsWithHTTPClient creates a new SchemaVersionParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewSchemaVersionParamsWithHTTPClient(client *http.Client) *SchemaVersionParams {
+
+	return &SchemaVersionParams{
+		HTTPClient: client,
+	}
+}
+
+/*SchemaVersionParams contains all the parameters to send to the API endpoint
+for the schema version operation typically these are written to a http.Request
+*/
+type SchemaVersionParams struct {
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the schema version params
+func (o *SchemaVersionParams) WithTimeout(timeout time.Duration) *SchemaVersionParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the schema version params
+func (o *SchemaVersionParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the schema version params
+func (o *SchemaVersionParams) WithContext(ctx context.Context) *SchemaVersionParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the schema version params
+func (o *SchemaVersionParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the schema version params
+func (o *SchemaVersionParams) WithHTTPClient(client *http.Client) *SchemaVersionParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the schema version params
+func (o *SchemaVersionParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *SchemaVersionParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package base
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	models "github.com/percona/pmm-managed/api/swagger/models"
+)
+
+// SchemaVersionReader is a Reader for the SchemaVersion structure.
+type SchemaVersionReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *SchemaVersionReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+
+	case 200:
+		result := NewSchemaVersionOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+
+	default:
+		return nil, runtime.NewAPIError("unknown error", response, response.Code())
+	}
+}
+
+// NewSchemaVersionOK creates a SchemaVersionOK with default headers values
+func NewSchemaVersionOK() *SchemaVersionOK {
+	return &SchemaVersionOK{}
+}
+
+/*SchemaVersionOK handles this case with default header values.
+
+(empty)
+*/
+type SchemaVersionOK struct {
+	Payload *models.APIBaseSchemaVersionResponse
+}
+
+func (o *SchemaVersionOK) Error() string {
+	return fmt.Sprintf("[GET /v1/schema-version][%d] schemaVersionOK  %+v", 200, o.Payload)
+}
+
+func (o *SchemaVersionOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIBaseSchemaVersionResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,67 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dashboards
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	models "github.com/percona/pmm-managed/api/swagger/models"
+)
+
+// CreateFolderReader is a Reader for the CreateFolder structure.
+type CreateFolderReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *CreateFolderReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+
+	case 200:
+		result := NewCreateFolderOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+
+	default:
+		return nil, runtime.NewAPIError("unknown error", response, response.Code())
+	}
+}
+
+// NewCreateFolderOK creates a CreateFolderOK with default headers values
+func NewCreateFolderOK() *CreateFolderOK {
+	return &CreateFolderOK{}
+}
+
+/*CreateFolderOK handles this case with default header values.
+
+(empty)
+*/
+type CreateFolderOK struct {
+	Payload *models.APIDashboardsCreateFolderResponse
+}
+
+func (o *CreateFolderOK) Error() string {
+	return fmt.Sprintf("[POST /v0/dashboard-folders][%d] createFolderOK  %+v", 200, o.Payload)
+}
+
+func (o *CreateFolderOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIDashboardsCreateFolderResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
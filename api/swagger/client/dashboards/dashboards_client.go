@@ -0,0 +1,226 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dashboards
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+// New creates a new dashboards API client.
+func New(transport runtime.ClientTransport, formats strfmt.Registry) *Client {
+	return &Client{transport: transport, formats: formats}
+}
+
+/*
+Client for dashboards API
+*/
+type Client struct {
+	transport runtime.ClientTransport
+	formats   strfmt.Registry
+}
+
+/*
+CreateFolder create folder API
+*/
+func (a *Client) CreateFolder(params *CreateFolderParams) (*CreateFolderOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewCreateFolderParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "CreateFolder",
+		Method:             "POST",
+		PathPattern:        "/v0/dashboard-folders",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &CreateFolderReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*CreateFolderOK), nil
+
+}
+
+/*
+DeleteFolder delete folder API
+*/
+func (a *Client) DeleteFolder(params *DeleteFolderParams) (*DeleteFolderOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewDeleteFolderParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "DeleteFolder",
+		Method:             "DELETE",
+		PathPattern:        "/v0/dashboard-folders/{uid}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &DeleteFolderReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*DeleteFolderOK), nil
+
+}
+
+/*
+DeleteMixin3 delete mixin3 API
+*/
+func (a *Client) DeleteMixin3(params *DeleteMixin3Params) (*DeleteMixin3OK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewDeleteMixin3Params()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "DeleteMixin3",
+		Method:             "DELETE",
+		PathPattern:        "/v0/dashboards/{uid}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &DeleteMixin3Reader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*DeleteMixin3OK), nil
+
+}
+
+/*
+Export export API
+*/
+func (a *Client) Export(params *ExportParams) (*ExportOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewExportParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "Export",
+		Method:             "GET",
+		PathPattern:        "/v0/dashboards/{uid}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &ExportReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*ExportOK), nil
+
+}
+
+/*
+Import import API
+*/
+func (a *Client) Import(params *ImportParams) (*ImportOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewImportParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "Import",
+		Method:             "POST",
+		PathPattern:        "/v0/dashboards",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &ImportReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*ImportOK), nil
+
+}
+
+/*
+ListFolders list folders API
+*/
+func (a *Client) ListFolders(params *ListFoldersParams) (*ListFoldersOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewListFoldersParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "ListFolders",
+		Method:             "GET",
+		PathPattern:        "/v0/dashboard-folders",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &ListFoldersReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*ListFoldersOK), nil
+
+}
+
+/*
+ListMixin3 list mixin3 API
+*/
+func (a *Client) ListMixin3(params *ListMixin3Params) (*ListMixin3OK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewListMixin3Params()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "ListMixin3",
+		Method:             "GET",
+		PathPattern:        "/v0/dashboards",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &ListMixin3Reader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*ListMixin3OK), nil
+
+}
+
+// SetTransport changes the transport on the client
+func (a *Client) SetTransport(transport runtime.ClientTransport) {
+	a.transport = transport
+}
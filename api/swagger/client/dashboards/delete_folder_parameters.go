@@ -0,0 +1,134 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dashboards
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+// NewDeleteFolderParams creates a new DeleteFolderParams object
+// with the default values initialized.
+func NewDeleteFolderParams() *DeleteFolderParams {
+	var ()
+	return &DeleteFolderParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewDeleteFolderParamsWithTimeout creates a new DeleteFolderParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewDeleteFolderParamsWithTimeout(timeout time.Duration) *DeleteFolderParams {
+	var ()
+	return &DeleteFolderParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewDeleteFolderParamsWithContext creates a new DeleteFolderParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewDeleteFolderParamsWithContext(ctx context.Context) *DeleteFolderParams {
+	var ()
+	return &DeleteFolderParams{
+
+		Context: ctx,
+	}
+}
+
+// NewDeleteFolderParamsWithHTTPClient creates a new DeleteFolderParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewDeleteFolderParamsWithHTTPClient(client *http.Client) *DeleteFolderParams {
+	var ()
+	return &DeleteFolderParams{
+		HTTPClient: client,
+	}
+}
+
+/*DeleteFolderParams contains all the parameters to send to the API endpoint
+for the delete folder operation typically these are written to a http.Request
+*/
+type DeleteFolderParams struct {
+
+	/*UID*/
+	UID string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the delete folder params
+func (o *DeleteFolderParams) WithTimeout(timeout time.Duration) *DeleteFolderParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the delete folder params
+func (o *DeleteFolderParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the delete folder params
+func (o *DeleteFolderParams) WithContext(ctx context.Context) *DeleteFolderParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the delete folder params
+func (o *DeleteFolderParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the delete folder params
+func (o *DeleteFolderParams) WithHTTPClient(client *http.Client) *DeleteFolderParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the delete folder params
+func (o *DeleteFolderParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithUID adds the uid to the delete folder params
+func (o *DeleteFolderParams) WithUID(uid string) *DeleteFolderParams {
+	o.SetUID(uid)
+	return o
+}
+
+// SetUID adds the uid to the delete folder params
+func (o *DeleteFolderParams) SetUID(uid string) {
+	o.UID = uid
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *DeleteFolderParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param uid
+	if err := r.SetPathParam("uid", o.UID); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
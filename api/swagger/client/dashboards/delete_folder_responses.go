@@ -0,0 +1,65 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dashboards
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	models "github.com/percona/pmm-managed/api/swagger/models"
+)
+
+// DeleteFolderReader is a Reader for the DeleteFolder structure.
+type DeleteFolderReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *DeleteFolderReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+
+	case 200:
+		result := NewDeleteFolderOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+
+	default:
+		return nil, runtime.NewAPIError("unknown error", response, response.Code())
+	}
+}
+
+// NewDeleteFolderOK creates a DeleteFolderOK with default headers values
+func NewDeleteFolderOK() *DeleteFolderOK {
+	return &DeleteFolderOK{}
+}
+
+/*DeleteFolderOK handles this case with default header values.
+
+(empty)
+*/
+type DeleteFolderOK struct {
+	Payload models.APIDashboardsDeleteFolderResponse
+}
+
+func (o *DeleteFolderOK) Error() string {
+	return fmt.Sprintf("[DELETE /v0/dashboard-folders/{uid}][%d] deleteFolderOK  %+v", 200, o.Payload)
+}
+
+func (o *DeleteFolderOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,134 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dashboards
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+// NewDeleteMixin3Params creates a new DeleteMixin3Params object
+// with the default values initialized.
+func NewDeleteMixin3Params() *DeleteMixin3Params {
+	var ()
+	return &DeleteMixin3Params{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewDeleteMixin3ParamsWithTimeout creates a new DeleteMixin3Params object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewDeleteMixin3ParamsWithTimeout(timeout time.Duration) *DeleteMixin3Params {
+	var ()
+	return &DeleteMixin3Params{
+
+		timeout: timeout,
+	}
+}
+
+// NewDeleteMixin3ParamsWithContext creates a new DeleteMixin3Params object
+// with the default values initialized, and the ability to set a context for a request
+func NewDeleteMixin3ParamsWithContext(ctx context.Context) *DeleteMixin3Params {
+	var ()
+	return &DeleteMixin3Params{
+
+		Context: ctx,
+	}
+}
+
+// NewDeleteMixin3ParamsWithHTTPClient creates a new DeleteMixin3Params object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewDeleteMixin3ParamsWithHTTPClient(client *http.Client) *DeleteMixin3Params {
+	var ()
+	return &DeleteMixin3Params{
+		HTTPClient: client,
+	}
+}
+
+/*DeleteMixin3Params contains all the parameters to send to the API endpoint
+for the delete mixin3 operation typically these are written to a http.Request
+*/
+type DeleteMixin3Params struct {
+
+	/*UID*/
+	UID string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the delete mixin3 params
+func (o *DeleteMixin3Params) WithTimeout(timeout time.Duration) *DeleteMixin3Params {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the delete mixin3 params
+func (o *DeleteMixin3Params) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the delete mixin3 params
+func (o *DeleteMixin3Params) WithContext(ctx context.Context) *DeleteMixin3Params {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the delete mixin3 params
+func (o *DeleteMixin3Params) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the delete mixin3 params
+func (o *DeleteMixin3Params) WithHTTPClient(client *http.Client) *DeleteMixin3Params {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the delete mixin3 params
+func (o *DeleteMixin3Params) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithUID adds the uid to the delete mixin3 params
+func (o *DeleteMixin3Params) WithUID(uid string) *DeleteMixin3Params {
+	o.SetUID(uid)
+	return o
+}
+
+// SetUID adds the uid to the delete mixin3 params
+func (o *DeleteMixin3Params) SetUID(uid string) {
+	o.UID = uid
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *DeleteMixin3Params) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param uid
+	if err := r.SetPathParam("uid", o.UID); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -1,6 +1,6 @@
 // Code generated by go-swagger; DO NOT EDIT.
 
-package scrape_configs
+package dashboards
 
 // This file was generated by the swagger tool.
 // Editing this file might prove futile when you re-run the swagger generate command
@@ -16,17 +16,17 @@ import (
 	models "github.com/percona/pmm-managed/api/swagger/models"
 )
 
-// CreateMixin7Reader is a Reader for the CreateMixin7 structure.
-type CreateMixin7Reader struct {
+// DeleteMixin3Reader is a Reader for the DeleteMixin3 structure.
+type DeleteMixin3Reader struct {
 	formats strfmt.Registry
 }
 
 // ReadResponse reads a server response into the received o.
-func (o *CreateMixin7Reader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+func (o *DeleteMixin3Reader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
 	switch response.Code() {
 
 	case 200:
-		result := NewCreateMixin7OK()
+		result := NewDeleteMixin3OK()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
 			return nil, err
 		}
@@ -37,24 +37,24 @@ func (o *CreateMixin7Reader) ReadResponse(response runtime.ClientResponse, consu
 	}
 }
 
-// NewCreateMixin7OK creates a CreateMixin7OK with default headers values
-func NewCreateMixin7OK() *CreateMixin7OK {
-	return &CreateMixin7OK{}
+// NewDeleteMixin3OK creates a DeleteMixin3OK with default headers values
+func NewDeleteMixin3OK() *DeleteMixin3OK {
+	return &DeleteMixin3OK{}
 }
 
-/*CreateMixin7OK handles this case with default header values.
+/*DeleteMixin3OK handles this case with default header values.
 
 (empty)
 */
-type CreateMixin7OK struct {
-	Payload models.APIScrapeConfigsCreateResponse
+type DeleteMixin3OK struct {
+	Payload models.APIDashboardsDeleteResponse
 }
 
-func (o *CreateMixin7OK) Error() string {
-	return fmt.Sprintf("[POST /v0/scrape-configs][%d] createMixin7OK  %+v", 200, o.Payload)
+func (o *DeleteMixin3OK) Error() string {
+	return fmt.Sprintf("[DELETE /v0/dashboards/{uid}][%d] deleteMixin3OK  %+v", 200, o.Payload)
 }
 
-func (o *CreateMixin7OK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+func (o *DeleteMixin3OK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
 
 	// response payload
 	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
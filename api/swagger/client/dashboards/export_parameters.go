@@ -0,0 +1,134 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dashboards
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+// NewExportParams creates a new ExportParams object
+// with the default values initialized.
+func NewExportParams() *ExportParams {
+	var ()
+	return &ExportParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewExportParamsWithTimeout creates a new ExportParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewExportParamsWithTimeout(timeout time.Duration) *ExportParams {
+	var ()
+	return &ExportParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewExportParamsWithContext creates a new ExportParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewExportParamsWithContext(ctx context.Context) *ExportParams {
+	var ()
+	return &ExportParams{
+
+		Context: ctx,
+	}
+}
+
+// NewExportParamsWithHTTPClient creates a new ExportParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewExportParamsWithHTTPClient(client *http.Client) *ExportParams {
+	var ()
+	return &ExportParams{
+		HTTPClient: client,
+	}
+}
+
+/*ExportParams contains all the parameters to send to the API endpoint
+for the export operation typically these are written to a http.Request
+*/
+type ExportParams struct {
+
+	/*UID*/
+	UID string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the export params
+func (o *ExportParams) WithTimeout(timeout time.Duration) *ExportParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the export params
+func (o *ExportParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the export params
+func (o *ExportParams) WithContext(ctx context.Context) *ExportParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the export params
+func (o *ExportParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the export params
+func (o *ExportParams) WithHTTPClient(client *http.Client) *ExportParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the export params
+func (o *ExportParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithUID adds the uid to the export params
+func (o *ExportParams) WithUID(uid string) *ExportParams {
+	o.SetUID(uid)
+	return o
+}
+
+// SetUID adds the uid to the export params
+func (o *ExportParams) SetUID(uid string) {
+	o.UID = uid
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ExportParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param uid
+	if err := r.SetPathParam("uid", o.UID); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,137 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dashboards
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	models "github.com/percona/pmm-managed/api/swagger/models"
+)
+
+// NewImportParams creates a new ImportParams object
+// with the default values initialized.
+func NewImportParams() *ImportParams {
+	var ()
+	return &ImportParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewImportParamsWithTimeout creates a new ImportParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewImportParamsWithTimeout(timeout time.Duration) *ImportParams {
+	var ()
+	return &ImportParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewImportParamsWithContext creates a new ImportParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewImportParamsWithContext(ctx context.Context) *ImportParams {
+	var ()
+	return &ImportParams{
+
+		Context: ctx,
+	}
+}
+
+// NewImportParamsWithHTTPClient creates a new ImportParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewImportParamsWithHTTPClient(client *http.Client) *ImportParams {
+	var ()
+	return &ImportParams{
+		HTTPClient: client,
+	}
+}
+
+/*ImportParams contains all the parameters to send to the API endpoint
+for the import operation typically these are written to a http.Request
+*/
+type ImportParams struct {
+
+	/*Body*/
+	Body *models.APIDashboardsImportRequest
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the import params
+func (o *ImportParams) WithTimeout(timeout time.Duration) *ImportParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the import params
+func (o *ImportParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the import params
+func (o *ImportParams) WithContext(ctx context.Context) *ImportParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the import params
+func (o *ImportParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the import params
+func (o *ImportParams) WithHTTPClient(client *http.Client) *ImportParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the import params
+func (o *ImportParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the import params
+func (o *ImportParams) WithBody(body *models.APIDashboardsImportRequest) *ImportParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the import params
+func (o *ImportParams) SetBody(body *models.APIDashboardsImportRequest) {
+	o.Body = body
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ImportParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,114 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dashboards
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+// NewListFoldersParams creates a new ListFoldersParams object
+// with the default values initialized.
+func NewListFoldersParams() *ListFoldersParams {
+
+	return &ListFoldersParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewListFoldersParamsWithTimeout creates a new ListFoldersParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewListFoldersParamsWithTimeout(timeout time.Duration) *ListFoldersParams {
+
+	return &ListFoldersParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewListFoldersParamsWithContext creates a new ListFoldersParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewListFoldersParamsWithContext(ctx context.Context) *ListFoldersParams {
+
+	return &ListFoldersParams{
+
+		Context: ctx,
+	}
+}
+
+// NewListFoldersParamsWithHTTPClient creates a new ListFoldersParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewListFoldersParamsWithHTTPClient(client *http.Client) *ListFoldersParams {
+
+	return &ListFoldersParams{
+		HTTPClient: client,
+	}
+}
+
+/*ListFoldersParams contains all the parameters to send to the API endpoint
+for the list folders operation typically these are written to a http.Request
+*/
+type ListFoldersParams struct {
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the list folders params
+func (o *ListFoldersParams) WithTimeout(timeout time.Duration) *ListFoldersParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the list folders params
+func (o *ListFoldersParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the list folders params
+func (o *ListFoldersParams) WithContext(ctx context.Context) *ListFoldersParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the list folders params
+func (o *ListFoldersParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the list folders params
+func (o *ListFoldersParams) WithHTTPClient(client *http.Client) *ListFoldersParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the list folders params
+func (o *ListFoldersParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ListFoldersParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dashboards
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	models "github.com/percona/pmm-managed/api/swagger/models"
+)
+
+// ListFoldersReader is a Reader for the ListFolders structure.
+type ListFoldersReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ListFoldersReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+
+	case 200:
+		result := NewListFoldersOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+
+	default:
+		return nil, runtime.NewAPIError("unknown error", response, response.Code())
+	}
+}
+
+// NewListFoldersOK creates a ListFoldersOK with default headers values
+func NewListFoldersOK() *ListFoldersOK {
+	return &ListFoldersOK{}
+}
+
+/*ListFoldersOK handles this case with default header values.
+
+(empty)
+*/
+type ListFoldersOK struct {
+	Payload *models.APIDashboardsListFoldersResponse
+}
+
+func (o *ListFoldersOK) Error() string {
+	return fmt.Sprintf("[GET /v0/dashboard-folders][%d] listFoldersOK  %+v", 200, o.Payload)
+}
+
+func (o *ListFoldersOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIDashboardsListFoldersResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,148 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dashboards
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+// NewListMixin3Params creates a new ListMixin3Params object
+// with the default values initialized.
+func NewListMixin3Params() *ListMixin3Params {
+	var ()
+	return &ListMixin3Params{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewListMixin3ParamsWithTimeout creates a new ListMixin3Params object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewListMixin3ParamsWithTimeout(timeout time.Duration) *ListMixin3Params {
+	var ()
+	return &ListMixin3Params{
+
+		timeout: timeout,
+	}
+}
+
+// NewListMixin3ParamsWithContext creates a new ListMixin3Params object
+// with the default values initialized, and the ability to set a context for a request
+func NewListMixin3ParamsWithContext(ctx context.Context) *ListMixin3Params {
+	var ()
+	return &ListMixin3Params{
+
+		Context: ctx,
+	}
+}
+
+// NewListMixin3ParamsWithHTTPClient creates a new ListMixin3Params object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewListMixin3ParamsWithHTTPClient(client *http.Client) *ListMixin3Params {
+	var ()
+	return &ListMixin3Params{
+		HTTPClient: client,
+	}
+}
+
+/*ListMixin3Params contains all the parameters to send to the API endpoint
+for the list mixin3 operation typically these are written to a http.Request
+*/
+type ListMixin3Params struct {
+
+	/*FolderUID
+	  List only dashboards in that folder; all dashboards are returned if empty.
+
+	*/
+	FolderUID *string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the list mixin3 params
+func (o *ListMixin3Params) WithTimeout(timeout time.Duration) *ListMixin3Params {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the list mixin3 params
+func (o *ListMixin3Params) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the list mixin3 params
+func (o *ListMixin3Params) WithContext(ctx context.Context) *ListMixin3Params {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the list mixin3 params
+func (o *ListMixin3Params) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the list mixin3 params
+func (o *ListMixin3Params) WithHTTPClient(client *http.Client) *ListMixin3Params {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the list mixin3 params
+func (o *ListMixin3Params) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithFolderUID adds the folderUID to the list mixin3 params
+func (o *ListMixin3Params) WithFolderUID(folderUID *string) *ListMixin3Params {
+	o.SetFolderUID(folderUID)
+	return o
+}
+
+// SetFolderUID adds the folderUid to the list mixin3 params
+func (o *ListMixin3Params) SetFolderUID(folderUID *string) {
+	o.FolderUID = folderUID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ListMixin3Params) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.FolderUID != nil {
+
+		// query param folder_uid
+		var qrFolderUID string
+		if o.FolderUID != nil {
+			qrFolderUID = *o.FolderUID
+		}
+		qFolderUID := qrFolderUID
+		if qFolderUID != "" {
+			if err := r.SetQueryParam("folder_uid", qFolderUID); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package dashboards
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	models "github.com/percona/pmm-managed/api/swagger/models"
+)
+
+// ListMixin3Reader is a Reader for the ListMixin3 structure.
+type ListMixin3Reader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ListMixin3Reader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+
+	case 200:
+		result := NewListMixin3OK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+
+	default:
+		return nil, runtime.NewAPIError("unknown error", response, response.Code())
+	}
+}
+
+// NewListMixin3OK creates a ListMixin3OK with default headers values
+func NewListMixin3OK() *ListMixin3OK {
+	return &ListMixin3OK{}
+}
+
+/*ListMixin3OK handles this case with default header values.
+
+(empty)
+*/
+type ListMixin3OK struct {
+	Payload *models.APIDashboardsListResponse
+}
+
+func (o *ListMixin3OK) Error() string {
+	return fmt.Sprintf("[GET /v0/dashboards][%d] listMixin3OK  %+v", 200, o.Payload)
+}
+
+func (o *ListMixin3OK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIDashboardsListResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
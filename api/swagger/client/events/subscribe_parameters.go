@@ -0,0 +1,184 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package events
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+// NewSubscribeParams creates a new SubscribeParams object
+// with the default values initialized.
+func NewSubscribeParams() *SubscribeParams {
+	var ()
+	return &SubscribeParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewSubscribeParamsWithTimeout creates a new SubscribeParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewSubscribeParamsWithTimeout(timeout time.Duration) *SubscribeParams {
+	var ()
+	return &SubscribeParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewSubscribeParamsWithContext creates a new SubscribeParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewSubscribeParamsWithContext(ctx context.Context) *SubscribeParams {
+	var ()
+	return &SubscribeParams{
+
+		Context: ctx,
+	}
+}
+
+// NewSubscribeParamsWithHTTPClient creates a new SubscribeParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewSubscribeParamsWithHTTPClient(client *http.Client) *SubscribeParams {
+	var ()
+	return &SubscribeParams{
+		HTTPClient: client,
+	}
+}
+
+/*SubscribeParams contains all the parameters to send to the API endpoint
+for the subscribe operation typically these are written to a http.Request
+*/
+type SubscribeParams struct {
+
+	/*SinceSeq
+	  Return events after that sequence number first (typically, the last received one);
+	only new events are returned if not set.
+	OutOfRange error is returned if some of those events are not available, and client should resynchronize its state.
+
+	*/
+	SinceSeq *string
+	/*StreamID
+	  Event stream ID of the last received event; required if since_seq is set.
+	Aborted error is returned if it does not match the current stream (pmm-managed was restarted),
+	and client should resynchronize its state.
+
+	*/
+	StreamID *string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the subscribe params
+func (o *SubscribeParams) WithTimeout(timeout time.Duration) *SubscribeParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the subscribe params
+func (o *SubscribeParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the subscribe params
+func (o *SubscribeParams) WithContext(ctx context.Context) *SubscribeParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the subscribe params
+func (o *SubscribeParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the subscribe params
+func (o *SubscribeParams) WithHTTPClient(client *http.Client) *SubscribeParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the subscribe params
+func (o *SubscribeParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithSinceSeq adds the sinceSeq to the subscribe params
+func (o *SubscribeParams) WithSinceSeq(sinceSeq *string) *SubscribeParams {
+	o.SetSinceSeq(sinceSeq)
+	return o
+}
+
+// SetSinceSeq adds the sinceSeq to the subscribe params
+func (o *SubscribeParams) SetSinceSeq(sinceSeq *string) {
+	o.SinceSeq = sinceSeq
+}
+
+// WithStreamID adds the streamID to the subscribe params
+func (o *SubscribeParams) WithStreamID(streamID *string) *SubscribeParams {
+	o.SetStreamID(streamID)
+	return o
+}
+
+// SetStreamID adds the streamId to the subscribe params
+func (o *SubscribeParams) SetStreamID(streamID *string) {
+	o.StreamID = streamID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *SubscribeParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.SinceSeq != nil {
+
+		// query param since_seq
+		var qrSinceSeq string
+		if o.SinceSeq != nil {
+			qrSinceSeq = *o.SinceSeq
+		}
+		qSinceSeq := qrSinceSeq
+		if qSinceSeq != "" {
+			if err := r.SetQueryParam("since_seq", qSinceSeq); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.StreamID != nil {
+
+		// query param stream_id
+		var qrStreamID string
+		if o.StreamID != nil {
+			qrStreamID = *o.StreamID
+		}
+		qStreamID := qrStreamID
+		if qStreamID != "" {
+			if err := r.SetQueryParam("stream_id", qStreamID); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
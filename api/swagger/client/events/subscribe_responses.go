@@ -0,0 +1,67 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package events
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	models "github.com/percona/pmm-managed/api/swagger/models"
+)
+
+// SubscribeReader is a Reader for the Subscribe structure.
+type SubscribeReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *SubscribeReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+
+	case 200:
+		result := NewSubscribeOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+
+	default:
+		return nil, runtime.NewAPIError("unknown error", response, response.Code())
+	}
+}
+
+// NewSubscribeOK creates a SubscribeOK with default headers values
+func NewSubscribeOK() *SubscribeOK {
+	return &SubscribeOK{}
+}
+
+/*SubscribeOK handles this case with default header values.
+
+(streaming responses)
+*/
+type SubscribeOK struct {
+	Payload *models.APIEventsSubscribeResponse
+}
+
+func (o *SubscribeOK) Error() string {
+	return fmt.Sprintf("[GET /v0/events][%d] subscribeOK  %+v", 200, o.Payload)
+}
+
+func (o *SubscribeOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIEventsSubscribeResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
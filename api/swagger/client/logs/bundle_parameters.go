@@ -0,0 +1,137 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package logs
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	models "github.com/percona/pmm-managed/api/swagger/models"
+)
+
+// NewBundleParams creates a new BundleParams object
+// with the default values initialized.
+func NewBundleParams() *BundleParams {
+	var ()
+	return &BundleParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewBundleParamsWithTimeout creates a new BundleParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewBundleParamsWithTimeout(timeout time.Duration) *BundleParams {
+	var ()
+	return &BundleParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewBundleParamsWithContext creates a new BundleParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewBundleParamsWithContext(ctx context.Context) *BundleParams {
+	var ()
+	return &BundleParams{
+
+		Context: ctx,
+	}
+}
+
+// NewBundleParamsWithHTTPClient creates a new BundleParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewBundleParamsWithHTTPClient(client *http.Client) *BundleParams {
+	var ()
+	return &BundleParams{
+		HTTPClient: client,
+	}
+}
+
+/*BundleParams contains all the parameters to send to the API endpoint
+for the bundle operation typically these are written to a http.Request
+*/
+type BundleParams struct {
+
+	/*Body*/
+	Body *models.APILogsBundleRequest
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the bundle params
+func (o *BundleParams) WithTimeout(timeout time.Duration) *BundleParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the bundle params
+func (o *BundleParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the bundle params
+func (o *BundleParams) WithContext(ctx context.Context) *BundleParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the bundle params
+func (o *BundleParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the bundle params
+func (o *BundleParams) WithHTTPClient(client *http.Client) *BundleParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the bundle params
+func (o *BundleParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the bundle params
+func (o *BundleParams) WithBody(body *models.APILogsBundleRequest) *BundleParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the bundle params
+func (o *BundleParams) SetBody(body *models.APILogsBundleRequest) {
+	o.Body = body
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *BundleParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
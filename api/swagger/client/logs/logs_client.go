@@ -52,6 +52,62 @@ func (a *Client) All(params *AllParams) (*AllOK, error) {
 
 }
 
+/*
+Bundle bundles streams zip archive with selected logs in chunks
+*/
+func (a *Client) Bundle(params *BundleParams) (*BundleOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewBundleParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "Bundle",
+		Method:             "POST",
+		PathPattern:        "/v0/logs/bundle",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &BundleReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*BundleOK), nil
+
+}
+
+/*
+Tail tails streams log lines optionally following log
+*/
+func (a *Client) Tail(params *TailParams) (*TailOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewTailParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "Tail",
+		Method:             "GET",
+		PathPattern:        "/v0/logs/tail",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &TailReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*TailOK), nil
+
+}
+
 // SetTransport changes the transport on the client
 func (a *Client) SetTransport(transport runtime.ClientTransport) {
 	a.transport = transport
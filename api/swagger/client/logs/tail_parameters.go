@@ -0,0 +1,277 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package logs
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/swag"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+// NewTailParams creates a new TailParams object
+// with the default values initialized.
+func NewTailParams() *TailParams {
+	var ()
+	return &TailParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewTailParamsWithTimeout creates a new TailParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewTailParamsWithTimeout(timeout time.Duration) *TailParams {
+	var ()
+	return &TailParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewTailParamsWithContext creates a new TailParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewTailParamsWithContext(ctx context.Context) *TailParams {
+	var ()
+	return &TailParams{
+
+		Context: ctx,
+	}
+}
+
+// NewTailParamsWithHTTPClient creates a new TailParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewTailParamsWithHTTPClient(client *http.Client) *TailParams {
+	var ()
+	return &TailParams{
+		HTTPClient: client,
+	}
+}
+
+/*TailParams contains all the parameters to send to the API endpoint
+for the tail operation typically these are written to a http.Request
+*/
+type TailParams struct {
+
+	/*Filter
+	  Return only lines matching that regular expression (RE2 syntax).
+
+	*/
+	Filter *string
+	/*Follow
+	  Stream new lines as they arrive until request is canceled.
+
+	*/
+	Follow *bool
+	/*Lines
+	  Number of last lines to return first; 1000 if not set and since is not set, all lines otherwise.
+
+	*/
+	Lines *int64
+	/*Name
+	  Log file name (as returned by All), systemd unit name, or Agent's supervisor name.
+
+	*/
+	Name *string
+	/*Since
+	  Return only lines after that time.
+
+	*/
+	Since *strfmt.DateTime
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the tail params
+func (o *TailParams) WithTimeout(timeout time.Duration) *TailParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the tail params
+func (o *TailParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the tail params
+func (o *TailParams) WithContext(ctx context.Context) *TailParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the tail params
+func (o *TailParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the tail params
+func (o *TailParams) WithHTTPClient(client *http.Client) *TailParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the tail params
+func (o *TailParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithFilter adds the filter to the tail params
+func (o *TailParams) WithFilter(filter *string) *TailParams {
+	o.SetFilter(filter)
+	return o
+}
+
+// SetFilter adds the filter to the tail params
+func (o *TailParams) SetFilter(filter *string) {
+	o.Filter = filter
+}
+
+// WithFollow adds the follow to the tail params
+func (o *TailParams) WithFollow(follow *bool) *TailParams {
+	o.SetFollow(follow)
+	return o
+}
+
+// SetFollow adds the follow to the tail params
+func (o *TailParams) SetFollow(follow *bool) {
+	o.Follow = follow
+}
+
+// WithLines adds the lines to the tail params
+func (o *TailParams) WithLines(lines *int64) *TailParams {
+	o.SetLines(lines)
+	return o
+}
+
+// SetLines adds the lines to the tail params
+func (o *TailParams) SetLines(lines *int64) {
+	o.Lines = lines
+}
+
+// WithName adds the name to the tail params
+func (o *TailParams) WithName(name *string) *TailParams {
+	o.SetName(name)
+	return o
+}
+
+// SetName adds the name to the tail params
+func (o *TailParams) SetName(name *string) {
+	o.Name = name
+}
+
+// WithSince adds the since to the tail params
+func (o *TailParams) WithSince(since *strfmt.DateTime) *TailParams {
+	o.SetSince(since)
+	return o
+}
+
+// SetSince adds the since to the tail params
+func (o *TailParams) SetSince(since *strfmt.DateTime) {
+	o.Since = since
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *TailParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Filter != nil {
+
+		// query param filter
+		var qrFilter string
+		if o.Filter != nil {
+			qrFilter = *o.Filter
+		}
+		qFilter := qrFilter
+		if qFilter != "" {
+			if err := r.SetQueryParam("filter", qFilter); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.Follow != nil {
+
+		// query param follow
+		var qrFollow bool
+		if o.Follow != nil {
+			qrFollow = *o.Follow
+		}
+		qFollow := swag.FormatBool(qrFollow)
+		if qFollow != "" {
+			if err := r.SetQueryParam("follow", qFollow); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.Lines != nil {
+
+		// query param lines
+		var qrLines int64
+		if o.Lines != nil {
+			qrLines = *o.Lines
+		}
+		qLines := swag.FormatInt64(qrLines)
+		if qLines != "" {
+			if err := r.SetQueryParam("lines", qLines); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.Name != nil {
+
+		// query param name
+		var qrName string
+		if o.Name != nil {
+			qrName = *o.Name
+		}
+		qName := qrName
+		if qName != "" {
+			if err := r.SetQueryParam("name", qName); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if o.Since != nil {
+
+		// query param since
+		var qrSince strfmt.DateTime
+		if o.Since != nil {
+			qrSince = *o.Since
+		}
+		qSince := qrSince.String()
+		if qSince != "" {
+			if err := r.SetQueryParam("since", qSince); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
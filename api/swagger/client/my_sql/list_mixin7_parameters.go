@@ -1,6 +1,6 @@
 // Code generated by go-swagger; DO NOT EDIT.
 
-package scrape_configs
+package my_sql
 
 // This file was generated by the swagger tool.
 // Editing this file might prove futile when you re-run the swagger generate command
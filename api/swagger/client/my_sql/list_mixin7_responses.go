@@ -1,6 +1,6 @@
 // Code generated by go-swagger; DO NOT EDIT.
 
-package scrape_configs
+package my_sql
 
 // This file was generated by the swagger tool.
 // Editing this file might prove futile when you re-run the swagger generate command
@@ -47,16 +47,16 @@ func NewListMixin7OK() *ListMixin7OK {
 (empty)
 */
 type ListMixin7OK struct {
-	Payload *models.APIScrapeConfigsListResponse
+	Payload *models.APIMySQLListResponse
 }
 
 func (o *ListMixin7OK) Error() string {
-	return fmt.Sprintf("[GET /v0/scrape-configs][%d] listMixin7OK  %+v", 200, o.Payload)
+	return fmt.Sprintf("[GET /v0/mysql][%d] listMixin7OK  %+v", 200, o.Payload)
 }
 
 func (o *ListMixin7OK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
 
-	o.Payload = new(models.APIScrapeConfigsListResponse)
+	o.Payload = new(models.APIMySQLListResponse)
 
 	// response payload
 	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
@@ -53,30 +53,30 @@ func (a *Client) Add(params *AddParams) (*AddOK, error) {
 }
 
 /*
-List list API
+ListMixin7 list mixin7 API
 */
-func (a *Client) List(params *ListParams) (*ListOK, error) {
+func (a *Client) ListMixin7(params *ListMixin7Params) (*ListMixin7OK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
-		params = NewListParams()
+		params = NewListMixin7Params()
 	}
 
 	result, err := a.transport.Submit(&runtime.ClientOperation{
-		ID:                 "List",
+		ID:                 "ListMixin7",
 		Method:             "GET",
 		PathPattern:        "/v0/mysql",
 		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json"},
 		Schemes:            []string{"http", "https"},
 		Params:             params,
-		Reader:             &ListReader{formats: a.formats},
+		Reader:             &ListMixin7Reader{formats: a.formats},
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	})
 	if err != nil {
 		return nil, err
 	}
-	return result.(*ListOK), nil
+	return result.(*ListMixin7OK), nil
 
 }
 
@@ -11,15 +11,21 @@ import (
 
 	strfmt "github.com/go-openapi/strfmt"
 
+	"github.com/percona/pmm-managed/api/swagger/client/agents"
 	"github.com/percona/pmm-managed/api/swagger/client/annotations"
+	"github.com/percona/pmm-managed/api/swagger/client/audit"
 	"github.com/percona/pmm-managed/api/swagger/client/base"
+	"github.com/percona/pmm-managed/api/swagger/client/dashboards"
 	"github.com/percona/pmm-managed/api/swagger/client/demo"
+	"github.com/percona/pmm-managed/api/swagger/client/events"
 	"github.com/percona/pmm-managed/api/swagger/client/logs"
 	"github.com/percona/pmm-managed/api/swagger/client/my_sql"
 	"github.com/percona/pmm-managed/api/swagger/client/postgre_sql"
 	"github.com/percona/pmm-managed/api/swagger/client/r_d_s"
 	"github.com/percona/pmm-managed/api/swagger/client/remote"
 	"github.com/percona/pmm-managed/api/swagger/client/scrape_configs"
+	"github.com/percona/pmm-managed/api/swagger/client/telemetry"
+	"github.com/percona/pmm-managed/api/swagger/client/webhooks"
 )
 
 // Default pmm managed HTTP client.
@@ -65,12 +71,20 @@ func New(transport runtime.ClientTransport, formats strfmt.Registry) *PmmManaged
 	cli := new(PmmManaged)
 	cli.Transport = transport
 
+	cli.Agents = agents.New(transport, formats)
+
 	cli.Annotations = annotations.New(transport, formats)
 
+	cli.Audit = audit.New(transport, formats)
+
 	cli.Base = base.New(transport, formats)
 
+	cli.Dashboards = dashboards.New(transport, formats)
+
 	cli.Demo = demo.New(transport, formats)
 
+	cli.Events = events.New(transport, formats)
+
 	cli.Logs = logs.New(transport, formats)
 
 	cli.MySQL = my_sql.New(transport, formats)
@@ -83,6 +97,10 @@ func New(transport runtime.ClientTransport, formats strfmt.Registry) *PmmManaged
 
 	cli.ScrapeConfigs = scrape_configs.New(transport, formats)
 
+	cli.Telemetry = telemetry.New(transport, formats)
+
+	cli.Webhooks = webhooks.New(transport, formats)
+
 	return cli
 }
 
@@ -127,12 +145,20 @@ func (cfg *TransportConfig) WithSchemes(schemes []string) *TransportConfig {
 
 // PmmManaged is a client for pmm managed
 type PmmManaged struct {
+	Agents *agents.Client
+
 	Annotations *annotations.Client
 
+	Audit *audit.Client
+
 	Base *base.Client
 
+	Dashboards *dashboards.Client
+
 	Demo *demo.Client
 
+	Events *events.Client
+
 	Logs *logs.Client
 
 	MySQL *my_sql.Client
@@ -145,6 +171,10 @@ type PmmManaged struct {
 
 	ScrapeConfigs *scrape_configs.Client
 
+	Telemetry *telemetry.Client
+
+	Webhooks *webhooks.Client
+
 	Transport runtime.ClientTransport
 }
 
@@ -152,12 +182,20 @@ type PmmManaged struct {
 func (c *PmmManaged) SetTransport(transport runtime.ClientTransport) {
 	c.Transport = transport
 
+	c.Agents.SetTransport(transport)
+
 	c.Annotations.SetTransport(transport)
 
+	c.Audit.SetTransport(transport)
+
 	c.Base.SetTransport(transport)
 
+	c.Dashboards.SetTransport(transport)
+
 	c.Demo.SetTransport(transport)
 
+	c.Events.SetTransport(transport)
+
 	c.Logs.SetTransport(transport)
 
 	c.MySQL.SetTransport(transport)
@@ -170,4 +208,8 @@ func (c *PmmManaged) SetTransport(transport runtime.ClientTransport) {
 
 	c.ScrapeConfigs.SetTransport(transport)
 
+	c.Telemetry.SetTransport(transport)
+
+	c.Webhooks.SetTransport(transport)
+
 }
@@ -1,137 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package postgre_sql
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"net/http"
-	"time"
-
-	"golang.org/x/net/context"
-
-	"github.com/go-openapi/errors"
-	"github.com/go-openapi/runtime"
-	cr "github.com/go-openapi/runtime/client"
-
-	strfmt "github.com/go-openapi/strfmt"
-
-	models "github.com/percona/pmm-managed/api/swagger/models"
-)
-
-// NewAddMixin4Params creates a new AddMixin4Params object
-// with the default values initialized.
-func NewAddMixin4Params() *AddMixin4Params {
-	var ()
-	return &AddMixin4Params{
-
-		timeout: cr.DefaultTimeout,
-	}
-}
-
-// NewAddMixin4ParamsWithTimeout creates a new AddMixin4Params object
-// with the default values initialized, and the ability to set a timeout on a request
-func NewAddMixin4ParamsWithTimeout(timeout time.Duration) *AddMixin4Params {
-	var ()
-	return &AddMixin4Params{
-
-		timeout: timeout,
-	}
-}
-
-// NewAddMixin4ParamsWithContext creates a new AddMixin4Params object
-// with the default values initialized, and the ability to set a context for a request
-func NewAddMixin4ParamsWithContext(ctx context.Context) *AddMixin4Params {
-	var ()
-	return &AddMixin4Params{
-
-		Context: ctx,
-	}
-}
-
-// NewAddMixin4ParamsWithHTTPClient creates a new AddMixin4Params object
-// with the default values initialized, and the ability to set a custom HTTPClient for a request
-func NewAddMixin4ParamsWithHTTPClient(client *http.Client) *AddMixin4Params {
-	var ()
-	return &AddMixin4Params{
-		HTTPClient: client,
-	}
-}
-
-/*AddMixin4Params contains all the parameters to send to the API endpoint
-for the add mixin4 operation typically these are written to a http.Request
-*/
-type AddMixin4Params struct {
-
-	/*Body*/
-	Body *models.APIPostgreSQLAddRequest
-
-	timeout    time.Duration
-	Context    context.Context
-	HTTPClient *http.Client
-}
-
-// WithTimeout adds the timeout to the add mixin4 params
-func (o *AddMixin4Params) WithTimeout(timeout time.Duration) *AddMixin4Params {
-	o.SetTimeout(timeout)
-	return o
-}
-
-// SetTimeout adds the timeout to the add mixin4 params
-func (o *AddMixin4Params) SetTimeout(timeout time.Duration) {
-	o.timeout = timeout
-}
-
-// WithContext adds the context to the add mixin4 params
-func (o *AddMixin4Params) WithContext(ctx context.Context) *AddMixin4Params {
-	o.SetContext(ctx)
-	return o
-}
-
-// SetContext adds the context to the add mixin4 params
-func (o *AddMixin4Params) SetContext(ctx context.Context) {
-	o.Context = ctx
-}
-
-// WithHTTPClient adds the HTTPClient to the add mixin4 params
-func (o *AddMixin4Params) WithHTTPClient(client *http.Client) *AddMixin4Params {
-	o.SetHTTPClient(client)
-	return o
-}
-
-// SetHTTPClient adds the HTTPClient to the add mixin4 params
-func (o *AddMixin4Params) SetHTTPClient(client *http.Client) {
-	o.HTTPClient = client
-}
-
-// WithBody adds the body to the add mixin4 params
-func (o *AddMixin4Params) WithBody(body *models.APIPostgreSQLAddRequest) *AddMixin4Params {
-	o.SetBody(body)
-	return o
-}
-
-// SetBody adds the body to the add mixin4 params
-func (o *AddMixin4Params) SetBody(body *models.APIPostgreSQLAddRequest) {
-	o.Body = body
-}
-
-// WriteToRequest writes these params to a swagger request
-func (o *AddMixin4Params) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
-
-	if err := r.SetTimeout(o.timeout); err != nil {
-		return err
-	}
-	var res []error
-
-	if o.Body != nil {
-		if err := r.SetBodyParam(o.Body); err != nil {
-			return err
-		}
-	}
-
-	if len(res) > 0 {
-		return errors.CompositeValidationError(res...)
-	}
-	return nil
-}
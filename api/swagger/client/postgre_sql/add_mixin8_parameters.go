@@ -0,0 +1,137 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package postgre_sql
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	models "github.com/percona/pmm-managed/api/swagger/models"
+)
+
+// NewAddMixin8Params creates a new AddMixin8Params object
+// with the default values initialized.
+func NewAddMixin8Params() *AddMixin8Params {
+	var ()
+	return &AddMixin8Params{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewAddMixin8ParamsWithTimeout creates a new AddMixin8Params object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewAddMixin8ParamsWithTimeout(timeout time.Duration) *AddMixin8Params {
+	var ()
+	return &AddMixin8Params{
+
+		timeout: timeout,
+	}
+}
+
+// NewAddMixin8ParamsWithContext creates a new AddMixin8Params object
+// with the default values initialized, and the ability to set a context for a request
+func NewAddMixin8ParamsWithContext(ctx context.Context) *AddMixin8Params {
+	var ()
+	return &AddMixin8Params{
+
+		Context: ctx,
+	}
+}
+
+// NewAddMixin8ParamsWithHTTPClient creates a new AddMixin8Params object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewAddMixin8ParamsWithHTTPClient(client *http.Client) *AddMixin8Params {
+	var ()
+	return &AddMixin8Params{
+		HTTPClient: client,
+	}
+}
+
+/*AddMixin8Params contains all the parameters to send to the API endpoint
+for the add mixin8 operation typically these are written to a http.Request
+*/
+type AddMixin8Params struct {
+
+	/*Body*/
+	Body *models.APIPostgreSQLAddRequest
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the add mixin8 params
+func (o *AddMixin8Params) WithTimeout(timeout time.Duration) *AddMixin8Params {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the add mixin8 params
+func (o *AddMixin8Params) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the add mixin8 params
+func (o *AddMixin8Params) WithContext(ctx context.Context) *AddMixin8Params {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the add mixin8 params
+func (o *AddMixin8Params) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the add mixin8 params
+func (o *AddMixin8Params) WithHTTPClient(client *http.Client) *AddMixin8Params {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the add mixin8 params
+func (o *AddMixin8Params) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the add mixin8 params
+func (o *AddMixin8Params) WithBody(body *models.APIPostgreSQLAddRequest) *AddMixin8Params {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the add mixin8 params
+func (o *AddMixin8Params) SetBody(body *models.APIPostgreSQLAddRequest) {
+	o.Body = body
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *AddMixin8Params) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -16,17 +16,17 @@ import (
 	models "github.com/percona/pmm-managed/api/swagger/models"
 )
 
-// AddMixin4Reader is a Reader for the AddMixin4 structure.
-type AddMixin4Reader struct {
+// AddMixin8Reader is a Reader for the AddMixin8 structure.
+type AddMixin8Reader struct {
 	formats strfmt.Registry
 }
 
 // ReadResponse reads a server response into the received o.
-func (o *AddMixin4Reader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+func (o *AddMixin8Reader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
 	switch response.Code() {
 
 	case 200:
-		result := NewAddMixin4OK()
+		result := NewAddMixin8OK()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
 			return nil, err
 		}
@@ -37,24 +37,24 @@ func (o *AddMixin4Reader) ReadResponse(response runtime.ClientResponse, consumer
 	}
 }
 
-// NewAddMixin4OK creates a AddMixin4OK with default headers values
-func NewAddMixin4OK() *AddMixin4OK {
-	return &AddMixin4OK{}
+// NewAddMixin8OK creates a AddMixin8OK with default headers values
+func NewAddMixin8OK() *AddMixin8OK {
+	return &AddMixin8OK{}
 }
 
-/*AddMixin4OK handles this case with default header values.
+/*AddMixin8OK handles this case with default header values.
 
 (empty)
 */
-type AddMixin4OK struct {
+type AddMixin8OK struct {
 	Payload *models.APIPostgreSQLAddResponse
 }
 
-func (o *AddMixin4OK) Error() string {
-	return fmt.Sprintf("[POST /v0/postgresql][%d] addMixin4OK  %+v", 200, o.Payload)
+func (o *AddMixin8OK) Error() string {
+	return fmt.Sprintf("[POST /v0/postgresql][%d] addMixin8OK  %+v", 200, o.Payload)
 }
 
-func (o *AddMixin4OK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+func (o *AddMixin8OK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
 
 	o.Payload = new(models.APIPostgreSQLAddResponse)
 
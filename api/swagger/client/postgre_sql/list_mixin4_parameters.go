@@ -1,114 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package postgre_sql
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"net/http"
-	"time"
-
-	"golang.org/x/net/context"
-
-	"github.com/go-openapi/errors"
-	"github.com/go-openapi/runtime"
-	cr "github.com/go-openapi/runtime/client"
-
-	strfmt "github.com/go-openapi/strfmt"
-)
-
-// NewListMixin4Params creates a new ListMixin4Params object
-// with the default values initialized.
-func NewListMixin4Params() *ListMixin4Params {
-
-	return &ListMixin4Params{
-
-		timeout: cr.DefaultTimeout,
-	}
-}
-
-// NewListMixin4ParamsWithTimeout creates a new ListMixin4Params object
-// with the default values initialized, and the ability to set a timeout on a request
-func NewListMixin4ParamsWithTimeout(timeout time.Duration) *ListMixin4Params {
-
-	return &ListMixin4Params{
-
-		timeout: timeout,
-	}
-}
-
-// NewListMixin4ParamsWithContext creates a new ListMixin4Params object
-// with the default values initialized, and the ability to set a context for a request
-func NewListMixin4ParamsWithContext(ctx context.Context) *ListMixin4Params {
-
-	return &ListMixin4Params{
-
-		Context: ctx,
-	}
-}
-
-// NewListMixin4ParamsWithHTTPClient creates a new ListMixin4Params object
-// with the default values initialized, and the ability to set a custom HTTPClient for a request
-func NewListMixin4ParamsWithHTTPClient(client *http.Client) *ListMixin4Params {
-
-	return &ListMixin4Params{
-		HTTPClient: client,
-	}
-}
-
-/*ListMixin4Params contains all the parameters to send to the API endpoint
-for the list mixin4 operation typically these are written to a http.Request
-*/
-type ListMixin4Params struct {
-	timeout    time.Duration
-	Context    context.Context
-	HTTPClient *http.Client
-}
-
-// WithTimeout adds the timeout to the list mixin4 params
-func (o *ListMixin4Params) WithTimeout(timeout time.Duration) *ListMixin4Params {
-	o.SetTimeout(timeout)
-	return o
-}
-
-// SetTimeout adds the timeout to the list mixin4 params
-func (o *ListMixin4Params) SetTimeout(timeout time.Duration) {
-	o.timeout = timeout
-}
-
-// WithContext adds the context to the list mixin4 params
-func (o *ListMixin4Params) WithContext(ctx context.Context) *ListMixin4Params {
-	o.SetContext(ctx)
-	return o
-}
-
-// SetContext adds the context to the list mixin4 params
-func (o *ListMixin4Params) SetContext(ctx context.Context) {
-	o.Context = ctx
-}
-
-// WithHTTPClient adds the HTTPClient to the list mixin4 params
-func (o *ListMixin4Params) WithHTTPClient(client *http.Client) *ListMixin4Params {
-	o.SetHTTPClient(client)
-	return o
-}
-
-// SetHTTPClient adds the HTTPClient to the list mixin4 params
-func (o *ListMixin4Params) SetHTTPClient(client *http.Client) {
-	o.HTTPClient = client
-}
-
-// WriteToRequest writes these params to a swagger request
-func (o *ListMixin4Params) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
-
-	if err := r.SetTimeout(o.timeout); err != nil {
-		return err
-	}
-	var res []error
-
-	if len(res) > 0 {
-		return errors.CompositeValidationError(res...)
-	}
-	return nil
-}
@@ -16,17 +16,17 @@ import (
 	models "github.com/percona/pmm-managed/api/swagger/models"
 )
 
-// ListMixin4Reader is a Reader for the ListMixin4 structure.
-type ListMixin4Reader struct {
+// ListMixin8Reader is a Reader for the ListMixin8 structure.
+type ListMixin8Reader struct {
 	formats strfmt.Registry
 }
 
 // ReadResponse reads a server response into the received o.
-func (o *ListMixin4Reader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+func (o *ListMixin8Reader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
 	switch response.Code() {
 
 	case 200:
-		result := NewListMixin4OK()
+		result := NewListMixin8OK()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
 			return nil, err
 		}
@@ -37,24 +37,24 @@ func (o *ListMixin4Reader) ReadResponse(response runtime.ClientResponse, consume
 	}
 }
 
-// NewListMixin4OK creates a ListMixin4OK with default headers values
-func NewListMixin4OK() *ListMixin4OK {
-	return &ListMixin4OK{}
+// NewListMixin8OK creates a ListMixin8OK with default headers values
+func NewListMixin8OK() *ListMixin8OK {
+	return &ListMixin8OK{}
 }
 
-/*ListMixin4OK handles this case with default header values.
+/*ListMixin8OK handles this case with default header values.
 
 (empty)
 */
-type ListMixin4OK struct {
+type ListMixin8OK struct {
 	Payload *models.APIPostgreSQLListResponse
 }
 
-func (o *ListMixin4OK) Error() string {
-	return fmt.Sprintf("[GET /v0/postgresql][%d] listMixin4OK  %+v", 200, o.Payload)
+func (o *ListMixin8OK) Error() string {
+	return fmt.Sprintf("[GET /v0/postgresql][%d] listMixin8OK  %+v", 200, o.Payload)
 }
 
-func (o *ListMixin4OK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+func (o *ListMixin8OK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
 
 	o.Payload = new(models.APIPostgreSQLListResponse)
 
@@ -25,86 +25,86 @@ type Client struct {
 }
 
 /*
-AddMixin4 add mixin4 API
+AddMixin8 add mixin8 API
 */
-func (a *Client) AddMixin4(params *AddMixin4Params) (*AddMixin4OK, error) {
+func (a *Client) AddMixin8(params *AddMixin8Params) (*AddMixin8OK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
-		params = NewAddMixin4Params()
+		params = NewAddMixin8Params()
 	}
 
 	result, err := a.transport.Submit(&runtime.ClientOperation{
-		ID:                 "AddMixin4",
+		ID:                 "AddMixin8",
 		Method:             "POST",
 		PathPattern:        "/v0/postgresql",
 		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json"},
 		Schemes:            []string{"http", "https"},
 		Params:             params,
-		Reader:             &AddMixin4Reader{formats: a.formats},
+		Reader:             &AddMixin8Reader{formats: a.formats},
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	})
 	if err != nil {
 		return nil, err
 	}
-	return result.(*AddMixin4OK), nil
+	return result.(*AddMixin8OK), nil
 
 }
 
 /*
-ListMixin4 list mixin4 API
+ListMixin8 list mixin8 API
 */
-func (a *Client) ListMixin4(params *ListMixin4Params) (*ListMixin4OK, error) {
+func (a *Client) ListMixin8(params *ListMixin8Params) (*ListMixin8OK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
-		params = NewListMixin4Params()
+		params = NewListMixin8Params()
 	}
 
 	result, err := a.transport.Submit(&runtime.ClientOperation{
-		ID:                 "ListMixin4",
+		ID:                 "ListMixin8",
 		Method:             "GET",
 		PathPattern:        "/v0/postgresql",
 		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json"},
 		Schemes:            []string{"http", "https"},
 		Params:             params,
-		Reader:             &ListMixin4Reader{formats: a.formats},
+		Reader:             &ListMixin8Reader{formats: a.formats},
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	})
 	if err != nil {
 		return nil, err
 	}
-	return result.(*ListMixin4OK), nil
+	return result.(*ListMixin8OK), nil
 
 }
 
 /*
-RemoveMixin4 remove mixin4 API
+RemoveMixin8 remove mixin8 API
 */
-func (a *Client) RemoveMixin4(params *RemoveMixin4Params) (*RemoveMixin4OK, error) {
+func (a *Client) RemoveMixin8(params *RemoveMixin8Params) (*RemoveMixin8OK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
-		params = NewRemoveMixin4Params()
+		params = NewRemoveMixin8Params()
 	}
 
 	result, err := a.transport.Submit(&runtime.ClientOperation{
-		ID:                 "RemoveMixin4",
+		ID:                 "RemoveMixin8",
 		Method:             "DELETE",
 		PathPattern:        "/v0/postgresql/{id}",
 		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json"},
 		Schemes:            []string{"http", "https"},
 		Params:             params,
-		Reader:             &RemoveMixin4Reader{formats: a.formats},
+		Reader:             &RemoveMixin8Reader{formats: a.formats},
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	})
 	if err != nil {
 		return nil, err
 	}
-	return result.(*RemoveMixin4OK), nil
+	return result.(*RemoveMixin8OK), nil
 
 }
 
@@ -0,0 +1,135 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package postgre_sql
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/swag"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+// NewRemoveMixin8Params creates a new RemoveMixin8Params object
+// with the default values initialized.
+func NewRemoveMixin8Params() *RemoveMixin8Params {
+	var ()
+	return &RemoveMixin8Params{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewRemoveMixin8ParamsWithTimeout creates a new RemoveMixin8Params object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewRemoveMixin8ParamsWithTimeout(timeout time.Duration) *RemoveMixin8Params {
+	var ()
+	return &RemoveMixin8Params{
+
+		timeout: timeout,
+	}
+}
+
+// NewRemoveMixin8ParamsWithContext creates a new RemoveMixin8Params object
+// with the default values initialized, and the ability to set a context for a request
+func NewRemoveMixin8ParamsWithContext(ctx context.Context) *RemoveMixin8Params {
+	var ()
+	return &RemoveMixin8Params{
+
+		Context: ctx,
+	}
+}
+
+// NewRemoveMixin8ParamsWithHTTPClient creates a new RemoveMixin8Params object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewRemoveMixin8ParamsWithHTTPClient(client *http.Client) *RemoveMixin8Params {
+	var ()
+	return &RemoveMixin8Params{
+		HTTPClient: client,
+	}
+}
+
+/*RemoveMixin8Params contains all the parameters to send to the API endpoint
+for the remove mixin8 operation typically these are written to a http.Request
+*/
+type RemoveMixin8Params struct {
+
+	/*ID*/
+	ID int32
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the remove mixin8 params
+func (o *RemoveMixin8Params) WithTimeout(timeout time.Duration) *RemoveMixin8Params {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the remove mixin8 params
+func (o *RemoveMixin8Params) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the remove mixin8 params
+func (o *RemoveMixin8Params) WithContext(ctx context.Context) *RemoveMixin8Params {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the remove mixin8 params
+func (o *RemoveMixin8Params) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the remove mixin8 params
+func (o *RemoveMixin8Params) WithHTTPClient(client *http.Client) *RemoveMixin8Params {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the remove mixin8 params
+func (o *RemoveMixin8Params) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithID adds the id to the remove mixin8 params
+func (o *RemoveMixin8Params) WithID(id int32) *RemoveMixin8Params {
+	o.SetID(id)
+	return o
+}
+
+// SetID adds the id to the remove mixin8 params
+func (o *RemoveMixin8Params) SetID(id int32) {
+	o.ID = id
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *RemoveMixin8Params) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param id
+	if err := r.SetPathParam("id", swag.FormatInt32(o.ID)); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
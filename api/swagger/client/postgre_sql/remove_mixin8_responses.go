@@ -16,17 +16,17 @@ import (
 	models "github.com/percona/pmm-managed/api/swagger/models"
 )
 
-// RemoveMixin4Reader is a Reader for the RemoveMixin4 structure.
-type RemoveMixin4Reader struct {
+// RemoveMixin8Reader is a Reader for the RemoveMixin8 structure.
+type RemoveMixin8Reader struct {
 	formats strfmt.Registry
 }
 
 // ReadResponse reads a server response into the received o.
-func (o *RemoveMixin4Reader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+func (o *RemoveMixin8Reader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
 	switch response.Code() {
 
 	case 200:
-		result := NewRemoveMixin4OK()
+		result := NewRemoveMixin8OK()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
 			return nil, err
 		}
@@ -37,24 +37,24 @@ func (o *RemoveMixin4Reader) ReadResponse(response runtime.ClientResponse, consu
 	}
 }
 
-// NewRemoveMixin4OK creates a RemoveMixin4OK with default headers values
-func NewRemoveMixin4OK() *RemoveMixin4OK {
-	return &RemoveMixin4OK{}
+// NewRemoveMixin8OK creates a RemoveMixin8OK with default headers values
+func NewRemoveMixin8OK() *RemoveMixin8OK {
+	return &RemoveMixin8OK{}
 }
 
-/*RemoveMixin4OK handles this case with default header values.
+/*RemoveMixin8OK handles this case with default header values.
 
 (empty)
 */
-type RemoveMixin4OK struct {
+type RemoveMixin8OK struct {
 	Payload models.APIPostgreSQLRemoveResponse
 }
 
-func (o *RemoveMixin4OK) Error() string {
-	return fmt.Sprintf("[DELETE /v0/postgresql/{id}][%d] removeMixin4OK  %+v", 200, o.Payload)
+func (o *RemoveMixin8OK) Error() string {
+	return fmt.Sprintf("[DELETE /v0/postgresql/{id}][%d] removeMixin8OK  %+v", 200, o.Payload)
 }
 
-func (o *RemoveMixin4OK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+func (o *RemoveMixin8OK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
 
 	// response payload
 	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
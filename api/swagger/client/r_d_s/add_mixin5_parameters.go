@@ -1,137 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package r_d_s
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"net/http"
-	"time"
-
-	"golang.org/x/net/context"
-
-	"github.com/go-openapi/errors"
-	"github.com/go-openapi/runtime"
-	cr "github.com/go-openapi/runtime/client"
-
-	strfmt "github.com/go-openapi/strfmt"
-
-	models "github.com/percona/pmm-managed/api/swagger/models"
-)
-
-// NewAddMixin5Params creates a new AddMixin5Params object
-// with the default values initialized.
-func NewAddMixin5Params() *AddMixin5Params {
-	var ()
-	return &AddMixin5Params{
-
-		timeout: cr.DefaultTimeout,
-	}
-}
-
-// NewAddMixin5ParamsWithTimeout creates a new AddMixin5Params object
-// with the default values initialized, and the ability to set a timeout on a request
-func NewAddMixin5ParamsWithTimeout(timeout time.Duration) *AddMixin5Params {
-	var ()
-	return &AddMixin5Params{
-
-		timeout: timeout,
-	}
-}
-
-// NewAddMixin5ParamsWithContext creates a new AddMixin5Params object
-// with the default values initialized, and the ability to set a context for a request
-func NewAddMixin5ParamsWithContext(ctx context.Context) *AddMixin5Params {
-	var ()
-	return &AddMixin5Params{
-
-		Context: ctx,
-	}
-}
-
-// NewAddMixin5ParamsWithHTTPClient creates a new AddMixin5Params object
-// with the default values initialized, and the ability to set a custom HTTPClient for a request
-func NewAddMixin5ParamsWithHTTPClient(client *http.Client) *AddMixin5Params {
-	var ()
-	return &AddMixin5Params{
-		HTTPClient: client,
-	}
-}
-
-/*AddMixin5Params contains all the parameters to send to the API endpoint
-for the add mixin5 operation typically these are written to a http.Request
-*/
-type AddMixin5Params struct {
-
-	/*Body*/
-	Body *models.APIRDSAddRequest
-
-	timeout    time.Duration
-	Context    context.Context
-	HTTPClient *http.Client
-}
-
-// WithTimeout adds the timeout to the add mixin5 params
-func (o *AddMixin5Params) WithTimeout(timeout time.Duration) *AddMixin5Params {
-	o.SetTimeout(timeout)
-	return o
-}
-
-// SetTimeout adds the timeout to the add mixin5 params
-func (o *AddMixin5Params) SetTimeout(timeout time.Duration) {
-	o.timeout = timeout
-}
-
-// WithContext adds the context to the add mixin5 params
-func (o *AddMixin5Params) WithContext(ctx context.Context) *AddMixin5Params {
-	o.SetContext(ctx)
-	return o
-}
-
-// SetContext adds the context to the add mixin5 params
-func (o *AddMixin5Params) SetContext(ctx context.Context) {
-	o.Context = ctx
-}
-
-// WithHTTPClient adds the HTTPClient to the add mixin5 params
-func (o *AddMixin5Params) WithHTTPClient(client *http.Client) *AddMixin5Params {
-	o.SetHTTPClient(client)
-	return o
-}
-
-// SetHTTPClient adds the HTTPClient to the add mixin5 params
-func (o *AddMixin5Params) SetHTTPClient(client *http.Client) {
-	o.HTTPClient = client
-}
-
-// WithBody adds the body to the add mixin5 params
-func (o *AddMixin5Params) WithBody(body *models.APIRDSAddRequest) *AddMixin5Params {
-	o.SetBody(body)
-	return o
-}
-
-// SetBody adds the body to the add mixin5 params
-func (o *AddMixin5Params) SetBody(body *models.APIRDSAddRequest) {
-	o.Body = body
-}
-
-// WriteToRequest writes these params to a swagger request
-func (o *AddMixin5Params) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
-
-	if err := r.SetTimeout(o.timeout); err != nil {
-		return err
-	}
-	var res []error
-
-	if o.Body != nil {
-		if err := r.SetBodyParam(o.Body); err != nil {
-			return err
-		}
-	}
-
-	if len(res) > 0 {
-		return errors.CompositeValidationError(res...)
-	}
-	return nil
-}
@@ -0,0 +1,137 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package r_d_s
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	models "github.com/percona/pmm-managed/api/swagger/models"
+)
+
+// NewAddMixin9Params creates a new AddMixin9Params object
+// with the default values initialized.
+func NewAddMixin9Params() *AddMixin9Params {
+	var ()
+	return &AddMixin9Params{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewAddMixin9ParamsWithTimeout creates a new AddMixin9Params object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewAddMixin9ParamsWithTimeout(timeout time.Duration) *AddMixin9Params {
+	var ()
+	return &AddMixin9Params{
+
+		timeout: timeout,
+	}
+}
+
+// NewAddMixin9ParamsWithContext creates a new AddMixin9Params object
+// with the default values initialized, and the ability to set a context for a request
+func NewAddMixin9ParamsWithContext(ctx context.Context) *AddMixin9Params {
+	var ()
+	return &AddMixin9Params{
+
+		Context: ctx,
+	}
+}
+
+// NewAddMixin9ParamsWithHTTPClient creates a new AddMixin9Params object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewAddMixin9ParamsWithHTTPClient(client *http.Client) *AddMixin9Params {
+	var ()
+	return &AddMixin9Params{
+		HTTPClient: client,
+	}
+}
+
+/*AddMixin9Params contains all the parameters to send to the API endpoint
+for the add mixin9 operation typically these are written to a http.Request
+*/
+type AddMixin9Params struct {
+
+	/*Body*/
+	Body *models.APIRDSAddRequest
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the add mixin9 params
+func (o *AddMixin9Params) WithTimeout(timeout time.Duration) *AddMixin9Params {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the add mixin9 params
+func (o *AddMixin9Params) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the add mixin9 params
+func (o *AddMixin9Params) WithContext(ctx context.Context) *AddMixin9Params {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the add mixin9 params
+func (o *AddMixin9Params) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the add mixin9 params
+func (o *AddMixin9Params) WithHTTPClient(client *http.Client) *AddMixin9Params {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the add mixin9 params
+func (o *AddMixin9Params) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the add mixin9 params
+func (o *AddMixin9Params) WithBody(body *models.APIRDSAddRequest) *AddMixin9Params {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the add mixin9 params
+func (o *AddMixin9Params) SetBody(body *models.APIRDSAddRequest) {
+	o.Body = body
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *AddMixin9Params) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -16,17 +16,17 @@ import (
 	models "github.com/percona/pmm-managed/api/swagger/models"
 )
 
-// AddMixin5Reader is a Reader for the AddMixin5 structure.
-type AddMixin5Reader struct {
+// AddMixin9Reader is a Reader for the AddMixin9 structure.
+type AddMixin9Reader struct {
 	formats strfmt.Registry
 }
 
 // ReadResponse reads a server response into the received o.
-func (o *AddMixin5Reader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+func (o *AddMixin9Reader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
 	switch response.Code() {
 
 	case 200:
-		result := NewAddMixin5OK()
+		result := NewAddMixin9OK()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
 			return nil, err
 		}
@@ -37,24 +37,24 @@ func (o *AddMixin5Reader) ReadResponse(response runtime.ClientResponse, consumer
 	}
 }
 
-// NewAddMixin5OK creates a AddMixin5OK with default headers values
-func NewAddMixin5OK() *AddMixin5OK {
-	return &AddMixin5OK{}
+// NewAddMixin9OK creates a AddMixin9OK with default headers values
+func NewAddMixin9OK() *AddMixin9OK {
+	return &AddMixin9OK{}
 }
 
-/*AddMixin5OK handles this case with default header values.
+/*AddMixin9OK handles this case with default header values.
 
 (empty)
 */
-type AddMixin5OK struct {
+type AddMixin9OK struct {
 	Payload models.APIRDSAddResponse
 }
 
-func (o *AddMixin5OK) Error() string {
-	return fmt.Sprintf("[POST /v0/rds][%d] addMixin5OK  %+v", 200, o.Payload)
+func (o *AddMixin9OK) Error() string {
+	return fmt.Sprintf("[POST /v0/rds][%d] addMixin9OK  %+v", 200, o.Payload)
 }
 
-func (o *AddMixin5OK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+func (o *AddMixin9OK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
 
 	// response payload
 	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
@@ -1,114 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package r_d_s
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"net/http"
-	"time"
-
-	"golang.org/x/net/context"
-
-	"github.com/go-openapi/errors"
-	"github.com/go-openapi/runtime"
-	cr "github.com/go-openapi/runtime/client"
-
-	strfmt "github.com/go-openapi/strfmt"
-)
-
-// NewListMixin5Params creates a new ListMixin5Params object
-// with the default values initialized.
-func NewListMixin5Params() *ListMixin5Params {
-
-	return &ListMixin5Params{
-
-		timeout: cr.DefaultTimeout,
-	}
-}
-
-// NewListMixin5ParamsWithTimeout creates a new ListMixin5Params object
-// with the default values initialized, and the ability to set a timeout on a request
-func NewListMixin5ParamsWithTimeout(timeout time.Duration) *ListMixin5Params {
-
-	return &ListMixin5Params{
-
-		timeout: timeout,
-	}
-}
-
-// NewListMixin5ParamsWithContext creates a new ListMixin5Params object
-// with the default values initialized, and the ability to set a context for a request
-func NewListMixin5ParamsWithContext(ctx context.Context) *ListMixin5Params {
-
-	return &ListMixin5Params{
-
-		Context: ctx,
-	}
-}
-
-// NewListMixin5ParamsWithHTTPClient creates a new ListMixin5Params object
-// with the default values initialized, and the ability to set a custom HTTPClient for a request
-func NewListMixin5ParamsWithHTTPClient(client *http.Client) *ListMixin5Params {
-
-	return &ListMixin5Params{
-		HTTPClient: client,
-	}
-}
-
-/*ListMixin5Params contains all the parameters to send to the API endpoint
-for the list mixin5 operation typically these are written to a http.Request
-*/
-type ListMixin5Params struct {
-	timeout    time.Duration
-	Context    context.Context
-	HTTPClient *http.Client
-}
-
-// WithTimeout adds the timeout to the list mixin5 params
-func (o *ListMixin5Params) WithTimeout(timeout time.Duration) *ListMixin5Params {
-	o.SetTimeout(timeout)
-	return o
-}
-
-// SetTimeout adds the timeout to the list mixin5 params
-func (o *ListMixin5Params) SetTimeout(timeout time.Duration) {
-	o.timeout = timeout
-}
-
-// WithContext adds the context to the list mixin5 params
-func (o *ListMixin5Params) WithContext(ctx context.Context) *ListMixin5Params {
-	o.SetContext(ctx)
-	return o
-}
-
-// SetContext adds the context to the list mixin5 params
-func (o *ListMixin5Params) SetContext(ctx context.Context) {
-	o.Context = ctx
-}
-
-// WithHTTPClient adds the HTTPClient to the list mixin5 params
-func (o *ListMixin5Params) WithHTTPClient(client *http.Client) *ListMixin5Params {
-	o.SetHTTPClient(client)
-	return o
-}
-
-// SetHTTPClient adds the HTTPClient to the list mixin5 params
-func (o *ListMixin5Params) SetHTTPClient(client *http.Client) {
-	o.HTTPClient = client
-}
-
-// WriteToRequest writes these params to a swagger request
-func (o *ListMixin5Params) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
-
-	if err := r.SetTimeout(o.timeout); err != nil {
-		return err
-	}
-	var res []error
-
-	if len(res) > 0 {
-		return errors.CompositeValidationError(res...)
-	}
-	return nil
-}
@@ -0,0 +1,114 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package r_d_s
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+// NewListMixin9Params creates a new ListMixin9Params object
+// with the default values initialized.
+func NewListMixin9Params() *ListMixin9Params {
+
+	return &ListMixin9Params{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewListMixin9ParamsWithTimeout creates a new ListMixin9Params object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewListMixin9ParamsWithTimeout(timeout time.Duration) *ListMixin9Params {
+
+	return &ListMixin9Params{
+
+		timeout: timeout,
+	}
+}
+
+// NewListMixin9ParamsWithContext creates a new ListMixin9Params object
+// with the default values initialized, and the ability to set a context for a request
+func NewListMixin9ParamsWithContext(ctx context.Context) *ListMixin9Params {
+
+	return &ListMixin9Params{
+
+		Context: ctx,
+	}
+}
+
+// NewListMixin9ParamsWithHTTPClient creates a new ListMixin9Params object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewListMixin9ParamsWithHTTPClient(client *http.Client) *ListMixin9Params {
+
+	return &ListMixin9Params{
+		HTTPClient: client,
+	}
+}
+
+/*ListMixin9Params contains all the parameters to send to the API endpoint
+for the list mixin9 operation typically these are written to a http.Request
+*/
+type ListMixin9Params struct {
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the list mixin9 params
+func (o *ListMixin9Params) WithTimeout(timeout time.Duration) *ListMixin9Params {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the list mixin9 params
+func (o *ListMixin9Params) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the list mixin9 params
+func (o *ListMixin9Params) WithContext(ctx context.Context) *ListMixin9Params {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the list mixin9 params
+func (o *ListMixin9Params) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the list mixin9 params
+func (o *ListMixin9Params) WithHTTPClient(client *http.Client) *ListMixin9Params {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the list mixin9 params
+func (o *ListMixin9Params) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ListMixin9Params) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -16,17 +16,17 @@ import (
 	models "github.com/percona/pmm-managed/api/swagger/models"
 )
 
-// ListMixin5Reader is a Reader for the ListMixin5 structure.
-type ListMixin5Reader struct {
+// ListMixin9Reader is a Reader for the ListMixin9 structure.
+type ListMixin9Reader struct {
 	formats strfmt.Registry
 }
 
 // ReadResponse reads a server response into the received o.
-func (o *ListMixin5Reader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+func (o *ListMixin9Reader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
 	switch response.Code() {
 
 	case 200:
-		result := NewListMixin5OK()
+		result := NewListMixin9OK()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
 			return nil, err
 		}
@@ -37,24 +37,24 @@ func (o *ListMixin5Reader) ReadResponse(response runtime.ClientResponse, consume
 	}
 }
 
-// NewListMixin5OK creates a ListMixin5OK with default headers values
-func NewListMixin5OK() *ListMixin5OK {
-	return &ListMixin5OK{}
+// NewListMixin9OK creates a ListMixin9OK with default headers values
+func NewListMixin9OK() *ListMixin9OK {
+	return &ListMixin9OK{}
 }
 
-/*ListMixin5OK handles this case with default header values.
+/*ListMixin9OK handles this case with default header values.
 
 (empty)
 */
-type ListMixin5OK struct {
+type ListMixin9OK struct {
 	Payload *models.APIRDSListResponse
 }
 
-func (o *ListMixin5OK) Error() string {
-	return fmt.Sprintf("[GET /v0/rds][%d] listMixin5OK  %+v", 200, o.Payload)
+func (o *ListMixin9OK) Error() string {
+	return fmt.Sprintf("[GET /v0/rds][%d] listMixin9OK  %+v", 200, o.Payload)
 }
 
-func (o *ListMixin5OK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+func (o *ListMixin9OK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
 
 	o.Payload = new(models.APIRDSListResponse)
 
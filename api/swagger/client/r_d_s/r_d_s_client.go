@@ -25,30 +25,30 @@ type Client struct {
 }
 
 /*
-AddMixin5 add mixin5 API
+AddMixin9 add mixin9 API
 */
-func (a *Client) AddMixin5(params *AddMixin5Params) (*AddMixin5OK, error) {
+func (a *Client) AddMixin9(params *AddMixin9Params) (*AddMixin9OK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
-		params = NewAddMixin5Params()
+		params = NewAddMixin9Params()
 	}
 
 	result, err := a.transport.Submit(&runtime.ClientOperation{
-		ID:                 "AddMixin5",
+		ID:                 "AddMixin9",
 		Method:             "POST",
 		PathPattern:        "/v0/rds",
 		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json"},
 		Schemes:            []string{"http", "https"},
 		Params:             params,
-		Reader:             &AddMixin5Reader{formats: a.formats},
+		Reader:             &AddMixin9Reader{formats: a.formats},
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	})
 	if err != nil {
 		return nil, err
 	}
-	return result.(*AddMixin5OK), nil
+	return result.(*AddMixin9OK), nil
 
 }
 
@@ -81,58 +81,58 @@ func (a *Client) Discover(params *DiscoverParams) (*DiscoverOK, error) {
 }
 
 /*
-ListMixin5 list mixin5 API
+ListMixin9 list mixin9 API
 */
-func (a *Client) ListMixin5(params *ListMixin5Params) (*ListMixin5OK, error) {
+func (a *Client) ListMixin9(params *ListMixin9Params) (*ListMixin9OK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
-		params = NewListMixin5Params()
+		params = NewListMixin9Params()
 	}
 
 	result, err := a.transport.Submit(&runtime.ClientOperation{
-		ID:                 "ListMixin5",
+		ID:                 "ListMixin9",
 		Method:             "GET",
 		PathPattern:        "/v0/rds",
 		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json"},
 		Schemes:            []string{"http", "https"},
 		Params:             params,
-		Reader:             &ListMixin5Reader{formats: a.formats},
+		Reader:             &ListMixin9Reader{formats: a.formats},
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	})
 	if err != nil {
 		return nil, err
 	}
-	return result.(*ListMixin5OK), nil
+	return result.(*ListMixin9OK), nil
 
 }
 
 /*
-RemoveMixin5 remove mixin5 API
+RemoveMixin9 remove mixin9 API
 */
-func (a *Client) RemoveMixin5(params *RemoveMixin5Params) (*RemoveMixin5OK, error) {
+func (a *Client) RemoveMixin9(params *RemoveMixin9Params) (*RemoveMixin9OK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
-		params = NewRemoveMixin5Params()
+		params = NewRemoveMixin9Params()
 	}
 
 	result, err := a.transport.Submit(&runtime.ClientOperation{
-		ID:                 "RemoveMixin5",
+		ID:                 "RemoveMixin9",
 		Method:             "DELETE",
 		PathPattern:        "/v0/rds",
 		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json"},
 		Schemes:            []string{"http", "https"},
 		Params:             params,
-		Reader:             &RemoveMixin5Reader{formats: a.formats},
+		Reader:             &RemoveMixin9Reader{formats: a.formats},
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	})
 	if err != nil {
 		return nil, err
 	}
-	return result.(*RemoveMixin5OK), nil
+	return result.(*RemoveMixin9OK), nil
 
 }
 
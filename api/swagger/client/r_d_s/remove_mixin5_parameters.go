@@ -1,137 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package r_d_s
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"net/http"
-	"time"
-
-	"golang.org/x/net/context"
-
-	"github.com/go-openapi/errors"
-	"github.com/go-openapi/runtime"
-	cr "github.com/go-openapi/runtime/client"
-
-	strfmt "github.com/go-openapi/strfmt"
-
-	models "github.com/percona/pmm-managed/api/swagger/models"
-)
-
-// NewRemoveMixin5Params creates a new RemoveMixin5Params object
-// with the default values initialized.
-func NewRemoveMixin5Params() *RemoveMixin5Params {
-	var ()
-	return &RemoveMixin5Params{
-
-		timeout: cr.DefaultTimeout,
-	}
-}
-
-// NewRemoveMixin5ParamsWithTimeout creates a new RemoveMixin5Params object
-// with the default values initialized, and the ability to set a timeout on a request
-func NewRemoveMixin5ParamsWithTimeout(timeout time.Duration) *RemoveMixin5Params {
-	var ()
-	return &RemoveMixin5Params{
-
-		timeout: timeout,
-	}
-}
-
-// NewRemoveMixin5ParamsWithContext creates a new RemoveMixin5Params object
-// with the default values initialized, and the ability to set a context for a request
-func NewRemoveMixin5ParamsWithContext(ctx context.Context) *RemoveMixin5Params {
-	var ()
-	return &RemoveMixin5Params{
-
-		Context: ctx,
-	}
-}
-
-// NewRemoveMixin5ParamsWithHTTPClient creates a new RemoveMixin5Params object
-// with the default values initialized, and the ability to set a custom HTTPClient for a request
-func NewRemoveMixin5ParamsWithHTTPClient(client *http.Client) *RemoveMixin5Params {
-	var ()
-	return &RemoveMixin5Params{
-		HTTPClient: client,
-	}
-}
-
-/*RemoveMixin5Params contains all the parameters to send to the API endpoint
-for the remove mixin5 operation typically these are written to a http.Request
-*/
-type RemoveMixin5Params struct {
-
-	/*Body*/
-	Body *models.APIRDSRemoveRequest
-
-	timeout    time.Duration
-	Context    context.Context
-	HTTPClient *http.Client
-}
-
-// WithTimeout adds the timeout to the remove mixin5 params
-func (o *RemoveMixin5Params) WithTimeout(timeout time.Duration) *RemoveMixin5Params {
-	o.SetTimeout(timeout)
-	return o
-}
-
-// SetTimeout adds the timeout to the remove mixin5 params
-func (o *RemoveMixin5Params) SetTimeout(timeout time.Duration) {
-	o.timeout = timeout
-}
-
-// WithContext adds the context to the remove mixin5 params
-func (o *RemoveMixin5Params) WithContext(ctx context.Context) *RemoveMixin5Params {
-	o.SetContext(ctx)
-	return o
-}
-
-// SetContext adds the context to the remove mixin5 params
-func (o *RemoveMixin5Params) SetContext(ctx context.Context) {
-	o.Context = ctx
-}
-
-// WithHTTPClient adds the HTTPClient to the remove mixin5 params
-func (o *RemoveMixin5Params) WithHTTPClient(client *http.Client) *RemoveMixin5Params {
-	o.SetHTTPClient(client)
-	return o
-}
-
-// SetHTTPClient adds the HTTPClient to the remove mixin5 params
-func (o *RemoveMixin5Params) SetHTTPClient(client *http.Client) {
-	o.HTTPClient = client
-}
-
-// WithBody adds the body to the remove mixin5 params
-func (o *RemoveMixin5Params) WithBody(body *models.APIRDSRemoveRequest) *RemoveMixin5Params {
-	o.SetBody(body)
-	return o
-}
-
-// SetBody adds the body to the remove mixin5 params
-func (o *RemoveMixin5Params) SetBody(body *models.APIRDSRemoveRequest) {
-	o.Body = body
-}
-
-// WriteToRequest writes these params to a swagger request
-func (o *RemoveMixin5Params) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
-
-	if err := r.SetTimeout(o.timeout); err != nil {
-		return err
-	}
-	var res []error
-
-	if o.Body != nil {
-		if err := r.SetBodyParam(o.Body); err != nil {
-			return err
-		}
-	}
-
-	if len(res) > 0 {
-		return errors.CompositeValidationError(res...)
-	}
-	return nil
-}
@@ -0,0 +1,137 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package r_d_s
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	models "github.com/percona/pmm-managed/api/swagger/models"
+)
+
+// NewRemoveMixin9Params creates a new RemoveMixin9Params object
+// with the default values initialized.
+func NewRemoveMixin9Params() *RemoveMixin9Params {
+	var ()
+	return &RemoveMixin9Params{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewRemoveMixin9ParamsWithTimeout creates a new RemoveMixin9Params object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewRemoveMixin9ParamsWithTimeout(timeout time.Duration) *RemoveMixin9Params {
+	var ()
+	return &RemoveMixin9Params{
+
+		timeout: timeout,
+	}
+}
+
+// NewRemoveMixin9ParamsWithContext creates a new RemoveMixin9Params object
+// with the default values initialized, and the ability to set a context for a request
+func NewRemoveMixin9ParamsWithContext(ctx context.Context) *RemoveMixin9Params {
+	var ()
+	return &RemoveMixin9Params{
+
+		Context: ctx,
+	}
+}
+
+// NewRemoveMixin9ParamsWithHTTPClient creates a new RemoveMixin9Params object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewRemoveMixin9ParamsWithHTTPClient(client *http.Client) *RemoveMixin9Params {
+	var ()
+	return &RemoveMixin9Params{
+		HTTPClient: client,
+	}
+}
+
+/*RemoveMixin9Params contains all the parameters to send to the API endpoint
+for the remove mixin9 operation typically these are written to a http.Request
+*/
+type RemoveMixin9Params struct {
+
+	/*Body*/
+	Body *models.APIRDSRemoveRequest
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the remove mixin9 params
+func (o *RemoveMixin9Params) WithTimeout(timeout time.Duration) *RemoveMixin9Params {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the remove mixin9 params
+func (o *RemoveMixin9Params) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the remove mixin9 params
+func (o *RemoveMixin9Params) WithContext(ctx context.Context) *RemoveMixin9Params {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the remove mixin9 params
+func (o *RemoveMixin9Params) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the remove mixin9 params
+func (o *RemoveMixin9Params) WithHTTPClient(client *http.Client) *RemoveMixin9Params {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the remove mixin9 params
+func (o *RemoveMixin9Params) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the remove mixin9 params
+func (o *RemoveMixin9Params) WithBody(body *models.APIRDSRemoveRequest) *RemoveMixin9Params {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the remove mixin9 params
+func (o *RemoveMixin9Params) SetBody(body *models.APIRDSRemoveRequest) {
+	o.Body = body
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *RemoveMixin9Params) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -16,17 +16,17 @@ import (
 	models "github.com/percona/pmm-managed/api/swagger/models"
 )
 
-// RemoveMixin5Reader is a Reader for the RemoveMixin5 structure.
-type RemoveMixin5Reader struct {
+// RemoveMixin9Reader is a Reader for the RemoveMixin9 structure.
+type RemoveMixin9Reader struct {
 	formats strfmt.Registry
 }
 
 // ReadResponse reads a server response into the received o.
-func (o *RemoveMixin5Reader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+func (o *RemoveMixin9Reader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
 	switch response.Code() {
 
 	case 200:
-		result := NewRemoveMixin5OK()
+		result := NewRemoveMixin9OK()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
 			return nil, err
 		}
@@ -37,24 +37,24 @@ func (o *RemoveMixin5Reader) ReadResponse(response runtime.ClientResponse, consu
 	}
 }
 
-// NewRemoveMixin5OK creates a RemoveMixin5OK with default headers values
-func NewRemoveMixin5OK() *RemoveMixin5OK {
-	return &RemoveMixin5OK{}
+// NewRemoveMixin9OK creates a RemoveMixin9OK with default headers values
+func NewRemoveMixin9OK() *RemoveMixin9OK {
+	return &RemoveMixin9OK{}
 }
 
-/*RemoveMixin5OK handles this case with default header values.
+/*RemoveMixin9OK handles this case with default header values.
 
 (empty)
 */
-type RemoveMixin5OK struct {
+type RemoveMixin9OK struct {
 	Payload models.APIRDSRemoveResponse
 }
 
-func (o *RemoveMixin5OK) Error() string {
-	return fmt.Sprintf("[DELETE /v0/rds][%d] removeMixin5OK  %+v", 200, o.Payload)
+func (o *RemoveMixin9OK) Error() string {
+	return fmt.Sprintf("[DELETE /v0/rds][%d] removeMixin9OK  %+v", 200, o.Payload)
 }
 
-func (o *RemoveMixin5OK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+func (o *RemoveMixin9OK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
 
 	// response payload
 	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
@@ -0,0 +1,114 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package remote
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+// NewListMixin10Params creates a new ListMixin10Params object
+// with the default values initialized.
+func NewListMixin10Params() *ListMixin10Params {
+
+	return &ListMixin10Params{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewListMixin10ParamsWithTimeout creates a new ListMixin10Params object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewListMixin10ParamsWithTimeout(timeout time.Duration) *ListMixin10Params {
+
+	return &ListMixin10Params{
+
+		timeout: timeout,
+	}
+}
+
+// NewListMixin10ParamsWithContext creates a new ListMixin10Params object
+// with the default values initialized, and the ability to set a context for a request
+func NewListMixin10ParamsWithContext(ctx context.Context) *ListMixin10Params {
+
+	return &ListMixin10Params{
+
+		Context: ctx,
+	}
+}
+
+// NewListMixin10ParamsWithHTTPClient creates a new ListMixin10Params object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewListMixin10ParamsWithHTTPClient(client *http.Client) *ListMixin10Params {
+
+	return &ListMixin10Params{
+		HTTPClient: client,
+	}
+}
+
+/*ListMixin10Params contains all the parameters to send to the API endpoint
+for the list mixin10 operation typically these are written to a http.Request
+*/
+type ListMixin10Params struct {
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the list mixin10 params
+func (o *ListMixin10Params) WithTimeout(timeout time.Duration) *ListMixin10Params {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the list mixin10 params
+func (o *ListMixin10Params) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the list mixin10 params
+func (o *ListMixin10Params) WithContext(ctx context.Context) *ListMixin10Params {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the list mixin10 params
+func (o *ListMixin10Params) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the list mixin10 params
+func (o *ListMixin10Params) WithHTTPClient(client *http.Client) *ListMixin10Params {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the list mixin10 params
+func (o *ListMixin10Params) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ListMixin10Params) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package remote
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	models "github.com/percona/pmm-managed/api/swagger/models"
+)
+
+// ListMixin10Reader is a Reader for the ListMixin10 structure.
+type ListMixin10Reader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ListMixin10Reader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+
+	case 200:
+		result := NewListMixin10OK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+
+	default:
+		return nil, runtime.NewAPIError("unknown error", response, response.Code())
+	}
+}
+
+// NewListMixin10OK creates a ListMixin10OK with default headers values
+func NewListMixin10OK() *ListMixin10OK {
+	return &ListMixin10OK{}
+}
+
+/*ListMixin10OK handles this case with default header values.
+
+(empty)
+*/
+type ListMixin10OK struct {
+	Payload *models.APIRemoteListResponse
+}
+
+func (o *ListMixin10OK) Error() string {
+	return fmt.Sprintf("[GET /v0/remote][%d] listMixin10OK  %+v", 200, o.Payload)
+}
+
+func (o *ListMixin10OK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIRemoteListResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
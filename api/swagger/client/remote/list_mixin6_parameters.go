@@ -1,114 +0,0 @@
-// Code generated by go-swagger; DO NOT EDIT.
-
-package remote
-
-// This file was generated by the swagger tool.
-// Editing this file might prove futile when you re-run the swagger generate command
-
-import (
-	"net/http"
-	"time"
-
-	"golang.org/x/net/context"
-
-	"github.com/go-openapi/errors"
-	"github.com/go-openapi/runtime"
-	cr "github.com/go-openapi/runtime/client"
-
-	strfmt "github.com/go-openapi/strfmt"
-)
-
-// NewListMixin6Params creates a new ListMixin6Params object
-// with the default values initialized.
-func NewListMixin6Params() *ListMixin6Params {
-
-	return &ListMixin6Params{
-
-		timeout: cr.DefaultTimeout,
-	}
-}
-
-// NewListMixin6ParamsWithTimeout creates a new ListMixin6Params object
-// with the default values initialized, and the ability to set a timeout on a request
-func NewListMixin6ParamsWithTimeout(timeout time.Duration) *ListMixin6Params {
-
-	return &ListMixin6Params{
-
-		timeout: timeout,
-	}
-}
-
-// NewListMixin6ParamsWithContext creates a new ListMixin6Params object
-// with the default values initialized, and the ability to set a context for a request
-func NewListMixin6ParamsWithContext(ctx context.Context) *ListMixin6Params {
-
-	return &ListMixin6Params{
-
-		Context: ctx,
-	}
-}
-
-// NewListMixin6ParamsWithHTTPClient creates a new ListMixin6Params object
-// with the default values initialized, and the ability to set a custom HTTPClient for a request
-func NewListMixin6ParamsWithHTTPClient(client *http.Client) *ListMixin6Params {
-
-	return &ListMixin6Params{
-		HTTPClient: client,
-	}
-}
-
-/*ListMixin6Params contains all the parameters to send to the API endpoint
-for the list mixin6 operation typically these are written to a http.Request
-*/
-type ListMixin6Params struct {
-	timeout    time.Duration
-	Context    context.Context
-	HTTPClient *http.Client
-}
-
-// WithTimeout adds the timeout to the list mixin6 params
-func (o *ListMixin6Params) WithTimeout(timeout time.Duration) *ListMixin6Params {
-	o.SetTimeout(timeout)
-	return o
-}
-
-// SetTimeout adds the timeout to the list mixin6 params
-func (o *ListMixin6Params) SetTimeout(timeout time.Duration) {
-	o.timeout = timeout
-}
-
-// WithContext adds the context to the list mixin6 params
-func (o *ListMixin6Params) WithContext(ctx context.Context) *ListMixin6Params {
-	o.SetContext(ctx)
-	return o
-}
-
-// SetContext adds the context to the list mixin6 params
-func (o *ListMixin6Params) SetContext(ctx context.Context) {
-	o.Context = ctx
-}
-
-// WithHTTPClient adds the HTTPClient to the list mixin6 params
-func (o *ListMixin6Params) WithHTTPClient(client *http.Client) *ListMixin6Params {
-	o.SetHTTPClient(client)
-	return o
-}
-
-// SetHTTPClient adds the HTTPClient to the list mixin6 params
-func (o *ListMixin6Params) SetHTTPClient(client *http.Client) {
-	o.HTTPClient = client
-}
-
-// WriteToRequest writes these params to a swagger request
-func (o *ListMixin6Params) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
-
-	if err := r.SetTimeout(o.timeout); err != nil {
-		return err
-	}
-	var res []error
-
-	if len(res) > 0 {
-		return errors.CompositeValidationError(res...)
-	}
-	return nil
-}
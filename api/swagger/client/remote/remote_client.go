@@ -25,30 +25,30 @@ type Client struct {
 }
 
 /*
-ListMixin6 list mixin6 API
+ListMixin10 list mixin10 API
 */
-func (a *Client) ListMixin6(params *ListMixin6Params) (*ListMixin6OK, error) {
+func (a *Client) ListMixin10(params *ListMixin10Params) (*ListMixin10OK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
-		params = NewListMixin6Params()
+		params = NewListMixin10Params()
 	}
 
 	result, err := a.transport.Submit(&runtime.ClientOperation{
-		ID:                 "ListMixin6",
+		ID:                 "ListMixin10",
 		Method:             "GET",
 		PathPattern:        "/v0/remote",
 		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json"},
 		Schemes:            []string{"http", "https"},
 		Params:             params,
-		Reader:             &ListMixin6Reader{formats: a.formats},
+		Reader:             &ListMixin10Reader{formats: a.formats},
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	})
 	if err != nil {
 		return nil, err
 	}
-	return result.(*ListMixin6OK), nil
+	return result.(*ListMixin10OK), nil
 
 }
 
@@ -0,0 +1,65 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package scrape_configs
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	models "github.com/percona/pmm-managed/api/swagger/models"
+)
+
+// CreateMixin11Reader is a Reader for the CreateMixin11 structure.
+type CreateMixin11Reader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *CreateMixin11Reader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+
+	case 200:
+		result := NewCreateMixin11OK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+
+	default:
+		return nil, runtime.NewAPIError("unknown error", response, response.Code())
+	}
+}
+
+// NewCreateMixin11OK creates a CreateMixin11OK with default headers values
+func NewCreateMixin11OK() *CreateMixin11OK {
+	return &CreateMixin11OK{}
+}
+
+/*CreateMixin11OK handles this case with default header values.
+
+(empty)
+*/
+type CreateMixin11OK struct {
+	Payload models.APIScrapeConfigsCreateResponse
+}
+
+func (o *CreateMixin11OK) Error() string {
+	return fmt.Sprintf("[POST /v0/scrape-configs][%d] createMixin11OK  %+v", 200, o.Payload)
+}
+
+func (o *CreateMixin11OK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
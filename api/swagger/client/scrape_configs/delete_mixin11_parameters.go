@@ -0,0 +1,134 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package scrape_configs
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+// NewDeleteMixin11Params creates a new DeleteMixin11Params object
+// with the default values initialized.
+func NewDeleteMixin11Params() *DeleteMixin11Params {
+	var ()
+	return &DeleteMixin11Params{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewDeleteMixin11ParamsWithTimeout creates a new DeleteMixin11Params object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewDeleteMixin11ParamsWithTimeout(timeout time.Duration) *DeleteMixin11Params {
+	var ()
+	return &DeleteMixin11Params{
+
+		timeout: timeout,
+	}
+}
+
+// NewDeleteMixin11ParamsWithContext creates a new DeleteMixin11Params object
+// with the default values initialized, and the ability to set a context for a request
+func NewDeleteMixin11ParamsWithContext(ctx context.Context) *DeleteMixin11Params {
+	var ()
+	return &DeleteMixin11Params{
+
+		Context: ctx,
+	}
+}
+
+// NewDeleteMixin11ParamsWithHTTPClient creates a new DeleteMixin11Params object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewDeleteMixin11ParamsWithHTTPClient(client *http.Client) *DeleteMixin11Params {
+	var ()
+	return &DeleteMixin11Params{
+		HTTPClient: client,
+	}
+}
+
+/*DeleteMixin11Params contains all the parameters to send to the API endpoint
+for the delete mixin11 operation typically these are written to a http.Request
+*/
+type DeleteMixin11Params struct {
+
+	/*JobName*/
+	JobName string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the delete mixin11 params
+func (o *DeleteMixin11Params) WithTimeout(timeout time.Duration) *DeleteMixin11Params {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the delete mixin11 params
+func (o *DeleteMixin11Params) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the delete mixin11 params
+func (o *DeleteMixin11Params) WithContext(ctx context.Context) *DeleteMixin11Params {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the delete mixin11 params
+func (o *DeleteMixin11Params) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the delete mixin11 params
+func (o *DeleteMixin11Params) WithHTTPClient(client *http.Client) *DeleteMixin11Params {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the delete mixin11 params
+func (o *DeleteMixin11Params) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithJobName adds the jobName to the delete mixin11 params
+func (o *DeleteMixin11Params) WithJobName(jobName string) *DeleteMixin11Params {
+	o.SetJobName(jobName)
+	return o
+}
+
+// SetJobName adds the jobName to the delete mixin11 params
+func (o *DeleteMixin11Params) SetJobName(jobName string) {
+	o.JobName = jobName
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *DeleteMixin11Params) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param job_name
+	if err := r.SetPathParam("job_name", o.JobName); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
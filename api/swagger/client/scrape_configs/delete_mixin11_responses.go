@@ -0,0 +1,65 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package scrape_configs
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	models "github.com/percona/pmm-managed/api/swagger/models"
+)
+
+// DeleteMixin11Reader is a Reader for the DeleteMixin11 structure.
+type DeleteMixin11Reader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *DeleteMixin11Reader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+
+	case 200:
+		result := NewDeleteMixin11OK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+
+	default:
+		return nil, runtime.NewAPIError("unknown error", response, response.Code())
+	}
+}
+
+// NewDeleteMixin11OK creates a DeleteMixin11OK with default headers values
+func NewDeleteMixin11OK() *DeleteMixin11OK {
+	return &DeleteMixin11OK{}
+}
+
+/*DeleteMixin11OK handles this case with default header values.
+
+(empty)
+*/
+type DeleteMixin11OK struct {
+	Payload models.APIScrapeConfigsDeleteResponse
+}
+
+func (o *DeleteMixin11OK) Error() string {
+	return fmt.Sprintf("[DELETE /v0/scrape-configs/{job_name}][%d] deleteMixin11OK  %+v", 200, o.Payload)
+}
+
+func (o *DeleteMixin11OK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,114 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package scrape_configs
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+// NewListMixin11Params creates a new ListMixin11Params object
+// with the default values initialized.
+func NewListMixin11Params() *ListMixin11Params {
+
+	return &ListMixin11Params{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewListMixin11ParamsWithTimeout creates a new ListMixin11Params object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewListMixin11ParamsWithTimeout(timeout time.Duration) *ListMixin11Params {
+
+	return &ListMixin11Params{
+
+		timeout: timeout,
+	}
+}
+
+// NewListMixin11ParamsWithContext creates a new ListMixin11Params object
+// with the default values initialized, and the ability to set a context for a request
+func NewListMixin11ParamsWithContext(ctx context.Context) *ListMixin11Params {
+
+	return &ListMixin11Params{
+
+		Context: ctx,
+	}
+}
+
+// NewListMixin11ParamsWithHTTPClient creates a new ListMixin11Params object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewListMixin11ParamsWithHTTPClient(client *http.Client) *ListMixin11Params {
+
+	return &ListMixin11Params{
+		HTTPClient: client,
+	}
+}
+
+/*ListMixin11Params contains all the parameters to send to the API endpoint
+for the list mixin11 operation typically these are written to a http.Request
+*/
+type ListMixin11Params struct {
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the list mixin11 params
+func (o *ListMixin11Params) WithTimeout(timeout time.Duration) *ListMixin11Params {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the list mixin11 params
+func (o *ListMixin11Params) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the list mixin11 params
+func (o *ListMixin11Params) WithContext(ctx context.Context) *ListMixin11Params {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the list mixin11 params
+func (o *ListMixin11Params) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the list mixin11 params
+func (o *ListMixin11Params) WithHTTPClient(client *http.Client) *ListMixin11Params {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the list mixin11 params
+func (o *ListMixin11Params) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ListMixin11Params) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
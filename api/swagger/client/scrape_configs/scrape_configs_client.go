@@ -25,58 +25,58 @@ type Client struct {
 }
 
 /*
-CreateMixin7 creates creates a new scrape config errors invalid argument 3 if some argument is not valid already exists 6 if scrape config with that job name is already present failed precondition 9 if reachability check was requested and some scrape target can t be reached
+CreateMixin11 creates creates a new scrape config errors invalid argument 3 if some argument is not valid already exists 6 if scrape config with that job name is already present failed precondition 9 if reachability check was requested and some scrape target can t be reached
 */
-func (a *Client) CreateMixin7(params *CreateMixin7Params) (*CreateMixin7OK, error) {
+func (a *Client) CreateMixin11(params *CreateMixin11Params) (*CreateMixin11OK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
-		params = NewCreateMixin7Params()
+		params = NewCreateMixin11Params()
 	}
 
 	result, err := a.transport.Submit(&runtime.ClientOperation{
-		ID:                 "CreateMixin7",
+		ID:                 "CreateMixin11",
 		Method:             "POST",
 		PathPattern:        "/v0/scrape-configs",
 		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json"},
 		Schemes:            []string{"http", "https"},
 		Params:             params,
-		Reader:             &CreateMixin7Reader{formats: a.formats},
+		Reader:             &CreateMixin11Reader{formats: a.formats},
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	})
 	if err != nil {
 		return nil, err
 	}
-	return result.(*CreateMixin7OK), nil
+	return result.(*CreateMixin11OK), nil
 
 }
 
 /*
-Delete deletes removes existing scrape config by job name errors not found 5 if no such scrape config is present
+DeleteMixin11 deletes removes existing scrape config by job name errors not found 5 if no such scrape config is present
 */
-func (a *Client) Delete(params *DeleteParams) (*DeleteOK, error) {
+func (a *Client) DeleteMixin11(params *DeleteMixin11Params) (*DeleteMixin11OK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
-		params = NewDeleteParams()
+		params = NewDeleteMixin11Params()
 	}
 
 	result, err := a.transport.Submit(&runtime.ClientOperation{
-		ID:                 "Delete",
+		ID:                 "DeleteMixin11",
 		Method:             "DELETE",
 		PathPattern:        "/v0/scrape-configs/{job_name}",
 		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json"},
 		Schemes:            []string{"http", "https"},
 		Params:             params,
-		Reader:             &DeleteReader{formats: a.formats},
+		Reader:             &DeleteMixin11Reader{formats: a.formats},
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	})
 	if err != nil {
 		return nil, err
 	}
-	return result.(*DeleteOK), nil
+	return result.(*DeleteMixin11OK), nil
 
 }
 
@@ -109,58 +109,58 @@ func (a *Client) Get(params *GetParams) (*GetOK, error) {
 }
 
 /*
-ListMixin7 lists returns all scrape configs
+ListMixin11 lists returns all scrape configs
 */
-func (a *Client) ListMixin7(params *ListMixin7Params) (*ListMixin7OK, error) {
+func (a *Client) ListMixin11(params *ListMixin11Params) (*ListMixin11OK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
-		params = NewListMixin7Params()
+		params = NewListMixin11Params()
 	}
 
 	result, err := a.transport.Submit(&runtime.ClientOperation{
-		ID:                 "ListMixin7",
+		ID:                 "ListMixin11",
 		Method:             "GET",
 		PathPattern:        "/v0/scrape-configs",
 		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json"},
 		Schemes:            []string{"http", "https"},
 		Params:             params,
-		Reader:             &ListMixin7Reader{formats: a.formats},
+		Reader:             &ListMixin11Reader{formats: a.formats},
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	})
 	if err != nil {
 		return nil, err
 	}
-	return result.(*ListMixin7OK), nil
+	return result.(*ListMixin11OK), nil
 
 }
 
 /*
-Update updates updates existing scrape config by job name errors invalid argument 3 if some argument is not valid not found 5 if no such scrape config is present failed precondition 9 if reachability check was requested and some scrape target can t be reached
+UpdateMixin11 updates updates existing scrape config by job name errors invalid argument 3 if some argument is not valid not found 5 if no such scrape config is present failed precondition 9 if reachability check was requested and some scrape target can t be reached
 */
-func (a *Client) Update(params *UpdateParams) (*UpdateOK, error) {
+func (a *Client) UpdateMixin11(params *UpdateMixin11Params) (*UpdateMixin11OK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
-		params = NewUpdateParams()
+		params = NewUpdateMixin11Params()
 	}
 
 	result, err := a.transport.Submit(&runtime.ClientOperation{
-		ID:                 "Update",
+		ID:                 "UpdateMixin11",
 		Method:             "PUT",
 		PathPattern:        "/v0/scrape-configs/{scrape_config.job_name}",
 		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json"},
 		Schemes:            []string{"http", "https"},
 		Params:             params,
-		Reader:             &UpdateReader{formats: a.formats},
+		Reader:             &UpdateMixin11Reader{formats: a.formats},
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	})
 	if err != nil {
 		return nil, err
 	}
-	return result.(*UpdateOK), nil
+	return result.(*UpdateMixin11OK), nil
 
 }
 
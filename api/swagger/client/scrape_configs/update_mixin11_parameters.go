@@ -0,0 +1,158 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package scrape_configs
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	models "github.com/percona/pmm-managed/api/swagger/models"
+)
+
+// NewUpdateMixin11Params creates a new UpdateMixin11Params object
+// with the default values initialized.
+func NewUpdateMixin11Params() *UpdateMixin11Params {
+	var ()
+	return &UpdateMixin11Params{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewUpdateMixin11ParamsWithTimeout creates a new UpdateMixin11Params object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewUpdateMixin11ParamsWithTimeout(timeout time.Duration) *UpdateMixin11Params {
+	var ()
+	return &UpdateMixin11Params{
+
+		timeout: timeout,
+	}
+}
+
+// NewUpdateMixin11ParamsWithContext creates a new UpdateMixin11Params object
+// with the default values initialized, and the ability to set a context for a request
+func NewUpdateMixin11ParamsWithContext(ctx context.Context) *UpdateMixin11Params {
+	var ()
+	return &UpdateMixin11Params{
+
+		Context: ctx,
+	}
+}
+
+// NewUpdateMixin11ParamsWithHTTPClient creates a new UpdateMixin11Params object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewUpdateMixin11ParamsWithHTTPClient(client *http.Client) *UpdateMixin11Params {
+	var ()
+	return &UpdateMixin11Params{
+		HTTPClient: client,
+	}
+}
+
+/*UpdateMixin11Params contains all the parameters to send to the API endpoint
+for the update mixin11 operation typically these are written to a http.Request
+*/
+type UpdateMixin11Params struct {
+
+	/*Body*/
+	Body *models.APIScrapeConfigsUpdateRequest
+	/*ScrapeConfigJobName
+	  The job name assigned to scraped metrics by default: "example-job" (required)
+
+	*/
+	ScrapeConfigJobName string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the update mixin11 params
+func (o *UpdateMixin11Params) WithTimeout(timeout time.Duration) *UpdateMixin11Params {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the update mixin11 params
+func (o *UpdateMixin11Params) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the update mixin11 params
+func (o *UpdateMixin11Params) WithContext(ctx context.Context) *UpdateMixin11Params {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the update mixin11 params
+func (o *UpdateMixin11Params) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the update mixin11 params
+func (o *UpdateMixin11Params) WithHTTPClient(client *http.Client) *UpdateMixin11Params {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the update mixin11 params
+func (o *UpdateMixin11Params) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the update mixin11 params
+func (o *UpdateMixin11Params) WithBody(body *models.APIScrapeConfigsUpdateRequest) *UpdateMixin11Params {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the update mixin11 params
+func (o *UpdateMixin11Params) SetBody(body *models.APIScrapeConfigsUpdateRequest) {
+	o.Body = body
+}
+
+// WithScrapeConfigJobName adds the scrapeConfigJobName to the update mixin11 params
+func (o *UpdateMixin11Params) WithScrapeConfigJobName(scrapeConfigJobName string) *UpdateMixin11Params {
+	o.SetScrapeConfigJobName(scrapeConfigJobName)
+	return o
+}
+
+// SetScrapeConfigJobName adds the scrapeConfigJobName to the update mixin11 params
+func (o *UpdateMixin11Params) SetScrapeConfigJobName(scrapeConfigJobName string) {
+	o.ScrapeConfigJobName = scrapeConfigJobName
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *UpdateMixin11Params) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	// path param scrape_config.job_name
+	if err := r.SetPathParam("scrape_config.job_name", o.ScrapeConfigJobName); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package scrape_configs
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	models "github.com/percona/pmm-managed/api/swagger/models"
+)
+
+// UpdateMixin11Reader is a Reader for the UpdateMixin11 structure.
+type UpdateMixin11Reader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *UpdateMixin11Reader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+
+	case 200:
+		result := NewUpdateMixin11OK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+
+	default:
+		return nil, runtime.NewAPIError("unknown error", response, response.Code())
+	}
+}
+
+// NewUpdateMixin11OK creates a UpdateMixin11OK with default headers values
+func NewUpdateMixin11OK() *UpdateMixin11OK {
+	return &UpdateMixin11OK{}
+}
+
+/*UpdateMixin11OK handles this case with default header values.
+
+(empty)
+*/
+type UpdateMixin11OK struct {
+	Payload models.APIScrapeConfigsUpdateResponse
+}
+
+func (o *UpdateMixin11OK) Error() string {
+	return fmt.Sprintf("[PUT /v0/scrape-configs/{scrape_config.job_name}][%d] updateMixin11OK  %+v", 200, o.Payload)
+}
+
+func (o *UpdateMixin11OK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,67 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package telemetry
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	models "github.com/percona/pmm-managed/api/swagger/models"
+)
+
+// GetSettingsReader is a Reader for the GetSettings structure.
+type GetSettingsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetSettingsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+
+	case 200:
+		result := NewGetSettingsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+
+	default:
+		return nil, runtime.NewAPIError("unknown error", response, response.Code())
+	}
+}
+
+// NewGetSettingsOK creates a GetSettingsOK with default headers values
+func NewGetSettingsOK() *GetSettingsOK {
+	return &GetSettingsOK{}
+}
+
+/*GetSettingsOK handles this case with default header values.
+
+(empty)
+*/
+type GetSettingsOK struct {
+	Payload *models.APITelemetryGetSettingsResponse
+}
+
+func (o *GetSettingsOK) Error() string {
+	return fmt.Sprintf("[GET /v0/telemetry/settings][%d] getSettingsOK  %+v", 200, o.Payload)
+}
+
+func (o *GetSettingsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APITelemetryGetSettingsResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
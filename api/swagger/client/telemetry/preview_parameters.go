@@ -0,0 +1,114 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package telemetry
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+// NewPreviewParams creates a new PreviewParams object
+// with the default values initialized.
+func NewPreviewParams() *PreviewParams {
+
+	return &PreviewParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewPreviewParamsWithTimeout creates a new PreviewParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewPreviewParamsWithTimeout(timeout time.Duration) *PreviewParams {
+
+	return &PreviewParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewPreviewParamsWithContext creates a new PreviewParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewPreviewParamsWithContext(ctx context.Context) *PreviewParams {
+
+	return &PreviewParams{
+
+		Context: ctx,
+	}
+}
+
+// NewPreviewParamsWithHTTPClient creates a new PreviewParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewPreviewParamsWithHTTPClient(client *http.Client) *PreviewParams {
+
+	return &PreviewParams{
+		HTTPClient: client,
+	}
+}
+
+/*PreviewParams contains all the parameters to send to the API endpoint
+for the preview operation typically these are written to a http.Request
+*/
+type PreviewParams struct {
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the preview params
+func (o *PreviewParams) WithTimeout(timeout time.Duration) *PreviewParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the preview params
+func (o *PreviewParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the preview params
+func (o *PreviewParams) WithContext(ctx context.Context) *PreviewParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the preview params
+func (o *PreviewParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the preview params
+func (o *PreviewParams) WithHTTPClient(client *http.Client) *PreviewParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the preview params
+func (o *PreviewParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *PreviewParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
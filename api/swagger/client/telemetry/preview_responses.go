@@ -0,0 +1,67 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package telemetry
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	models "github.com/percona/pmm-managed/api/swagger/models"
+)
+
+// PreviewReader is a Reader for the Preview structure.
+type PreviewReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *PreviewReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+
+	case 200:
+		result := NewPreviewOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+
+	default:
+		return nil, runtime.NewAPIError("unknown error", response, response.Code())
+	}
+}
+
+// NewPreviewOK creates a PreviewOK with default headers values
+func NewPreviewOK() *PreviewOK {
+	return &PreviewOK{}
+}
+
+/*PreviewOK handles this case with default header values.
+
+(empty)
+*/
+type PreviewOK struct {
+	Payload *models.APITelemetryPreviewResponse
+}
+
+func (o *PreviewOK) Error() string {
+	return fmt.Sprintf("[GET /v0/telemetry/preview][%d] previewOK  %+v", 200, o.Payload)
+}
+
+func (o *PreviewOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APITelemetryPreviewResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,65 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package telemetry
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	models "github.com/percona/pmm-managed/api/swagger/models"
+)
+
+// SetEnabledReader is a Reader for the SetEnabled structure.
+type SetEnabledReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *SetEnabledReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+
+	case 200:
+		result := NewSetEnabledOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+
+	default:
+		return nil, runtime.NewAPIError("unknown error", response, response.Code())
+	}
+}
+
+// NewSetEnabledOK creates a SetEnabledOK with default headers values
+func NewSetEnabledOK() *SetEnabledOK {
+	return &SetEnabledOK{}
+}
+
+/*SetEnabledOK handles this case with default header values.
+
+(empty)
+*/
+type SetEnabledOK struct {
+	Payload models.APITelemetrySetEnabledResponse
+}
+
+func (o *SetEnabledOK) Error() string {
+	return fmt.Sprintf("[POST /v0/telemetry/settings][%d] setEnabledOK  %+v", 200, o.Payload)
+}
+
+func (o *SetEnabledOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
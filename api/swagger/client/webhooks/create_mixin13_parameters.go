@@ -0,0 +1,137 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package webhooks
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	models "github.com/percona/pmm-managed/api/swagger/models"
+)
+
+// NewCreateMixin13Params creates a new CreateMixin13Params object
+// with the default values initialized.
+func NewCreateMixin13Params() *CreateMixin13Params {
+	var ()
+	return &CreateMixin13Params{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewCreateMixin13ParamsWithTimeout creates a new CreateMixin13Params object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewCreateMixin13ParamsWithTimeout(timeout time.Duration) *CreateMixin13Params {
+	var ()
+	return &CreateMixin13Params{
+
+		timeout: timeout,
+	}
+}
+
+// NewCreateMixin13ParamsWithContext creates a new CreateMixin13Params object
+// with the default values initialized, and the ability to set a context for a request
+func NewCreateMixin13ParamsWithContext(ctx context.Context) *CreateMixin13Params {
+	var ()
+	return &CreateMixin13Params{
+
+		Context: ctx,
+	}
+}
+
+// NewCreateMixin13ParamsWithHTTPClient creates a new CreateMixin13Params object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewCreateMixin13ParamsWithHTTPClient(client *http.Client) *CreateMixin13Params {
+	var ()
+	return &CreateMixin13Params{
+		HTTPClient: client,
+	}
+}
+
+/*CreateMixin13Params contains all the parameters to send to the API endpoint
+for the create mixin13 operation typically these are written to a http.Request
+*/
+type CreateMixin13Params struct {
+
+	/*Body*/
+	Body *models.APIWebhooksCreateRequest
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the create mixin13 params
+func (o *CreateMixin13Params) WithTimeout(timeout time.Duration) *CreateMixin13Params {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the create mixin13 params
+func (o *CreateMixin13Params) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the create mixin13 params
+func (o *CreateMixin13Params) WithContext(ctx context.Context) *CreateMixin13Params {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the create mixin13 params
+func (o *CreateMixin13Params) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the create mixin13 params
+func (o *CreateMixin13Params) WithHTTPClient(client *http.Client) *CreateMixin13Params {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the create mixin13 params
+func (o *CreateMixin13Params) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the create mixin13 params
+func (o *CreateMixin13Params) WithBody(body *models.APIWebhooksCreateRequest) *CreateMixin13Params {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the create mixin13 params
+func (o *CreateMixin13Params) SetBody(body *models.APIWebhooksCreateRequest) {
+	o.Body = body
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *CreateMixin13Params) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package webhooks
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	models "github.com/percona/pmm-managed/api/swagger/models"
+)
+
+// CreateMixin13Reader is a Reader for the CreateMixin13 structure.
+type CreateMixin13Reader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *CreateMixin13Reader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+
+	case 200:
+		result := NewCreateMixin13OK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+
+	default:
+		return nil, runtime.NewAPIError("unknown error", response, response.Code())
+	}
+}
+
+// NewCreateMixin13OK creates a CreateMixin13OK with default headers values
+func NewCreateMixin13OK() *CreateMixin13OK {
+	return &CreateMixin13OK{}
+}
+
+/*CreateMixin13OK handles this case with default header values.
+
+(empty)
+*/
+type CreateMixin13OK struct {
+	Payload *models.APIWebhooksCreateResponse
+}
+
+func (o *CreateMixin13OK) Error() string {
+	return fmt.Sprintf("[POST /v0/webhooks][%d] createMixin13OK  %+v", 200, o.Payload)
+}
+
+func (o *CreateMixin13OK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIWebhooksCreateResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
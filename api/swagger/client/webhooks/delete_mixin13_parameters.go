@@ -0,0 +1,135 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package webhooks
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/swag"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+// NewDeleteMixin13Params creates a new DeleteMixin13Params object
+// with the default values initialized.
+func NewDeleteMixin13Params() *DeleteMixin13Params {
+	var ()
+	return &DeleteMixin13Params{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewDeleteMixin13ParamsWithTimeout creates a new DeleteMixin13Params object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewDeleteMixin13ParamsWithTimeout(timeout time.Duration) *DeleteMixin13Params {
+	var ()
+	return &DeleteMixin13Params{
+
+		timeout: timeout,
+	}
+}
+
+// NewDeleteMixin13ParamsWithContext creates a new DeleteMixin13Params object
+// with the default values initialized, and the ability to set a context for a request
+func NewDeleteMixin13ParamsWithContext(ctx context.Context) *DeleteMixin13Params {
+	var ()
+	return &DeleteMixin13Params{
+
+		Context: ctx,
+	}
+}
+
+// NewDeleteMixin13ParamsWithHTTPClient creates a new DeleteMixin13Params object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewDeleteMixin13ParamsWithHTTPClient(client *http.Client) *DeleteMixin13Params {
+	var ()
+	return &DeleteMixin13Params{
+		HTTPClient: client,
+	}
+}
+
+/*DeleteMixin13Params contains all the parameters to send to the API endpoint
+for the delete mixin13 operation typically these are written to a http.Request
+*/
+type DeleteMixin13Params struct {
+
+	/*ID*/
+	ID int32
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the delete mixin13 params
+func (o *DeleteMixin13Params) WithTimeout(timeout time.Duration) *DeleteMixin13Params {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the delete mixin13 params
+func (o *DeleteMixin13Params) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the delete mixin13 params
+func (o *DeleteMixin13Params) WithContext(ctx context.Context) *DeleteMixin13Params {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the delete mixin13 params
+func (o *DeleteMixin13Params) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the delete mixin13 params
+func (o *DeleteMixin13Params) WithHTTPClient(client *http.Client) *DeleteMixin13Params {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the delete mixin13 params
+func (o *DeleteMixin13Params) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithID adds the id to the delete mixin13 params
+func (o *DeleteMixin13Params) WithID(id int32) *DeleteMixin13Params {
+	o.SetID(id)
+	return o
+}
+
+// SetID adds the id to the delete mixin13 params
+func (o *DeleteMixin13Params) SetID(id int32) {
+	o.ID = id
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *DeleteMixin13Params) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param id
+	if err := r.SetPathParam("id", swag.FormatInt32(o.ID)); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,65 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package webhooks
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	models "github.com/percona/pmm-managed/api/swagger/models"
+)
+
+// DeleteMixin13Reader is a Reader for the DeleteMixin13 structure.
+type DeleteMixin13Reader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *DeleteMixin13Reader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+
+	case 200:
+		result := NewDeleteMixin13OK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+
+	default:
+		return nil, runtime.NewAPIError("unknown error", response, response.Code())
+	}
+}
+
+// NewDeleteMixin13OK creates a DeleteMixin13OK with default headers values
+func NewDeleteMixin13OK() *DeleteMixin13OK {
+	return &DeleteMixin13OK{}
+}
+
+/*DeleteMixin13OK handles this case with default header values.
+
+(empty)
+*/
+type DeleteMixin13OK struct {
+	Payload models.APIWebhooksDeleteResponse
+}
+
+func (o *DeleteMixin13OK) Error() string {
+	return fmt.Sprintf("[DELETE /v0/webhooks/{id}][%d] deleteMixin13OK  %+v", 200, o.Payload)
+}
+
+func (o *DeleteMixin13OK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,149 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package webhooks
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/swag"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+// NewListDeadLettersParams creates a new ListDeadLettersParams object
+// with the default values initialized.
+func NewListDeadLettersParams() *ListDeadLettersParams {
+	var ()
+	return &ListDeadLettersParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewListDeadLettersParamsWithTimeout creates a new ListDeadLettersParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewListDeadLettersParamsWithTimeout(timeout time.Duration) *ListDeadLettersParams {
+	var ()
+	return &ListDeadLettersParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewListDeadLettersParamsWithContext creates a new ListDeadLettersParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewListDeadLettersParamsWithContext(ctx context.Context) *ListDeadLettersParams {
+	var ()
+	return &ListDeadLettersParams{
+
+		Context: ctx,
+	}
+}
+
+// NewListDeadLettersParamsWithHTTPClient creates a new ListDeadLettersParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewListDeadLettersParamsWithHTTPClient(client *http.Client) *ListDeadLettersParams {
+	var ()
+	return &ListDeadLettersParams{
+		HTTPClient: client,
+	}
+}
+
+/*ListDeadLettersParams contains all the parameters to send to the API endpoint
+for the list dead letters operation typically these are written to a http.Request
+*/
+type ListDeadLettersParams struct {
+
+	/*WebhookID
+	  Return only dead letters of that webhook; all if not set.
+
+	*/
+	WebhookID *int32
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the list dead letters params
+func (o *ListDeadLettersParams) WithTimeout(timeout time.Duration) *ListDeadLettersParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the list dead letters params
+func (o *ListDeadLettersParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the list dead letters params
+func (o *ListDeadLettersParams) WithContext(ctx context.Context) *ListDeadLettersParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the list dead letters params
+func (o *ListDeadLettersParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the list dead letters params
+func (o *ListDeadLettersParams) WithHTTPClient(client *http.Client) *ListDeadLettersParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the list dead letters params
+func (o *ListDeadLettersParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithWebhookID adds the webhookID to the list dead letters params
+func (o *ListDeadLettersParams) WithWebhookID(webhookID *int32) *ListDeadLettersParams {
+	o.SetWebhookID(webhookID)
+	return o
+}
+
+// SetWebhookID adds the webhookId to the list dead letters params
+func (o *ListDeadLettersParams) SetWebhookID(webhookID *int32) {
+	o.WebhookID = webhookID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ListDeadLettersParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.WebhookID != nil {
+
+		// query param webhook_id
+		var qrWebhookID int32
+		if o.WebhookID != nil {
+			qrWebhookID = *o.WebhookID
+		}
+		qWebhookID := swag.FormatInt32(qrWebhookID)
+		if qWebhookID != "" {
+			if err := r.SetQueryParam("webhook_id", qWebhookID); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package webhooks
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	models "github.com/percona/pmm-managed/api/swagger/models"
+)
+
+// ListDeadLettersReader is a Reader for the ListDeadLetters structure.
+type ListDeadLettersReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ListDeadLettersReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+
+	case 200:
+		result := NewListDeadLettersOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+
+	default:
+		return nil, runtime.NewAPIError("unknown error", response, response.Code())
+	}
+}
+
+// NewListDeadLettersOK creates a ListDeadLettersOK with default headers values
+func NewListDeadLettersOK() *ListDeadLettersOK {
+	return &ListDeadLettersOK{}
+}
+
+/*ListDeadLettersOK handles this case with default header values.
+
+(empty)
+*/
+type ListDeadLettersOK struct {
+	Payload *models.APIWebhooksListDeadLettersResponse
+}
+
+func (o *ListDeadLettersOK) Error() string {
+	return fmt.Sprintf("[GET /v0/webhooks/dead-letters][%d] listDeadLettersOK  %+v", 200, o.Payload)
+}
+
+func (o *ListDeadLettersOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIWebhooksListDeadLettersResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
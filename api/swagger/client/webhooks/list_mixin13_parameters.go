@@ -0,0 +1,114 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package webhooks
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+// NewListMixin13Params creates a new ListMixin13Params object
+// with the default values initialized.
+func NewListMixin13Params() *ListMixin13Params {
+
+	return &ListMixin13Params{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewListMixin13ParamsWithTimeout creates a new ListMixin13Params object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewListMixin13ParamsWithTimeout(timeout time.Duration) *ListMixin13Params {
+
+	return &ListMixin13Params{
+
+		timeout: timeout,
+	}
+}
+
+// NewListMixin13ParamsWithContext creates a new ListMixin13Params object
+// with the default values initialized, and the ability to set a context for a request
+func NewListMixin13ParamsWithContext(ctx context.Context) *ListMixin13Params {
+
+	return &ListMixin13Params{
+
+		Context: ctx,
+	}
+}
+
+// NewListMixin13ParamsWithHTTPClient creates a new ListMixin13Params object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewListMixin13ParamsWithHTTPClient(client *http.Client) *ListMixin13Params {
+
+	return &ListMixin13Params{
+		HTTPClient: client,
+	}
+}
+
+/*ListMixin13Params contains all the parameters to send to the API endpoint
+for the list mixin13 operation typically these are written to a http.Request
+*/
+type ListMixin13Params struct {
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the list mixin13 params
+func (o *ListMixin13Params) WithTimeout(timeout time.Duration) *ListMixin13Params {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the list mixin13 params
+func (o *ListMixin13Params) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the list mixin13 params
+func (o *ListMixin13Params) WithContext(ctx context.Context) *ListMixin13Params {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the list mixin13 params
+func (o *ListMixin13Params) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the list mixin13 params
+func (o *ListMixin13Params) WithHTTPClient(client *http.Client) *ListMixin13Params {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the list mixin13 params
+func (o *ListMixin13Params) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ListMixin13Params) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
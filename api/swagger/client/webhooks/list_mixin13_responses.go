@@ -0,0 +1,67 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package webhooks
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	models "github.com/percona/pmm-managed/api/swagger/models"
+)
+
+// ListMixin13Reader is a Reader for the ListMixin13 structure.
+type ListMixin13Reader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ListMixin13Reader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+
+	case 200:
+		result := NewListMixin13OK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+
+	default:
+		return nil, runtime.NewAPIError("unknown error", response, response.Code())
+	}
+}
+
+// NewListMixin13OK creates a ListMixin13OK with default headers values
+func NewListMixin13OK() *ListMixin13OK {
+	return &ListMixin13OK{}
+}
+
+/*ListMixin13OK handles this case with default header values.
+
+(empty)
+*/
+type ListMixin13OK struct {
+	Payload *models.APIWebhooksListResponse
+}
+
+func (o *ListMixin13OK) Error() string {
+	return fmt.Sprintf("[GET /v0/webhooks][%d] listMixin13OK  %+v", 200, o.Payload)
+}
+
+func (o *ListMixin13OK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIWebhooksListResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,156 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package webhooks
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+	"time"
+
+	"golang.org/x/net/context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/swag"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	models "github.com/percona/pmm-managed/api/swagger/models"
+)
+
+// NewTestParams creates a new TestParams object
+// with the default values initialized.
+func NewTestParams() *TestParams {
+	var ()
+	return &TestParams{
+
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewTestParamsWithTimeout creates a new TestParams object
+// with the default values initialized, and the ability to set a timeout on a request
+func NewTestParamsWithTimeout(timeout time.Duration) *TestParams {
+	var ()
+	return &TestParams{
+
+		timeout: timeout,
+	}
+}
+
+// NewTestParamsWithContext creates a new TestParams object
+// with the default values initialized, and the ability to set a context for a request
+func NewTestParamsWithContext(ctx context.Context) *TestParams {
+	var ()
+	return &TestParams{
+
+		Context: ctx,
+	}
+}
+
+// NewTestParamsWithHTTPClient creates a new TestParams object
+// with the default values initialized, and the ability to set a custom HTTPClient for a request
+func NewTestParamsWithHTTPClient(client *http.Client) *TestParams {
+	var ()
+	return &TestParams{
+		HTTPClient: client,
+	}
+}
+
+/*TestParams contains all the parameters to send to the API endpoint
+for the test operation typically these are written to a http.Request
+*/
+type TestParams struct {
+
+	/*Body*/
+	Body *models.APIWebhooksTestRequest
+	/*ID*/
+	ID int32
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithTimeout adds the timeout to the test params
+func (o *TestParams) WithTimeout(timeout time.Duration) *TestParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the test params
+func (o *TestParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the test params
+func (o *TestParams) WithContext(ctx context.Context) *TestParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the test params
+func (o *TestParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the test params
+func (o *TestParams) WithHTTPClient(client *http.Client) *TestParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the test params
+func (o *TestParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the test params
+func (o *TestParams) WithBody(body *models.APIWebhooksTestRequest) *TestParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the test params
+func (o *TestParams) SetBody(body *models.APIWebhooksTestRequest) {
+	o.Body = body
+}
+
+// WithID adds the id to the test params
+func (o *TestParams) WithID(id int32) *TestParams {
+	o.SetID(id)
+	return o
+}
+
+// SetID adds the id to the test params
+func (o *TestParams) SetID(id int32) {
+	o.ID = id
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *TestParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	// path param id
+	if err := r.SetPathParam("id", swag.FormatInt32(o.ID)); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,170 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package webhooks
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"github.com/go-openapi/runtime"
+
+	strfmt "github.com/go-openapi/strfmt"
+)
+
+// New creates a new webhooks API client.
+func New(transport runtime.ClientTransport, formats strfmt.Registry) *Client {
+	return &Client{transport: transport, formats: formats}
+}
+
+/*
+Client for webhooks API
+*/
+type Client struct {
+	transport runtime.ClientTransport
+	formats   strfmt.Registry
+}
+
+/*
+CreateMixin13 creates registers a new webhook
+*/
+func (a *Client) CreateMixin13(params *CreateMixin13Params) (*CreateMixin13OK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewCreateMixin13Params()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "CreateMixin13",
+		Method:             "POST",
+		PathPattern:        "/v0/webhooks",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &CreateMixin13Reader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*CreateMixin13OK), nil
+
+}
+
+/*
+DeleteMixin13 deletes removes webhook and its dead letters
+*/
+func (a *Client) DeleteMixin13(params *DeleteMixin13Params) (*DeleteMixin13OK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewDeleteMixin13Params()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "DeleteMixin13",
+		Method:             "DELETE",
+		PathPattern:        "/v0/webhooks/{id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &DeleteMixin13Reader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*DeleteMixin13OK), nil
+
+}
+
+/*
+ListDeadLetters lists dead letters returns events which were not delivered after all retries
+*/
+func (a *Client) ListDeadLetters(params *ListDeadLettersParams) (*ListDeadLettersOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewListDeadLettersParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "ListDeadLetters",
+		Method:             "GET",
+		PathPattern:        "/v0/webhooks/dead-letters",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &ListDeadLettersReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*ListDeadLettersOK), nil
+
+}
+
+/*
+ListMixin13 lists returns all webhooks
+*/
+func (a *Client) ListMixin13(params *ListMixin13Params) (*ListMixin13OK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewListMixin13Params()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "ListMixin13",
+		Method:             "GET",
+		PathPattern:        "/v0/webhooks",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &ListMixin13Reader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*ListMixin13OK), nil
+
+}
+
+/*
+Test tests synchronously sends a single test event to webhook without retries
+*/
+func (a *Client) Test(params *TestParams) (*TestOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewTestParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "Test",
+		Method:             "POST",
+		PathPattern:        "/v0/webhooks/{id}/test",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &TestReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*TestOK), nil
+
+}
+
+// SetTransport changes the transport on the client
+func (a *Client) SetTransport(transport runtime.ClientTransport) {
+	a.transport = transport
+}
@@ -0,0 +1,69 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/validate"
+)
+
+// AgentStatusState Supervisor process state: unknown, running, stopped, or failed (exited and waiting for restart).
+// swagger:model AgentStatusState
+type AgentStatusState string
+
+const (
+
+	// AgentStatusStateUNKNOWN captures enum value "UNKNOWN"
+	AgentStatusStateUNKNOWN AgentStatusState = "UNKNOWN"
+
+	// AgentStatusStateRUNNING captures enum value "RUNNING"
+	AgentStatusStateRUNNING AgentStatusState = "RUNNING"
+
+	// AgentStatusStateSTOPPED captures enum value "STOPPED"
+	AgentStatusStateSTOPPED AgentStatusState = "STOPPED"
+
+	// AgentStatusStateFAILED captures enum value "FAILED"
+	AgentStatusStateFAILED AgentStatusState = "FAILED"
+)
+
+// for schema
+var agentStatusStateEnum []interface{}
+
+func init() {
+	var res []AgentStatusState
+	if err := json.Unmarshal([]byte(`["UNKNOWN","RUNNING","STOPPED","FAILED"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		agentStatusStateEnum = append(agentStatusStateEnum, v)
+	}
+}
+
+func (m AgentStatusState) validateAgentStatusStateEnum(path, location string, value AgentStatusState) error {
+	if err := validate.Enum(path, location, value, agentStatusStateEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates this agent status state
+func (m AgentStatusState) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	// value enum
+	if err := m.validateAgentStatusStateEnum("", "body", m); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
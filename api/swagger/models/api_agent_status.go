@@ -0,0 +1,93 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/swag"
+)
+
+// APIAgentStatus AgentStatus represents Agent runtime status.
+// swagger:model apiAgentStatus
+type APIAgentStatus struct {
+
+	// id
+	ID int32 `json:"id,omitempty"`
+
+	// Last process exit error, if known
+	LastError string `json:"last_error,omitempty"`
+
+	// listen port
+	ListenPort int64 `json:"listen_port,omitempty"`
+
+	// True if Agent answers on /metrics endpoint
+	MetricsUp bool `json:"metrics_up,omitempty"`
+
+	// Process ID, if known
+	Pid int64 `json:"pid,omitempty"`
+
+	// Number of process restarts, if known
+	Restarts int64 `json:"restarts,omitempty"`
+
+	// state
+	State AgentStatusState `json:"state,omitempty"`
+
+	// type
+	Type string `json:"type,omitempty"`
+
+	// Process uptime, if known
+	Uptime string `json:"uptime,omitempty"`
+}
+
+// Validate validates this api agent status
+func (m *APIAgentStatus) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateState(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *APIAgentStatus) validateState(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.State) { // not required
+		return nil
+	}
+
+	if err := m.State.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("state")
+		}
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *APIAgentStatus) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *APIAgentStatus) UnmarshalBinary(b []byte) error {
+	var res APIAgentStatus
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,75 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/validate"
+)
+
+// APIAgentsHealth AgentsHealth represents aggregated health of instance's Agents.
+//
+//   - AGENTS_HEALTH_UNKNOWN: There are no Agents, or their status can't be determined.
+//   - AGENTS_HEALTH_DOWN: All Agents are down.
+//   - AGENTS_HEALTH_DEGRADED: Some Agents are down.
+//   - AGENTS_HEALTH_UP: All Agents are up.
+//
+// swagger:model apiAgentsHealth
+type APIAgentsHealth string
+
+const (
+
+	// APIAgentsHealthAGENTSHEALTHUNKNOWN captures enum value "AGENTS_HEALTH_UNKNOWN"
+	APIAgentsHealthAGENTSHEALTHUNKNOWN APIAgentsHealth = "AGENTS_HEALTH_UNKNOWN"
+
+	// APIAgentsHealthAGENTSHEALTHDOWN captures enum value "AGENTS_HEALTH_DOWN"
+	APIAgentsHealthAGENTSHEALTHDOWN APIAgentsHealth = "AGENTS_HEALTH_DOWN"
+
+	// APIAgentsHealthAGENTSHEALTHDEGRADED captures enum value "AGENTS_HEALTH_DEGRADED"
+	APIAgentsHealthAGENTSHEALTHDEGRADED APIAgentsHealth = "AGENTS_HEALTH_DEGRADED"
+
+	// APIAgentsHealthAGENTSHEALTHUP captures enum value "AGENTS_HEALTH_UP"
+	APIAgentsHealthAGENTSHEALTHUP APIAgentsHealth = "AGENTS_HEALTH_UP"
+)
+
+// for schema
+var apiAgentsHealthEnum []interface{}
+
+func init() {
+	var res []APIAgentsHealth
+	if err := json.Unmarshal([]byte(`["AGENTS_HEALTH_UNKNOWN","AGENTS_HEALTH_DOWN","AGENTS_HEALTH_DEGRADED","AGENTS_HEALTH_UP"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		apiAgentsHealthEnum = append(apiAgentsHealthEnum, v)
+	}
+}
+
+func (m APIAgentsHealth) validateAPIAgentsHealthEnum(path, location string, value APIAgentsHealth) error {
+	if err := validate.Enum(path, location, value, apiAgentsHealthEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates this api agents health
+func (m APIAgentsHealth) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	// value enum
+	if err := m.validateAPIAgentsHealthEnum("", "body", m); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
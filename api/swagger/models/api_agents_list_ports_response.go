@@ -0,0 +1,118 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"strconv"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/swag"
+)
+
+// APIAgentsListPortsResponse api agents list ports response
+// swagger:model apiAgentsListPortsResponse
+type APIAgentsListPortsResponse struct {
+
+	// allocations
+	Allocations []*APIPortAllocation `json:"allocations"`
+
+	// conflicts
+	Conflicts []*APIPortConflict `json:"conflicts"`
+
+	// max port
+	MaxPort int64 `json:"max_port,omitempty"`
+
+	// Configured ports range (inclusive).
+	MinPort int64 `json:"min_port,omitempty"`
+}
+
+// Validate validates this api agents list ports response
+func (m *APIAgentsListPortsResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAllocations(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateConflicts(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *APIAgentsListPortsResponse) validateAllocations(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Allocations) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Allocations); i++ {
+		if swag.IsZero(m.Allocations[i]) { // not required
+			continue
+		}
+
+		if m.Allocations[i] != nil {
+			if err := m.Allocations[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("allocations" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *APIAgentsListPortsResponse) validateConflicts(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Conflicts) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Conflicts); i++ {
+		if swag.IsZero(m.Conflicts[i]) { // not required
+			continue
+		}
+
+		if m.Conflicts[i] != nil {
+			if err := m.Conflicts[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("conflicts" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *APIAgentsListPortsResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *APIAgentsListPortsResponse) UnmarshalBinary(b []byte) error {
+	var res APIAgentsListPortsResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
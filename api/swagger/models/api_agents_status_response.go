@@ -0,0 +1,80 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"strconv"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/swag"
+)
+
+// APIAgentsStatusResponse api agents status response
+// swagger:model apiAgentsStatusResponse
+type APIAgentsStatusResponse struct {
+
+	// agents
+	Agents []*APIAgentStatus `json:"agents"`
+}
+
+// Validate validates this api agents status response
+func (m *APIAgentsStatusResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAgents(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *APIAgentsStatusResponse) validateAgents(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Agents) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Agents); i++ {
+		if swag.IsZero(m.Agents[i]) { // not required
+			continue
+		}
+
+		if m.Agents[i] != nil {
+			if err := m.Agents[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("agents" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *APIAgentsStatusResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *APIAgentsStatusResponse) UnmarshalBinary(b []byte) error {
+	var res APIAgentsStatusResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
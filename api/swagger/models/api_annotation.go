@@ -0,0 +1,98 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// APIAnnotation api annotation
+// swagger:model apiAnnotation
+type APIAnnotation struct {
+
+	// id
+	ID string `json:"id,omitempty"`
+
+	// tags
+	Tags []string `json:"tags"`
+
+	// text
+	Text string `json:"text,omitempty"`
+
+	// Annotation time or region start time.
+	// Format: date-time
+	Time strfmt.DateTime `json:"time,omitempty"`
+
+	// Region end time, not set for point annotations.
+	// Format: date-time
+	TimeEnd strfmt.DateTime `json:"time_end,omitempty"`
+}
+
+// Validate validates this api annotation
+func (m *APIAnnotation) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateTime(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTimeEnd(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *APIAnnotation) validateTime(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Time) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("time", "body", "date-time", m.Time.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *APIAnnotation) validateTimeEnd(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.TimeEnd) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("time_end", "body", "date-time", m.TimeEnd.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *APIAnnotation) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *APIAnnotation) UnmarshalBinary(b []byte) error {
+	var res APIAnnotation
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -8,22 +8,77 @@ package models
 import (
 	strfmt "github.com/go-openapi/strfmt"
 
+	"github.com/go-openapi/errors"
 	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
 )
 
 // APIAnnotationsCreateRequest api annotations create request
 // swagger:model apiAnnotationsCreateRequest
 type APIAnnotationsCreateRequest struct {
 
+	// Names of instances to tag annotation with ("instance:<name>" tags).
+	InstanceNames []string `json:"instance_names"`
+
+	// Names of nodes to tag annotation with ("node:<name>" tags).
+	NodeNames []string `json:"node_names"`
+
 	// tags
 	Tags []string `json:"tags"`
 
 	// text
 	Text string `json:"text,omitempty"`
+
+	// Annotation time or region start time; current time is used if not set.
+	// Format: date-time
+	Time strfmt.DateTime `json:"time,omitempty"`
+
+	// Region end time; point annotation is created if not set.
+	// Format: date-time
+	TimeEnd strfmt.DateTime `json:"time_end,omitempty"`
 }
 
 // Validate validates this api annotations create request
 func (m *APIAnnotationsCreateRequest) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateTime(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTimeEnd(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *APIAnnotationsCreateRequest) validateTime(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Time) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("time", "body", "date-time", m.Time.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *APIAnnotationsCreateRequest) validateTimeEnd(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.TimeEnd) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("time_end", "body", "date-time", m.TimeEnd.String(), formats); err != nil {
+		return err
+	}
+
 	return nil
 }
 
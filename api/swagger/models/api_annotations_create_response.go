@@ -15,6 +15,9 @@ import (
 // swagger:model apiAnnotationsCreateResponse
 type APIAnnotationsCreateResponse struct {
 
+	// id
+	ID string `json:"id,omitempty"`
+
 	// message
 	Message string `json:"message,omitempty"`
 }
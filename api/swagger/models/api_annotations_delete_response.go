@@ -0,0 +1,10 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+// APIAnnotationsDeleteResponse api annotations delete response
+// swagger:model apiAnnotationsDeleteResponse
+type APIAnnotationsDeleteResponse interface{}
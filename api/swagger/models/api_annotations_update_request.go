@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/swag"
+)
+
+// APIAnnotationsUpdateRequest api annotations update request
+// swagger:model apiAnnotationsUpdateRequest
+type APIAnnotationsUpdateRequest struct {
+
+	// annotation
+	Annotation *APIAnnotation `json:"annotation,omitempty"`
+}
+
+// Validate validates this api annotations update request
+func (m *APIAnnotationsUpdateRequest) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAnnotation(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *APIAnnotationsUpdateRequest) validateAnnotation(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Annotation) { // not required
+		return nil
+	}
+
+	if m.Annotation != nil {
+		if err := m.Annotation.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("annotation")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *APIAnnotationsUpdateRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *APIAnnotationsUpdateRequest) UnmarshalBinary(b []byte) error {
+	var res APIAnnotationsUpdateRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,95 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// APIAuditEntry api audit entry
+// swagger:model apiAuditEntry
+type APIAuditEntry struct {
+
+	// Authentication method: token, basic, upstream, or empty if authentication is disabled
+	AuthMethod string `json:"auth_method,omitempty"`
+
+	// Token name, username, or upstream user; anonymous if authentication is disabled
+	Caller string `json:"caller,omitempty"`
+
+	// gRPC status code name, e.g. OK or InvalidArgument
+	Code string `json:"code,omitempty"`
+
+	// Call duration
+	Duration string `json:"duration,omitempty"`
+
+	// Error message, if any
+	Error string `json:"error,omitempty"`
+
+	// id
+	ID string `json:"id,omitempty"`
+
+	// Full gRPC method name, e.g. /api.RDS/Add
+	Method string `json:"method,omitempty"`
+
+	// Request in JSON format with secrets redacted
+	Request string `json:"request,omitempty"`
+
+	// Request ID, as in pmm-managed logs
+	RequestID string `json:"request_id,omitempty"`
+
+	// Time when call was made
+	// Format: date-time
+	Time strfmt.DateTime `json:"time,omitempty"`
+}
+
+// Validate validates this api audit entry
+func (m *APIAuditEntry) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateTime(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *APIAuditEntry) validateTime(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Time) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("time", "body", "date-time", m.Time.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *APIAuditEntry) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *APIAuditEntry) UnmarshalBinary(b []byte) error {
+	var res APIAuditEntry
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,86 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"strconv"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/swag"
+)
+
+// APIBaseSchemaVersionResponse api base schema version response
+// swagger:model apiBaseSchemaVersionResponse
+type APIBaseSchemaVersionResponse struct {
+
+	// current version
+	CurrentVersion int32 `json:"current_version,omitempty"`
+
+	// latest version
+	LatestVersion int32 `json:"latest_version,omitempty"`
+
+	// migrations
+	Migrations []*APISchemaMigration `json:"migrations"`
+}
+
+// Validate validates this api base schema version response
+func (m *APIBaseSchemaVersionResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateMigrations(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *APIBaseSchemaVersionResponse) validateMigrations(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Migrations) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Migrations); i++ {
+		if swag.IsZero(m.Migrations[i]) { // not required
+			continue
+		}
+
+		if m.Migrations[i] != nil {
+			if err := m.Migrations[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("migrations" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *APIBaseSchemaVersionResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *APIBaseSchemaVersionResponse) UnmarshalBinary(b []byte) error {
+	var res APIBaseSchemaVersionResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,58 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/swag"
+)
+
+// APIDashboard api dashboard
+// swagger:model apiDashboard
+type APIDashboard struct {
+
+	// folder title
+	FolderTitle string `json:"folder_title,omitempty"`
+
+	// folder uid
+	FolderUID string `json:"folder_uid,omitempty"`
+
+	// tags
+	Tags []string `json:"tags"`
+
+	// title
+	Title string `json:"title,omitempty"`
+
+	// uid
+	UID string `json:"uid,omitempty"`
+
+	// url
+	URL string `json:"url,omitempty"`
+}
+
+// Validate validates this api dashboard
+func (m *APIDashboard) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *APIDashboard) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *APIDashboard) UnmarshalBinary(b []byte) error {
+	var res APIDashboard
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,46 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/swag"
+)
+
+// APIDashboardFolder api dashboard folder
+// swagger:model apiDashboardFolder
+type APIDashboardFolder struct {
+
+	// title
+	Title string `json:"title,omitempty"`
+
+	// uid
+	UID string `json:"uid,omitempty"`
+}
+
+// Validate validates this api dashboard folder
+func (m *APIDashboardFolder) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *APIDashboardFolder) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *APIDashboardFolder) UnmarshalBinary(b []byte) error {
+	var res APIDashboardFolder
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,46 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/swag"
+)
+
+// APIDashboardsCreateFolderRequest api dashboards create folder request
+// swagger:model apiDashboardsCreateFolderRequest
+type APIDashboardsCreateFolderRequest struct {
+
+	// title
+	Title string `json:"title,omitempty"`
+
+	// Folder UID; generated by Grafana if empty.
+	UID string `json:"uid,omitempty"`
+}
+
+// Validate validates this api dashboards create folder request
+func (m *APIDashboardsCreateFolderRequest) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *APIDashboardsCreateFolderRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *APIDashboardsCreateFolderRequest) UnmarshalBinary(b []byte) error {
+	var res APIDashboardsCreateFolderRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/swag"
+)
+
+// APIDashboardsCreateFolderResponse api dashboards create folder response
+// swagger:model apiDashboardsCreateFolderResponse
+type APIDashboardsCreateFolderResponse struct {
+
+	// folder
+	Folder *APIDashboardFolder `json:"folder,omitempty"`
+}
+
+// Validate validates this api dashboards create folder response
+func (m *APIDashboardsCreateFolderResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateFolder(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *APIDashboardsCreateFolderResponse) validateFolder(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Folder) { // not required
+		return nil
+	}
+
+	if m.Folder != nil {
+		if err := m.Folder.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("folder")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *APIDashboardsCreateFolderResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *APIDashboardsCreateFolderResponse) UnmarshalBinary(b []byte) error {
+	var res APIDashboardsCreateFolderResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,43 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/swag"
+)
+
+// APIDashboardsExportResponse api dashboards export response
+// swagger:model apiDashboardsExportResponse
+type APIDashboardsExportResponse struct {
+
+	// Dashboard JSON model.
+	DashboardJSON string `json:"dashboard_json,omitempty"`
+}
+
+// Validate validates this api dashboards export response
+func (m *APIDashboardsExportResponse) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *APIDashboardsExportResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *APIDashboardsExportResponse) UnmarshalBinary(b []byte) error {
+	var res APIDashboardsExportResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,49 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/swag"
+)
+
+// APIDashboardsImportRequest api dashboards import request
+// swagger:model apiDashboardsImportRequest
+type APIDashboardsImportRequest struct {
+
+	// Dashboard JSON model.
+	DashboardJSON string `json:"dashboard_json,omitempty"`
+
+	// Import dashboard into that folder; General folder is used if empty.
+	FolderUID string `json:"folder_uid,omitempty"`
+
+	// Overwrite existing dashboard with the same UID or title.
+	Overwrite bool `json:"overwrite,omitempty"`
+}
+
+// Validate validates this api dashboards import request
+func (m *APIDashboardsImportRequest) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *APIDashboardsImportRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *APIDashboardsImportRequest) UnmarshalBinary(b []byte) error {
+	var res APIDashboardsImportRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,80 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"strconv"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/swag"
+)
+
+// APIDashboardsListFoldersResponse api dashboards list folders response
+// swagger:model apiDashboardsListFoldersResponse
+type APIDashboardsListFoldersResponse struct {
+
+	// folders
+	Folders []*APIDashboardFolder `json:"folders"`
+}
+
+// Validate validates this api dashboards list folders response
+func (m *APIDashboardsListFoldersResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateFolders(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *APIDashboardsListFoldersResponse) validateFolders(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Folders) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Folders); i++ {
+		if swag.IsZero(m.Folders[i]) { // not required
+			continue
+		}
+
+		if m.Folders[i] != nil {
+			if err := m.Folders[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("folders" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *APIDashboardsListFoldersResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *APIDashboardsListFoldersResponse) UnmarshalBinary(b []byte) error {
+	var res APIDashboardsListFoldersResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
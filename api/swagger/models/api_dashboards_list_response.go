@@ -0,0 +1,80 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"strconv"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/swag"
+)
+
+// APIDashboardsListResponse api dashboards list response
+// swagger:model apiDashboardsListResponse
+type APIDashboardsListResponse struct {
+
+	// dashboards
+	Dashboards []*APIDashboard `json:"dashboards"`
+}
+
+// Validate validates this api dashboards list response
+func (m *APIDashboardsListResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateDashboards(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *APIDashboardsListResponse) validateDashboards(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Dashboards) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Dashboards); i++ {
+		if swag.IsZero(m.Dashboards[i]) { // not required
+			continue
+		}
+
+		if m.Dashboards[i] != nil {
+			if err := m.Dashboards[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("dashboards" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *APIDashboardsListResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *APIDashboardsListResponse) UnmarshalBinary(b []byte) error {
+	var res APIDashboardsListResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,147 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// APIEvent api event
+// swagger:model apiEvent
+type APIEvent struct {
+
+	// Set for agent events
+	AgentID int32 `json:"agent_id,omitempty"`
+
+	// New process state; set for AGENT_STATE_CHANGED events
+	AgentState AgentStatusState `json:"agent_state,omitempty"`
+
+	// agent type
+	AgentType string `json:"agent_type,omitempty"`
+
+	// Scrape target instance label value; set for TARGET_DOWN events
+	Instance string `json:"instance,omitempty"`
+
+	// Scrape config job name; set for scrape config and TARGET_DOWN events
+	JobName string `json:"job_name,omitempty"`
+
+	// Node and service of added or removed instance; set for node, service and agent add/remove events
+	NodeID int32 `json:"node_id,omitempty"`
+
+	// node name
+	NodeName string `json:"node_name,omitempty"`
+
+	// node type
+	NodeType string `json:"node_type,omitempty"`
+
+	// Sequence number; pass the last received one with stream_id to Subscribe to resume after reconnect
+	Seq string `json:"seq,omitempty"`
+
+	// service id
+	ServiceID int32 `json:"service_id,omitempty"`
+
+	// service type
+	ServiceType string `json:"service_type,omitempty"`
+
+	// Event stream ID; it changes when pmm-managed is restarted and sequence numbers start over
+	StreamID string `json:"stream_id,omitempty"`
+
+	// Time when event was published
+	// Format: date-time
+	Time strfmt.DateTime `json:"time,omitempty"`
+
+	// type
+	Type APIEventType `json:"type,omitempty"`
+}
+
+// Validate validates this api event
+func (m *APIEvent) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAgentState(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTime(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateType(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *APIEvent) validateAgentState(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.AgentState) { // not required
+		return nil
+	}
+
+	if err := m.AgentState.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("agent_state")
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (m *APIEvent) validateTime(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Time) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("time", "body", "date-time", m.Time.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *APIEvent) validateType(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Type) { // not required
+		return nil
+	}
+
+	if err := m.Type.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("type")
+		}
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *APIEvent) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *APIEvent) UnmarshalBinary(b []byte) error {
+	var res APIEvent
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,98 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/validate"
+)
+
+// APIEventType  - AGENT_STATE_CHANGED: Agent's supervisor process state changed, see agent_state.
+//   - TARGET_DOWN: Managed scrape target health changed to down, see job_name and instance.
+//
+// swagger:model apiEventType
+type APIEventType string
+
+const (
+
+	// APIEventTypeUNKNOWN captures enum value "UNKNOWN"
+	APIEventTypeUNKNOWN APIEventType = "UNKNOWN"
+
+	// APIEventTypeNODEADDED captures enum value "NODE_ADDED"
+	APIEventTypeNODEADDED APIEventType = "NODE_ADDED"
+
+	// APIEventTypeNODEREMOVED captures enum value "NODE_REMOVED"
+	APIEventTypeNODEREMOVED APIEventType = "NODE_REMOVED"
+
+	// APIEventTypeSERVICEADDED captures enum value "SERVICE_ADDED"
+	APIEventTypeSERVICEADDED APIEventType = "SERVICE_ADDED"
+
+	// APIEventTypeSERVICEREMOVED captures enum value "SERVICE_REMOVED"
+	APIEventTypeSERVICEREMOVED APIEventType = "SERVICE_REMOVED"
+
+	// APIEventTypeAGENTADDED captures enum value "AGENT_ADDED"
+	APIEventTypeAGENTADDED APIEventType = "AGENT_ADDED"
+
+	// APIEventTypeAGENTREMOVED captures enum value "AGENT_REMOVED"
+	APIEventTypeAGENTREMOVED APIEventType = "AGENT_REMOVED"
+
+	// APIEventTypeAGENTSTATECHANGED captures enum value "AGENT_STATE_CHANGED"
+	APIEventTypeAGENTSTATECHANGED APIEventType = "AGENT_STATE_CHANGED"
+
+	// APIEventTypeSCRAPECONFIGCREATED captures enum value "SCRAPE_CONFIG_CREATED"
+	APIEventTypeSCRAPECONFIGCREATED APIEventType = "SCRAPE_CONFIG_CREATED"
+
+	// APIEventTypeSCRAPECONFIGUPDATED captures enum value "SCRAPE_CONFIG_UPDATED"
+	APIEventTypeSCRAPECONFIGUPDATED APIEventType = "SCRAPE_CONFIG_UPDATED"
+
+	// APIEventTypeSCRAPECONFIGDELETED captures enum value "SCRAPE_CONFIG_DELETED"
+	APIEventTypeSCRAPECONFIGDELETED APIEventType = "SCRAPE_CONFIG_DELETED"
+
+	// APIEventTypePROMETHEUSRELOADED captures enum value "PROMETHEUS_RELOADED"
+	APIEventTypePROMETHEUSRELOADED APIEventType = "PROMETHEUS_RELOADED"
+
+	// APIEventTypeTARGETDOWN captures enum value "TARGET_DOWN"
+	APIEventTypeTARGETDOWN APIEventType = "TARGET_DOWN"
+)
+
+// for schema
+var apiEventTypeEnum []interface{}
+
+func init() {
+	var res []APIEventType
+	if err := json.Unmarshal([]byte(`["UNKNOWN","NODE_ADDED","NODE_REMOVED","SERVICE_ADDED","SERVICE_REMOVED","AGENT_ADDED","AGENT_REMOVED","AGENT_STATE_CHANGED","SCRAPE_CONFIG_CREATED","SCRAPE_CONFIG_UPDATED","SCRAPE_CONFIG_DELETED","PROMETHEUS_RELOADED","TARGET_DOWN"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		apiEventTypeEnum = append(apiEventTypeEnum, v)
+	}
+}
+
+func (m APIEventType) validateAPIEventTypeEnum(path, location string, value APIEventType) error {
+	if err := validate.Enum(path, location, value, apiEventTypeEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates this api event type
+func (m APIEventType) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	// value enum
+	if err := m.validateAPIEventTypeEnum("", "body", m); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
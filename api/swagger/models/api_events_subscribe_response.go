@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/swag"
+)
+
+// APIEventsSubscribeResponse api events subscribe response
+// swagger:model apiEventsSubscribeResponse
+type APIEventsSubscribeResponse struct {
+
+	// event
+	Event *APIEvent `json:"event,omitempty"`
+}
+
+// Validate validates this api events subscribe response
+func (m *APIEventsSubscribeResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateEvent(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *APIEventsSubscribeResponse) validateEvent(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Event) { // not required
+		return nil
+	}
+
+	if m.Event != nil {
+		if err := m.Event.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("event")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *APIEventsSubscribeResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *APIEventsSubscribeResponse) UnmarshalBinary(b []byte) error {
+	var res APIEventsSubscribeResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
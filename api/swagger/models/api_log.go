@@ -15,8 +15,14 @@ import (
 // swagger:model apiLog
 type APILog struct {
 
+	// Collection error, if any
+	Error string `json:"error,omitempty"`
+
 	// Last lines of log file
 	Lines []string `json:"lines"`
+
+	// True if some lines were dropped due to timeout
+	Truncated bool `json:"truncated,omitempty"`
 }
 
 // Validate validates this api log
@@ -0,0 +1,98 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// APILogsBundleRequest api logs bundle request
+// swagger:model apiLogsBundleRequest
+type APILogsBundleRequest struct {
+
+	// Maximum number of last lines for each log; 1000 if not set
+	Lines int64 `json:"lines,omitempty"`
+
+	// Include only log lines after that time
+	// Format: date-time
+	Since strfmt.DateTime `json:"since,omitempty"`
+
+	// Names of sources to include (log file names, systemd unit names, Agent's supervisor names); all if empty
+	Sources []string `json:"sources"`
+
+	// Timeout for each source; 10s if not set
+	Timeout string `json:"timeout,omitempty"`
+
+	// Include only log lines before that time
+	// Format: date-time
+	Until strfmt.DateTime `json:"until,omitempty"`
+}
+
+// Validate validates this api logs bundle request
+func (m *APILogsBundleRequest) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateSince(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateUntil(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *APILogsBundleRequest) validateSince(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Since) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("since", "body", "date-time", m.Since.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *APILogsBundleRequest) validateUntil(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Until) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("until", "body", "date-time", m.Until.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *APILogsBundleRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *APILogsBundleRequest) UnmarshalBinary(b []byte) error {
+	var res APILogsBundleRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,100 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"strconv"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/swag"
+)
+
+// APILogsBundleResponse api logs bundle response
+// swagger:model apiLogsBundleResponse
+type APILogsBundleResponse struct {
+
+	// Collection summary for each source; set only in the last message
+	Sources []*APILogsBundleSource `json:"sources"`
+
+	// Next chunk of .zip archive with logs, summary.json and redaction_manifest.json;
+	// chunks of all messages should be concatenated
+	// Format: byte
+	Zip strfmt.Base64 `json:"zip,omitempty"`
+}
+
+// Validate validates this api logs bundle response
+func (m *APILogsBundleResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateSources(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateZip(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *APILogsBundleResponse) validateSources(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Sources) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Sources); i++ {
+		if swag.IsZero(m.Sources[i]) { // not required
+			continue
+		}
+
+		if m.Sources[i] != nil {
+			if err := m.Sources[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("sources" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *APILogsBundleResponse) validateZip(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Zip) { // not required
+		return nil
+	}
+
+	// Format "byte" (base64 string) is already validated when unmarshalled
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *APILogsBundleResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *APILogsBundleResponse) UnmarshalBinary(b []byte) error {
+	var res APILogsBundleResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,55 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/swag"
+)
+
+// APILogsBundleSource api logs bundle source
+// swagger:model apiLogsBundleSource
+type APILogsBundleSource struct {
+
+	// Time spent collecting source
+	Duration string `json:"duration,omitempty"`
+
+	// Collection error, if any
+	Error string `json:"error,omitempty"`
+
+	// Number of collected lines
+	Lines int64 `json:"lines,omitempty"`
+
+	// Source name
+	Name string `json:"name,omitempty"`
+
+	// True if some lines were dropped due to line limit or timeout
+	Truncated bool `json:"truncated,omitempty"`
+}
+
+// Validate validates this api logs bundle source
+func (m *APILogsBundleSource) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *APILogsBundleSource) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *APILogsBundleSource) UnmarshalBinary(b []byte) error {
+	var res APILogsBundleSource
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
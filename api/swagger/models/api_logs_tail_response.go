@@ -0,0 +1,43 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/swag"
+)
+
+// APILogsTailResponse api logs tail response
+// swagger:model apiLogsTailResponse
+type APILogsTailResponse struct {
+
+	// Next log lines
+	Lines []string `json:"lines"`
+}
+
+// Validate validates this api logs tail response
+func (m *APILogsTailResponse) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *APILogsTailResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *APILogsTailResponse) UnmarshalBinary(b []byte) error {
+	var res APILogsTailResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
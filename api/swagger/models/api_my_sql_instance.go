@@ -16,6 +16,9 @@ import (
 // swagger:model apiMySQLInstance
 type APIMySQLInstance struct {
 
+	// health
+	Health APIAgentsHealth `json:"health,omitempty"`
+
 	// node
 	Node *APIMySQLNode `json:"node,omitempty"`
 
@@ -27,6 +30,10 @@ type APIMySQLInstance struct {
 func (m *APIMySQLInstance) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateHealth(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateNode(formats); err != nil {
 		res = append(res, err)
 	}
@@ -41,6 +48,22 @@ func (m *APIMySQLInstance) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *APIMySQLInstance) validateHealth(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Health) { // not required
+		return nil
+	}
+
+	if err := m.Health.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("health")
+		}
+		return err
+	}
+
+	return nil
+}
+
 func (m *APIMySQLInstance) validateNode(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.Node) { // not required
@@ -16,6 +16,9 @@ import (
 // swagger:model apiPostgreSQLInstance
 type APIPostgreSQLInstance struct {
 
+	// health
+	Health APIAgentsHealth `json:"health,omitempty"`
+
 	// node
 	Node *APIPostgreSQLNode `json:"node,omitempty"`
 
@@ -27,6 +30,10 @@ type APIPostgreSQLInstance struct {
 func (m *APIPostgreSQLInstance) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateHealth(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateNode(formats); err != nil {
 		res = append(res, err)
 	}
@@ -41,6 +48,22 @@ func (m *APIPostgreSQLInstance) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *APIPostgreSQLInstance) validateHealth(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Health) { // not required
+		return nil
+	}
+
+	if err := m.Health.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("health")
+		}
+		return err
+	}
+
+	return nil
+}
+
 func (m *APIPostgreSQLInstance) validateNode(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.Node) { // not required
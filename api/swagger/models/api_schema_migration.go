@@ -0,0 +1,77 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// APISchemaMigration api schema migration
+// swagger:model apiSchemaMigration
+type APISchemaMigration struct {
+
+	// Empty for dirty migration.
+	// Format: date-time
+	AppliedAt strfmt.DateTime `json:"applied_at,omitempty"`
+
+	// checksum
+	Checksum string `json:"checksum,omitempty"`
+
+	// True if migration failed halfway.
+	Dirty bool `json:"dirty,omitempty"`
+
+	// version
+	Version int32 `json:"version,omitempty"`
+}
+
+// Validate validates this api schema migration
+func (m *APISchemaMigration) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAppliedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *APISchemaMigration) validateAppliedAt(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.AppliedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("applied_at", "body", "date-time", m.AppliedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *APISchemaMigration) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *APISchemaMigration) UnmarshalBinary(b []byte) error {
+	var res APISchemaMigration
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,55 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/swag"
+)
+
+// APITelemetryGetSettingsResponse api telemetry get settings response
+// swagger:model apiTelemetryGetSettingsResponse
+type APITelemetryGetSettingsResponse struct {
+
+	// True if telemetry is disabled by DISABLE_TELEMETRY environment variable; it can't be enabled then
+	DisabledByEnv bool `json:"disabled_by_env,omitempty"`
+
+	// True if telemetry is enabled
+	Enabled bool `json:"enabled,omitempty"`
+
+	// Interval between telemetry data sends
+	Interval string `json:"interval,omitempty"`
+
+	// Telemetry endpoint URL
+	URL string `json:"url,omitempty"`
+
+	// PMM Server UUID sent with telemetry data
+	UUID string `json:"uuid,omitempty"`
+}
+
+// Validate validates this api telemetry get settings response
+func (m *APITelemetryGetSettingsResponse) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *APITelemetryGetSettingsResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *APITelemetryGetSettingsResponse) UnmarshalBinary(b []byte) error {
+	var res APITelemetryGetSettingsResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,43 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/swag"
+)
+
+// APITelemetryPreviewResponse api telemetry preview response
+// swagger:model apiTelemetryPreviewResponse
+type APITelemetryPreviewResponse struct {
+
+	// Exact payload that is sent (or would be sent if telemetry is disabled)
+	Payload string `json:"payload,omitempty"`
+}
+
+// Validate validates this api telemetry preview response
+func (m *APITelemetryPreviewResponse) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *APITelemetryPreviewResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *APITelemetryPreviewResponse) UnmarshalBinary(b []byte) error {
+	var res APITelemetryPreviewResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,86 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// APIWebhookDeadLetter api webhook dead letter
+// swagger:model apiWebhookDeadLetter
+type APIWebhookDeadLetter struct {
+
+	// Number of delivery attempts
+	Attempts int64 `json:"attempts,omitempty"`
+
+	// Time when delivery failed for the last time
+	// Format: date-time
+	CreatedAt strfmt.DateTime `json:"created_at,omitempty"`
+
+	// Event type
+	Event string `json:"event,omitempty"`
+
+	// id
+	ID string `json:"id,omitempty"`
+
+	// Error of the last delivery attempt
+	LastError string `json:"last_error,omitempty"`
+
+	// JSON payload
+	Payload string `json:"payload,omitempty"`
+
+	// webhook id
+	WebhookID int32 `json:"webhook_id,omitempty"`
+}
+
+// Validate validates this api webhook dead letter
+func (m *APIWebhookDeadLetter) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateCreatedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *APIWebhookDeadLetter) validateCreatedAt(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.CreatedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("created_at", "body", "date-time", m.CreatedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *APIWebhookDeadLetter) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *APIWebhookDeadLetter) UnmarshalBinary(b []byte) error {
+	var res APIWebhookDeadLetter
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,49 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/swag"
+)
+
+// APIWebhooksCreateRequest api webhooks create request
+// swagger:model apiWebhooksCreateRequest
+type APIWebhooksCreateRequest struct {
+
+	// Event types to send; all if empty
+	Events []string `json:"events"`
+
+	// Secret for HMAC-SHA256 payload signatures in X-PMM-Signature header
+	Secret string `json:"secret,omitempty"`
+
+	// url
+	URL string `json:"url,omitempty"`
+}
+
+// Validate validates this api webhooks create request
+func (m *APIWebhooksCreateRequest) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *APIWebhooksCreateRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *APIWebhooksCreateRequest) UnmarshalBinary(b []byte) error {
+	var res APIWebhooksCreateRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/swag"
+)
+
+// APIWebhooksCreateResponse api webhooks create response
+// swagger:model apiWebhooksCreateResponse
+type APIWebhooksCreateResponse struct {
+
+	// webhook
+	Webhook *APIWebhook `json:"webhook,omitempty"`
+}
+
+// Validate validates this api webhooks create response
+func (m *APIWebhooksCreateResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateWebhook(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *APIWebhooksCreateResponse) validateWebhook(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Webhook) { // not required
+		return nil
+	}
+
+	if m.Webhook != nil {
+		if err := m.Webhook.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("webhook")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *APIWebhooksCreateResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *APIWebhooksCreateResponse) UnmarshalBinary(b []byte) error {
+	var res APIWebhooksCreateResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,80 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"strconv"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/swag"
+)
+
+// APIWebhooksListDeadLettersResponse api webhooks list dead letters response
+// swagger:model apiWebhooksListDeadLettersResponse
+type APIWebhooksListDeadLettersResponse struct {
+
+	// Dead letters from the newest to the oldest
+	DeadLetters []*APIWebhookDeadLetter `json:"dead_letters"`
+}
+
+// Validate validates this api webhooks list dead letters response
+func (m *APIWebhooksListDeadLettersResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateDeadLetters(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *APIWebhooksListDeadLettersResponse) validateDeadLetters(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.DeadLetters) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.DeadLetters); i++ {
+		if swag.IsZero(m.DeadLetters[i]) { // not required
+			continue
+		}
+
+		if m.DeadLetters[i] != nil {
+			if err := m.DeadLetters[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("dead_letters" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *APIWebhooksListDeadLettersResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *APIWebhooksListDeadLettersResponse) UnmarshalBinary(b []byte) error {
+	var res APIWebhooksListDeadLettersResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,80 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"strconv"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/swag"
+)
+
+// APIWebhooksListResponse api webhooks list response
+// swagger:model apiWebhooksListResponse
+type APIWebhooksListResponse struct {
+
+	// webhooks
+	Webhooks []*APIWebhook `json:"webhooks"`
+}
+
+// Validate validates this api webhooks list response
+func (m *APIWebhooksListResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateWebhooks(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *APIWebhooksListResponse) validateWebhooks(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Webhooks) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Webhooks); i++ {
+		if swag.IsZero(m.Webhooks[i]) { // not required
+			continue
+		}
+
+		if m.Webhooks[i] != nil {
+			if err := m.Webhooks[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("webhooks" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *APIWebhooksListResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *APIWebhooksListResponse) UnmarshalBinary(b []byte) error {
+	var res APIWebhooksListResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
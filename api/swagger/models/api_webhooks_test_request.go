@@ -0,0 +1,43 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/swag"
+)
+
+// APIWebhooksTestRequest api webhooks test request
+// swagger:model apiWebhooksTestRequest
+type APIWebhooksTestRequest struct {
+
+	// id
+	ID int32 `json:"id,omitempty"`
+}
+
+// Validate validates this api webhooks test request
+func (m *APIWebhooksTestRequest) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *APIWebhooksTestRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *APIWebhooksTestRequest) UnmarshalBinary(b []byte) error {
+	var res APIWebhooksTestRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
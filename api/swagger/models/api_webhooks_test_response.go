@@ -0,0 +1,46 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/swag"
+)
+
+// APIWebhooksTestResponse api webhooks test response
+// swagger:model apiWebhooksTestResponse
+type APIWebhooksTestResponse struct {
+
+	// Delivery error, if any
+	Error string `json:"error,omitempty"`
+
+	// HTTP status code returned by webhook
+	StatusCode int64 `json:"status_code,omitempty"`
+}
+
+// Validate validates this api webhooks test response
+func (m *APIWebhooksTestResponse) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *APIWebhooksTestResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *APIWebhooksTestResponse) UnmarshalBinary(b []byte) error {
+	var res APIWebhooksTestResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
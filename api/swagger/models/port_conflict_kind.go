@@ -0,0 +1,76 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/validate"
+)
+
+// PortConflictKind  - UNALLOCATED: Port is used by Agent, but not allocated.
+//   - UNUSED: Port is allocated, but not used by any Agent.
+//   - SHARED: Port is used by several Agents.
+//   - OUT_OF_RANGE: Allocated port is outside of configured range.
+//
+// swagger:model PortConflictKind
+type PortConflictKind string
+
+const (
+
+	// PortConflictKindUNKNOWN captures enum value "UNKNOWN"
+	PortConflictKindUNKNOWN PortConflictKind = "UNKNOWN"
+
+	// PortConflictKindUNALLOCATED captures enum value "UNALLOCATED"
+	PortConflictKindUNALLOCATED PortConflictKind = "UNALLOCATED"
+
+	// PortConflictKindUNUSED captures enum value "UNUSED"
+	PortConflictKindUNUSED PortConflictKind = "UNUSED"
+
+	// PortConflictKindSHARED captures enum value "SHARED"
+	PortConflictKindSHARED PortConflictKind = "SHARED"
+
+	// PortConflictKindOUTOFRANGE captures enum value "OUT_OF_RANGE"
+	PortConflictKindOUTOFRANGE PortConflictKind = "OUT_OF_RANGE"
+)
+
+// for schema
+var portConflictKindEnum []interface{}
+
+func init() {
+	var res []PortConflictKind
+	if err := json.Unmarshal([]byte(`["UNKNOWN","UNALLOCATED","UNUSED","SHARED","OUT_OF_RANGE"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		portConflictKindEnum = append(portConflictKindEnum, v)
+	}
+}
+
+func (m PortConflictKind) validatePortConflictKindEnum(path, location string, value PortConflictKind) error {
+	if err := validate.Enum(path, location, value, portConflictKindEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates this port conflict kind
+func (m PortConflictKind) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	// value enum
+	if err := m.validatePortConflictKindEnum("", "body", m); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,452 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: telemetry.proto
+
+package api
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import duration "github.com/golang/protobuf/ptypes/duration"
+import _ "google.golang.org/genproto/googleapis/api/annotations"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type TelemetryGetSettingsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TelemetryGetSettingsRequest) Reset()         { *m = TelemetryGetSettingsRequest{} }
+func (m *TelemetryGetSettingsRequest) String() string { return proto.CompactTextString(m) }
+func (*TelemetryGetSettingsRequest) ProtoMessage()    {}
+func (*TelemetryGetSettingsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_telemetry_f2c0080ab749033c, []int{0}
+}
+func (m *TelemetryGetSettingsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TelemetryGetSettingsRequest.Unmarshal(m, b)
+}
+func (m *TelemetryGetSettingsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TelemetryGetSettingsRequest.Marshal(b, m, deterministic)
+}
+func (dst *TelemetryGetSettingsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TelemetryGetSettingsRequest.Merge(dst, src)
+}
+func (m *TelemetryGetSettingsRequest) XXX_Size() int {
+	return xxx_messageInfo_TelemetryGetSettingsRequest.Size(m)
+}
+func (m *TelemetryGetSettingsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TelemetryGetSettingsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TelemetryGetSettingsRequest proto.InternalMessageInfo
+
+type TelemetryGetSettingsResponse struct {
+	// True if telemetry is enabled
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// True if telemetry is disabled by DISABLE_TELEMETRY environment variable; it can't be enabled then
+	DisabledByEnv bool `protobuf:"varint,2,opt,name=disabled_by_env,json=disabledByEnv,proto3" json:"disabled_by_env,omitempty"`
+	// PMM Server UUID sent with telemetry data
+	Uuid string `protobuf:"bytes,3,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	// Telemetry endpoint URL
+	Url string `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`
+	// Interval between telemetry data sends
+	Interval             *duration.Duration `protobuf:"bytes,5,opt,name=interval,proto3" json:"interval,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *TelemetryGetSettingsResponse) Reset()         { *m = TelemetryGetSettingsResponse{} }
+func (m *TelemetryGetSettingsResponse) String() string { return proto.CompactTextString(m) }
+func (*TelemetryGetSettingsResponse) ProtoMessage()    {}
+func (*TelemetryGetSettingsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_telemetry_f2c0080ab749033c, []int{1}
+}
+func (m *TelemetryGetSettingsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TelemetryGetSettingsResponse.Unmarshal(m, b)
+}
+func (m *TelemetryGetSettingsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TelemetryGetSettingsResponse.Marshal(b, m, deterministic)
+}
+func (dst *TelemetryGetSettingsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TelemetryGetSettingsResponse.Merge(dst, src)
+}
+func (m *TelemetryGetSettingsResponse) XXX_Size() int {
+	return xxx_messageInfo_TelemetryGetSettingsResponse.Size(m)
+}
+func (m *TelemetryGetSettingsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TelemetryGetSettingsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TelemetryGetSettingsResponse proto.InternalMessageInfo
+
+func (m *TelemetryGetSettingsResponse) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *TelemetryGetSettingsResponse) GetDisabledByEnv() bool {
+	if m != nil {
+		return m.DisabledByEnv
+	}
+	return false
+}
+
+func (m *TelemetryGetSettingsResponse) GetUuid() string {
+	if m != nil {
+		return m.Uuid
+	}
+	return ""
+}
+
+func (m *TelemetryGetSettingsResponse) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *TelemetryGetSettingsResponse) GetInterval() *duration.Duration {
+	if m != nil {
+		return m.Interval
+	}
+	return nil
+}
+
+type TelemetrySetEnabledRequest struct {
+	Enabled              bool     `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TelemetrySetEnabledRequest) Reset()         { *m = TelemetrySetEnabledRequest{} }
+func (m *TelemetrySetEnabledRequest) String() string { return proto.CompactTextString(m) }
+func (*TelemetrySetEnabledRequest) ProtoMessage()    {}
+func (*TelemetrySetEnabledRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_telemetry_f2c0080ab749033c, []int{2}
+}
+func (m *TelemetrySetEnabledRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TelemetrySetEnabledRequest.Unmarshal(m, b)
+}
+func (m *TelemetrySetEnabledRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TelemetrySetEnabledRequest.Marshal(b, m, deterministic)
+}
+func (dst *TelemetrySetEnabledRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TelemetrySetEnabledRequest.Merge(dst, src)
+}
+func (m *TelemetrySetEnabledRequest) XXX_Size() int {
+	return xxx_messageInfo_TelemetrySetEnabledRequest.Size(m)
+}
+func (m *TelemetrySetEnabledRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TelemetrySetEnabledRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TelemetrySetEnabledRequest proto.InternalMessageInfo
+
+func (m *TelemetrySetEnabledRequest) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+type TelemetrySetEnabledResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TelemetrySetEnabledResponse) Reset()         { *m = TelemetrySetEnabledResponse{} }
+func (m *TelemetrySetEnabledResponse) String() string { return proto.CompactTextString(m) }
+func (*TelemetrySetEnabledResponse) ProtoMessage()    {}
+func (*TelemetrySetEnabledResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_telemetry_f2c0080ab749033c, []int{3}
+}
+func (m *TelemetrySetEnabledResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TelemetrySetEnabledResponse.Unmarshal(m, b)
+}
+func (m *TelemetrySetEnabledResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TelemetrySetEnabledResponse.Marshal(b, m, deterministic)
+}
+func (dst *TelemetrySetEnabledResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TelemetrySetEnabledResponse.Merge(dst, src)
+}
+func (m *TelemetrySetEnabledResponse) XXX_Size() int {
+	return xxx_messageInfo_TelemetrySetEnabledResponse.Size(m)
+}
+func (m *TelemetrySetEnabledResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TelemetrySetEnabledResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TelemetrySetEnabledResponse proto.InternalMessageInfo
+
+type TelemetryPreviewRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TelemetryPreviewRequest) Reset()         { *m = TelemetryPreviewRequest{} }
+func (m *TelemetryPreviewRequest) String() string { return proto.CompactTextString(m) }
+func (*TelemetryPreviewRequest) ProtoMessage()    {}
+func (*TelemetryPreviewRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_telemetry_f2c0080ab749033c, []int{4}
+}
+func (m *TelemetryPreviewRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TelemetryPreviewRequest.Unmarshal(m, b)
+}
+func (m *TelemetryPreviewRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TelemetryPreviewRequest.Marshal(b, m, deterministic)
+}
+func (dst *TelemetryPreviewRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TelemetryPreviewRequest.Merge(dst, src)
+}
+func (m *TelemetryPreviewRequest) XXX_Size() int {
+	return xxx_messageInfo_TelemetryPreviewRequest.Size(m)
+}
+func (m *TelemetryPreviewRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TelemetryPreviewRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TelemetryPreviewRequest proto.InternalMessageInfo
+
+type TelemetryPreviewResponse struct {
+	// Exact payload that is sent (or would be sent if telemetry is disabled)
+	Payload              string   `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TelemetryPreviewResponse) Reset()         { *m = TelemetryPreviewResponse{} }
+func (m *TelemetryPreviewResponse) String() string { return proto.CompactTextString(m) }
+func (*TelemetryPreviewResponse) ProtoMessage()    {}
+func (*TelemetryPreviewResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_telemetry_f2c0080ab749033c, []int{5}
+}
+func (m *TelemetryPreviewResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TelemetryPreviewResponse.Unmarshal(m, b)
+}
+func (m *TelemetryPreviewResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TelemetryPreviewResponse.Marshal(b, m, deterministic)
+}
+func (dst *TelemetryPreviewResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TelemetryPreviewResponse.Merge(dst, src)
+}
+func (m *TelemetryPreviewResponse) XXX_Size() int {
+	return xxx_messageInfo_TelemetryPreviewResponse.Size(m)
+}
+func (m *TelemetryPreviewResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TelemetryPreviewResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TelemetryPreviewResponse proto.InternalMessageInfo
+
+func (m *TelemetryPreviewResponse) GetPayload() string {
+	if m != nil {
+		return m.Payload
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*TelemetryGetSettingsRequest)(nil), "api.TelemetryGetSettingsRequest")
+	proto.RegisterType((*TelemetryGetSettingsResponse)(nil), "api.TelemetryGetSettingsResponse")
+	proto.RegisterType((*TelemetrySetEnabledRequest)(nil), "api.TelemetrySetEnabledRequest")
+	proto.RegisterType((*TelemetrySetEnabledResponse)(nil), "api.TelemetrySetEnabledResponse")
+	proto.RegisterType((*TelemetryPreviewRequest)(nil), "api.TelemetryPreviewRequest")
+	proto.RegisterType((*TelemetryPreviewResponse)(nil), "api.TelemetryPreviewResponse")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// TelemetryClient is the client API for Telemetry service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type TelemetryClient interface {
+	// GetSettings returns current telemetry settings.
+	GetSettings(ctx context.Context, in *TelemetryGetSettingsRequest, opts ...grpc.CallOption) (*TelemetryGetSettingsResponse, error)
+	// SetEnabled enables or disables telemetry without pmm-managed restart.
+	SetEnabled(ctx context.Context, in *TelemetrySetEnabledRequest, opts ...grpc.CallOption) (*TelemetrySetEnabledResponse, error)
+	// Preview returns telemetry payload.
+	Preview(ctx context.Context, in *TelemetryPreviewRequest, opts ...grpc.CallOption) (*TelemetryPreviewResponse, error)
+}
+
+type telemetryClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewTelemetryClient(cc *grpc.ClientConn) TelemetryClient {
+	return &telemetryClient{cc}
+}
+
+func (c *telemetryClient) GetSettings(ctx context.Context, in *TelemetryGetSettingsRequest, opts ...grpc.CallOption) (*TelemetryGetSettingsResponse, error) {
+	out := new(TelemetryGetSettingsResponse)
+	err := c.cc.Invoke(ctx, "/api.Telemetry/GetSettings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *telemetryClient) SetEnabled(ctx context.Context, in *TelemetrySetEnabledRequest, opts ...grpc.CallOption) (*TelemetrySetEnabledResponse, error) {
+	out := new(TelemetrySetEnabledResponse)
+	err := c.cc.Invoke(ctx, "/api.Telemetry/SetEnabled", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *telemetryClient) Preview(ctx context.Context, in *TelemetryPreviewRequest, opts ...grpc.CallOption) (*TelemetryPreviewResponse, error) {
+	out := new(TelemetryPreviewResponse)
+	err := c.cc.Invoke(ctx, "/api.Telemetry/Preview", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TelemetryServer is the server API for Telemetry service.
+type TelemetryServer interface {
+	// GetSettings returns current telemetry settings.
+	GetSettings(context.Context, *TelemetryGetSettingsRequest) (*TelemetryGetSettingsResponse, error)
+	// SetEnabled enables or disables telemetry without pmm-managed restart.
+	SetEnabled(context.Context, *TelemetrySetEnabledRequest) (*TelemetrySetEnabledResponse, error)
+	// Preview returns telemetry payload.
+	Preview(context.Context, *TelemetryPreviewRequest) (*TelemetryPreviewResponse, error)
+}
+
+func RegisterTelemetryServer(s *grpc.Server, srv TelemetryServer) {
+	s.RegisterService(&_Telemetry_serviceDesc, srv)
+}
+
+func _Telemetry_GetSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TelemetryGetSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TelemetryServer).GetSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Telemetry/GetSettings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TelemetryServer).GetSettings(ctx, req.(*TelemetryGetSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Telemetry_SetEnabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TelemetrySetEnabledRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TelemetryServer).SetEnabled(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Telemetry/SetEnabled",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TelemetryServer).SetEnabled(ctx, req.(*TelemetrySetEnabledRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Telemetry_Preview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TelemetryPreviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TelemetryServer).Preview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Telemetry/Preview",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TelemetryServer).Preview(ctx, req.(*TelemetryPreviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Telemetry_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "api.Telemetry",
+	HandlerType: (*TelemetryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetSettings",
+			Handler:    _Telemetry_GetSettings_Handler,
+		},
+		{
+			MethodName: "SetEnabled",
+			Handler:    _Telemetry_SetEnabled_Handler,
+		},
+		{
+			MethodName: "Preview",
+			Handler:    _Telemetry_Preview_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "telemetry.proto",
+}
+
+func init() { proto.RegisterFile("telemetry.proto", fileDescriptor_telemetry_f2c0080ab749033c) }
+
+var fileDescriptor_telemetry_f2c0080ab749033c = []byte{
+	// 389 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x52, 0xcb, 0xae, 0xd3, 0x30,
+	0x10, 0x55, 0xda, 0x42, 0xdb, 0xa9, 0x50, 0x91, 0x25, 0xa8, 0x9b, 0x3e, 0x48, 0xb3, 0x40, 0x15,
+	0x8b, 0x04, 0x95, 0xc7, 0x82, 0x25, 0xa2, 0x62, 0x8b, 0x52, 0xf6, 0x95, 0xa3, 0x0c, 0x95, 0xa5,
+	0x60, 0x07, 0xc7, 0x09, 0xca, 0x96, 0x5f, 0xe0, 0x7b, 0xf8, 0x0a, 0x76, 0xac, 0xf9, 0x90, 0xab,
+	0xeb, 0x3c, 0xda, 0xa8, 0x37, 0xdd, 0xcd, 0xf8, 0x1c, 0xcf, 0x99, 0x73, 0x6c, 0x98, 0x6a, 0x8c,
+	0xf1, 0x3b, 0x6a, 0x55, 0x78, 0x89, 0x92, 0x5a, 0x92, 0x3e, 0x4b, 0xb8, 0xbd, 0x3c, 0x49, 0x79,
+	0x8a, 0xd1, 0x67, 0x09, 0xf7, 0x99, 0x10, 0x52, 0x33, 0xcd, 0xa5, 0x48, 0x4b, 0x8a, 0xbd, 0xae,
+	0x50, 0xd3, 0x85, 0xd9, 0x37, 0x3f, 0xca, 0x94, 0x21, 0x94, 0xb8, 0xbb, 0x82, 0xc5, 0xd7, 0x7a,
+	0xea, 0x67, 0xd4, 0x07, 0xd4, 0x9a, 0x8b, 0x53, 0x1a, 0xe0, 0x8f, 0x0c, 0x53, 0xed, 0xfe, 0xb1,
+	0x60, 0xf9, 0x30, 0x9e, 0x26, 0x52, 0xa4, 0x48, 0x28, 0x0c, 0x51, 0xb0, 0x30, 0xc6, 0x88, 0x5a,
+	0x8e, 0xb5, 0x1d, 0x05, 0x75, 0x4b, 0x5e, 0xc2, 0x34, 0xe2, 0xa9, 0xa9, 0x8f, 0x61, 0x71, 0x44,
+	0x91, 0xd3, 0x9e, 0x61, 0x3c, 0xa9, 0x8f, 0x3f, 0x16, 0x7b, 0x91, 0x13, 0x02, 0x83, 0x2c, 0xe3,
+	0x11, 0xed, 0x3b, 0xd6, 0x76, 0x1c, 0x98, 0x9a, 0x3c, 0x85, 0x7e, 0xa6, 0x62, 0x3a, 0x30, 0x47,
+	0xf7, 0x25, 0x79, 0x07, 0x23, 0x2e, 0x34, 0xaa, 0x9c, 0xc5, 0xf4, 0x91, 0x63, 0x6d, 0x27, 0xbb,
+	0xb9, 0x57, 0x5a, 0xf3, 0x6a, 0x6b, 0xde, 0xa7, 0xca, 0x5a, 0xd0, 0x50, 0xdd, 0xf7, 0x60, 0x37,
+	0xeb, 0x1f, 0x50, 0xef, 0xcb, 0xdd, 0x2a, 0x77, 0xdd, 0xcb, 0xb7, 0x62, 0xb9, 0xbc, 0x57, 0xba,
+	0x76, 0xe7, 0x30, 0x6b, 0xe0, 0x2f, 0x0a, 0x73, 0x8e, 0x3f, 0xeb, 0xc4, 0xde, 0x02, 0xbd, 0x86,
+	0xce, 0x61, 0x25, 0xac, 0x88, 0x25, 0x2b, 0xf5, 0xc6, 0x41, 0xdd, 0xee, 0xfe, 0xf5, 0x60, 0xdc,
+	0x5c, 0x23, 0x0a, 0x26, 0x17, 0x59, 0x13, 0xc7, 0x63, 0x09, 0xf7, 0x6e, 0x3c, 0x93, 0xbd, 0xb9,
+	0xc1, 0xa8, 0x56, 0x5e, 0xff, 0xfa, 0xfb, 0xff, 0x77, 0x8f, 0x92, 0xe7, 0x7e, 0xfe, 0xda, 0x6f,
+	0x3e, 0x92, 0x9f, 0xd6, 0x22, 0x0a, 0xe0, 0x6c, 0x94, 0xbc, 0x68, 0x0f, 0xbc, 0x8a, 0xce, 0x76,
+	0xba, 0x09, 0x95, 0xe0, 0xc6, 0x08, 0x2e, 0xdc, 0x0e, 0xc1, 0x0f, 0xd6, 0x2b, 0x82, 0x30, 0xac,
+	0x22, 0x22, 0xcb, 0xf6, 0xbc, 0x76, 0xa8, 0xf6, 0xaa, 0x03, 0xad, 0xa4, 0x56, 0x46, 0x6a, 0x46,
+	0x9e, 0xb5, 0xa5, 0x92, 0x92, 0x16, 0x3e, 0x36, 0x3f, 0xe4, 0xcd, 0xdd, 0x00, 0xa4, 0x2a, 0xee,
+	0x90, 0x40, 0x03, 0x00, 0x00,
+}
@@ -0,0 +1,851 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: webhooks.proto
+
+package api
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import timestamp "github.com/golang/protobuf/ptypes/timestamp"
+import _ "google.golang.org/genproto/googleapis/api/annotations"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type Webhook struct {
+	Id int32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// URL receiving HTTP POST requests with JSON payloads
+	Url string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// Event types sent to that webhook: instance_added, instance_removed, target_down; all if empty
+	Events               []string             `protobuf:"bytes,3,rep,name=events,proto3" json:"events,omitempty"`
+	CreatedAt            *timestamp.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *Webhook) Reset()         { *m = Webhook{} }
+func (m *Webhook) String() string { return proto.CompactTextString(m) }
+func (*Webhook) ProtoMessage()    {}
+func (*Webhook) Descriptor() ([]byte, []int) {
+	return fileDescriptor_webhooks_ec77a25ac041f549, []int{0}
+}
+func (m *Webhook) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Webhook.Unmarshal(m, b)
+}
+func (m *Webhook) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Webhook.Marshal(b, m, deterministic)
+}
+func (dst *Webhook) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Webhook.Merge(dst, src)
+}
+func (m *Webhook) XXX_Size() int {
+	return xxx_messageInfo_Webhook.Size(m)
+}
+func (m *Webhook) XXX_DiscardUnknown() {
+	xxx_messageInfo_Webhook.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Webhook proto.InternalMessageInfo
+
+func (m *Webhook) GetId() int32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Webhook) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *Webhook) GetEvents() []string {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+func (m *Webhook) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+type WebhookDeadLetter struct {
+	Id        int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	WebhookId int32 `protobuf:"varint,2,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"`
+	// Time when delivery failed for the last time
+	CreatedAt *timestamp.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Event type
+	Event string `protobuf:"bytes,4,opt,name=event,proto3" json:"event,omitempty"`
+	// JSON payload
+	Payload string `protobuf:"bytes,5,opt,name=payload,proto3" json:"payload,omitempty"`
+	// Number of delivery attempts
+	Attempts uint32 `protobuf:"varint,6,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	// Error of the last delivery attempt
+	LastError            string   `protobuf:"bytes,7,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WebhookDeadLetter) Reset()         { *m = WebhookDeadLetter{} }
+func (m *WebhookDeadLetter) String() string { return proto.CompactTextString(m) }
+func (*WebhookDeadLetter) ProtoMessage()    {}
+func (*WebhookDeadLetter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_webhooks_ec77a25ac041f549, []int{1}
+}
+func (m *WebhookDeadLetter) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WebhookDeadLetter.Unmarshal(m, b)
+}
+func (m *WebhookDeadLetter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WebhookDeadLetter.Marshal(b, m, deterministic)
+}
+func (dst *WebhookDeadLetter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WebhookDeadLetter.Merge(dst, src)
+}
+func (m *WebhookDeadLetter) XXX_Size() int {
+	return xxx_messageInfo_WebhookDeadLetter.Size(m)
+}
+func (m *WebhookDeadLetter) XXX_DiscardUnknown() {
+	xxx_messageInfo_WebhookDeadLetter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WebhookDeadLetter proto.InternalMessageInfo
+
+func (m *WebhookDeadLetter) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *WebhookDeadLetter) GetWebhookId() int32 {
+	if m != nil {
+		return m.WebhookId
+	}
+	return 0
+}
+
+func (m *WebhookDeadLetter) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+func (m *WebhookDeadLetter) GetEvent() string {
+	if m != nil {
+		return m.Event
+	}
+	return ""
+}
+
+func (m *WebhookDeadLetter) GetPayload() string {
+	if m != nil {
+		return m.Payload
+	}
+	return ""
+}
+
+func (m *WebhookDeadLetter) GetAttempts() uint32 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
+func (m *WebhookDeadLetter) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
+type WebhooksCreateRequest struct {
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// Secret for HMAC-SHA256 payload signatures in X-PMM-Signature header
+	Secret string `protobuf:"bytes,2,opt,name=secret,proto3" json:"secret,omitempty"`
+	// Event types to send; all if empty
+	Events               []string `protobuf:"bytes,3,rep,name=events,proto3" json:"events,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WebhooksCreateRequest) Reset()         { *m = WebhooksCreateRequest{} }
+func (m *WebhooksCreateRequest) String() string { return proto.CompactTextString(m) }
+func (*WebhooksCreateRequest) ProtoMessage()    {}
+func (*WebhooksCreateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_webhooks_ec77a25ac041f549, []int{2}
+}
+func (m *WebhooksCreateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WebhooksCreateRequest.Unmarshal(m, b)
+}
+func (m *WebhooksCreateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WebhooksCreateRequest.Marshal(b, m, deterministic)
+}
+func (dst *WebhooksCreateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WebhooksCreateRequest.Merge(dst, src)
+}
+func (m *WebhooksCreateRequest) XXX_Size() int {
+	return xxx_messageInfo_WebhooksCreateRequest.Size(m)
+}
+func (m *WebhooksCreateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WebhooksCreateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WebhooksCreateRequest proto.InternalMessageInfo
+
+func (m *WebhooksCreateRequest) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *WebhooksCreateRequest) GetSecret() string {
+	if m != nil {
+		return m.Secret
+	}
+	return ""
+}
+
+func (m *WebhooksCreateRequest) GetEvents() []string {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+type WebhooksCreateResponse struct {
+	Webhook              *Webhook `protobuf:"bytes,1,opt,name=webhook,proto3" json:"webhook,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WebhooksCreateResponse) Reset()         { *m = WebhooksCreateResponse{} }
+func (m *WebhooksCreateResponse) String() string { return proto.CompactTextString(m) }
+func (*WebhooksCreateResponse) ProtoMessage()    {}
+func (*WebhooksCreateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_webhooks_ec77a25ac041f549, []int{3}
+}
+func (m *WebhooksCreateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WebhooksCreateResponse.Unmarshal(m, b)
+}
+func (m *WebhooksCreateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WebhooksCreateResponse.Marshal(b, m, deterministic)
+}
+func (dst *WebhooksCreateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WebhooksCreateResponse.Merge(dst, src)
+}
+func (m *WebhooksCreateResponse) XXX_Size() int {
+	return xxx_messageInfo_WebhooksCreateResponse.Size(m)
+}
+func (m *WebhooksCreateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WebhooksCreateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WebhooksCreateResponse proto.InternalMessageInfo
+
+func (m *WebhooksCreateResponse) GetWebhook() *Webhook {
+	if m != nil {
+		return m.Webhook
+	}
+	return nil
+}
+
+type WebhooksListRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WebhooksListRequest) Reset()         { *m = WebhooksListRequest{} }
+func (m *WebhooksListRequest) String() string { return proto.CompactTextString(m) }
+func (*WebhooksListRequest) ProtoMessage()    {}
+func (*WebhooksListRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_webhooks_ec77a25ac041f549, []int{4}
+}
+func (m *WebhooksListRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WebhooksListRequest.Unmarshal(m, b)
+}
+func (m *WebhooksListRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WebhooksListRequest.Marshal(b, m, deterministic)
+}
+func (dst *WebhooksListRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WebhooksListRequest.Merge(dst, src)
+}
+func (m *WebhooksListRequest) XXX_Size() int {
+	return xxx_messageInfo_WebhooksListRequest.Size(m)
+}
+func (m *WebhooksListRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WebhooksListRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WebhooksListRequest proto.InternalMessageInfo
+
+type WebhooksListResponse struct {
+	Webhooks             []*Webhook `protobuf:"bytes,1,rep,name=webhooks,proto3" json:"webhooks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *WebhooksListResponse) Reset()         { *m = WebhooksListResponse{} }
+func (m *WebhooksListResponse) String() string { return proto.CompactTextString(m) }
+func (*WebhooksListResponse) ProtoMessage()    {}
+func (*WebhooksListResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_webhooks_ec77a25ac041f549, []int{5}
+}
+func (m *WebhooksListResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WebhooksListResponse.Unmarshal(m, b)
+}
+func (m *WebhooksListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WebhooksListResponse.Marshal(b, m, deterministic)
+}
+func (dst *WebhooksListResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WebhooksListResponse.Merge(dst, src)
+}
+func (m *WebhooksListResponse) XXX_Size() int {
+	return xxx_messageInfo_WebhooksListResponse.Size(m)
+}
+func (m *WebhooksListResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WebhooksListResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WebhooksListResponse proto.InternalMessageInfo
+
+func (m *WebhooksListResponse) GetWebhooks() []*Webhook {
+	if m != nil {
+		return m.Webhooks
+	}
+	return nil
+}
+
+type WebhooksDeleteRequest struct {
+	Id                   int32    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WebhooksDeleteRequest) Reset()         { *m = WebhooksDeleteRequest{} }
+func (m *WebhooksDeleteRequest) String() string { return proto.CompactTextString(m) }
+func (*WebhooksDeleteRequest) ProtoMessage()    {}
+func (*WebhooksDeleteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_webhooks_ec77a25ac041f549, []int{6}
+}
+func (m *WebhooksDeleteRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WebhooksDeleteRequest.Unmarshal(m, b)
+}
+func (m *WebhooksDeleteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WebhooksDeleteRequest.Marshal(b, m, deterministic)
+}
+func (dst *WebhooksDeleteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WebhooksDeleteRequest.Merge(dst, src)
+}
+func (m *WebhooksDeleteRequest) XXX_Size() int {
+	return xxx_messageInfo_WebhooksDeleteRequest.Size(m)
+}
+func (m *WebhooksDeleteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WebhooksDeleteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WebhooksDeleteRequest proto.InternalMessageInfo
+
+func (m *WebhooksDeleteRequest) GetId() int32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type WebhooksDeleteResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WebhooksDeleteResponse) Reset()         { *m = WebhooksDeleteResponse{} }
+func (m *WebhooksDeleteResponse) String() string { return proto.CompactTextString(m) }
+func (*WebhooksDeleteResponse) ProtoMessage()    {}
+func (*WebhooksDeleteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_webhooks_ec77a25ac041f549, []int{7}
+}
+func (m *WebhooksDeleteResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WebhooksDeleteResponse.Unmarshal(m, b)
+}
+func (m *WebhooksDeleteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WebhooksDeleteResponse.Marshal(b, m, deterministic)
+}
+func (dst *WebhooksDeleteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WebhooksDeleteResponse.Merge(dst, src)
+}
+func (m *WebhooksDeleteResponse) XXX_Size() int {
+	return xxx_messageInfo_WebhooksDeleteResponse.Size(m)
+}
+func (m *WebhooksDeleteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WebhooksDeleteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WebhooksDeleteResponse proto.InternalMessageInfo
+
+type WebhooksTestRequest struct {
+	Id                   int32    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WebhooksTestRequest) Reset()         { *m = WebhooksTestRequest{} }
+func (m *WebhooksTestRequest) String() string { return proto.CompactTextString(m) }
+func (*WebhooksTestRequest) ProtoMessage()    {}
+func (*WebhooksTestRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_webhooks_ec77a25ac041f549, []int{8}
+}
+func (m *WebhooksTestRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WebhooksTestRequest.Unmarshal(m, b)
+}
+func (m *WebhooksTestRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WebhooksTestRequest.Marshal(b, m, deterministic)
+}
+func (dst *WebhooksTestRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WebhooksTestRequest.Merge(dst, src)
+}
+func (m *WebhooksTestRequest) XXX_Size() int {
+	return xxx_messageInfo_WebhooksTestRequest.Size(m)
+}
+func (m *WebhooksTestRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WebhooksTestRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WebhooksTestRequest proto.InternalMessageInfo
+
+func (m *WebhooksTestRequest) GetId() int32 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type WebhooksTestResponse struct {
+	// HTTP status code returned by webhook
+	StatusCode uint32 `protobuf:"varint,1,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	// Delivery error, if any
+	Error                string   `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WebhooksTestResponse) Reset()         { *m = WebhooksTestResponse{} }
+func (m *WebhooksTestResponse) String() string { return proto.CompactTextString(m) }
+func (*WebhooksTestResponse) ProtoMessage()    {}
+func (*WebhooksTestResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_webhooks_ec77a25ac041f549, []int{9}
+}
+func (m *WebhooksTestResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WebhooksTestResponse.Unmarshal(m, b)
+}
+func (m *WebhooksTestResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WebhooksTestResponse.Marshal(b, m, deterministic)
+}
+func (dst *WebhooksTestResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WebhooksTestResponse.Merge(dst, src)
+}
+func (m *WebhooksTestResponse) XXX_Size() int {
+	return xxx_messageInfo_WebhooksTestResponse.Size(m)
+}
+func (m *WebhooksTestResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WebhooksTestResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WebhooksTestResponse proto.InternalMessageInfo
+
+func (m *WebhooksTestResponse) GetStatusCode() uint32 {
+	if m != nil {
+		return m.StatusCode
+	}
+	return 0
+}
+
+func (m *WebhooksTestResponse) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type WebhooksListDeadLettersRequest struct {
+	// Return only dead letters of that webhook; all if not set
+	WebhookId            int32    `protobuf:"varint,1,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WebhooksListDeadLettersRequest) Reset()         { *m = WebhooksListDeadLettersRequest{} }
+func (m *WebhooksListDeadLettersRequest) String() string { return proto.CompactTextString(m) }
+func (*WebhooksListDeadLettersRequest) ProtoMessage()    {}
+func (*WebhooksListDeadLettersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_webhooks_ec77a25ac041f549, []int{10}
+}
+func (m *WebhooksListDeadLettersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WebhooksListDeadLettersRequest.Unmarshal(m, b)
+}
+func (m *WebhooksListDeadLettersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WebhooksListDeadLettersRequest.Marshal(b, m, deterministic)
+}
+func (dst *WebhooksListDeadLettersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WebhooksListDeadLettersRequest.Merge(dst, src)
+}
+func (m *WebhooksListDeadLettersRequest) XXX_Size() int {
+	return xxx_messageInfo_WebhooksListDeadLettersRequest.Size(m)
+}
+func (m *WebhooksListDeadLettersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WebhooksListDeadLettersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WebhooksListDeadLettersRequest proto.InternalMessageInfo
+
+func (m *WebhooksListDeadLettersRequest) GetWebhookId() int32 {
+	if m != nil {
+		return m.WebhookId
+	}
+	return 0
+}
+
+type WebhooksListDeadLettersResponse struct {
+	// Dead letters from the newest to the oldest
+	DeadLetters          []*WebhookDeadLetter `protobuf:"bytes,1,rep,name=dead_letters,json=deadLetters,proto3" json:"dead_letters,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *WebhooksListDeadLettersResponse) Reset()         { *m = WebhooksListDeadLettersResponse{} }
+func (m *WebhooksListDeadLettersResponse) String() string { return proto.CompactTextString(m) }
+func (*WebhooksListDeadLettersResponse) ProtoMessage()    {}
+func (*WebhooksListDeadLettersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_webhooks_ec77a25ac041f549, []int{11}
+}
+func (m *WebhooksListDeadLettersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WebhooksListDeadLettersResponse.Unmarshal(m, b)
+}
+func (m *WebhooksListDeadLettersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WebhooksListDeadLettersResponse.Marshal(b, m, deterministic)
+}
+func (dst *WebhooksListDeadLettersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WebhooksListDeadLettersResponse.Merge(dst, src)
+}
+func (m *WebhooksListDeadLettersResponse) XXX_Size() int {
+	return xxx_messageInfo_WebhooksListDeadLettersResponse.Size(m)
+}
+func (m *WebhooksListDeadLettersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WebhooksListDeadLettersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WebhooksListDeadLettersResponse proto.InternalMessageInfo
+
+func (m *WebhooksListDeadLettersResponse) GetDeadLetters() []*WebhookDeadLetter {
+	if m != nil {
+		return m.DeadLetters
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Webhook)(nil), "api.Webhook")
+	proto.RegisterType((*WebhookDeadLetter)(nil), "api.WebhookDeadLetter")
+	proto.RegisterType((*WebhooksCreateRequest)(nil), "api.WebhooksCreateRequest")
+	proto.RegisterType((*WebhooksCreateResponse)(nil), "api.WebhooksCreateResponse")
+	proto.RegisterType((*WebhooksListRequest)(nil), "api.WebhooksListRequest")
+	proto.RegisterType((*WebhooksListResponse)(nil), "api.WebhooksListResponse")
+	proto.RegisterType((*WebhooksDeleteRequest)(nil), "api.WebhooksDeleteRequest")
+	proto.RegisterType((*WebhooksDeleteResponse)(nil), "api.WebhooksDeleteResponse")
+	proto.RegisterType((*WebhooksTestRequest)(nil), "api.WebhooksTestRequest")
+	proto.RegisterType((*WebhooksTestResponse)(nil), "api.WebhooksTestResponse")
+	proto.RegisterType((*WebhooksListDeadLettersRequest)(nil), "api.WebhooksListDeadLettersRequest")
+	proto.RegisterType((*WebhooksListDeadLettersResponse)(nil), "api.WebhooksListDeadLettersResponse")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// WebhooksClient is the client API for Webhooks service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type WebhooksClient interface {
+	// Create registers a new webhook.
+	Create(ctx context.Context, in *WebhooksCreateRequest, opts ...grpc.CallOption) (*WebhooksCreateResponse, error)
+	// List returns all webhooks.
+	List(ctx context.Context, in *WebhooksListRequest, opts ...grpc.CallOption) (*WebhooksListResponse, error)
+	// Delete removes webhook and its dead letters.
+	Delete(ctx context.Context, in *WebhooksDeleteRequest, opts ...grpc.CallOption) (*WebhooksDeleteResponse, error)
+	// Test synchronously sends a single test event to webhook, without retries.
+	Test(ctx context.Context, in *WebhooksTestRequest, opts ...grpc.CallOption) (*WebhooksTestResponse, error)
+	// ListDeadLetters returns events which were not delivered after all retries.
+	ListDeadLetters(ctx context.Context, in *WebhooksListDeadLettersRequest, opts ...grpc.CallOption) (*WebhooksListDeadLettersResponse, error)
+}
+
+type webhooksClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewWebhooksClient(cc *grpc.ClientConn) WebhooksClient {
+	return &webhooksClient{cc}
+}
+
+func (c *webhooksClient) Create(ctx context.Context, in *WebhooksCreateRequest, opts ...grpc.CallOption) (*WebhooksCreateResponse, error) {
+	out := new(WebhooksCreateResponse)
+	err := c.cc.Invoke(ctx, "/api.Webhooks/Create", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webhooksClient) List(ctx context.Context, in *WebhooksListRequest, opts ...grpc.CallOption) (*WebhooksListResponse, error) {
+	out := new(WebhooksListResponse)
+	err := c.cc.Invoke(ctx, "/api.Webhooks/List", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webhooksClient) Delete(ctx context.Context, in *WebhooksDeleteRequest, opts ...grpc.CallOption) (*WebhooksDeleteResponse, error) {
+	out := new(WebhooksDeleteResponse)
+	err := c.cc.Invoke(ctx, "/api.Webhooks/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webhooksClient) Test(ctx context.Context, in *WebhooksTestRequest, opts ...grpc.CallOption) (*WebhooksTestResponse, error) {
+	out := new(WebhooksTestResponse)
+	err := c.cc.Invoke(ctx, "/api.Webhooks/Test", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webhooksClient) ListDeadLetters(ctx context.Context, in *WebhooksListDeadLettersRequest, opts ...grpc.CallOption) (*WebhooksListDeadLettersResponse, error) {
+	out := new(WebhooksListDeadLettersResponse)
+	err := c.cc.Invoke(ctx, "/api.Webhooks/ListDeadLetters", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WebhooksServer is the server API for Webhooks service.
+type WebhooksServer interface {
+	// Create registers a new webhook.
+	Create(context.Context, *WebhooksCreateRequest) (*WebhooksCreateResponse, error)
+	// List returns all webhooks.
+	List(context.Context, *WebhooksListRequest) (*WebhooksListResponse, error)
+	// Delete removes webhook and its dead letters.
+	Delete(context.Context, *WebhooksDeleteRequest) (*WebhooksDeleteResponse, error)
+	// Test synchronously sends a single test event to webhook, without retries.
+	Test(context.Context, *WebhooksTestRequest) (*WebhooksTestResponse, error)
+	// ListDeadLetters returns events which were not delivered after all retries.
+	ListDeadLetters(context.Context, *WebhooksListDeadLettersRequest) (*WebhooksListDeadLettersResponse, error)
+}
+
+func RegisterWebhooksServer(s *grpc.Server, srv WebhooksServer) {
+	s.RegisterService(&_Webhooks_serviceDesc, srv)
+}
+
+func _Webhooks_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebhooksCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebhooksServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Webhooks/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebhooksServer).Create(ctx, req.(*WebhooksCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Webhooks_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebhooksListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebhooksServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Webhooks/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebhooksServer).List(ctx, req.(*WebhooksListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Webhooks_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebhooksDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebhooksServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Webhooks/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebhooksServer).Delete(ctx, req.(*WebhooksDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Webhooks_Test_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebhooksTestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebhooksServer).Test(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Webhooks/Test",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebhooksServer).Test(ctx, req.(*WebhooksTestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Webhooks_ListDeadLetters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebhooksListDeadLettersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebhooksServer).ListDeadLetters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.Webhooks/ListDeadLetters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebhooksServer).ListDeadLetters(ctx, req.(*WebhooksListDeadLettersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Webhooks_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "api.Webhooks",
+	HandlerType: (*WebhooksServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _Webhooks_Create_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _Webhooks_List_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _Webhooks_Delete_Handler,
+		},
+		{
+			MethodName: "Test",
+			Handler:    _Webhooks_Test_Handler,
+		},
+		{
+			MethodName: "ListDeadLetters",
+			Handler:    _Webhooks_ListDeadLetters_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "webhooks.proto",
+}
+
+func init() { proto.RegisterFile("webhooks.proto", fileDescriptor_webhooks_ec77a25ac041f549) }
+
+var fileDescriptor_webhooks_ec77a25ac041f549 = []byte{
+	// 644 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcd, 0x6e, 0xd3, 0x4c,
+	0x14, 0x95, 0xe3, 0x26, 0x69, 0x6e, 0xda, 0x7e, 0x5f, 0xa7, 0x69, 0x70, 0x5d, 0xa0, 0x61, 0xf8,
+	0x8b, 0x2a, 0x61, 0xa3, 0xb2, 0x2a, 0x1b, 0x8a, 0x5a, 0x16, 0x48, 0x65, 0x81, 0x15, 0x09, 0x81,
+	0x2a, 0xa2, 0x69, 0xe7, 0x52, 0x2c, 0xdc, 0x8c, 0xf1, 0x4c, 0x8a, 0x10, 0x02, 0x24, 0x5e, 0x81,
+	0x47, 0xe3, 0x15, 0x58, 0xf0, 0x08, 0x2c, 0x91, 0xc7, 0xe3, 0xfa, 0x27, 0x0d, 0x12, 0x3b, 0xcf,
+	0xfd, 0x39, 0xf7, 0xdc, 0x73, 0x8f, 0x0c, 0x2b, 0x1f, 0xf0, 0xf8, 0xad, 0x10, 0xef, 0xa4, 0x17,
+	0x27, 0x42, 0x09, 0x62, 0xb3, 0x38, 0x74, 0xaf, 0x9e, 0x0a, 0x71, 0x1a, 0xa1, 0xcf, 0xe2, 0xd0,
+	0x67, 0x93, 0x89, 0x50, 0x4c, 0x85, 0x62, 0x62, 0x4a, 0xdc, 0x2d, 0x93, 0xd5, 0xaf, 0xe3, 0xe9,
+	0x1b, 0x5f, 0x85, 0x67, 0x28, 0x15, 0x3b, 0x8b, 0xb3, 0x02, 0xfa, 0x05, 0xda, 0x2f, 0x32, 0x54,
+	0xb2, 0x02, 0x8d, 0x90, 0x3b, 0xd6, 0xc0, 0x1a, 0x36, 0x83, 0x46, 0xc8, 0xc9, 0xff, 0x60, 0x4f,
+	0x93, 0xc8, 0x69, 0x0c, 0xac, 0x61, 0x27, 0x48, 0x3f, 0x49, 0x1f, 0x5a, 0x78, 0x8e, 0x13, 0x25,
+	0x1d, 0x7b, 0x60, 0x0f, 0x3b, 0x81, 0x79, 0x91, 0x5d, 0x80, 0x93, 0x04, 0x99, 0x42, 0x3e, 0x66,
+	0xca, 0x59, 0x18, 0x58, 0xc3, 0xee, 0x8e, 0xeb, 0x65, 0xa3, 0xbd, 0x7c, 0xb4, 0x37, 0xca, 0x47,
+	0x07, 0x1d, 0x53, 0xfd, 0x58, 0xd1, 0x5f, 0x16, 0xac, 0x1a, 0x02, 0x07, 0xc8, 0xf8, 0x21, 0x2a,
+	0x85, 0x49, 0x89, 0x8a, 0xad, 0xa9, 0x5c, 0x03, 0x30, 0xbb, 0x8f, 0x43, 0xae, 0x19, 0x35, 0x83,
+	0x8e, 0x89, 0x3c, 0xe5, 0xb5, 0xf9, 0xf6, 0x3f, 0xcc, 0x27, 0x3d, 0x68, 0xea, 0x25, 0x34, 0xeb,
+	0x4e, 0x90, 0x3d, 0x88, 0x03, 0xed, 0x98, 0x7d, 0x8c, 0x04, 0xe3, 0x4e, 0x53, 0xc7, 0xf3, 0x27,
+	0x71, 0x61, 0x91, 0x29, 0x85, 0x67, 0xb1, 0x92, 0x4e, 0x6b, 0x60, 0x0d, 0x97, 0x83, 0x8b, 0x77,
+	0xca, 0x32, 0x62, 0x52, 0x8d, 0x31, 0x49, 0x44, 0xe2, 0xb4, 0x75, 0x63, 0x27, 0x8d, 0x3c, 0x49,
+	0x03, 0xf4, 0x25, 0xac, 0x9b, 0x4d, 0xe5, 0xbe, 0x9e, 0x1f, 0xe0, 0xfb, 0x29, 0x4a, 0x95, 0x0b,
+	0x6d, 0x55, 0x84, 0x96, 0x78, 0x92, 0xa0, 0x32, 0xea, 0x9b, 0xd7, 0xbc, 0x03, 0xd0, 0x3d, 0xe8,
+	0xd7, 0xa1, 0x65, 0x2c, 0x26, 0x12, 0xc9, 0x1d, 0x68, 0x1b, 0x9d, 0x34, 0x7e, 0x77, 0x67, 0xc9,
+	0x63, 0x71, 0xe8, 0x99, 0xea, 0x20, 0x4f, 0xd2, 0x75, 0x58, 0xcb, 0x11, 0x0e, 0x43, 0xa9, 0x0c,
+	0x35, 0xba, 0x07, 0xbd, 0x6a, 0xd8, 0xc0, 0x0e, 0x61, 0xd1, 0x74, 0x4a, 0xc7, 0x1a, 0xd8, 0x33,
+	0xb8, 0x17, 0x59, 0x7a, 0xb7, 0xd8, 0xfa, 0x00, 0x23, 0x2c, 0xb6, 0xae, 0xd9, 0x8d, 0x3a, 0xd0,
+	0xaf, 0x17, 0x66, 0xc3, 0xe8, 0xed, 0x82, 0xdb, 0x08, 0xa5, 0x9a, 0x07, 0xf0, 0x0c, 0x7a, 0xd5,
+	0x32, 0xc3, 0x75, 0x0b, 0xba, 0x52, 0x31, 0x35, 0x95, 0xe3, 0x13, 0xc1, 0x51, 0x37, 0x2c, 0x07,
+	0x90, 0x85, 0xf6, 0x05, 0x47, 0xed, 0x01, 0x7d, 0xb2, 0x86, 0xf1, 0x80, 0x3e, 0xd7, 0x23, 0xb8,
+	0x5e, 0x5e, 0xbd, 0x70, 0xa7, 0xcc, 0x09, 0x54, 0x5d, 0x69, 0xd5, 0x5c, 0x49, 0x8f, 0x60, 0x6b,
+	0x2e, 0x80, 0xa1, 0xb6, 0x0b, 0x4b, 0x1c, 0x19, 0x1f, 0x47, 0x59, 0xdc, 0x48, 0xd9, 0x2f, 0x4b,
+	0x59, 0xb4, 0x05, 0x5d, 0x5e, 0x40, 0xec, 0xfc, 0xb6, 0x61, 0x31, 0x87, 0x27, 0xaf, 0xa0, 0x95,
+	0xdd, 0x9d, 0xb8, 0xe5, 0xde, 0xaa, 0xcf, 0xdc, 0xcd, 0x4b, 0x73, 0x46, 0xe4, 0x2b, 0xdf, 0x7e,
+	0xfc, 0xfc, 0xde, 0x58, 0xa5, 0x4b, 0xfe, 0xf9, 0x7d, 0x3f, 0xbf, 0xde, 0x43, 0x6b, 0x9b, 0x3c,
+	0x87, 0x85, 0x94, 0x3e, 0x71, 0x2a, 0xdd, 0x25, 0x93, 0xb8, 0x1b, 0x97, 0x64, 0x0c, 0x6a, 0x4f,
+	0xa3, 0xae, 0x90, 0x0a, 0x2a, 0x39, 0x82, 0x56, 0x76, 0xe2, 0x1a, 0xdd, 0x8a, 0x41, 0xdc, 0xcd,
+	0x4b, 0x73, 0x06, 0x78, 0x43, 0x03, 0xaf, 0x6d, 0xaf, 0x96, 0x81, 0xfd, 0x4f, 0x21, 0xff, 0x4c,
+	0x5e, 0xc3, 0xc2, 0x08, 0x67, 0x08, 0x8f, 0x70, 0x1e, 0xe1, 0xb2, 0x59, 0xe8, 0x0d, 0x8d, 0xbb,
+	0x49, 0xfb, 0x33, 0xb8, 0xbe, 0x42, 0xa9, 0x52, 0x41, 0xbe, 0xc2, 0x7f, 0xb5, 0x7b, 0x92, 0x9b,
+	0x33, 0x0a, 0xcc, 0xda, 0xc5, 0xbd, 0xf5, 0xf7, 0xa2, 0x2a, 0x01, 0xb2, 0x51, 0x21, 0x90, 0x5e,
+	0xfe, 0x9e, 0x71, 0xc9, 0x71, 0x4b, 0xff, 0xd2, 0x1e, 0xfc, 0x19, 0x00, 0x1b, 0x26, 0x8c, 0xdf,
+	0x10, 0x06, 0x00, 0x00,
+}
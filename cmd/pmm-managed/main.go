@@ -18,16 +18,21 @@ package main
 
 import (
 	"bytes"
+	"crypto/tls"
+	"database/sql"
 	_ "expvar"
 	"flag"
 	"fmt"
 	"html/template"
 	"log"
+	"math"
 	"net"
 	"net/http"
 	_ "net/http/pprof"
+	"net/url"
 	"os"
 	"os/signal"
+	"strconv"
 	"strings"
 	"sync"
 	"syscall"
@@ -39,16 +44,23 @@ import (
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/sirupsen/logrus"
 	"golang.org/x/net/context"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/grpclog"
+	"google.golang.org/grpc/status"
 	"gopkg.in/reform.v1"
-	reformMySQL "gopkg.in/reform.v1/dialects/mysql"
 
 	"github.com/percona/pmm-managed/api"
 	"github.com/percona/pmm-managed/handlers"
 	"github.com/percona/pmm-managed/models"
+	"github.com/percona/pmm-managed/services"
+	"github.com/percona/pmm-managed/services/agents"
 	"github.com/percona/pmm-managed/services/consul"
+	"github.com/percona/pmm-managed/services/events"
 	"github.com/percona/pmm-managed/services/grafana"
+	"github.com/percona/pmm-managed/services/kv"
 	"github.com/percona/pmm-managed/services/logs"
 	"github.com/percona/pmm-managed/services/mysql"
 	"github.com/percona/pmm-managed/services/postgresql"
@@ -58,9 +70,13 @@ import (
 	"github.com/percona/pmm-managed/services/remote"
 	"github.com/percona/pmm-managed/services/supervisor"
 	"github.com/percona/pmm-managed/services/telemetry"
+	"github.com/percona/pmm-managed/services/webhooks"
+	"github.com/percona/pmm-managed/utils/inprocess"
 	"github.com/percona/pmm-managed/utils/interceptors"
 	"github.com/percona/pmm-managed/utils/logger"
 	"github.com/percona/pmm-managed/utils/ports"
+	"github.com/percona/pmm-managed/utils/secrets"
+	"github.com/percona/pmm-managed/utils/tlsconfig"
 )
 
 const (
@@ -70,13 +86,30 @@ const (
 	Version = "1.17.3"
 )
 
+// how long to retry copying settings from Consul to -kv-store before giving up;
+// overridden in tests
 var (
-	// TODO we can combine gRPC and REST ports, but only with TLS
-	// see https://github.com/grpc/grpc-go/issues/555
-	// alternatively, we can try to use cmux: https://open.dgraph.io/post/cmux/
-	gRPCAddrF  = flag.String("listen-grpc-addr", "127.0.0.1:7771", "gRPC server listen address")
-	restAddrF  = flag.String("listen-rest-addr", "127.0.0.1:7772", "REST server listen address")
-	debugAddrF = flag.String("listen-debug-addr", "127.0.0.1:7773", "Debug server listen address")
+	kvMigrateTimeout    = time.Minute
+	kvMigrateRetryDelay = 3 * time.Second
+)
+
+var (
+	gRPCAddrF   = flag.String("listen-grpc-addr", "127.0.0.1:7771", "gRPC server listen address")
+	restAddrF   = flag.String("listen-rest-addr", "127.0.0.1:7772", "REST server listen address")
+	debugAddrF  = flag.String("listen-debug-addr", "127.0.0.1:7773", "Debug server listen address")
+	listenAddrF = flag.String("listen-addr", "", "Serve gRPC, REST, Swagger and /logs.zip on that single address instead of gRPC and REST addresses")
+
+	tlsCertF     = flag.String("tls-cert", "", "TLS certificate file for all servers; TLS is disabled if empty (reloaded on SIGHUP)")
+	tlsKeyF      = flag.String("tls-key", "", "TLS private key file (reloaded on SIGHUP)")
+	tlsClientCAF = flag.String("tls-client-ca", "", "CA certificates file for verifying client certificates (mutual TLS); disabled if empty")
+
+	encryptionKeyFileF    = flag.String("encryption-key-file", "", "File with base64-encoded 32-byte master key for encryption of stored secrets; "+secrets.EnvKey+" environment variable is used if empty; encryption is disabled if neither is set")
+	encryptionOldKeyFileF = flag.String("encryption-old-key-file", "", "File with previous master key, used only for decryption during key rotation")
+	rotateEncryptionKeyF  = flag.Bool("rotate-encryption-key", false, "Re-encrypt all stored secrets with the current master key and exit")
+
+	authConfigF = flag.String("auth-config", "", "YAML file with API authentication configuration (tokens, users, upstream); authentication is disabled if empty")
+
+	auditRetentionF = flag.Duration("audit-retention", 90*24*time.Hour, "Audit log retention period; audit log entries are kept forever if 0")
 
 	swaggerF = flag.String("swagger", "off", "Server to serve Swagger: rest, debug or off")
 
@@ -84,18 +117,52 @@ var (
 	prometheusURLF    = flag.String("prometheus-url", "http://127.0.0.1:9090/", "Prometheus base URL")
 	promtoolF         = flag.String("promtool", "promtool", "promtool path")
 
-	consulAddrF  = flag.String("consul-addr", "127.0.0.1:8500", "Consul HTTP API address")
-	grafanaAddrF = flag.String("grafana-addr", "127.0.0.1:3000", "Grafana HTTP API address")
+	consulAddrF         = flag.String("consul-addr", "127.0.0.1:8500", "Consul HTTP API address")
+	kvStoreF            = flag.String("kv-store", "consul", "Settings store: consul, db or file")
+	kvFileF             = flag.String("kv-file", "/srv/pmm-managed/kv.json", "Settings store file path for -kv-store=file")
+	kvMigrateF          = flag.Bool("kv-migrate-from-consul", true, "Copy existing Consul keys once to db or file store; Consul is not used at all if disabled")
+	grafanaAddrF        = flag.String("grafana-addr", "127.0.0.1:3000", "Grafana HTTP API address")
+	grafanaDashboardsF  = flag.String("grafana-dashboards-dir", "", "Directory with Grafana dashboards to provision per instance type (<dir>/<type>/*.json)")
+	grafanaAnnotationsF = flag.Bool("grafana-annotations", true, "Create Grafana annotations for inventory and scrape configs changes")
 
-	dbNameF     = flag.String("db-name", "", "Database name")
+	dbNameF     = flag.String("db-name", "", "Database name, or database file path for SQLite")
 	dbUsernameF = flag.String("db-username", "pmm-managed", "Database username")
 	dbPasswordF = flag.String("db-password", "pmm-managed", "Database password")
 
+	dbDriverF          = flag.String("db-driver", models.MySQLDriver, "Database driver: "+strings.Join(models.Drivers, " or "))
+	dbAddressF         = flag.String("db-address", "", "Database address (host:port); 127.0.0.1:3306 for MySQL and 127.0.0.1:5432 for PostgreSQL by default")
+	dbSocketF          = flag.String("db-socket", "", "Database Unix socket path for MySQL, or socket directory for PostgreSQL; overrides -db-address if set")
+	dbTLSCAF           = flag.String("db-tls-ca", "", "CA certificates file for database TLS connection; TLS is disabled if empty")
+	dbMaxOpenConnsF    = flag.Int("db-max-open-conns", 10, "Maximum number of open database connections (0 means unlimited)")
+	dbMaxIdleConnsF    = flag.Int("db-max-idle-conns", 10, "Maximum number of idle database connections")
+	dbConnMaxLifetimeF = flag.Duration("db-conn-max-lifetime", 0, "Maximum database connection lifetime (0 means unlimited)")
+	dbConnectRetriesF  = flag.Int("db-connect-retries", 10, "Number of database connection retries at startup")
+	dbConnectBackoffF  = flag.Duration("db-connect-backoff", time.Second, "Initial delay between database connection retries, doubled after each retry")
+
+	migrateOnlyF = flag.Bool("migrate-only", false, "Apply database migrations and exit")
+	migrateDownF = flag.Int("migrate-down", -1, "Reverse database migrations down to a given schema version and exit (0 reverses all)")
+
 	agentMySQLdExporterF    = flag.String("agent-mysqld-exporter", "/usr/local/percona/pmm-client/mysqld_exporter", "mysqld_exporter path")
 	agentPostgresExporterF  = flag.String("agent-postgres-exporter", "/usr/local/percona/pmm-client/postgres_exporter", "postgres_exporter path")
 	agentRDSExporterF       = flag.String("agent-rds-exporter", "/usr/sbin/rds_exporter", "rds_exporter path")
 	agentRDSExporterConfigF = flag.String("agent-rds-exporter-config", "/etc/percona-rds-exporter.yml", "rds_exporter configuration file path")
 	agentQANBaseF           = flag.String("agent-qan-base", "/usr/local/percona/qan-agent", "qan-agent installation base path")
+	agentPortMinF           = flag.Uint("agent-port-min", 10000, "Minimal exporter listen port")
+	agentPortMaxF           = flag.Uint("agent-port-max", 10999, "Maximal exporter listen port")
+
+	// see services.ParseResourceLimits for format
+	agentMySQLdExporterLimitsF   = flag.String("agent-mysqld-exporter-limits", "memory=1G,cpu=100,nice=10,nofile=4096", "mysqld_exporter resource limits")
+	agentPostgresExporterLimitsF = flag.String("agent-postgres-exporter-limits", "memory=512M,cpu=100,nice=10,nofile=1024", "postgres_exporter resource limits")
+	agentRDSExporterLimitsF      = flag.String("agent-rds-exporter-limits", "memory=512M,cpu=100,nice=10,nofile=1024", "rds_exporter resource limits")
+	agentQANLimitsF              = flag.String("agent-qan-limits", "memory=1G,cpu=100,nice=10,nofile=4096", "qan-agent resource limits")
+
+	supervisorF       = flag.String("supervisor", "system", "Agents supervisor: system (systemd or supervisord) or builtin")
+	supervisorLogDirF = flag.String("supervisor-log-dir", "/var/log/", "Agents logs directory for builtin supervisor")
+
+	eventsAllowedOriginsF = flag.String("events-allowed-origins", "", "Comma-separated origins of web pages (like https://grafana.example.com) allowed to open Events WebSocket connections in addition to the same origin; * allows any")
+
+	logsRedactConfigF  = flag.String("logs-redact-config", "", "YAML file with additional secret patterns to redact from logs")
+	logsSourcesConfigF = flag.String("logs-sources-config", "", "YAML file with additional log sources")
 
 	rdsEnableGovCloud = flag.Bool("rds-enable-gov-cloud", false, "Enable GOV cloud for RDS")
 	rdsEnableCnCloud  = flag.Bool("rds-enable-cn-cloud", false, "Enable AWS CN cloud for RDS")
@@ -113,69 +180,422 @@ func addSwaggerHandler(mux *http.ServeMux) {
 	})
 }
 
-func addLogsHandler(mux *http.ServeMux, logs *logs.Logs) {
+// parseBundleOptions parses logs.zip query parameters:
+// sources (comma-separated and/or repeated), since and until (RFC 3339), lines, and timeout (Go duration).
+func parseBundleOptions(q url.Values) (*logs.BundleOptions, error) {
+	opts := new(logs.BundleOptions)
+	for _, v := range q["sources"] {
+		for _, source := range strings.Split(v, ",") {
+			if source = strings.TrimSpace(source); source != "" {
+				opts.Sources = append(opts.Sources, source)
+			}
+		}
+	}
+
+	var err error
+	if v := q.Get("since"); v != "" {
+		if opts.Since, err = time.Parse(time.RFC3339Nano, v); err != nil {
+			return nil, errors.Wrap(err, "invalid since")
+		}
+	}
+	if v := q.Get("until"); v != "" {
+		if opts.Until, err = time.Parse(time.RFC3339Nano, v); err != nil {
+			return nil, errors.Wrap(err, "invalid until")
+		}
+	}
+	if !opts.Since.IsZero() && !opts.Until.IsZero() && opts.Until.Before(opts.Since) {
+		return nil, errors.New("until is before since")
+	}
+	if v := q.Get("lines"); v != "" {
+		if opts.Lines, err = strconv.Atoi(v); err != nil || opts.Lines < 0 {
+			return nil, errors.Errorf("invalid lines %q", v)
+		}
+	}
+	if v := q.Get("timeout"); v != "" {
+		if opts.Timeout, err = time.ParseDuration(v); err != nil || opts.Timeout < 0 {
+			return nil, errors.Errorf("invalid timeout %q", v)
+		}
+	}
+	return opts, nil
+}
+
+func addLogsHandler(mux *http.ServeMux, logs *logs.Logs, auth *interceptors.Auth) {
 	l := logrus.WithField("component", "logs.zip")
 
-	mux.HandleFunc("/logs.zip", func(rw http.ResponseWriter, req *http.Request) {
-		// fail-safe
-		ctx, cancel := context.WithTimeout(req.Context(), 10*time.Second)
-		defer cancel()
+	mux.Handle("/logs.zip", auth.HTTPHandler(interceptors.RoleAdmin, http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Header().Set(`Access-Control-Allow-Origin`, `*`)
+
+		opts, err := parseBundleOptions(req.URL.Query())
+		if err != nil {
+			http.Error(rw, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		// sources are collected concurrently with own timeouts, so there is no overall timeout
+		ctx, _ := logger.Set(req.Context(), "logs")
+		var buf bytes.Buffer
+		if _, err = logs.Bundle(ctx, &buf, opts); err != nil {
+			l.Error(err)
+			code := http.StatusInternalServerError
+			if status.Code(err) == codes.InvalidArgument {
+				code = http.StatusBadRequest
+			}
+			http.Error(rw, err.Error(), code)
+			return
+		}
 
 		t := time.Now().UTC()
 		filename := fmt.Sprintf("pmm-server_%4d-%02d-%02d-%02d-%02d.zip", t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute())
 
-		rw.Header().Set(`Access-Control-Allow-Origin`, `*`)
 		rw.Header().Set(`Content-Type`, `application/zip`)
 		rw.Header().Set(`Content-Disposition`, `attachment; filename="`+filename+`"`)
-		ctx, _ = logger.Set(ctx, "logs")
-		if err := logs.Zip(ctx, rw); err != nil {
+		if _, err = buf.WriteTo(rw); err != nil {
 			l.Error(err)
 		}
+	})))
+}
+
+// addEventsHandlers adds Server-Sent Events and WebSocket equivalents of Events.Subscribe streaming API.
+func addEventsHandlers(mux *http.ServeMux, bus *events.Bus, auth *interceptors.Auth) {
+	server := &handlers.EventsServer{
+		Events: bus,
+	}
+	for _, origin := range strings.Split(*eventsAllowedOriginsF, ",") {
+		if origin = strings.TrimSpace(origin); origin != "" {
+			server.AllowedOrigins = append(server.AllowedOrigins, origin)
+		}
+	}
+	mux.Handle("/v0/events/sse", auth.HTTPHandler(interceptors.RoleViewer, server.SSEHandler()))
+	mux.Handle("/v0/events/ws", auth.HTTPHandler(interceptors.RoleViewer, server.WebSocketHandler()))
+}
+
+// makeAuth returns API authentication and authorization configured by flag, or nil if it is disabled.
+func makeAuth() (*interceptors.Auth, error) {
+	if *authConfigF == "" {
+		return nil, nil
+	}
+	config, err := interceptors.LoadAuthConfig(*authConfigF)
+	if err != nil {
+		return nil, err
+	}
+	return interceptors.NewAuth(config)
+}
+
+// makeKeyring returns keyring for encryption of stored secrets configured by flags and environment,
+// or nil if encryption is disabled.
+func makeKeyring() (*secrets.Keyring, error) {
+	key, err := secrets.LoadKey(*encryptionKeyFileF)
+	if err != nil {
+		return nil, err
+	}
+	if key == nil {
+		if *encryptionOldKeyFileF != "" {
+			return nil, errors.New("-encryption-old-key-file requires a current master key")
+		}
+		return nil, nil
+	}
+
+	var old [][]byte
+	if *encryptionOldKeyFileF != "" {
+		oldKey, err := secrets.LoadKey(*encryptionOldKeyFileF)
+		if err != nil {
+			return nil, err
+		}
+		old = append(old, oldKey)
+	}
+	return secrets.NewKeyring(key, old...)
+}
+
+// encryptSecrets encrypts not yet encrypted secrets in the database and settings store,
+// and re-encrypts secrets encrypted with old master keys.
+func encryptSecrets(ctx context.Context, db *reform.DB, prometheus *prometheus.Service) error {
+	var n int
+	err := db.InTransaction(func(tx *reform.TX) error {
+		var e error
+		n, e = models.EncryptSecrets(tx.Querier)
+		return e
 	})
+	if err != nil {
+		return err
+	}
+	if n != 0 {
+		logger.Get(ctx).Infof("Encrypted %d secret(s) in the database.", n)
+	}
+
+	_, err = prometheus.EncryptSecrets(ctx)
+	return err
 }
 
-func makePortsRegistry(db *reform.DB) (*ports.Registry, error) {
-	// collect already reserved ports
-	rows, err := db.Query("SELECT listen_port FROM agents")
+// rotateEncryptionKey re-encrypts all stored secrets with the current master key.
+func rotateEncryptionKey(ctx context.Context, keyring *secrets.Keyring, consulClient *consul.Client) error {
+	if keyring == nil {
+		return errors.New("-rotate-encryption-key requires a master key")
+	}
+
+	sqlDB, err := openDB(ctx)
 	if err != nil {
-		return nil, errors.WithStack(err)
+		return err
+	}
+	defer sqlDB.Close()
+	if err = models.MigrateUp(ctx, sqlDB, logger.Get(ctx).Debugf); err != nil {
+		return err
+	}
+	db := newReformDB(sqlDB)
+
+	kvStore, err := makeKVStore(ctx, db, consulClient)
+	if err != nil {
+		return err
+	}
+	prometheus, err := prometheus.NewService(*prometheusConfigF, *prometheusURLF, *promtoolF, kvStore)
+	if err != nil {
+		return err
+	}
+	prometheus.Keyring = keyring
+
+	return encryptSecrets(ctx, db, prometheus)
+}
+
+// openDB connects to the database configured by flags.
+func openDB(ctx context.Context) (*sql.DB, error) {
+	return models.OpenDB(ctx, &models.DBConfig{
+		Driver:          *dbDriverF,
+		Name:            *dbNameF,
+		Username:        *dbUsernameF,
+		Password:        *dbPasswordF,
+		Address:         *dbAddressF,
+		Socket:          *dbSocketF,
+		TLSCAFile:       *dbTLSCAF,
+		MaxOpenConns:    *dbMaxOpenConnsF,
+		MaxIdleConns:    *dbMaxIdleConnsF,
+		ConnMaxLifetime: *dbConnMaxLifetimeF,
+		ConnectRetries:  *dbConnectRetriesF,
+		ConnectBackoff:  *dbConnectBackoffF,
+	}, logger.Get(ctx).Warnf)
+}
+
+// newReformDB returns reform DB for the database driver configured by flag.
+func newReformDB(sqlDB *sql.DB) *reform.DB {
+	dialect, err := models.ReformDialect(*dbDriverF)
+	if err != nil {
+		panic(err) // flag value is checked in main
 	}
-	defer rows.Close()
+	return reform.NewDB(sqlDB, dialect, nil)
+}
+
+// runMigrations applies or reverses database migrations as requested by flags.
+func runMigrations(ctx context.Context) error {
+	l := logger.Get(ctx)
 
-	var reserved []uint16
-	for rows.Next() {
-		var port uint16
-		if err = rows.Scan(&port); err != nil {
-			return nil, errors.WithStack(err)
+	sqlDB, err := openDB(ctx)
+	if err != nil {
+		return err
+	}
+	defer sqlDB.Close()
+
+	if *migrateDownF >= 0 {
+		err = models.MigrateDown(ctx, sqlDB, *migrateDownF, l.Infof)
+	} else {
+		err = models.MigrateUp(ctx, sqlDB, l.Infof)
+	}
+	if err != nil {
+		return err
+	}
+
+	status, err := models.GetSchemaStatus(ctx, sqlDB)
+	if err != nil {
+		return err
+	}
+	l.Infof("Database schema version: %d. Latest version: %d.", status.CurrentVersion, status.LatestVersion)
+	return nil
+}
+
+// makeKVStore returns settings store configured by flags.
+// For database and file stores, existing Consul keys are copied once (if enabled).
+// If Consul is not available for kvMigrateTimeout, empty store is used as is, and error is returned otherwise:
+// that store may contain keys copied by the migration which failed partway.
+func makeKVStore(ctx context.Context, db *reform.DB, consulClient *consul.Client) (kv.Store, error) {
+	var store kv.ListStore
+	switch *kvStoreF {
+	case "consul":
+		return consulClient, nil
+	case "db":
+		store = kv.NewDB(db)
+	case "file":
+		f, err := kv.NewFile(*kvFileF)
+		if err != nil {
+			return nil, err
 		}
-		reserved = append(reserved, port)
+		store = f
+	default:
+		panic("unexpected -kv-store value") // flag value is checked in main
 	}
-	if err = rows.Err(); err != nil {
-		return nil, errors.WithStack(err)
+
+	if !*kvMigrateF {
+		return store, nil
+	}
+
+	// retry until migration is done: starting with an empty store would silently lose settings
+	l := logger.Get(ctx)
+	ctx, cancel := context.WithTimeout(ctx, kvMigrateTimeout)
+	defer cancel()
+	for {
+		n, err := kv.MigrateFromConsul(consulClient, store)
+		if err == nil {
+			if n != 0 {
+				l.Infof("Copied %d setting(s) from Consul.", n)
+			}
+			return store, nil
+		}
+
+		l.Warnf("Failed to copy settings from Consul, retrying: %s.", err)
+		select {
+		case <-ctx.Done():
+			if errors.Cause(err) != kv.ErrSourceUnavailable {
+				return nil, errors.Wrap(err, "failed to copy settings from Consul")
+			}
+
+			// Consul is not installed or not running: there is nothing to lose if store is empty
+			if err = kv.MarkMigrated(store); err != nil {
+				return nil, errors.Wrap(err, "failed to copy settings from Consul")
+			}
+			l.Warnf("Consul is not available, settings were not copied. Use -kv-migrate-from-consul=false to skip copying.")
+			return store, nil
+		case <-time.After(kvMigrateRetryDelay):
+		}
+	}
+}
+
+// makePortsRegistry returns Agent listen ports registry for the range configured by flags,
+// and logs conflicts between stored allocations and Agents.
+func makePortsRegistry(ctx context.Context, db *reform.DB) (*ports.Registry, error) {
+	registry := ports.NewRegistry(uint16(*agentPortMinF), uint16(*agentPortMaxF))
+
+	var d *ports.Diagnostics
+	err := db.InTransaction(func(tx *reform.TX) error {
+		var e error
+		d, e = registry.Diagnose(tx.Querier)
+		return e
+	})
+	if err != nil {
+		return nil, err
+	}
+	l := logger.Get(ctx)
+	for _, c := range d.Conflicts {
+		l.Warnf("Agent listen port %d conflict: %s (agents %v).", c.Port, c.Kind, c.AgentIDs)
+	}
+	return registry, nil
+}
+
+// makeSupervisor returns Agents supervisor selected by -supervisor flag and a function
+// that stops all Agents on shutdown (no-op for system supervisor).
+func makeSupervisor(l *logrus.Entry) (services.Supervisor, func(context.Context)) {
+	if *supervisorF == "builtin" {
+		builtin := supervisor.NewBuiltin(l, *supervisorLogDirF)
+		return builtin, builtin.StopAll
+	}
+	return supervisor.New(l), func(context.Context) {}
+}
+
+// makeResourceLimits parses Agents resource limits flags.
+func makeResourceLimits() (map[models.AgentType]*services.ResourceLimits, error) {
+	res := make(map[models.AgentType]*services.ResourceLimits)
+	for agentType, value := range map[models.AgentType]string{
+		models.MySQLdExporterAgentType:   *agentMySQLdExporterLimitsF,
+		models.PostgresExporterAgentType: *agentPostgresExporterLimitsF,
+		models.RDSExporterAgentType:      *agentRDSExporterLimitsF,
+		models.QanAgentAgentType:         *agentQANLimitsF,
+	} {
+		limits, err := services.ParseResourceLimits(value)
+		if err != nil {
+			return nil, errors.Wrapf(err, "%s", agentType)
+		}
+		res[agentType] = limits
 	}
-	registry := ports.NewRegistry(10000, 10999, reserved)
-	return registry, err
+	return res, nil
+}
+
+// makeRedactor returns logs redactor with default and configured patterns.
+func makeRedactor() (*logs.Redactor, error) {
+	patterns := logs.DefaultRedactPatterns()
+	if *logsRedactConfigF != "" {
+		extra, err := logs.LoadRedactPatterns(*logsRedactConfigF)
+		if err != nil {
+			return nil, err
+		}
+		patterns = append(patterns, extra...)
+	}
+	return logs.NewRedactor(patterns)
+}
+
+type logsContributors struct {
+	mysql      *mysql.Service
+	postgresql *postgresql.Service
+	qan        *qan.Service
+	prometheus *prometheus.Service
+}
+
+// addLogsSources adds diagnostics contributed by other services and log sources from configuration file.
+func addLogsSources(l *logs.Logs, c *logsContributors) error {
+	for _, s := range []struct {
+		name string
+		f    func(context.Context) ([]byte, error)
+	}{
+		{"mysqld_exporter_commands.txt", c.mysql.ExporterCommands},
+		{"postgres_exporter_commands.txt", c.postgresql.ExporterCommands},
+		{"qan-agent_configs.txt", c.qan.AgentConfigs},
+		{"prometheus_status_config.yml", c.prometheus.StatusConfig},
+		{"prometheus_status_flags.json", c.prometheus.StatusFlags},
+		{"prometheus_targets.json", c.prometheus.Targets},
+		{"prometheus_rules.json", c.prometheus.Rules},
+		{"prometheus_tsdb_status.json", c.prometheus.TSDBStatus},
+	} {
+		if err := l.AddSource(s.name, s.f); err != nil {
+			return err
+		}
+	}
+
+	if *logsSourcesConfigF == "" {
+		return nil
+	}
+	sources, err := logs.LoadLogs(*logsSourcesConfigF)
+	if err != nil {
+		return err
+	}
+	return l.AddLogs(sources...)
 }
 
 type serviceDependencies struct {
 	prometheus    *prometheus.Service
-	supervisor    *supervisor.Supervisor
+	supervisor    services.Supervisor
+	agents        *agents.Service
 	db            *reform.DB
+	sqlDB         *sql.DB
 	portsRegistry *ports.Registry
 	qan           *qan.Service
+	grafana       *grafana.Client
+	annotator     *grafana.Annotator
+	dashboards    *grafana.Provisioner
+	events        *events.Bus
+	limits        map[models.AgentType]*services.ResourceLimits
 }
 
 func makeRDSService(ctx context.Context, deps *serviceDependencies) (*rds.Service, error) {
 	rdsConfig := rds.ServiceConfig{
 		MySQLdExporterPath:    *agentMySQLdExporterF,
+		MySQLdExporterLimits:  deps.limits[models.MySQLdExporterAgentType],
 		RDSExporterPath:       *agentRDSExporterF,
 		RDSExporterConfigPath: *agentRDSExporterConfigF,
+		RDSExporterLimits:     deps.limits[models.RDSExporterAgentType],
 
 		Prometheus:    deps.prometheus,
 		Supervisor:    deps.supervisor,
+		Agents:        deps.agents,
 		DB:            deps.db,
 		PortsRegistry: deps.portsRegistry,
 		QAN:           deps.qan,
+		Annotator:     deps.annotator,
+		Dashboards:    deps.dashboards,
+		Events:        deps.events,
 
 		RDSEnableGovCloud: *rdsEnableGovCloud,
 		RDSEnableCnCloud:  *rdsEnableCnCloud,
@@ -185,12 +605,16 @@ func makeRDSService(ctx context.Context, deps *serviceDependencies) (*rds.Servic
 		return nil, err
 	}
 
+	var reloaded []events.Event
 	err = deps.db.InTransaction(func(tx *reform.TX) error {
-		return rdsService.ApplyPrometheusConfiguration(ctx, tx.Querier)
+		var e error
+		reloaded, e = rdsService.ApplyPrometheusConfiguration(ctx, tx.Querier)
+		return e
 	})
 	if err != nil {
 		return nil, err
 	}
+	deps.events.Publish(reloaded...)
 	err = deps.db.InTransaction(func(tx *reform.TX) error {
 		return rdsService.Restore(ctx, tx)
 	})
@@ -203,25 +627,34 @@ func makeRDSService(ctx context.Context, deps *serviceDependencies) (*rds.Servic
 
 func makeMySQLService(ctx context.Context, deps *serviceDependencies) (*mysql.Service, error) {
 	serviceConfig := mysql.ServiceConfig{
-		MySQLdExporterPath: *agentMySQLdExporterF,
+		MySQLdExporterPath:   *agentMySQLdExporterF,
+		MySQLdExporterLimits: deps.limits[models.MySQLdExporterAgentType],
 
 		Prometheus:    deps.prometheus,
 		Supervisor:    deps.supervisor,
+		Agents:        deps.agents,
 		DB:            deps.db,
 		PortsRegistry: deps.portsRegistry,
 		QAN:           deps.qan,
+		Annotator:     deps.annotator,
+		Dashboards:    deps.dashboards,
+		Events:        deps.events,
 	}
 	mysqlService, err := mysql.NewService(&serviceConfig)
 	if err != nil {
 		return nil, err
 	}
 
+	var reloaded []events.Event
 	err = deps.db.InTransaction(func(tx *reform.TX) error {
-		return mysqlService.ApplyPrometheusConfiguration(ctx, tx.Querier)
+		var e error
+		reloaded, e = mysqlService.ApplyPrometheusConfiguration(ctx, tx.Querier)
+		return e
 	})
 	if err != nil {
 		return nil, err
 	}
+	deps.events.Publish(reloaded...)
 	err = deps.db.InTransaction(func(tx *reform.TX) error {
 		return mysqlService.Restore(ctx, tx)
 	})
@@ -234,24 +667,33 @@ func makeMySQLService(ctx context.Context, deps *serviceDependencies) (*mysql.Se
 
 func makePostgreSQLService(ctx context.Context, deps *serviceDependencies) (*postgresql.Service, error) {
 	serviceConfig := postgresql.ServiceConfig{
-		PostgresExporterPath: *agentPostgresExporterF,
+		PostgresExporterPath:   *agentPostgresExporterF,
+		PostgresExporterLimits: deps.limits[models.PostgresExporterAgentType],
 
 		Prometheus:    deps.prometheus,
 		Supervisor:    deps.supervisor,
+		Agents:        deps.agents,
 		DB:            deps.db,
 		PortsRegistry: deps.portsRegistry,
+		Annotator:     deps.annotator,
+		Dashboards:    deps.dashboards,
+		Events:        deps.events,
 	}
 	postgresqlService, err := postgresql.NewService(&serviceConfig)
 	if err != nil {
 		return nil, err
 	}
 
+	var reloaded []events.Event
 	err = deps.db.InTransaction(func(tx *reform.TX) error {
-		return postgresqlService.ApplyPrometheusConfiguration(ctx, tx.Querier)
+		var e error
+		reloaded, e = postgresqlService.ApplyPrometheusConfiguration(ctx, tx.Querier)
+		return e
 	})
 	if err != nil {
 		return nil, err
 	}
+	deps.events.Publish(reloaded...)
 	err = deps.db.InTransaction(func(tx *reform.TX) error {
 		return postgresqlService.Restore(ctx, tx)
 	})
@@ -270,20 +712,20 @@ type grpcServerDependencies struct {
 	postgres     *postgresql.Service
 	remote       *remote.Service
 	logs         *logs.Logs
+	telemetry    *telemetry.Service
+	webhooks     *webhooks.Service
+	auth         *interceptors.Auth
+	audit        *interceptors.Audit
 }
 
-// runGRPCServer runs gRPC server until context is canceled, then gracefully stops it.
-func runGRPCServer(ctx context.Context, deps *grpcServerDependencies) {
-	l := logrus.WithField("component", "gRPC")
-	l.Infof("Starting server on http://%s/ ...", *gRPCAddrF)
-
-	grafana := grafana.NewClient(*grafanaAddrF)
-
+// makeGRPCServer creates gRPC server with all API handlers.
+// TLS is handled by listeners, so the same server can also serve in-process connections.
+func makeGRPCServer(deps *grpcServerDependencies) *grpc.Server {
 	gRPCServer := grpc.NewServer(
-		grpc.UnaryInterceptor(interceptors.Unary),
-		grpc.StreamInterceptor(interceptors.Stream),
+		grpc.UnaryInterceptor(interceptors.ChainUnary(interceptors.Unary, deps.audit.Unary, deps.auth.Unary)),
+		grpc.StreamInterceptor(deps.auth.Stream),
 	)
-	api.RegisterBaseServer(gRPCServer, &handlers.BaseServer{PMMVersion: Version})
+	api.RegisterBaseServer(gRPCServer, &handlers.BaseServer{PMMVersion: Version, DB: deps.sqlDB})
 	api.RegisterDemoServer(gRPCServer, &handlers.DemoServer{})
 	api.RegisterScrapeConfigsServer(gRPCServer, &handlers.ScrapeConfigsServer{
 		Prometheus: deps.prometheus,
@@ -304,26 +746,70 @@ func runGRPCServer(ctx context.Context, deps *grpcServerDependencies) {
 		Logs: deps.logs,
 	})
 	api.RegisterAnnotationsServer(gRPCServer, &handlers.AnnotationsServer{
-		Grafana: grafana,
+		Grafana: deps.grafana,
+	})
+	api.RegisterDashboardsServer(gRPCServer, &handlers.DashboardsServer{
+		Grafana: deps.grafana,
+	})
+	api.RegisterAgentsServer(gRPCServer, &handlers.AgentsServer{
+		Agents: deps.agents,
+	})
+	api.RegisterTelemetryServer(gRPCServer, &handlers.TelemetryServer{
+		Telemetry: deps.telemetry,
+	})
+	api.RegisterAuditServer(gRPCServer, &handlers.AuditServer{
+		DB: deps.db,
+	})
+	api.RegisterEventsServer(gRPCServer, &handlers.EventsServer{
+		Events: deps.events,
+	})
+	api.RegisterWebhooksServer(gRPCServer, &handlers.WebhooksServer{
+		Webhooks: deps.webhooks,
 	})
 
 	grpc_prometheus.Register(gRPCServer)
 	grpc_prometheus.EnableHandlingTimeHistogram()
 
-	listener, err := net.Listen("tcp", *gRPCAddrF)
+	return gRPCServer
+}
+
+// scheme returns URL scheme for logging.
+func scheme(tlsConfig *tls.Config) string {
+	if tlsConfig == nil {
+		return "http"
+	}
+	return "https"
+}
+
+// listen returns TCP listener for given address, with TLS if tlsConfig is not nil.
+func listen(addr string, tlsConfig *tls.Config) (net.Listener, error) {
+	listener, err := net.Listen("tcp", addr)
 	if err != nil {
-		l.Panic(err)
+		return nil, errors.WithStack(err)
 	}
-	go func() {
-		for {
-			err = gRPCServer.Serve(listener)
-			if err == nil || err == grpc.ErrServerStopped {
-				break
+	if tlsConfig != nil {
+		listener = tls.NewListener(listener, tlsConfig)
+	}
+	return listener, nil
+}
+
+// runGRPCServer runs gRPC server on given listeners until context is canceled, then gracefully stops it.
+func runGRPCServer(ctx context.Context, gRPCServer *grpc.Server, listeners ...net.Listener) {
+	l := logrus.WithField("component", "gRPC")
+
+	for _, listener := range listeners {
+		l.Infof("Starting server on %s ...", listener.Addr())
+		go func(listener net.Listener) {
+			for {
+				err := gRPCServer.Serve(listener)
+				if err == nil || err == grpc.ErrServerStopped {
+					break
+				}
+				l.Errorf("Failed to serve: %s", err)
 			}
-			l.Errorf("Failed to serve: %s", err)
-		}
-		l.Info("Server stopped.")
-	}()
+			l.Infof("Server on %s stopped.", listener.Addr())
+		}(listener)
+	}
 
 	<-ctx.Done()
 	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
@@ -335,13 +821,16 @@ func runGRPCServer(ctx context.Context, deps *grpcServerDependencies) {
 	cancel()
 }
 
-// runRESTServer runs REST proxy server until context is canceled, then gracefully stops it.
-func runRESTServer(ctx context.Context, logs *logs.Logs) {
-	l := logrus.WithField("component", "REST")
-	l.Infof("Starting server on http://%s/ ...", *restAddrF)
-
-	proxyMux := runtime.NewServeMux()
-	opts := []grpc.DialOption{grpc.WithInsecure()}
+// makeRESTHandler returns handler for REST API, Swagger, /logs.zip and events streams.
+// REST API (grpc-gateway) connects to gRPC server over in-process channel.
+func makeRESTHandler(ctx context.Context, inProcess *inprocess.Listener, logs *logs.Logs, bus *events.Bus, auth *interceptors.Auth) (http.Handler, error) {
+	proxyMux := runtime.NewServeMux(runtime.WithIncomingHeaderMatcher(auth.HeaderMatcher()))
+	opts := []grpc.DialOption{
+		grpc.WithInsecure(), // in-process channel
+		grpc.WithDialer(func(string, time.Duration) (net.Conn, error) {
+			return inProcess.Dial()
+		}),
+	}
 
 	type registrar func(context.Context, *runtime.ServeMux, string, []grpc.DialOption) error
 	for _, r := range []registrar{
@@ -354,32 +843,42 @@ func runRESTServer(ctx context.Context, logs *logs.Logs) {
 		api.RegisterRemoteHandlerFromEndpoint,
 		api.RegisterLogsHandlerFromEndpoint,
 		api.RegisterAnnotationsHandlerFromEndpoint,
+		api.RegisterDashboardsHandlerFromEndpoint,
+		api.RegisterAgentsHandlerFromEndpoint,
+		api.RegisterTelemetryHandlerFromEndpoint,
+		api.RegisterAuditHandlerFromEndpoint,
+		api.RegisterEventsHandlerFromEndpoint,
+		api.RegisterWebhooksHandlerFromEndpoint,
 	} {
-		if err := r(ctx, proxyMux, *gRPCAddrF, opts); err != nil {
-			l.Panic(err)
+		if err := r(ctx, proxyMux, "in-process", opts); err != nil {
+			return nil, err
 		}
 	}
 
 	mux := http.NewServeMux()
 	if *swaggerF == "rest" {
-		l.Printf("Swagger enabled. http://%s/swagger/", *restAddrF)
 		addSwaggerHandler(mux)
 	}
-	addLogsHandler(mux, logs)
+	addLogsHandler(mux, logs, auth)
+	addEventsHandlers(mux, bus, auth)
 	mux.Handle("/", proxyMux)
+	return mux, nil
+}
 
-	server := &http.Server{
-		Addr:     *restAddrF,
-		ErrorLog: log.New(os.Stderr, "runRESTServer: ", 0),
-		Handler:  mux,
-
-		// TODO we probably will need it for TLS+HTTP/2, see https://github.com/philips/grpc-gateway-example/issues/11
-		// TLSConfig: &tls.Config{
-		// 	NextProtos: []string{"h2"},
-		// },
+// runHTTPServer runs HTTP server on given address until context is canceled, then gracefully stops it.
+func runHTTPServer(ctx context.Context, l *logrus.Entry, server *http.Server, tlsConfig *tls.Config) {
+	listener, err := net.Listen("tcp", server.Addr)
+	if err != nil {
+		l.Panic(err)
 	}
 	go func() {
-		if err := server.ListenAndServe(); err != http.ErrServerClosed {
+		if tlsConfig != nil {
+			server.TLSConfig = tlsConfig
+			err = server.ServeTLS(listener, "", "")
+		} else {
+			err = server.Serve(listener)
+		}
+		if err != http.ErrServerClosed {
 			l.Panic(err)
 		}
 		l.Info("Server stopped.")
@@ -393,8 +892,51 @@ func runRESTServer(ctx context.Context, logs *logs.Logs) {
 	cancel()
 }
 
+// runRESTServer runs REST proxy server until context is canceled, then gracefully stops it.
+func runRESTServer(ctx context.Context, handler http.Handler, tlsConfig *tls.Config) {
+	l := logrus.WithField("component", "REST")
+	l.Infof("Starting server on %s://%s/ ...", scheme(tlsConfig), *restAddrF)
+	if *swaggerF == "rest" {
+		l.Printf("Swagger enabled. %s://%s/swagger/", scheme(tlsConfig), *restAddrF)
+	}
+
+	runHTTPServer(ctx, l, &http.Server{
+		Addr:     *restAddrF,
+		ErrorLog: log.New(os.Stderr, "runRESTServer: ", 0),
+		Handler:  handler,
+	}, tlsConfig)
+}
+
+// runSinglePortServer runs server for both gRPC and REST API until context is canceled, then gracefully stops it.
+// Requests are routed to gRPC server by HTTP/2 protocol and content type.
+func runSinglePortServer(ctx context.Context, gRPCServer *grpc.Server, restHandler http.Handler, tlsConfig *tls.Config) {
+	l := logrus.WithField("component", "server")
+	l.Infof("Starting gRPC and REST server on %s://%s/ ...", scheme(tlsConfig), *listenAddrF)
+	if *swaggerF == "rest" {
+		l.Printf("Swagger enabled. %s://%s/swagger/", scheme(tlsConfig), *listenAddrF)
+	}
+
+	var handler http.Handler = http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.ProtoMajor == 2 && strings.HasPrefix(req.Header.Get("Content-Type"), "application/grpc") {
+			gRPCServer.ServeHTTP(rw, req)
+			return
+		}
+		restHandler.ServeHTTP(rw, req)
+	})
+	if tlsConfig == nil {
+		// gRPC requires HTTP/2, so use HTTP/2 without TLS (h2c)
+		handler = h2c.NewHandler(handler, &http2.Server{})
+	}
+
+	runHTTPServer(ctx, l, &http.Server{
+		Addr:     *listenAddrF,
+		ErrorLog: log.New(os.Stderr, "runSinglePortServer: ", 0),
+		Handler:  handler,
+	}, tlsConfig)
+}
+
 // runDebugServer runs debug server until context is canceled, then gracefully stops it.
-func runDebugServer(ctx context.Context) {
+func runDebugServer(ctx context.Context, tlsConfig *tls.Config) {
 	l := logrus.WithField("component", "debug")
 
 	http.Handle("/debug/metrics", promhttp.Handler())
@@ -402,12 +944,12 @@ func runDebugServer(ctx context.Context) {
 	handlers := []string{"/debug/metrics", "/debug/vars", "/debug/requests", "/debug/events", "/debug/pprof"}
 	if *swaggerF == "debug" {
 		handlers = append(handlers, "/swagger")
-		l.Printf("Swagger enabled. http://%s/swagger/", *debugAddrF)
+		l.Printf("Swagger enabled. %s://%s/swagger/", scheme(tlsConfig), *debugAddrF)
 		addSwaggerHandler(http.DefaultServeMux)
 	}
 
 	for i, h := range handlers {
-		handlers[i] = "http://" + *debugAddrF + h
+		handlers[i] = scheme(tlsConfig) + "://" + *debugAddrF + h
 	}
 
 	var buf bytes.Buffer
@@ -428,41 +970,39 @@ func runDebugServer(ctx context.Context) {
 	http.HandleFunc("/debug", func(rw http.ResponseWriter, req *http.Request) {
 		rw.Write(buf.Bytes())
 	})
-	l.Infof("Starting server on http://%s/debug\nRegistered handlers:\n\t%s", *debugAddrF, strings.Join(handlers, "\n\t"))
+	l.Infof("Starting server on %s://%s/debug\nRegistered handlers:\n\t%s", scheme(tlsConfig), *debugAddrF, strings.Join(handlers, "\n\t"))
 
-	server := &http.Server{
+	runHTTPServer(ctx, l, &http.Server{
 		Addr:     *debugAddrF,
 		ErrorLog: log.New(os.Stderr, "runDebugServer: ", 0),
-	}
-	go func() {
-		if err := server.ListenAndServe(); err != http.ErrServerClosed {
-			l.Panic(err)
-		}
-		l.Info("Server stopped.")
-	}()
+	}, tlsConfig)
+}
 
-	<-ctx.Done()
-	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
-	if err := server.Shutdown(ctx); err != nil {
-		l.Errorf("Failed to shutdown gracefully: %s", err)
+// makeTLSReloader returns TLS certificates reloader configured by flags, or nil if TLS is disabled.
+func makeTLSReloader() (*tlsconfig.Reloader, error) {
+	if *tlsCertF == "" && *tlsKeyF == "" {
+		if *tlsClientCAF != "" {
+			return nil, errors.New("-tls-client-ca requires -tls-cert and -tls-key")
+		}
+		return nil, nil
 	}
-	cancel()
+	return tlsconfig.NewReloader(*tlsCertF, *tlsKeyF, *tlsClientCAF)
 }
 
-func runTelemetryService(ctx context.Context, consulClient *consul.Client) {
-	l := logrus.WithField("component", "telemetry")
-
-	uuid, err := getTelemetryUUID(consulClient)
+func makeTelemetryService(kvStore kv.Store, deps *serviceDependencies) (*telemetry.Service, error) {
+	uuid, err := getTelemetryUUID(kvStore)
 	if err != nil {
-		l.Panicf("cannot get/set telemetry UUID in Consul: %s", err)
+		return nil, errors.Wrap(err, "cannot get/set telemetry UUID in settings store")
 	}
 
-	svc := telemetry.NewService(uuid, Version)
-	svc.Run(ctx)
+	return telemetry.NewService(uuid, Version, kvStore,
+		telemetry.NewInventoryCollector(deps.db),
+		telemetry.NewScrapeJobsCollector(deps.prometheus),
+	), nil
 }
 
-func getTelemetryUUID(consulClient *consul.Client) (string, error) {
-	b, err := consulClient.GetKV("telemetry/uuid")
+func getTelemetryUUID(kvStore kv.Store) (string, error) {
+	b, err := kvStore.GetKV("telemetry/uuid")
 	if err != nil {
 		return "", err
 	}
@@ -474,7 +1014,7 @@ func getTelemetryUUID(consulClient *consul.Client) (string, error) {
 	if err != nil {
 		return "", err
 	}
-	if err = consulClient.PutKV("telemetry/uuid", []byte(uuid)); err != nil {
+	if err = kvStore.PutKV("telemetry/uuid", []byte(uuid)); err != nil {
 		return "", err
 	}
 	return uuid, nil
@@ -500,6 +1040,35 @@ func main() {
 		log.Fatalf("Unexpected value %q for -swagger flag.", *swaggerF)
 	}
 
+	if *supervisorF != "system" && *supervisorF != "builtin" {
+		flag.Usage()
+		log.Fatalf("Unexpected value %q for -supervisor flag.", *supervisorF)
+	}
+
+	if _, err := models.ReformDialect(*dbDriverF); err != nil {
+		flag.Usage()
+		log.Fatalf("Unexpected value %q for -db-driver flag.", *dbDriverF)
+	}
+
+	if *kvStoreF != "consul" && *kvStoreF != "db" && *kvStoreF != "file" {
+		flag.Usage()
+		log.Fatalf("Unexpected value %q for -kv-store flag.", *kvStoreF)
+	}
+
+	if *auditRetentionF < 0 {
+		flag.Usage()
+		log.Fatalf("Unexpected value %s for -audit-retention flag.", *auditRetentionF)
+	}
+
+	if *agentPortMinF == 0 || *agentPortMinF > math.MaxUint16 {
+		flag.Usage()
+		log.Fatalf("Unexpected value %d for -agent-port-min flag.", *agentPortMinF)
+	}
+	if *agentPortMaxF < *agentPortMinF || *agentPortMaxF > math.MaxUint16 {
+		flag.Usage()
+		log.Fatalf("Unexpected value %d for -agent-port-max flag.", *agentPortMaxF)
+	}
+
 	l := logrus.WithField("component", "main")
 	ctx, cancel := context.WithCancel(context.Background())
 	ctx, _ = logger.Set(ctx, "main")
@@ -515,44 +1084,142 @@ func main() {
 		cancel()
 	}()
 
-	consulClient, err := consul.NewClient(*consulAddrF)
+	if *migrateOnlyF || *migrateDownF >= 0 {
+		if err := runMigrations(ctx); err != nil {
+			l.Panicf("Database migrations problem: %+v", err)
+		}
+		return
+	}
+
+	tlsReloader, err := makeTLSReloader()
+	if err != nil {
+		l.Panicf("TLS configuration problem: %+v", err)
+	}
+	var tlsConfig *tls.Config
+	if tlsReloader != nil {
+		tlsConfig = tlsReloader.Config()
+		l.Infof("TLS enabled, mutual TLS: %t.", tlsReloader.MutualTLS())
+
+		// reload certificates on SIGHUP
+		hup := make(chan os.Signal, 1)
+		signal.Notify(hup, syscall.SIGHUP)
+		go func() {
+			for range hup {
+				if err := tlsReloader.Reload(); err != nil {
+					l.Errorf("Failed to reload TLS certificates: %+v", err)
+					continue
+				}
+				l.Info("TLS certificates reloaded.")
+			}
+		}()
+	}
+
+	keyring, err := makeKeyring()
+	if err != nil {
+		l.Panicf("Encryption configuration problem: %+v", err)
+	}
+	models.SetKeyring(keyring)
+	if keyring == nil {
+		l.Warn("Encryption of stored secrets is disabled.")
+	} else {
+		l.Infof("Encryption of stored secrets is enabled: %s.", keyring)
+	}
+
+	// Consul is not used at all with db or file store when migration is disabled
+	var consulClient *consul.Client
+	if *kvStoreF == "consul" || *kvMigrateF {
+		if consulClient, err = consul.NewClient(*consulAddrF); err != nil {
+			l.Panic(err)
+		}
+	}
+
+	if *rotateEncryptionKeyF {
+		if err = rotateEncryptionKey(ctx, keyring, consulClient); err != nil {
+			l.Panicf("Encryption key rotation problem: %+v", err)
+		}
+		l.Info("All stored secrets are encrypted with the current master key.")
+		return
+	}
+
+	sqlDB, err := openDB(ctx)
 	if err != nil {
 		l.Panic(err)
 	}
+	defer sqlDB.Close()
+	if err = models.MigrateUp(ctx, sqlDB, l.Debugf); err != nil {
+		l.Panicf("Database migrations problem: %+v", err)
+	}
+	db := newReformDB(sqlDB)
 
-	prometheus, err := prometheus.NewService(*prometheusConfigF, *prometheusURLF, *promtoolF, consulClient)
+	kvStore, err := makeKVStore(ctx, db, consulClient)
+	if err != nil {
+		l.Panicf("Settings store problem: %+v", err)
+	}
+
+	eventsBus := events.NewBus(events.DefaultHistorySize)
+
+	prometheus, err := prometheus.NewService(*prometheusConfigF, *prometheusURLF, *promtoolF, kvStore)
 	if err == nil {
+		prometheus.Keyring = keyring
+		prometheus.Events = eventsBus
 		err = prometheus.Check(ctx)
 	}
 	if err != nil {
 		l.Panicf("Prometheus service problem: %+v", err)
 	}
 
-	supervisor := supervisor.New(l)
+	supervisor, stopAgents := makeSupervisor(l)
+	defer stopAgents(ctx)
 
-	qan, err := qan.NewService(ctx, *agentQANBaseF, supervisor)
+	limits, err := makeResourceLimits()
 	if err != nil {
-		l.Panicf("QAN service problem: %+v", err)
+		l.Panicf("Resource limits problem: %+v", err)
 	}
 
-	sqlDB, err := models.OpenDB(*dbNameF, *dbUsernameF, *dbPasswordF, l.Debugf)
+	qan, err := qan.NewService(ctx, *agentQANBaseF, supervisor, limits[models.QanAgentAgentType])
 	if err != nil {
-		l.Panic(err)
+		l.Panicf("QAN service problem: %+v", err)
+	}
+
+	if err = encryptSecrets(ctx, db, prometheus); err != nil {
+		l.Panicf("Secrets encryption problem: %+v", err)
 	}
-	defer sqlDB.Close()
-	db := reform.NewDB(sqlDB, reformMySQL.Dialect, nil)
 
-	portsRegistry, err := makePortsRegistry(db)
+	portsRegistry, err := makePortsRegistry(ctx, db)
 	if err != nil {
-		l.Panic(err)
+		l.Panicf("Ports registry problem: %+v", err)
+	}
+
+	grafanaClient := grafana.NewClient(*grafanaAddrF)
+	var annotator *grafana.Annotator
+	if *grafanaAnnotationsF {
+		annotator = grafana.NewAnnotator(grafanaClient)
+		prometheus.Annotator = annotator
+	}
+	dashboards := grafana.NewProvisioner(grafanaClient, *grafanaDashboardsF, *prometheusURLF)
+	if err = dashboards.Check(); err != nil {
+		l.Panicf("Grafana dashboards problem: %+v", err)
 	}
 
+	agentsService := agents.NewService(&agents.ServiceConfig{
+		DB:            db,
+		Supervisor:    supervisor,
+		PortsRegistry: portsRegistry,
+	})
+
 	deps := &serviceDependencies{
 		prometheus:    prometheus,
 		supervisor:    supervisor,
+		agents:        agentsService,
 		qan:           qan,
 		db:            db,
+		sqlDB:         sqlDB,
 		portsRegistry: portsRegistry,
+		grafana:       grafanaClient,
+		annotator:     annotator,
+		dashboards:    dashboards,
+		events:        eventsBus,
+		limits:        limits,
 	}
 	rds, err := makeRDSService(ctx, deps)
 	if err != nil {
@@ -576,41 +1243,133 @@ func main() {
 		l.Panicf("Remote service problem: %+v", err)
 	}
 
-	logs := logs.New(Version, consulClient, db, rds, nil)
+	redactor, err := makeRedactor()
+	if err != nil {
+		l.Panicf("Logs redactor problem: %+v", err)
+	}
+	logs := logs.New(Version, consulClient, db, rds, nil, redactor)
+	if err = addLogsSources(logs, &logsContributors{
+		mysql:      mysqlService,
+		postgresql: postgres,
+		qan:        qan,
+		prometheus: prometheus,
+	}); err != nil {
+		l.Panicf("Logs sources problem: %+v", err)
+	}
+
+	telemetryService, err := makeTelemetryService(kvStore, deps)
+	if err != nil {
+		l.Panicf("Telemetry service problem: %+v", err)
+	}
+
+	webhooksService := webhooks.NewService(&webhooks.ServiceConfig{
+		DB:     db,
+		Events: eventsBus,
+	})
+
+	auth, err := makeAuth()
+	if err != nil {
+		l.Panicf("Authentication configuration problem: %+v", err)
+	}
+	if auth == nil {
+		l.Info("API authentication is disabled.")
+	}
+	audit := interceptors.NewAudit(db, *auditRetentionF)
+
+	gRPCServer := makeGRPCServer(&grpcServerDependencies{
+		serviceDependencies: deps,
+		rds:                 rds,
+		postgres:            postgres,
+		mysql:               mysqlService,
+		remote:              remoteService,
+		consulClient:        consulClient,
+		logs:                logs,
+		telemetry:           telemetryService,
+		webhooks:            webhooksService,
+		auth:                auth,
+		audit:               audit,
+	})
+	inProcess := inprocess.NewListener()
+	restHandler, err := makeRESTHandler(ctx, inProcess, logs, eventsBus, auth)
+	if err != nil {
+		l.Panicf("REST handler problem: %+v", err)
+	}
 
 	var wg sync.WaitGroup
 
+	if *listenAddrF == "" {
+		gRPCListener, err := listen(*gRPCAddrF, tlsConfig)
+		if err != nil {
+			l.Panic(err)
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			runGRPCServer(ctx, gRPCServer, gRPCListener, inProcess)
+		}()
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			runRESTServer(ctx, restHandler, tlsConfig)
+		}()
+	} else {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			runGRPCServer(ctx, gRPCServer, inProcess)
+		}()
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			runSinglePortServer(ctx, gRPCServer, restHandler, tlsConfig)
+		}()
+	}
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		runDebugServer(ctx, tlsConfig)
+	}()
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		telemetryService.Run(ctx)
+	}()
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		dashboards.Run(ctx)
+	}()
+
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
-		runGRPCServer(ctx, &grpcServerDependencies{
-			serviceDependencies: deps,
-			rds:                 rds,
-			postgres:            postgres,
-			mysql:               mysqlService,
-			remote:              remoteService,
-			consulClient:        consulClient,
-			logs:                logs,
-		})
+		audit.Run(ctx)
 	}()
 
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
-		runRESTServer(ctx, logs)
+		eventsBus.WatchAgents(ctx, agentsService, events.AgentsCheckInterval)
 	}()
 
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
-		runDebugServer(ctx)
+		prometheus.WatchTargets(ctx)
 	}()
 
 	wg.Add(1)
 	go func() {
 		defer wg.Done()
-		runTelemetryService(ctx, consulClient)
+		webhooksService.Run(ctx)
 	}()
 
 	wg.Wait()
+	annotator.Wait()
 }
@@ -17,12 +17,20 @@
 package main
 
 import (
+	"context"
+	"io/ioutil"
+	"os"
 	"os/exec"
+	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/percona/pmm-managed/services/consul"
+	"github.com/percona/pmm-managed/utils/logger"
 )
 
 func TestPackages(t *testing.T) {
@@ -34,3 +42,65 @@ func TestPackages(t *testing.T) {
 	assert.False(t, strings.Contains(out, "-httptest.serve"), `pmm-managed should not import package "net/http/httptest"`)
 	assert.False(t, strings.Contains(out, "-test.run"), `pmm-managed should not import package "testing"`)
 }
+
+func TestMakeKVStore(t *testing.T) {
+	ctx, _ := logger.Set(context.Background(), t.Name())
+
+	dir, err := ioutil.TempDir("", "pmm-managed-main-")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir) //nolint:errcheck
+	path := filepath.Join(dir, "kv.json")
+
+	oldStore, oldFile, oldMigrate := *kvStoreF, *kvFileF, *kvMigrateF
+	oldTimeout, oldDelay := kvMigrateTimeout, kvMigrateRetryDelay
+	defer func() {
+		*kvStoreF, *kvFileF, *kvMigrateF = oldStore, oldFile, oldMigrate
+		kvMigrateTimeout, kvMigrateRetryDelay = oldTimeout, oldDelay
+	}()
+	*kvStoreF, *kvFileF = "file", path
+	kvMigrateTimeout, kvMigrateRetryDelay = 100*time.Millisecond, 10*time.Millisecond
+
+	// nothing listens there
+	unavailable, err := consul.NewClient("127.0.0.1:1")
+	require.NoError(t, err)
+
+	t.Run("UnavailableEmpty", func(t *testing.T) {
+		defer os.Remove(path) //nolint:errcheck
+		*kvMigrateF = true
+
+		store, err := makeKVStore(ctx, nil, unavailable)
+		require.NoError(t, err)
+		v, err := store.GetKV("kv/migrated-from-consul")
+		require.NoError(t, err)
+		assert.Equal(t, []byte("1"), v)
+
+		// marked store does not need Consul anymore
+		start := time.Now()
+		_, err = makeKVStore(ctx, nil, unavailable)
+		require.NoError(t, err)
+		assert.True(t, time.Since(start) < kvMigrateTimeout, "%s", time.Since(start))
+	})
+
+	t.Run("UnavailableNotEmpty", func(t *testing.T) {
+		defer os.Remove(path) //nolint:errcheck
+		*kvMigrateF = true
+		require.NoError(t, ioutil.WriteFile(path, []byte(`{"telemetry/uuid":"dXVpZA=="}`), 0600))
+
+		_, err := makeKVStore(ctx, nil, unavailable)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "store is not empty")
+	})
+
+	t.Run("Disabled", func(t *testing.T) {
+		defer os.Remove(path) //nolint:errcheck
+		*kvMigrateF = false
+
+		start := time.Now()
+		store, err := makeKVStore(ctx, nil, nil)
+		require.NoError(t, err)
+		assert.True(t, time.Since(start) < kvMigrateTimeout, "%s", time.Since(start))
+		v, err := store.GetKV("kv/migrated-from-consul")
+		require.NoError(t, err)
+		assert.Nil(t, v)
+	})
+}
@@ -0,0 +1,145 @@
+// pmm-managed
+// Copyright (C) 2017 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package handlers
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+
+	"github.com/percona/pmm-managed/api"
+	"github.com/percona/pmm-managed/services"
+	"github.com/percona/pmm-managed/services/agents"
+	"github.com/percona/pmm-managed/utils/logger"
+	"github.com/percona/pmm-managed/utils/ports"
+)
+
+// AgentsServer handles requests for Agents runtime status.
+type AgentsServer struct {
+	Agents *agents.Service
+}
+
+func convertAgentsHealth(h agents.Health) api.AgentsHealth {
+	switch h {
+	case agents.HealthDown:
+		return api.AgentsHealth_AGENTS_HEALTH_DOWN
+	case agents.HealthDegraded:
+		return api.AgentsHealth_AGENTS_HEALTH_DEGRADED
+	case agents.HealthUp:
+		return api.AgentsHealth_AGENTS_HEALTH_UP
+	default:
+		return api.AgentsHealth_AGENTS_HEALTH_UNKNOWN
+	}
+}
+
+func convertProcessState(s services.ProcessState) api.AgentStatus_State {
+	switch s {
+	case services.ProcessRunning:
+		return api.AgentStatus_RUNNING
+	case services.ProcessStopped:
+		return api.AgentStatus_STOPPED
+	case services.ProcessFailed:
+		return api.AgentStatus_FAILED
+	default:
+		return api.AgentStatus_UNKNOWN
+	}
+}
+
+// Status returns runtime status of all Agents.
+func (s *AgentsServer) Status(ctx context.Context, req *api.AgentsStatusRequest) (*api.AgentsStatusResponse, error) {
+	res, err := s.Agents.List(ctx)
+	if err != nil {
+		logger.Get(ctx).Errorf("%+v", err)
+		return nil, err
+	}
+
+	var resp api.AgentsStatusResponse
+	for _, status := range res {
+		a := &api.AgentStatus{
+			Id:        status.Agent.ID,
+			Type:      string(status.Agent.Type),
+			State:     convertProcessState(status.Process.State),
+			Pid:       uint32(status.Process.PID),
+			Restarts:  uint32(status.Process.Restarts),
+			LastError: status.Process.LastError,
+			MetricsUp: status.MetricsUp,
+		}
+		if status.Agent.ListenPort != nil {
+			a.ListenPort = uint32(*status.Agent.ListenPort)
+		}
+		if !status.Process.StartedAt.IsZero() {
+			a.Uptime = ptypes.DurationProto(time.Since(status.Process.StartedAt))
+		}
+		resp.Agents = append(resp.Agents, a)
+	}
+	return &resp, nil
+}
+
+func convertConflictKind(k ports.ConflictKind) api.PortConflict_Kind {
+	switch k {
+	case ports.ConflictUnallocated:
+		return api.PortConflict_UNALLOCATED
+	case ports.ConflictUnused:
+		return api.PortConflict_UNUSED
+	case ports.ConflictShared:
+		return api.PortConflict_SHARED
+	case ports.ConflictOutOfRange:
+		return api.PortConflict_OUT_OF_RANGE
+	default:
+		return api.PortConflict_UNKNOWN
+	}
+}
+
+// ListPorts returns Agent listen ports allocations and conflicts between them and Agents.
+func (s *AgentsServer) ListPorts(ctx context.Context, req *api.AgentsListPortsRequest) (*api.AgentsListPortsResponse, error) {
+	res, err := s.Agents.Ports(ctx)
+	if err != nil {
+		logger.Get(ctx).Errorf("%+v", err)
+		return nil, err
+	}
+
+	resp := &api.AgentsListPortsResponse{
+		MinPort: uint32(res.Min),
+		MaxPort: uint32(res.Max),
+	}
+	for _, a := range res.Allocations {
+		createdAt, err := ptypes.TimestampProto(a.CreatedAt)
+		if err != nil {
+			logger.Get(ctx).Errorf("%+v", err)
+			return nil, err
+		}
+		resp.Allocations = append(resp.Allocations, &api.PortAllocation{
+			Port:      uint32(a.Port),
+			CreatedAt: createdAt,
+			AgentIds:  a.AgentIDs,
+		})
+	}
+	for _, c := range res.Conflicts {
+		resp.Conflicts = append(resp.Conflicts, &api.PortConflict{
+			Port:     uint32(c.Port),
+			Kind:     convertConflictKind(c.Kind),
+			AgentIds: c.AgentIDs,
+		})
+	}
+	return resp, nil
+}
+
+// check interfaces
+var (
+	_ api.AgentsServer = (*AgentsServer)(nil)
+)
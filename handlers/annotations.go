@@ -18,26 +18,155 @@ package handlers
 
 import (
 	"context"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/percona/pmm-managed/api"
 	"github.com/percona/pmm-managed/services/grafana"
+	"github.com/percona/pmm-managed/utils/logger"
 )
 
 type AnnotationsServer struct {
 	Grafana *grafana.Client
 }
 
+// timestampField converts optional protobuf timestamp to time.Time (zero if not set).
+func timestampField(name string, ts *timestamp.Timestamp) (time.Time, error) {
+	if ts == nil {
+		return time.Time{}, nil
+	}
+	t, err := ptypes.Timestamp(ts)
+	if err != nil {
+		return time.Time{}, status.Errorf(codes.InvalidArgument, "Invalid %s: %s.", name, err)
+	}
+	return t, nil
+}
+
+// timestampProto converts time.Time to protobuf timestamp (nil if zero).
+func timestampProto(t time.Time) *timestamp.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	ts, _ := ptypes.TimestampProto(t)
+	return ts
+}
+
+func annotationFromProto(a *api.Annotation) (*grafana.Annotation, error) {
+	t, err := timestampField("time", a.Time)
+	if err != nil {
+		return nil, err
+	}
+	timeEnd, err := timestampField("time_end", a.TimeEnd)
+	if err != nil {
+		return nil, err
+	}
+	return &grafana.Annotation{
+		ID:      a.Id,
+		Time:    t,
+		TimeEnd: timeEnd,
+		Tags:    a.Tags,
+		Text:    a.Text,
+	}, nil
+}
+
+// List returns annotations created by pmm-managed matching given tags and time range.
+func (s *AnnotationsServer) List(ctx context.Context, req *api.AnnotationsListRequest) (*api.AnnotationsListResponse, error) {
+	from, err := timestampField("from", req.From)
+	if err != nil {
+		return nil, err
+	}
+	to, err := timestampField("to", req.To)
+	if err != nil {
+		return nil, err
+	}
+	if !from.IsZero() && !to.IsZero() && to.Before(from) {
+		return nil, status.Error(codes.InvalidArgument, "To is before from.")
+	}
+
+	annotations, err := s.Grafana.FindAnnotations(ctx, &grafana.AnnotationsQuery{
+		From:  from,
+		To:    to,
+		Tags:  req.Tags,
+		Limit: int(req.Limit),
+	})
+	if err != nil {
+		logger.Get(ctx).Errorf("%+v", err)
+		return nil, err
+	}
+
+	res := &api.AnnotationsListResponse{
+		Annotations: make([]*api.Annotation, len(annotations)),
+	}
+	for i, a := range annotations {
+		res.Annotations[i] = &api.Annotation{
+			Id:      a.ID,
+			Time:    timestampProto(a.Time),
+			TimeEnd: timestampProto(a.TimeEnd),
+			Tags:    a.Tags,
+			Text:    a.Text,
+		}
+	}
+	return res, nil
+}
+
 // Create creates annotation with given text and tags ("pmm_annotation" is added automatically).
 func (s *AnnotationsServer) Create(ctx context.Context, req *api.AnnotationsCreateRequest) (*api.AnnotationsCreateResponse, error) {
-	msg, err := s.Grafana.CreateAnnotation(ctx, req.Tags, req.Text)
+	a, err := annotationFromProto(&api.Annotation{
+		Time:    req.Time,
+		TimeEnd: req.TimeEnd,
+		Tags:    req.Tags,
+		Text:    req.Text,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range req.InstanceNames {
+		a.Tags = append(a.Tags, grafana.InstanceTag(name))
+	}
+	for _, name := range req.NodeNames {
+		a.Tags = append(a.Tags, grafana.NodeTag(name))
+	}
+
+	msg, err := s.Grafana.CreateAnnotation(ctx, a)
 	if err != nil {
+		logger.Get(ctx).Errorf("%+v", err)
 		return nil, err
 	}
 	return &api.AnnotationsCreateResponse{
 		Message: msg,
+		Id:      a.ID,
 	}, nil
 }
 
+// Update updates annotation with given ID.
+func (s *AnnotationsServer) Update(ctx context.Context, req *api.AnnotationsUpdateRequest) (*api.AnnotationsUpdateResponse, error) {
+	if req.Annotation == nil {
+		return nil, status.Error(codes.InvalidArgument, "Annotation is not given.")
+	}
+	a, err := annotationFromProto(req.Annotation)
+	if err != nil {
+		return nil, err
+	}
+	if err = s.Grafana.UpdateAnnotation(ctx, a); err != nil {
+		logger.Get(ctx).Errorf("%+v", err)
+		return nil, err
+	}
+	return &api.AnnotationsUpdateResponse{}, nil
+}
+
+// Delete deletes annotation with given ID.
+func (s *AnnotationsServer) Delete(ctx context.Context, req *api.AnnotationsDeleteRequest) (*api.AnnotationsDeleteResponse, error) {
+	if err := s.Grafana.DeleteAnnotation(ctx, req.Id); err != nil {
+		logger.Get(ctx).Errorf("%+v", err)
+		return nil, err
+	}
+	return &api.AnnotationsDeleteResponse{}, nil
+}
+
 // check interfaces
 var (
 	_ api.AnnotationsServer = (*AnnotationsServer)(nil)
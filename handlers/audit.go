@@ -0,0 +1,117 @@
+// pmm-managed
+// Copyright (C) 2017 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package handlers
+
+import (
+	"strconv"
+
+	"github.com/golang/protobuf/ptypes"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gopkg.in/reform.v1"
+
+	"github.com/percona/pmm-managed/api"
+	"github.com/percona/pmm-managed/models"
+	"github.com/percona/pmm-managed/utils/logger"
+)
+
+const (
+	auditDefaultPageSize = 100
+	auditMaxPageSize     = 1000
+)
+
+// AuditServer handles requests for audit log of mutating API calls.
+type AuditServer struct {
+	DB *reform.DB
+}
+
+// List returns audit log entries of mutating API calls.
+func (s *AuditServer) List(ctx context.Context, req *api.AuditListRequest) (*api.AuditListResponse, error) {
+	filter := &models.AuditFilter{
+		Method: req.Method,
+		Code:   req.Code,
+		Limit:  auditDefaultPageSize,
+	}
+	if req.Since != nil {
+		since, err := ptypes.Timestamp(req.Since)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid since: %s.", err)
+		}
+		filter.Since = since
+	}
+	if req.Until != nil {
+		until, err := ptypes.Timestamp(req.Until)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid until: %s.", err)
+		}
+		filter.Until = until
+	}
+	if req.PageSize != 0 {
+		if req.PageSize > auditMaxPageSize {
+			return nil, status.Errorf(codes.InvalidArgument, "Page size should be at most %d.", auditMaxPageSize)
+		}
+		filter.Limit = int(req.PageSize)
+	}
+	if req.PageToken != "" {
+		id, err := strconv.ParseInt(req.PageToken, 10, 64)
+		if err != nil || id <= 0 {
+			return nil, status.Error(codes.InvalidArgument, "Invalid page token.")
+		}
+		filter.BeforeID = id
+	}
+
+	// request one more entry to know if there is a next page
+	filter.Limit++
+	entries, err := models.FindAuditEntries(s.DB.Querier, filter)
+	if err != nil {
+		logger.Get(ctx).Errorf("%+v", err)
+		return nil, err
+	}
+	filter.Limit--
+
+	resp := new(api.AuditListResponse)
+	if len(entries) > filter.Limit {
+		entries = entries[:filter.Limit]
+		resp.NextPageToken = strconv.FormatInt(entries[len(entries)-1].ID, 10)
+	}
+	for _, e := range entries {
+		createdAt, err := ptypes.TimestampProto(e.CreatedAt)
+		if err != nil {
+			logger.Get(ctx).Errorf("%+v", err)
+			return nil, err
+		}
+		resp.Entries = append(resp.Entries, &api.AuditEntry{
+			Id:         e.ID,
+			Time:       createdAt,
+			RequestId:  e.RequestID,
+			Method:     e.Method,
+			Caller:     e.Caller,
+			AuthMethod: e.AuthMethod,
+			Request:    e.Request,
+			Code:       e.Code,
+			Error:      e.Error,
+			Duration:   ptypes.DurationProto(e.Duration),
+		})
+	}
+	return resp, nil
+}
+
+// check interfaces
+var (
+	_ api.AuditServer = (*AuditServer)(nil)
+)
@@ -18,13 +18,18 @@
 package handlers
 
 import (
+	"database/sql"
+
 	"golang.org/x/net/context"
 
 	"github.com/percona/pmm-managed/api"
+	"github.com/percona/pmm-managed/models"
+	"github.com/percona/pmm-managed/utils/logger"
 )
 
 type BaseServer struct {
 	PMMVersion string
+	DB         *sql.DB
 }
 
 func (s *BaseServer) Version(context.Context, *api.BaseVersionRequest) (*api.BaseVersionResponse, error) {
@@ -33,6 +38,32 @@ func (s *BaseServer) Version(context.Context, *api.BaseVersionRequest) (*api.Bas
 	}, nil
 }
 
+// SchemaVersion returns database schema version and applied migrations.
+func (s *BaseServer) SchemaVersion(ctx context.Context, req *api.BaseSchemaVersionRequest) (*api.BaseSchemaVersionResponse, error) {
+	status, err := models.GetSchemaStatus(ctx, s.DB)
+	if err != nil {
+		logger.Get(ctx).Errorf("%+v", err)
+		return nil, err
+	}
+
+	res := &api.BaseSchemaVersionResponse{
+		CurrentVersion: int32(status.CurrentVersion),
+		LatestVersion:  int32(status.LatestVersion),
+	}
+	for _, m := range status.Applied {
+		migration := &api.SchemaMigration{
+			Version:  int32(m.Version),
+			Checksum: m.Checksum,
+			Dirty:    m.Dirty,
+		}
+		if m.AppliedAt != nil {
+			migration.AppliedAt = timestampProto(*m.AppliedAt)
+		}
+		res.Migrations = append(res.Migrations, migration)
+	}
+	return res, nil
+}
+
 // check interfaces
 var (
 	_ api.BaseServer = (*BaseServer)(nil)
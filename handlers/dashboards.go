@@ -0,0 +1,138 @@
+// pmm-managed
+// Copyright (C) 2017 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package handlers
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/percona/pmm-managed/api"
+	"github.com/percona/pmm-managed/services/grafana"
+	"github.com/percona/pmm-managed/utils/logger"
+)
+
+type DashboardsServer struct {
+	Grafana *grafana.Client
+}
+
+// List returns dashboards in given folder or all dashboards.
+func (s *DashboardsServer) List(ctx context.Context, req *api.DashboardsListRequest) (*api.DashboardsListResponse, error) {
+	dashboards, err := s.Grafana.ListDashboards(ctx, req.FolderUid)
+	if err != nil {
+		logger.Get(ctx).Errorf("%+v", err)
+		return nil, err
+	}
+
+	res := &api.DashboardsListResponse{
+		Dashboards: make([]*api.Dashboard, len(dashboards)),
+	}
+	for i, d := range dashboards {
+		res.Dashboards[i] = &api.Dashboard{
+			Uid:         d.UID,
+			Title:       d.Title,
+			Url:         d.URL,
+			Tags:        d.Tags,
+			FolderUid:   d.FolderUID,
+			FolderTitle: d.FolderTitle,
+		}
+	}
+	return res, nil
+}
+
+// Export returns dashboard JSON model.
+func (s *DashboardsServer) Export(ctx context.Context, req *api.DashboardsExportRequest) (*api.DashboardsExportResponse, error) {
+	dashboard, err := s.Grafana.ExportDashboard(ctx, req.Uid)
+	if err != nil {
+		logger.Get(ctx).Errorf("%+v", err)
+		return nil, err
+	}
+	return &api.DashboardsExportResponse{
+		DashboardJson: string(dashboard),
+	}, nil
+}
+
+// Import creates or updates dashboard from JSON model.
+func (s *DashboardsServer) Import(ctx context.Context, req *api.DashboardsImportRequest) (*api.DashboardsImportResponse, error) {
+	d, err := s.Grafana.ImportDashboard(ctx, json.RawMessage(req.DashboardJson), req.FolderUid, req.Overwrite)
+	if err != nil {
+		logger.Get(ctx).Errorf("%+v", err)
+		return nil, err
+	}
+	return &api.DashboardsImportResponse{
+		Uid:     d.UID,
+		Url:     d.URL,
+		Version: d.Version,
+	}, nil
+}
+
+// Delete deletes dashboard.
+func (s *DashboardsServer) Delete(ctx context.Context, req *api.DashboardsDeleteRequest) (*api.DashboardsDeleteResponse, error) {
+	if err := s.Grafana.DeleteDashboard(ctx, req.Uid); err != nil {
+		logger.Get(ctx).Errorf("%+v", err)
+		return nil, err
+	}
+	return &api.DashboardsDeleteResponse{}, nil
+}
+
+// ListFolders returns all dashboards folders.
+func (s *DashboardsServer) ListFolders(ctx context.Context, req *api.DashboardsListFoldersRequest) (*api.DashboardsListFoldersResponse, error) {
+	folders, err := s.Grafana.ListFolders(ctx)
+	if err != nil {
+		logger.Get(ctx).Errorf("%+v", err)
+		return nil, err
+	}
+
+	res := &api.DashboardsListFoldersResponse{
+		Folders: make([]*api.DashboardFolder, len(folders)),
+	}
+	for i, f := range folders {
+		res.Folders[i] = &api.DashboardFolder{
+			Uid:   f.UID,
+			Title: f.Title,
+		}
+	}
+	return res, nil
+}
+
+// CreateFolder creates dashboards folder.
+func (s *DashboardsServer) CreateFolder(ctx context.Context, req *api.DashboardsCreateFolderRequest) (*api.DashboardsCreateFolderResponse, error) {
+	f, err := s.Grafana.CreateFolder(ctx, req.Uid, req.Title)
+	if err != nil {
+		logger.Get(ctx).Errorf("%+v", err)
+		return nil, err
+	}
+	return &api.DashboardsCreateFolderResponse{
+		Folder: &api.DashboardFolder{
+			Uid:   f.UID,
+			Title: f.Title,
+		},
+	}, nil
+}
+
+// DeleteFolder deletes dashboards folder with all dashboards in it.
+func (s *DashboardsServer) DeleteFolder(ctx context.Context, req *api.DashboardsDeleteFolderRequest) (*api.DashboardsDeleteFolderResponse, error) {
+	if err := s.Grafana.DeleteFolder(ctx, req.Uid); err != nil {
+		logger.Get(ctx).Errorf("%+v", err)
+		return nil, err
+	}
+	return &api.DashboardsDeleteFolderResponse{}, nil
+}
+
+// check interfaces
+var (
+	_ api.DashboardsServer = (*DashboardsServer)(nil)
+)
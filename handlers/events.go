@@ -0,0 +1,339 @@
+// pmm-managed
+// Copyright (C) 2017 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package handlers
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+	"golang.org/x/net/websocket"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/percona/pmm-managed/api"
+	"github.com/percona/pmm-managed/services/events"
+	"github.com/percona/pmm-managed/utils/logger"
+)
+
+// keepAliveInterval is an interval of SSE comments sent to keep idle connections open.
+const keepAliveInterval = 15 * time.Second
+
+var eventTypes = map[events.Type]api.Event_Type{
+	events.NodeAdded:           api.Event_NODE_ADDED,
+	events.NodeRemoved:         api.Event_NODE_REMOVED,
+	events.ServiceAdded:        api.Event_SERVICE_ADDED,
+	events.ServiceRemoved:      api.Event_SERVICE_REMOVED,
+	events.AgentAdded:          api.Event_AGENT_ADDED,
+	events.AgentRemoved:        api.Event_AGENT_REMOVED,
+	events.AgentStateChanged:   api.Event_AGENT_STATE_CHANGED,
+	events.ScrapeConfigCreated: api.Event_SCRAPE_CONFIG_CREATED,
+	events.ScrapeConfigUpdated: api.Event_SCRAPE_CONFIG_UPDATED,
+	events.ScrapeConfigDeleted: api.Event_SCRAPE_CONFIG_DELETED,
+	events.PrometheusReloaded:  api.Event_PROMETHEUS_RELOADED,
+	events.TargetDown:          api.Event_TARGET_DOWN,
+}
+
+func convertEvent(e *events.Event) (*api.Event, error) {
+	t, err := ptypes.TimestampProto(e.Time)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &api.Event{
+		StreamId:    e.StreamID,
+		Seq:         e.Seq,
+		Time:        t,
+		Type:        eventTypes[e.Type],
+		NodeId:      e.NodeID,
+		NodeType:    string(e.NodeType),
+		NodeName:    e.NodeName,
+		ServiceId:   e.ServiceID,
+		ServiceType: string(e.ServiceType),
+		AgentId:     e.AgentID,
+		AgentType:   string(e.AgentType),
+		JobName:     e.JobName,
+		Instance:    e.Instance,
+	}
+	if e.Type == events.AgentStateChanged {
+		res.AgentState = convertProcessState(e.AgentState)
+	}
+	return res, nil
+}
+
+// EventsServer handles requests for inventory and configuration change events.
+type EventsServer struct {
+	Events *events.Bus
+
+	// AllowedOrigins contains additional origins (like "https://grafana.example.com") of web pages
+	// allowed to open WebSocket connections; may be nil. "*" allows any origin.
+	AllowedOrigins []string
+}
+
+// checkOrigin returns error if WebSocket connection is opened by a web page from a different origin,
+// preventing cross-site WebSocket hijacking with browser credentials.
+// Connections without Origin header (non-browser clients) are allowed.
+func (s *EventsServer) checkOrigin(req *http.Request) error {
+	origin := req.Header.Get("Origin")
+	if origin == "" {
+		return nil
+	}
+	for _, o := range s.AllowedOrigins {
+		if o == "*" || strings.EqualFold(o, origin) {
+			return nil
+		}
+	}
+
+	u, err := url.Parse(origin)
+	if err != nil {
+		return errors.Wrapf(err, "invalid Origin %q", origin)
+	}
+	if !strings.EqualFold(u.Host, req.Host) {
+		return errors.Errorf("Origin %q does not match Host %q", origin, req.Host)
+	}
+	return nil
+}
+
+func (s *EventsServer) subscribe(streamID string, sinceSeq uint64) (*events.Subscription, error) {
+	sub, err := s.Events.Subscribe(streamID, sinceSeq)
+	switch err {
+	case events.ErrReset:
+		return nil, status.Errorf(codes.Aborted, "Event stream %q was reset (pmm-managed was restarted), resubscribe without since_seq and resynchronize.", streamID)
+	case events.ErrUnavailable:
+		return nil, status.Errorf(codes.OutOfRange, "Events after sequence number %d are not available, resubscribe without it.", sinceSeq)
+	}
+	return sub, err
+}
+
+// stream calls send for each subscription's event until ctx is canceled or error is encountered.
+// If ping is not nil, it is called when there were no events for keepAliveInterval.
+func (s *EventsServer) stream(ctx context.Context, sub *events.Subscription, send func(*api.Event) error, ping func() error) error {
+	for {
+		nextCtx, cancel := context.WithTimeout(ctx, keepAliveInterval)
+		e, err := sub.Next(nextCtx)
+		cancel()
+
+		switch {
+		case err == nil:
+			// nothing
+		case ctx.Err() != nil:
+			return nil
+		case err == context.DeadlineExceeded:
+			if ping != nil {
+				if err = ping(); err != nil {
+					return err
+				}
+			}
+			continue
+		case err == events.ErrOverflow:
+			return status.Error(codes.ResourceExhausted, "Too many events are queued, resubscribe with the last received sequence number.")
+		default:
+			return err
+		}
+
+		ev, err := convertEvent(e)
+		if err != nil {
+			return err
+		}
+		if err = send(ev); err != nil {
+			return err
+		}
+	}
+}
+
+// Subscribe streams inventory and configuration change events.
+func (s *EventsServer) Subscribe(req *api.EventsSubscribeRequest, stream api.Events_SubscribeServer) error {
+	ctx, _ := logger.Set(stream.Context(), logger.MakeRequestID())
+
+	sub, err := s.subscribe(req.StreamId, req.SinceSeq)
+	if err != nil {
+		logger.Get(ctx).Errorf("%+v", err)
+		return err
+	}
+	defer sub.Close()
+
+	err = s.stream(ctx, sub, func(e *api.Event) error {
+		return stream.Send(&api.EventsSubscribeResponse{
+			Event: e,
+		})
+	}, nil)
+	if err != nil {
+		logger.Get(ctx).Errorf("%+v", err)
+	}
+	return err
+}
+
+// parseResumeToken returns stream ID and sequence number from stream_id and since_seq query parameters,
+// or from Last-Event-ID header in "<stream_id>-<seq>" format (set by browsers when EventSource reconnects).
+func parseResumeToken(req *http.Request) (string, uint64, error) {
+	streamID, v := req.URL.Query().Get("stream_id"), req.URL.Query().Get("since_seq")
+	if v == "" {
+		if id := req.Header.Get("Last-Event-ID"); id != "" {
+			// stream ID may contain "-" itself
+			i := strings.LastIndex(id, "-")
+			if i <= 0 || i == len(id)-1 {
+				return "", 0, status.Errorf(codes.InvalidArgument, "Invalid Last-Event-ID: %q.", id)
+			}
+			streamID, v = id[:i], id[i+1:]
+		}
+	}
+	if v == "" {
+		return "", 0, nil
+	}
+	sinceSeq, err := strconv.ParseUint(v, 10, 64)
+	if err != nil {
+		return "", 0, status.Errorf(codes.InvalidArgument, "Invalid since_seq: %q.", v)
+	}
+	return streamID, sinceSeq, nil
+}
+
+var eventMarshaler = &jsonpb.Marshaler{OrigName: true}
+
+// SSEHandler returns HTTP handler streaming events as Server-Sent Events.
+// Each event has id set to stream ID and sequence number, so browsers resume from the last received event on reconnect.
+func (s *EventsServer) SSEHandler() http.Handler {
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		ctx, l := logger.Set(req.Context(), logger.MakeRequestID())
+
+		flusher, ok := rw.(http.Flusher)
+		if !ok {
+			http.Error(rw, "Streaming is not supported.", http.StatusInternalServerError)
+			return
+		}
+
+		streamID, sinceSeq, err := parseResumeToken(req)
+		if err != nil {
+			http.Error(rw, status.Convert(err).Message(), http.StatusBadRequest)
+			return
+		}
+		sub, err := s.subscribe(streamID, sinceSeq)
+		if err != nil {
+			l.Errorf("%+v", err)
+			code := http.StatusInternalServerError
+			switch status.Code(err) {
+			case codes.OutOfRange, codes.Aborted:
+				code = http.StatusGone
+			}
+			http.Error(rw, status.Convert(err).Message(), code)
+			return
+		}
+		defer sub.Close()
+
+		rw.Header().Set("Content-Type", "text/event-stream")
+		rw.Header().Set("Cache-Control", "no-cache")
+		rw.WriteHeader(http.StatusOK)
+		flusher.Flush()
+
+		var buf bytes.Buffer
+		err = s.stream(ctx, sub, func(e *api.Event) error {
+			buf.Reset()
+			fmt.Fprintf(&buf, "id: %s-%d\nevent: %s\ndata: ", e.StreamId, e.Seq, e.Type)
+			if err := eventMarshaler.Marshal(&buf, e); err != nil {
+				return err
+			}
+			buf.WriteString("\n\n")
+			if _, err := buf.WriteTo(rw); err != nil {
+				return err
+			}
+			flusher.Flush()
+			return nil
+		}, func() error {
+			if _, err := io.WriteString(rw, ": ping\n\n"); err != nil {
+				return err
+			}
+			flusher.Flush()
+			return nil
+		})
+		if err != nil {
+			l.Errorf("%+v", err)
+			fmt.Fprintf(rw, "event: error\ndata: %s\n\n", status.Convert(err).Message())
+			flusher.Flush()
+		}
+	})
+}
+
+// WebSocketHandler returns HTTP handler streaming events over WebSocket as JSON text messages.
+// On error, the last message is a JSON object with "error" and "code" (gRPC status code) fields.
+func (s *EventsServer) WebSocketHandler() http.Handler {
+	return &websocket.Server{
+		// default handshake requires Origin header, so non-browser clients can't connect
+		Handshake: func(_ *websocket.Config, req *http.Request) error {
+			if err := s.checkOrigin(req); err != nil {
+				_, l := logger.Set(req.Context(), logger.MakeRequestID())
+				l.Warnf("Rejecting WebSocket connection: %s.", err)
+				return err
+			}
+			return nil
+		},
+
+		Handler: func(ws *websocket.Conn) {
+			ctx, cancel := context.WithCancel(ws.Request().Context())
+			defer cancel()
+			ctx, l := logger.Set(ctx, logger.MakeRequestID())
+
+			// clients are not expected to send anything; stop when connection is closed
+			go func() {
+				io.Copy(ioutil.Discard, ws) //nolint:errcheck
+				cancel()
+			}()
+
+			err := func() error {
+				streamID, sinceSeq, err := parseResumeToken(ws.Request())
+				if err != nil {
+					return err
+				}
+				sub, err := s.subscribe(streamID, sinceSeq)
+				if err != nil {
+					return err
+				}
+				defer sub.Close()
+
+				return s.stream(ctx, sub, func(e *api.Event) error {
+					b, err := eventMarshaler.MarshalToString(e)
+					if err != nil {
+						return err
+					}
+					return websocket.Message.Send(ws, b)
+				}, nil)
+			}()
+			if err != nil {
+				l.Errorf("%+v", err)
+				st := status.Convert(err)
+				websocket.JSON.Send(ws, map[string]interface{}{ //nolint:errcheck
+					"error": st.Message(),
+					"code":  st.Code(),
+				})
+			}
+			ws.Close()
+		},
+	}
+}
+
+// check interfaces
+var (
+	_ api.EventsServer = (*EventsServer)(nil)
+)
@@ -0,0 +1,104 @@
+// pmm-managed
+// Copyright (C) 2017 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package handlers
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/percona/pmm-managed/utils/tests"
+)
+
+func TestCheckOrigin(t *testing.T) {
+	for _, tc := range []struct {
+		name           string
+		origin         string
+		allowedOrigins []string
+		err            string
+	}{
+		{"NoOrigin", "", nil, ""},
+		{"SameHost", "https://pmm.example.com:8443", nil, ""},
+		{"SameHostCase", "https://PMM.example.com:8443", nil, ""},
+		{"OtherHost", "https://evil.example.com", nil, `Origin "https://evil.example.com" does not match Host "pmm.example.com:8443"`},
+		{"OtherPort", "https://pmm.example.com", nil, `Origin "https://pmm.example.com" does not match Host "pmm.example.com:8443"`},
+		{"Invalid", "https://%zz", nil, `invalid Origin "https://%zz": parse "https://%zz": invalid URL escape "%zz"`},
+		{"Allowed", "https://grafana.example.com", []string{"https://grafana.example.com"}, ""},
+		{"AllowedCase", "https://Grafana.example.com", []string{"https://grafana.example.com"}, ""},
+		{"NotAllowed", "https://evil.example.com", []string{"https://grafana.example.com"}, `Origin "https://evil.example.com" does not match Host "pmm.example.com:8443"`},
+		{"AllowedAny", "https://evil.example.com", []string{"https://grafana.example.com", "*"}, ""},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			s := &EventsServer{AllowedOrigins: tc.allowedOrigins}
+			req := httptest.NewRequest("GET", "https://pmm.example.com:8443/v0/events/ws", nil)
+			if tc.origin != "" {
+				req.Header.Set("Origin", tc.origin)
+			}
+
+			err := s.checkOrigin(req)
+			if tc.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tc.err)
+			}
+		})
+	}
+}
+
+func TestParseResumeToken(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		query       string
+		lastEventID string
+		streamID    string
+		sinceSeq    uint64
+		err         string
+	}{
+		{"Empty", "", "", "", 0, ""},
+		{"Query", "stream_id=abc&since_seq=42", "", "abc", 42, ""},
+		{"QueryOverHeader", "stream_id=abc&since_seq=42", "def-43", "abc", 42, ""},
+		{"QueryInvalidSeq", "stream_id=abc&since_seq=x", "", "", 0, `Invalid since_seq: "x".`},
+		{"QueryNegativeSeq", "stream_id=abc&since_seq=-1", "", "", 0, `Invalid since_seq: "-1".`},
+		{"Header", "", "abc-42", "abc", 42, ""},
+		{"HeaderStreamIDWithDashes", "", "0b1d2c3e-4f5a-6b7c-8d9e-0f1a2b3c4d5e-42", "0b1d2c3e-4f5a-6b7c-8d9e-0f1a2b3c4d5e", 42, ""},
+		{"HeaderNoDash", "", "42", "", 0, `Invalid Last-Event-ID: "42".`},
+		{"HeaderNoSeq", "", "abc-", "", 0, `Invalid Last-Event-ID: "abc-".`},
+		{"HeaderNoStreamID", "", "-42", "", 0, `Invalid Last-Event-ID: "-42".`},
+		{"HeaderInvalidSeq", "", "abc-def", "", 0, `Invalid since_seq: "def".`},
+		{"HeaderInvalidSeqWithDashes", "", "abc-4-2x", "", 0, `Invalid since_seq: "2x".`},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest("GET", "/v0/events/sse?"+tc.query, nil)
+			if tc.lastEventID != "" {
+				req.Header.Set("Last-Event-ID", tc.lastEventID)
+			}
+
+			streamID, sinceSeq, err := parseResumeToken(req)
+			if tc.err != "" {
+				tests.AssertGRPCError(t, status.New(codes.InvalidArgument, tc.err), err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.streamID, streamID)
+			assert.Equal(t, tc.sinceSeq, sinceSeq)
+		})
+	}
+}
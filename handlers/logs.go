@@ -17,13 +17,17 @@
 package handlers
 
 import (
+	"regexp"
 	"strings"
-	"time"
 
+	"github.com/golang/protobuf/ptypes"
 	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/percona/pmm-managed/api"
 	"github.com/percona/pmm-managed/services/logs"
+	"github.com/percona/pmm-managed/utils/logger"
 )
 
 type LogsServer struct {
@@ -36,20 +40,142 @@ func (s *LogsServer) All(ctx context.Context, req *api.LogsAllRequest) (*api.Log
 		Logs: make(map[string]*api.Log),
 	}
 
-	// fail-safe
-	ctx, cancel := context.WithTimeout(ctx, time.Second)
-	defer cancel()
-
 	for _, f := range s.Logs.Files(ctx) {
 		lines := strings.Split(string(f.Data), "\n")
 		resp.Logs[f.Name] = &api.Log{
-			Lines: lines,
+			Lines:     lines,
+			Truncated: f.Truncated,
+		}
+		if f.Err != nil {
+			resp.Logs[f.Name].Error = f.Err.Error()
 		}
 	}
 
 	return &resp, nil
 }
 
+// Tail streams log lines, optionally following log.
+func (s *LogsServer) Tail(req *api.LogsTailRequest, stream api.Logs_TailServer) error {
+	ctx, _ := logger.Set(stream.Context(), logger.MakeRequestID())
+
+	if req.Name == "" {
+		return status.Error(codes.InvalidArgument, "Log name is not given.")
+	}
+	opts := &logs.TailOptions{
+		Follow: req.Follow,
+		Lines:  int(req.Lines),
+	}
+	if req.Since != nil {
+		since, err := ptypes.Timestamp(req.Since)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "Invalid since: %s.", err)
+		}
+		opts.Since = since
+	}
+	if req.Filter != "" {
+		filter, err := regexp.Compile(req.Filter)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "Invalid filter: %s.", err)
+		}
+		opts.Filter = filter
+	}
+
+	err := s.Logs.Tail(ctx, req.Name, opts, func(lines []string) error {
+		return stream.Send(&api.LogsTailResponse{
+			Lines: lines,
+		})
+	})
+	if err != nil {
+		logger.Get(ctx).Errorf("%+v", err)
+	}
+	return err
+}
+
+// bundleChunkSize is a maximum size of .zip archive chunk in a single Bundle response message,
+// well below default 4 MB gRPC message size limit.
+const bundleChunkSize = 1024 * 1024
+
+// chunkWriter calls send for each full chunk of written data; remaining data is kept in buf.
+type chunkWriter struct {
+	buf  []byte
+	send func(chunk []byte) error
+}
+
+func (w *chunkWriter) Write(p []byte) (int, error) {
+	w.buf = append(w.buf, p...)
+	for len(w.buf) >= bundleChunkSize {
+		if err := w.send(w.buf[:bundleChunkSize]); err != nil {
+			return 0, err
+		}
+		w.buf = append(w.buf[:0], w.buf[bundleChunkSize:]...)
+	}
+	return len(p), nil
+}
+
+// Bundle streams .zip archive with selected logs in chunks.
+func (s *LogsServer) Bundle(req *api.LogsBundleRequest, stream api.Logs_BundleServer) error {
+	ctx, _ := logger.Set(stream.Context(), logger.MakeRequestID())
+
+	opts := &logs.BundleOptions{
+		Sources: req.Sources,
+		Lines:   int(req.Lines),
+	}
+	if req.Since != nil {
+		since, err := ptypes.Timestamp(req.Since)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "Invalid since: %s.", err)
+		}
+		opts.Since = since
+	}
+	if req.Until != nil {
+		until, err := ptypes.Timestamp(req.Until)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "Invalid until: %s.", err)
+		}
+		opts.Until = until
+	}
+	if !opts.Since.IsZero() && !opts.Until.IsZero() && opts.Until.Before(opts.Since) {
+		return status.Error(codes.InvalidArgument, "Until is before since.")
+	}
+	if req.Timeout != nil {
+		timeout, err := ptypes.Duration(req.Timeout)
+		if err != nil || timeout < 0 {
+			return status.Errorf(codes.InvalidArgument, "Invalid timeout: %s.", req.Timeout)
+		}
+		opts.Timeout = timeout
+	}
+
+	w := &chunkWriter{
+		send: func(chunk []byte) error {
+			return stream.Send(&api.LogsBundleResponse{
+				Zip: chunk,
+			})
+		},
+	}
+	files, err := s.Logs.Bundle(ctx, w, opts)
+	if err != nil {
+		logger.Get(ctx).Errorf("%+v", err)
+		return err
+	}
+
+	resp := &api.LogsBundleResponse{
+		Zip:     w.buf,
+		Sources: make([]*api.LogsBundleSource, len(files)),
+	}
+	for i, f := range files {
+		resp.Sources[i] = &api.LogsBundleSource{
+			Name:      f.Name,
+			Duration:  ptypes.DurationProto(f.Duration),
+			Lines:     uint32(f.Lines),
+			Truncated: f.Truncated,
+		}
+		if f.Err != nil {
+			resp.Sources[i].Error = f.Err.Error()
+		}
+	}
+	return stream.Send(resp)
+}
+
 // check interfaces
 var (
 	_ api.LogsServer = (*LogsServer)(nil)
@@ -49,6 +49,7 @@ func (s *MySQLServer) List(ctx context.Context, req *api.MySQLListRequest) (*api
 				Engine:        *db.Service.Engine,
 				EngineVersion: *db.Service.EngineVersion,
 			},
+			Health: convertAgentsHealth(db.Health),
 		})
 	}
 	return &resp, nil
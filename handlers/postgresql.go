@@ -49,6 +49,7 @@ func (s *PostgreSQLServer) List(ctx context.Context, req *api.PostgreSQLListRequ
 				Engine:        *db.Service.Engine,
 				EngineVersion: *db.Service.EngineVersion,
 			},
+			Health: convertAgentsHealth(db.Health),
 		})
 	}
 	return &resp, nil
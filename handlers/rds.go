@@ -73,6 +73,7 @@ func (s *RDSServer) List(ctx context.Context, req *api.RDSListRequest) (*api.RDS
 				Engine:        *db.Service.Engine,
 				EngineVersion: *db.Service.EngineVersion,
 			},
+			Health: convertAgentsHealth(db.Health),
 		})
 	}
 	return &resp, nil
@@ -21,18 +21,23 @@ import (
 
 	"github.com/percona/pmm-managed/api"
 	"github.com/percona/pmm-managed/services/prometheus"
+	"github.com/percona/pmm-managed/utils/interceptors"
 )
 
 type ScrapeConfigsServer struct {
 	Prometheus *prometheus.Service
 }
 
-func convertServiceScrapeConfig(cfg *prometheus.ScrapeConfig) *api.ScrapeConfig {
+// convertServiceScrapeConfig converts scrape config for API response.
+// Basic auth password is returned only to callers with admin role.
+func convertServiceScrapeConfig(ctx context.Context, cfg *prometheus.ScrapeConfig) *api.ScrapeConfig {
 	var basicAuth *api.BasicAuth
 	if cfg.BasicAuth != nil {
 		basicAuth = &api.BasicAuth{
 			Username: cfg.BasicAuth.Username,
-			Password: cfg.BasicAuth.Password,
+		}
+		if interceptors.IdentityFromContext(ctx).Role >= interceptors.RoleAdmin {
+			basicAuth.Password = cfg.BasicAuth.Password
 		}
 	}
 
@@ -132,7 +137,7 @@ func (s *ScrapeConfigsServer) List(ctx context.Context, req *api.ScrapeConfigsLi
 		ScrapeTargetsHealth: make([]*api.ScrapeTargetHealth, len(health)),
 	}
 	for i, cfg := range cfgs {
-		res.ScrapeConfigs[i] = convertServiceScrapeConfig(&cfg)
+		res.ScrapeConfigs[i] = convertServiceScrapeConfig(ctx, &cfg)
 	}
 	for i, h := range health {
 		res.ScrapeTargetsHealth[i] = convertServiceScrapeTargetHealth(&h)
@@ -148,7 +153,7 @@ func (s *ScrapeConfigsServer) Get(ctx context.Context, req *api.ScrapeConfigsGet
 		return nil, err
 	}
 	res := &api.ScrapeConfigsGetResponse{
-		ScrapeConfig:        convertServiceScrapeConfig(cfg),
+		ScrapeConfig:        convertServiceScrapeConfig(ctx, cfg),
 		ScrapeTargetsHealth: make([]*api.ScrapeTargetHealth, len(health)),
 	}
 	for i, h := range health {
@@ -0,0 +1,65 @@
+// pmm-managed
+// Copyright (C) 2017 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package handlers
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes"
+
+	"github.com/percona/pmm-managed/api"
+	"github.com/percona/pmm-managed/services/telemetry"
+	"github.com/percona/pmm-managed/utils/logger"
+)
+
+// TelemetryServer handles requests for telemetry settings.
+type TelemetryServer struct {
+	Telemetry *telemetry.Service
+}
+
+// GetSettings returns current telemetry settings.
+func (s *TelemetryServer) GetSettings(ctx context.Context, req *api.TelemetryGetSettingsRequest) (*api.TelemetryGetSettingsResponse, error) {
+	settings := s.Telemetry.Settings()
+	return &api.TelemetryGetSettingsResponse{
+		Enabled:       settings.Enabled,
+		DisabledByEnv: settings.DisabledByEnv,
+		Uuid:          settings.UUID,
+		Url:           settings.URL,
+		Interval:      ptypes.DurationProto(settings.Interval),
+	}, nil
+}
+
+// SetEnabled enables or disables telemetry without pmm-managed restart.
+func (s *TelemetryServer) SetEnabled(ctx context.Context, req *api.TelemetrySetEnabledRequest) (*api.TelemetrySetEnabledResponse, error) {
+	if err := s.Telemetry.SetEnabled(ctx, req.Enabled); err != nil {
+		logger.Get(ctx).Errorf("%+v", err)
+		return nil, err
+	}
+	return &api.TelemetrySetEnabledResponse{}, nil
+}
+
+// Preview returns telemetry payload.
+func (s *TelemetryServer) Preview(ctx context.Context, req *api.TelemetryPreviewRequest) (*api.TelemetryPreviewResponse, error) {
+	return &api.TelemetryPreviewResponse{
+		Payload: string(s.Telemetry.Preview(ctx)),
+	}, nil
+}
+
+// check interfaces
+var (
+	_ api.TelemetryServer = (*TelemetryServer)(nil)
+)
@@ -0,0 +1,144 @@
+// pmm-managed
+// Copyright (C) 2017 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package handlers
+
+import (
+	"github.com/golang/protobuf/ptypes"
+	"golang.org/x/net/context"
+
+	"github.com/percona/pmm-managed/api"
+	"github.com/percona/pmm-managed/models"
+	"github.com/percona/pmm-managed/services/webhooks"
+	"github.com/percona/pmm-managed/utils/logger"
+)
+
+// WebhooksServer handles requests for outbound webhooks management.
+type WebhooksServer struct {
+	Webhooks *webhooks.Service
+}
+
+// convertWebhook converts webhook without its secret.
+func convertWebhook(w *models.Webhook) (*api.Webhook, error) {
+	createdAt, err := ptypes.TimestampProto(w.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &api.Webhook{
+		Id:        w.ID,
+		Url:       w.URL,
+		Events:    w.EventTypes(),
+		CreatedAt: createdAt,
+	}, nil
+}
+
+// Create registers a new webhook.
+func (s *WebhooksServer) Create(ctx context.Context, req *api.WebhooksCreateRequest) (*api.WebhooksCreateResponse, error) {
+	webhook, err := s.Webhooks.Create(ctx, req.Url, req.Secret, req.Events)
+	if err != nil {
+		logger.Get(ctx).Errorf("%+v", err)
+		return nil, err
+	}
+
+	w, err := convertWebhook(webhook)
+	if err != nil {
+		logger.Get(ctx).Errorf("%+v", err)
+		return nil, err
+	}
+	return &api.WebhooksCreateResponse{
+		Webhook: w,
+	}, nil
+}
+
+// List returns all webhooks.
+func (s *WebhooksServer) List(ctx context.Context, req *api.WebhooksListRequest) (*api.WebhooksListResponse, error) {
+	res, err := s.Webhooks.List(ctx)
+	if err != nil {
+		logger.Get(ctx).Errorf("%+v", err)
+		return nil, err
+	}
+
+	var resp api.WebhooksListResponse
+	for i := range res {
+		w, err := convertWebhook(&res[i])
+		if err != nil {
+			logger.Get(ctx).Errorf("%+v", err)
+			return nil, err
+		}
+		resp.Webhooks = append(resp.Webhooks, w)
+	}
+	return &resp, nil
+}
+
+// Delete removes webhook and its dead letters.
+func (s *WebhooksServer) Delete(ctx context.Context, req *api.WebhooksDeleteRequest) (*api.WebhooksDeleteResponse, error) {
+	if err := s.Webhooks.Delete(ctx, req.Id); err != nil {
+		logger.Get(ctx).Errorf("%+v", err)
+		return nil, err
+	}
+	return &api.WebhooksDeleteResponse{}, nil
+}
+
+// Test synchronously sends a single test event to webhook, without retries.
+// Delivery errors are returned in response, not as gRPC errors.
+func (s *WebhooksServer) Test(ctx context.Context, req *api.WebhooksTestRequest) (*api.WebhooksTestResponse, error) {
+	res, err := s.Webhooks.Test(ctx, req.Id)
+	if err != nil {
+		logger.Get(ctx).Errorf("%+v", err)
+		return nil, err
+	}
+
+	resp := &api.WebhooksTestResponse{
+		StatusCode: uint32(res.StatusCode),
+	}
+	if res.Err != nil {
+		resp.Error = res.Err.Error()
+	}
+	return resp, nil
+}
+
+// ListDeadLetters returns events which were not delivered after all retries.
+func (s *WebhooksServer) ListDeadLetters(ctx context.Context, req *api.WebhooksListDeadLettersRequest) (*api.WebhooksListDeadLettersResponse, error) {
+	res, err := s.Webhooks.ListDeadLetters(ctx, req.WebhookId)
+	if err != nil {
+		logger.Get(ctx).Errorf("%+v", err)
+		return nil, err
+	}
+
+	var resp api.WebhooksListDeadLettersResponse
+	for _, l := range res {
+		createdAt, err := ptypes.TimestampProto(l.CreatedAt)
+		if err != nil {
+			logger.Get(ctx).Errorf("%+v", err)
+			return nil, err
+		}
+		resp.DeadLetters = append(resp.DeadLetters, &api.WebhookDeadLetter{
+			Id:        l.ID,
+			WebhookId: l.WebhookID,
+			CreatedAt: createdAt,
+			Event:     l.Event,
+			Payload:   l.Payload,
+			Attempts:  uint32(l.Attempts),
+			LastError: l.LastError,
+		})
+	}
+	return &resp, nil
+}
+
+// check interfaces
+var (
+	_ api.WebhooksServer = (*WebhooksServer)(nil)
+)
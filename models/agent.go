@@ -65,7 +65,7 @@ type MySQLdExporter struct {
 	RunsOnNodeID int32     `reform:"runs_on_node_id"`
 
 	ServiceUsername        *string `reform:"service_username"`
-	ServicePassword        *string `reform:"service_password"`
+	ServicePassword        *Secret `reform:"service_password"`
 	ListenPort             *uint16 `reform:"listen_port"`
 	MySQLDisableTablestats *bool   `reform:"mysql_disable_tablestats"`
 }
@@ -73,7 +73,7 @@ type MySQLdExporter struct {
 func (m *MySQLdExporter) DSN(service *MySQLService) string {
 	cfg := mysql.NewConfig()
 	cfg.User = *m.ServiceUsername
-	cfg.Passwd = *m.ServicePassword
+	cfg.Passwd = string(*m.ServicePassword)
 
 	cfg.Net = "tcp"
 	cfg.Addr = net.JoinHostPort(*service.Address, strconv.Itoa(int(*service.Port)))
@@ -95,7 +95,7 @@ type PostgresExporter struct {
 	RunsOnNodeID int32     `reform:"runs_on_node_id"`
 
 	ServiceUsername *string `reform:"service_username"`
-	ServicePassword *string `reform:"service_password"`
+	ServicePassword *Secret `reform:"service_password"`
 	ListenPort      *uint16 `reform:"listen_port"`
 }
 
@@ -108,7 +108,7 @@ func (p *PostgresExporter) DSN(service *PostgreSQLService) string {
 	address := net.JoinHostPort(*service.Address, strconv.Itoa(int(*service.Port)))
 	uri := url.URL{
 		Scheme:   "postgres",
-		User:     url.UserPassword(*p.ServiceUsername, *p.ServicePassword),
+		User:     url.UserPassword(*p.ServiceUsername, string(*p.ServicePassword)),
 		Host:     address,
 		Path:     "postgres",
 		RawQuery: q.Encode(),
@@ -132,7 +132,7 @@ type QanAgent struct {
 	RunsOnNodeID int32     `reform:"runs_on_node_id"`
 
 	ServiceUsername   *string `reform:"service_username"`
-	ServicePassword   *string `reform:"service_password"`
+	ServicePassword   *Secret `reform:"service_password"`
 	ListenPort        *uint16 `reform:"listen_port"`
 	QANDBInstanceUUID *string `reform:"qan_db_instance_uuid"` // MySQL instance UUID in QAN
 }
@@ -140,7 +140,7 @@ type QanAgent struct {
 func (q *QanAgent) DSN(service *MySQLService) string {
 	cfg := mysql.NewConfig()
 	cfg.User = *q.ServiceUsername
-	cfg.Passwd = *q.ServicePassword
+	cfg.Passwd = string(*q.ServicePassword)
 
 	cfg.Net = "tcp"
 	cfg.Addr = net.JoinHostPort(*service.Address, strconv.Itoa(int(*service.Port)))
@@ -31,7 +31,7 @@ type AgentNode struct {
 
 // AgentsForNodeID returns agents providing insights for a given node.
 func AgentsForNodeID(q *reform.Querier, nodeID int32) ([]Agent, error) {
-	agentNodes, err := q.SelectAllFrom(AgentNodeView, "WHERE node_id = ?", nodeID)
+	agentNodes, err := q.SelectAllFrom(AgentNodeView, "WHERE node_id = "+q.Placeholder(1), nodeID)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
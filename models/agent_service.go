@@ -31,7 +31,7 @@ type AgentService struct {
 
 // AgentsForServiceID returns agents providing insights for a given service.
 func AgentsForServiceID(q *reform.Querier, serviceID int32) ([]Agent, error) {
-	agentServices, err := q.SelectAllFrom(AgentServiceView, "WHERE service_id = ?", serviceID)
+	agentServices, err := q.SelectAllFrom(AgentServiceView, "WHERE service_id = "+q.Placeholder(1), serviceID)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
@@ -0,0 +1,107 @@
+// pmm-managed
+// Copyright (C) 2017 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package models
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"gopkg.in/reform.v1"
+)
+
+//go:generate reform
+
+// AuditEntry represents a single mutating API call recorded by interceptors.Audit.
+//
+//reform:audit_log
+type AuditEntry struct {
+	ID         int64         `reform:"id,pk"`
+	CreatedAt  time.Time     `reform:"created_at"`
+	RequestID  string        `reform:"request_id"`
+	Method     string        `reform:"method"`
+	Caller     string        `reform:"caller"`
+	AuthMethod string        `reform:"auth_method"`
+	Request    string        `reform:"request"` // JSON with redacted secrets
+	Code       string        `reform:"code"`
+	Error      string        `reform:"error"`
+	Duration   time.Duration `reform:"duration"`
+}
+
+// AuditFilter represents filters for FindAuditEntries. Zero values mean "no filter".
+type AuditFilter struct {
+	Since    time.Time
+	Until    time.Time
+	Method   string
+	Code     string
+	BeforeID int64 // for pagination: return only entries older than that
+	Limit    int
+}
+
+// FindAuditEntries returns audit log entries matching a given filter, from the newest to the oldest.
+func FindAuditEntries(q *reform.Querier, filter *AuditFilter) ([]AuditEntry, error) {
+	var conds []string
+	var args []interface{}
+	add := func(cond string, arg interface{}) {
+		args = append(args, arg)
+		conds = append(conds, cond+" "+q.Placeholder(len(args)))
+	}
+	if !filter.Since.IsZero() {
+		add("created_at >=", filter.Since.UTC())
+	}
+	if !filter.Until.IsZero() {
+		add("created_at <", filter.Until.UTC())
+	}
+	if filter.Method != "" {
+		add("method =", filter.Method)
+	}
+	if filter.Code != "" {
+		add("code =", filter.Code)
+	}
+	if filter.BeforeID != 0 {
+		add("id <", filter.BeforeID)
+	}
+
+	var tail string
+	if len(conds) != 0 {
+		tail = "WHERE " + strings.Join(conds, " AND ") + " "
+	}
+	tail += "ORDER BY id DESC"
+	if filter.Limit > 0 {
+		tail += fmt.Sprintf(" LIMIT %d", filter.Limit)
+	}
+
+	structs, err := q.SelectAllFrom(AuditEntryTable, tail, args...)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	entries := make([]AuditEntry, len(structs))
+	for i, str := range structs {
+		entries[i] = *str.(*AuditEntry)
+	}
+	return entries, nil
+}
+
+// PruneAuditEntries removes audit log entries created before a given time and returns their number.
+func PruneAuditEntries(q *reform.Querier, before time.Time) (int, error) {
+	n, err := q.DeleteFrom(AuditEntryTable, "WHERE created_at < "+q.Placeholder(1), before.UTC())
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+	return int(n), nil
+}
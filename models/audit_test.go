@@ -0,0 +1,91 @@
+// pmm-managed
+// Copyright (C) 2017 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package models_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/reform.v1"
+
+	"github.com/percona/pmm-managed/models"
+	"github.com/percona/pmm-managed/utils/tests"
+)
+
+func TestAuditEntries(t *testing.T) {
+	sqlDB := tests.OpenTestDB(t)
+	defer sqlDB.Close() //nolint:errcheck
+	db := reform.NewDB(sqlDB, tests.TestDBDialect(t), reform.NewPrintfLogger(t.Logf))
+
+	now := time.Now().UTC().Truncate(time.Second)
+	for i, e := range []models.AuditEntry{
+		{CreatedAt: now.Add(-3 * time.Hour), Method: "/api.MySQL/Add", Code: "OK"},
+		{CreatedAt: now.Add(-2 * time.Hour), Method: "/api.MySQL/Add", Code: "InvalidArgument", Error: "Invalid address."},
+		{CreatedAt: now.Add(-time.Hour), Method: "/api.MySQL/Remove", Code: "OK", Duration: time.Second},
+		{CreatedAt: now, Method: "/api.RDS/Add", Code: "OK"},
+	} {
+		e.RequestID = "request"
+		e.Caller = "anonymous"
+		e.Request = "{}"
+		require.NoError(t, db.Insert(&e), "%d", i)
+	}
+
+	find := func(filter *models.AuditFilter) []string {
+		entries, err := models.FindAuditEntries(db.Querier, filter)
+		require.NoError(t, err)
+		res := make([]string, len(entries))
+		for i, e := range entries {
+			res[i] = e.Method + " " + e.Code
+		}
+		return res
+	}
+
+	t.Run("All", func(t *testing.T) {
+		entries, err := models.FindAuditEntries(db.Querier, new(models.AuditFilter))
+		require.NoError(t, err)
+		require.Len(t, entries, 4)
+		assert.Equal(t, now, entries[0].CreatedAt)
+		assert.Equal(t, time.Second, entries[1].Duration)
+		assert.Equal(t, "Invalid address.", entries[2].Error)
+	})
+
+	t.Run("Filters", func(t *testing.T) {
+		assert.Equal(t, []string{"/api.MySQL/Add InvalidArgument", "/api.MySQL/Add OK"}, find(&models.AuditFilter{Method: "/api.MySQL/Add"}))
+		assert.Equal(t, []string{"/api.MySQL/Add InvalidArgument"}, find(&models.AuditFilter{Code: "InvalidArgument"}))
+		assert.Equal(t, []string{"/api.MySQL/Remove OK", "/api.MySQL/Add InvalidArgument"}, find(&models.AuditFilter{
+			Since: now.Add(-2 * time.Hour),
+			Until: now,
+		}))
+		assert.Empty(t, find(&models.AuditFilter{Method: "/api.RDS/Remove"}))
+	})
+
+	t.Run("Pagination", func(t *testing.T) {
+		entries, err := models.FindAuditEntries(db.Querier, &models.AuditFilter{Limit: 3})
+		require.NoError(t, err)
+		require.Len(t, entries, 3)
+		assert.Equal(t, []string{"/api.MySQL/Add OK"}, find(&models.AuditFilter{BeforeID: entries[2].ID, Limit: 3}))
+	})
+
+	t.Run("Prune", func(t *testing.T) {
+		n, err := models.PruneAuditEntries(db.Querier, now.Add(-90*time.Minute))
+		require.NoError(t, err)
+		assert.Equal(t, 2, n)
+		assert.Equal(t, []string{"/api.RDS/Add OK", "/api.MySQL/Remove OK"}, find(new(models.AuditFilter)))
+	})
+}
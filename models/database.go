@@ -17,157 +17,811 @@
 package models
 
 import (
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
 	"database/sql"
-	"fmt"
+	"encoding/hex"
+	"io/ioutil"
+	"net/url"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/go-sql-driver/mysql" // register SQL driver
 	"github.com/pkg/errors"
 	"gopkg.in/reform.v1"
 )
 
-// databaseSchema maps schema version from schema_migrations table (id column) to a slice of DDL queries.
+const (
+	// name of MySQL advisory lock and ID of PostgreSQL advisory lock used to prevent concurrent migrations
+	migrationsLockName = "pmm-managed-migrations"
+	migrationsLockID   = int64(0x706d6d2d6d616e) // "pmm-man"
+
+	// maximum time for waiting for another pmm-managed instance to finish migrations
+	migrationsLockTimeout = time.Minute
+)
+
+// Migration is a single reversible database schema migration.
+//
+// Migrations are plain SQL queries without access to the master key (they are also applied by -migrate-only
+// flag that does not load it), so they never change secrets. Rows are deliberately encrypted outside of
+// migrations by EncryptSecrets, called after them on startup (see encryptSecrets in main);
+// migration 5 only widens the columns for encrypted values.
+type Migration struct {
+	Version int
+	Up      []string // queries to migrate from Version-1 to Version
+	Down    []string // queries to migrate from Version to Version-1
+}
+
+// Checksum returns a checksum of migration queries.
+// It is stored in schema_migrations table to detect changes of already applied migrations.
+func (m *Migration) Checksum() string {
+	h := sha256.New()
+	for _, qs := range [][]string{m.Up, m.Down} {
+		for _, q := range qs {
+			h.Write([]byte(strings.TrimSpace(q))) //nolint:errcheck
+			h.Write([]byte{0})                    //nolint:errcheck
+		}
+		h.Write([]byte{1}) //nolint:errcheck
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// mysqlMigrations contains all MySQL schema migrations; migration for version N has index N-1.
+// See postgresqlMigrations and sqlite3Migrations for other databases.
 //
 // Initial AUTO_INCREMENT values are spaced to prevent programming errors, or at least make them more visible.
 // It does not imply that one can have at most 1000 nodes, etc.
-var databaseSchema = [][]string{
-	1: {
-		`CREATE TABLE schema_migrations (
-			id INT NOT NULL,
-			PRIMARY KEY (id)
-		)`,
-
-		`CREATE TABLE nodes (
-			id INT NOT NULL AUTO_INCREMENT,
-			type VARCHAR(255) NOT NULL,
-			name VARCHAR(255) NOT NULL,
-
-			region VARCHAR(255) NOT NULL DEFAULT '', -- NOT NULL for unique index below
-
-			PRIMARY KEY (id),
-			UNIQUE (type, name, region)
-		) AUTO_INCREMENT = 1`,
-
-		`INSERT INTO nodes (type, name) VALUES ('` + string(PMMServerNodeType) + `', 'PMM Server')`,
-
-		`CREATE TABLE services (
-			id INT NOT NULL AUTO_INCREMENT,
-			type VARCHAR(255) NOT NULL,
-			node_id INT NOT NULL,
-
-			aws_access_key VARCHAR(255),
-			aws_secret_key VARCHAR(255),
-			address VARCHAR(255),
-			port SMALLINT UNSIGNED,
-			engine VARCHAR(255),
-			engine_version VARCHAR(255),
-
-			PRIMARY KEY (id),
-			FOREIGN KEY (node_id) REFERENCES nodes (id)
-		) AUTO_INCREMENT = 1000`,
-
-		`CREATE TABLE agents (
-			id INT NOT NULL AUTO_INCREMENT,
-			type VARCHAR(255) NOT NULL,
-			runs_on_node_id INT NOT NULL,
-
-			service_username VARCHAR(255),
-			service_password VARCHAR(255),
-			listen_port SMALLINT UNSIGNED,
-			qan_db_instance_uuid VARCHAR(255),
-
-			PRIMARY KEY (id),
-			FOREIGN KEY (runs_on_node_id) REFERENCES nodes (id)
-		) AUTO_INCREMENT = 1000000`,
-
-		`CREATE TABLE agent_nodes (
-			agent_id INT NOT NULL,
-			node_id INT NOT NULL,
-			FOREIGN KEY (agent_id) REFERENCES agents (id),
-			FOREIGN KEY (node_id) REFERENCES nodes (id),
-			UNIQUE (agent_id, node_id)
-		)`,
-
-		`CREATE TABLE agent_services (
-			agent_id INT NOT NULL,
-			service_id INT NOT NULL,
-			FOREIGN KEY (agent_id) REFERENCES agents (id),
-			FOREIGN KEY (service_id) REFERENCES services (id),
-			UNIQUE (agent_id, service_id)
-		)`,
+//
+// Applied migrations should never be changed (that is detected by checksums), add a new one instead.
+var mysqlMigrations = []Migration{
+	{
+		Version: 1,
+		Up: []string{
+			`CREATE TABLE nodes (
+				id INT NOT NULL AUTO_INCREMENT,
+				type VARCHAR(255) NOT NULL,
+				name VARCHAR(255) NOT NULL,
+
+				region VARCHAR(255) NOT NULL DEFAULT '', -- NOT NULL for unique index below
+
+				PRIMARY KEY (id),
+				UNIQUE (type, name, region)
+			) AUTO_INCREMENT = 1`,
+
+			`INSERT INTO nodes (type, name) VALUES ('` + string(PMMServerNodeType) + `', 'PMM Server')`,
+
+			`CREATE TABLE services (
+				id INT NOT NULL AUTO_INCREMENT,
+				type VARCHAR(255) NOT NULL,
+				node_id INT NOT NULL,
+
+				aws_access_key VARCHAR(255),
+				aws_secret_key VARCHAR(255),
+				address VARCHAR(255),
+				port SMALLINT UNSIGNED,
+				engine VARCHAR(255),
+				engine_version VARCHAR(255),
+
+				PRIMARY KEY (id),
+				FOREIGN KEY (node_id) REFERENCES nodes (id)
+			) AUTO_INCREMENT = 1000`,
+
+			`CREATE TABLE agents (
+				id INT NOT NULL AUTO_INCREMENT,
+				type VARCHAR(255) NOT NULL,
+				runs_on_node_id INT NOT NULL,
+
+				service_username VARCHAR(255),
+				service_password VARCHAR(255),
+				listen_port SMALLINT UNSIGNED,
+				qan_db_instance_uuid VARCHAR(255),
+
+				PRIMARY KEY (id),
+				FOREIGN KEY (runs_on_node_id) REFERENCES nodes (id)
+			) AUTO_INCREMENT = 1000000`,
+
+			`CREATE TABLE agent_nodes (
+				agent_id INT NOT NULL,
+				node_id INT NOT NULL,
+				FOREIGN KEY (agent_id) REFERENCES agents (id),
+				FOREIGN KEY (node_id) REFERENCES nodes (id),
+				UNIQUE (agent_id, node_id)
+			)`,
+
+			`CREATE TABLE agent_services (
+				agent_id INT NOT NULL,
+				service_id INT NOT NULL,
+				FOREIGN KEY (agent_id) REFERENCES agents (id),
+				FOREIGN KEY (service_id) REFERENCES services (id),
+				UNIQUE (agent_id, service_id)
+			)`,
+		},
+		Down: []string{
+			`DROP TABLE agent_services`,
+			`DROP TABLE agent_nodes`,
+			`DROP TABLE agents`,
+			`DROP TABLE services`,
+			`DROP TABLE nodes`,
+		},
+	},
+
+	{
+		Version: 2,
+		Up: []string{
+			`ALTER TABLE nodes
+				ADD COLUMN aws_dbi_resource_id VARCHAR(255)
+			`,
+		},
+		Down: []string{
+			`ALTER TABLE nodes
+				DROP COLUMN aws_dbi_resource_id
+			`,
+		},
 	},
 
-	2: {
-		`ALTER TABLE nodes
-			ADD COLUMN aws_dbi_resource_id VARCHAR(255)
-		`,
+	{
+		Version: 3,
+		Up: []string{
+			`ALTER TABLE agents
+				ADD COLUMN mysql_disable_tablestats TINYINT(1)
+			`,
+		},
+		Down: []string{
+			`ALTER TABLE agents
+				DROP COLUMN mysql_disable_tablestats
+			`,
+		},
 	},
 
-	3: {
-		`ALTER TABLE agents
-			ADD COLUMN mysql_disable_tablestats TINYINT(1)
-		`,
+	{
+		Version: 4,
+		Up: []string{
+			`ALTER TABLE nodes
+				DROP COLUMN aws_dbi_resource_id
+			`,
+		},
+		Down: []string{
+			`ALTER TABLE nodes
+				ADD COLUMN aws_dbi_resource_id VARCHAR(255)
+			`,
+		},
 	},
 
-	4: {
-		`ALTER TABLE nodes
-			DROP COLUMN aws_dbi_resource_id
-		`,
+	// wider columns for encrypted secrets, see EncryptSecrets
+	{
+		Version: 5,
+		Up: []string{
+			`ALTER TABLE agents
+				MODIFY COLUMN service_password VARCHAR(1024)
+			`,
+			`ALTER TABLE services
+				MODIFY COLUMN aws_secret_key VARCHAR(1024)
+			`,
+		},
+		Down: []string{
+			`ALTER TABLE agents
+				MODIFY COLUMN service_password VARCHAR(255)
+			`,
+			`ALTER TABLE services
+				MODIFY COLUMN aws_secret_key VARCHAR(255)
+			`,
+		},
 	},
+
+	// allocated Agent listen ports, see ports.Registry
+	{
+		Version: 6,
+		Up: []string{
+			`CREATE TABLE ports (
+				port SMALLINT UNSIGNED NOT NULL,
+				created_at DATETIME NOT NULL,
+
+				PRIMARY KEY (port)
+			)`,
+
+			`INSERT INTO ports (port, created_at)
+				SELECT DISTINCT listen_port, CURRENT_TIMESTAMP FROM agents
+				WHERE type IN ('` + string(MySQLdExporterAgentType) + `', '` + string(PostgresExporterAgentType) + `') AND listen_port IS NOT NULL`,
+		},
+		Down: []string{
+			`DROP TABLE ports`,
+		},
+	},
+
+	// settings store, see kv.DB
+	{
+		Version: 7,
+		Up: []string{
+			`CREATE TABLE kv (
+				name VARCHAR(255) NOT NULL,
+				value MEDIUMBLOB NOT NULL,
+
+				PRIMARY KEY (name)
+			)`,
+		},
+		Down: []string{
+			`DROP TABLE kv`,
+		},
+	},
+
+	// audit log, see interceptors.Audit
+	{
+		Version: 8,
+		Up: []string{
+			`CREATE TABLE audit_log (
+				id BIGINT NOT NULL AUTO_INCREMENT,
+				created_at DATETIME NOT NULL,
+				request_id VARCHAR(255) NOT NULL,
+				method VARCHAR(255) NOT NULL,
+				caller VARCHAR(255) NOT NULL,
+				auth_method VARCHAR(255) NOT NULL,
+				request MEDIUMTEXT NOT NULL,
+				code VARCHAR(255) NOT NULL,
+				error TEXT NOT NULL,
+				duration BIGINT NOT NULL,
+
+				PRIMARY KEY (id),
+				INDEX (created_at)
+			)`,
+		},
+		Down: []string{
+			`DROP TABLE audit_log`,
+		},
+	},
+
+	// webhooks, see webhooks.Service
+	{
+		Version: 9,
+		Up: []string{
+			`CREATE TABLE webhooks (
+				id INT NOT NULL AUTO_INCREMENT,
+				url VARCHAR(2048) NOT NULL,
+				secret VARCHAR(1024) NOT NULL,
+				events VARCHAR(255) NOT NULL,
+				created_at DATETIME NOT NULL,
+
+				PRIMARY KEY (id)
+			)`,
+
+			`CREATE TABLE webhook_dead_letters (
+				id BIGINT NOT NULL AUTO_INCREMENT,
+				webhook_id INT NOT NULL,
+				created_at DATETIME NOT NULL,
+				event VARCHAR(255) NOT NULL,
+				payload MEDIUMTEXT NOT NULL,
+				attempts INT NOT NULL,
+				last_error TEXT NOT NULL,
+
+				PRIMARY KEY (id),
+				FOREIGN KEY (webhook_id) REFERENCES webhooks (id)
+			)`,
+		},
+		Down: []string{
+			`DROP TABLE webhook_dead_letters`,
+			`DROP TABLE webhooks`,
+		},
+	},
+}
+
+// LatestSchemaVersion returns the latest known database schema version.
+func LatestSchemaVersion() int {
+	return len(mysqlMigrations)
+}
+
+// migrationsFor returns migrations for given driver.
+func migrationsFor(driver string) []Migration {
+	switch driver {
+	case PostgreSQLDriver:
+		return postgresqlMigrations
+	case SQLite3Driver:
+		return sqlite3Migrations
+	default:
+		return mysqlMigrations
+	}
+}
+
+// DBConfig contains pmm-managed's own database connection parameters.
+type DBConfig struct {
+	Driver   string // MySQLDriver (default), PostgreSQLDriver or SQLite3Driver
+	Name     string // empty name connects without default database; database file path for SQLite
+	Username string
+	Password string
+
+	Address   string // host:port, used if Socket is empty; default depends on driver
+	Socket    string // Unix socket path for MySQL, or Unix socket directory for PostgreSQL
+	TLSCAFile string // CA certificates file; TLS is disabled if empty
+
+	MaxOpenConns    int           // 0 means unlimited
+	MaxIdleConns    int           // 0 means no idle connections
+	ConnMaxLifetime time.Duration // 0 means connections are reused forever
+
+	ConnectRetries int           // number of connection retries; 0 means fail immediately
+	ConnectBackoff time.Duration // initial delay between retries, doubled after each retry up to maxConnectBackoff
 }
 
-func OpenDB(name, username, password string, logf reform.Printf) (*sql.DB, error) {
+const (
+	// name of registered TLS configuration for MySQL driver
+	mysqlTLSConfigName = "pmm-managed"
+
+	// maximum delay between connection retries
+	maxConnectBackoff = 30 * time.Second
+
+	// how long SQLite waits for a write lock held by another connection
+	sqlite3BusyTimeout = 10 * time.Second
+)
+
+// mysqlConfig returns MySQL driver configuration for given parameters.
+func (c *DBConfig) mysqlConfig() (*mysql.Config, error) {
 	cfg := mysql.NewConfig()
-	cfg.User = username
-	cfg.Passwd = password
-	cfg.DBName = name
+	cfg.User = c.Username
+	cfg.Passwd = c.Password
+	cfg.DBName = c.Name
+
+	if c.Socket != "" {
+		cfg.Net = "unix"
+		cfg.Addr = c.Socket
+	} else {
+		cfg.Net = "tcp"
+		cfg.Addr = c.Address
+		if cfg.Addr == "" {
+			cfg.Addr = "127.0.0.1:3306"
+		}
+	}
+
+	if c.TLSCAFile != "" {
+		b, err := ioutil.ReadFile(c.TLSCAFile)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(b) {
+			return nil, errors.Errorf("no certificates found in %s", c.TLSCAFile)
+		}
+		// server name is set by driver from address
+		if err = mysql.RegisterTLSConfig(mysqlTLSConfigName, &tls.Config{RootCAs: pool}); err != nil {
+			return nil, errors.WithStack(err)
+		}
+		cfg.TLSConfig = mysqlTLSConfigName
+	}
 
-	cfg.Net = "tcp"
-	cfg.Addr = "127.0.0.1:3306"
+	cfg.Timeout = sqlDialTimeout
 
 	// required for reform
 	cfg.ClientFoundRows = true
 	cfg.ParseTime = true
 
-	dsn := cfg.FormatDSN()
-	db, err := sql.Open("mysql", dsn)
-	if err == nil {
-		db.SetMaxIdleConns(10)
-		db.SetMaxOpenConns(10)
-		db.SetConnMaxLifetime(0)
-		err = db.Ping()
+	return cfg, nil
+}
+
+// postgresqlDSN returns PostgreSQL DSN for given parameters.
+func (c *DBConfig) postgresqlDSN() string {
+	q := make(url.Values)
+	q.Set("connect_timeout", strconv.Itoa(int(sqlDialTimeout.Seconds())))
+	if c.TLSCAFile != "" {
+		q.Set("sslmode", "verify-full")
+		q.Set("sslrootcert", c.TLSCAFile)
+	} else {
+		q.Set("sslmode", "disable")
+	}
+
+	name := c.Name
+	if name == "" {
+		name = "postgres" // there is always a database, but we don't use it
+	}
+	u := url.URL{
+		Scheme: "postgres",
+		User:   url.UserPassword(c.Username, c.Password),
+		Path:   "/" + name,
+	}
+	if c.Socket != "" {
+		q.Set("host", c.Socket)
+	} else {
+		u.Host = c.Address
+		if u.Host == "" {
+			u.Host = "127.0.0.1:5432"
+		}
 	}
+	u.RawQuery = q.Encode()
+	return u.String()
+}
+
+// sqlite3DSN returns SQLite DSN for given parameters.
+// Only Name is used; other parameters are ignored.
+func (c *DBConfig) sqlite3DSN() (string, error) {
+	if c.Name == "" {
+		return "", errors.New("database file path is required for SQLite")
+	}
+
+	q := make(url.Values)
+	q.Set("_foreign_keys", "1")
+	q.Set("_journal_mode", "WAL")                                                // readers do not block writer
+	q.Set("_busy_timeout", strconv.Itoa(int(sqlite3BusyTimeout.Seconds()*1000))) // in milliseconds
+	q.Set("_txlock", "immediate")                                                // prevent deadlocks between transactions
+	return "file:" + c.Name + "?" + q.Encode(), nil
+}
+
+// OpenDB connects to MySQL or PostgreSQL database, or opens SQLite database file,
+// retrying with backoff if it is not available yet.
+// It does not apply migrations, see MigrateUp.
+func OpenDB(ctx context.Context, config *DBConfig, logf reform.Printf) (*sql.DB, error) {
+	var driverName, dsn string
+	switch config.Driver {
+	case "", MySQLDriver:
+		cfg, err := config.mysqlConfig()
+		if err != nil {
+			return nil, err
+		}
+		driverName, dsn = "mysql", cfg.FormatDSN()
+	case PostgreSQLDriver:
+		driverName, dsn = "postgres", config.postgresqlDSN()
+	case SQLite3Driver:
+		var err error
+		if dsn, err = config.sqlite3DSN(); err != nil {
+			return nil, err
+		}
+		driverName = "sqlite3"
+	default:
+		return nil, errors.Errorf("unexpected database driver %q", config.Driver)
+	}
+
+	db, err := sql.Open(driverName, dsn)
 	if err != nil {
-		return nil, errors.Wrap(err, "Failed to connect to MySQL")
+		return nil, errors.Wrap(err, "Failed to connect to database")
 	}
+	db.SetMaxIdleConns(config.MaxIdleConns)
+	db.SetMaxOpenConns(config.MaxOpenConns)
+	db.SetConnMaxLifetime(config.ConnMaxLifetime)
 
-	if name == "" {
-		return db, nil
+	backoff := config.ConnectBackoff
+	for retry := 0; ; retry++ {
+		if err = db.PingContext(ctx); err == nil {
+			return db, nil
+		}
+		if retry >= config.ConnectRetries || ctx.Err() != nil {
+			break
+		}
+
+		logf("Failed to connect to database (%s), retrying in %s (%d/%d) ...", err, backoff, retry+1, config.ConnectRetries)
+		select {
+		case <-ctx.Done():
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+		if backoff > maxConnectBackoff {
+			backoff = maxConnectBackoff
+		}
 	}
 
-	latestVersion := len(databaseSchema) - 1 // skip item 0
-	var currentVersion int
-	err = db.QueryRow("SELECT id FROM schema_migrations ORDER BY id DESC LIMIT 1").Scan(&currentVersion)
-	if myErr, ok := err.(*mysql.MySQLError); ok && myErr.Number == 0x47a { // 1046 table doesn't exist
-		err = nil
+	db.Close() //nolint:errcheck
+	return nil, errors.Wrap(err, "Failed to connect to database")
+}
+
+// AppliedMigration represents a single row of schema_migrations table.
+type AppliedMigration struct {
+	Version   int
+	Checksum  string
+	AppliedAt *time.Time // nil for dirty migrations and migrations applied by old versions
+	Dirty     bool       // true if migration failed halfway
+}
+
+// SchemaStatus represents database schema migrations status.
+type SchemaStatus struct {
+	CurrentVersion int
+	LatestVersion  int
+	Applied        []AppliedMigration
+}
+
+// migrator applies migrations using a single database connection.
+type migrator struct {
+	conn       *sql.Conn
+	driver     string
+	dialect    reform.Dialect
+	migrations []Migration
+	logf       reform.Printf
+}
+
+// newMigrator returns migrator with a new connection; it should be closed by the caller.
+func newMigrator(ctx context.Context, db *sql.DB, logf reform.Printf) (*migrator, error) {
+	driver, err := driverOf(db)
+	if err != nil {
+		return nil, err
+	}
+	dialect, err := ReformDialect(driver)
+	if err != nil {
+		return nil, err
+	}
+
+	// advisory lock and all queries should use the same connection
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return &migrator{
+		conn:       conn,
+		driver:     driver,
+		dialect:    dialect,
+		migrations: migrationsFor(driver),
+		logf:       logf,
+	}, nil
+}
+
+// GetSchemaStatus returns database schema migrations status.
+func GetSchemaStatus(ctx context.Context, db *sql.DB) (*SchemaStatus, error) {
+	m, err := newMigrator(ctx, db, func(string, ...interface{}) {})
+	if err != nil {
+		return nil, err
 	}
+	defer m.conn.Close()
+
+	if err = m.ensureMigrationsTable(ctx); err != nil {
+		return nil, err
+	}
+	applied, err := m.getAppliedMigrations(ctx)
 	if err != nil {
 		return nil, err
 	}
-	logf("Current database schema version: %d. Latest version: %d.", currentVersion, latestVersion)
-
-	for version := currentVersion + 1; version <= latestVersion; version++ {
-		logf("Migrating database to schema version %d ...", version)
-		queries := databaseSchema[version]
-		queries = append(queries, fmt.Sprintf(`INSERT INTO schema_migrations (id) VALUES (%d)`, version))
-		for _, q := range queries {
-			q = strings.TrimSpace(q)
-			logf("\n%s\n", q)
-			if _, err = db.Exec(q); err != nil {
-				return nil, errors.Wrapf(err, "Failed to execute\n%s", q)
+	res := &SchemaStatus{
+		LatestVersion: len(m.migrations),
+		Applied:       applied,
+	}
+	if len(applied) != 0 {
+		res.CurrentVersion = applied[len(applied)-1].Version
+	}
+	return res, nil
+}
+
+// MigrateUp applies all not yet applied migrations.
+func MigrateUp(ctx context.Context, db *sql.DB, logf reform.Printf) error {
+	return migrate(ctx, db, LatestSchemaVersion(), false, logf)
+}
+
+// MigrateDown reverses applied migrations down to a given version (0 reverses all migrations).
+func MigrateDown(ctx context.Context, db *sql.DB, version int, logf reform.Printf) error {
+	if version < 0 {
+		return errors.Errorf("invalid target schema version %d", version)
+	}
+	return migrate(ctx, db, version, true, logf)
+}
+
+// migrate migrates database schema up or down to a given version under advisory lock.
+func migrate(ctx context.Context, db *sql.DB, target int, down bool, logf reform.Printf) error {
+	m, err := newMigrator(ctx, db, logf)
+	if err != nil {
+		return err
+	}
+	defer m.conn.Close()
+
+	if err = m.lock(ctx); err != nil {
+		return err
+	}
+	defer func() {
+		if e := m.unlock(); e != nil {
+			logf("Failed to release migrations lock: %s.", e)
+		}
+	}()
+
+	if err = m.ensureMigrationsTable(ctx); err != nil {
+		return err
+	}
+	applied, err := m.getAppliedMigrations(ctx)
+	if err != nil {
+		return err
+	}
+	if err = m.verifyAppliedMigrations(ctx, applied); err != nil {
+		return err
+	}
+
+	var current int
+	if len(applied) != 0 {
+		current = applied[len(applied)-1].Version
+	}
+	logf("Current database schema version: %d. Latest version: %d. Target version: %d.", current, len(m.migrations), target)
+	if down && target > current {
+		return errors.Errorf("can't migrate down to schema version %d: current version is %d", target, current)
+	}
+
+	for version := current + 1; version <= target; version++ {
+		logf("Migrating database up to schema version %d ...", version)
+		if err = m.apply(ctx, &m.migrations[version-1], true); err != nil {
+			return err
+		}
+	}
+	for version := current; version > target; version-- {
+		logf("Migrating database down to schema version %d ...", version-1)
+		if err = m.apply(ctx, &m.migrations[version-1], false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// query replaces ? in query with dialect's placeholders.
+func (m *migrator) query(q string) string {
+	parts := strings.Split(q, "?")
+	for i := 1; i < len(parts); i++ {
+		parts[i] = m.dialect.Placeholder(i) + parts[i]
+	}
+	return strings.Join(parts, "")
+}
+
+func (m *migrator) lock(ctx context.Context) error {
+	switch m.driver {
+	case SQLite3Driver:
+		// embedded database file is used by a single pmm-managed process
+		return nil
+
+	case PostgreSQLDriver:
+		// poll instead of blocking pg_advisory_lock to respect timeout
+		deadline := time.Now().Add(migrationsLockTimeout)
+		for {
+			var locked bool
+			if err := m.conn.QueryRowContext(ctx, m.query("SELECT pg_try_advisory_lock(?)"), migrationsLockID).Scan(&locked); err != nil {
+				return errors.WithStack(err)
+			}
+			if locked {
+				return nil
+			}
+			if time.Now().After(deadline) {
+				break
+			}
+			select {
+			case <-ctx.Done():
+				return errors.WithStack(ctx.Err())
+			case <-time.After(time.Second):
+			}
+		}
+
+	default:
+		var res sql.NullInt64
+		q := m.query("SELECT GET_LOCK(?, ?)")
+		if err := m.conn.QueryRowContext(ctx, q, migrationsLockName, int(migrationsLockTimeout.Seconds())).Scan(&res); err != nil {
+			return errors.WithStack(err)
+		}
+		if res.Valid && res.Int64 == 1 {
+			return nil
+		}
+	}
+
+	return errors.Errorf("failed to acquire migrations lock in %s: other pmm-managed instance is migrating database", migrationsLockTimeout)
+}
+
+func (m *migrator) unlock() error {
+	q, arg := "SELECT RELEASE_LOCK(?)", interface{}(migrationsLockName)
+	switch m.driver {
+	case SQLite3Driver:
+		return nil
+	case PostgreSQLDriver:
+		q, arg = "SELECT pg_advisory_unlock(?)", migrationsLockID
+	}
+	var res sql.NullBool
+	err := m.conn.QueryRowContext(context.Background(), m.query(q), arg).Scan(&res)
+	return errors.WithStack(err)
+}
+
+// ensureMigrationsTable creates schema_migrations table, or adds new columns to the table created by old versions.
+func (m *migrator) ensureMigrationsTable(ctx context.Context) error {
+	q := `CREATE TABLE IF NOT EXISTS schema_migrations (
+		id INTEGER NOT NULL,
+		checksum VARCHAR(64) NOT NULL DEFAULT '',
+		applied_at TIMESTAMP NULL,
+		dirty BOOLEAN NOT NULL DEFAULT FALSE,
+		PRIMARY KEY (id)
+	)`
+	if _, err := m.conn.ExecContext(ctx, q); err != nil {
+		return errors.WithStack(err)
+	}
+
+	// old versions supported only MySQL
+	if m.driver != MySQLDriver {
+		return nil
+	}
+	var count int
+	q = `SELECT COUNT(*) FROM information_schema.columns
+		WHERE table_schema = DATABASE() AND table_name = 'schema_migrations' AND column_name = 'checksum'`
+	if err := m.conn.QueryRowContext(ctx, q).Scan(&count); err != nil {
+		return errors.WithStack(err)
+	}
+	if count != 0 {
+		return nil
+	}
+	q = `ALTER TABLE schema_migrations
+		ADD COLUMN checksum VARCHAR(64) NOT NULL DEFAULT '',
+		ADD COLUMN applied_at TIMESTAMP NULL,
+		ADD COLUMN dirty BOOLEAN NOT NULL DEFAULT FALSE`
+	_, err := m.conn.ExecContext(ctx, q)
+	return errors.WithStack(err)
+}
+
+func (m *migrator) getAppliedMigrations(ctx context.Context) ([]AppliedMigration, error) {
+	rows, err := m.conn.QueryContext(ctx, "SELECT id, checksum, applied_at, dirty FROM schema_migrations ORDER BY id")
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer rows.Close()
+
+	var res []AppliedMigration
+	for rows.Next() {
+		var am AppliedMigration
+		if err = rows.Scan(&am.Version, &am.Checksum, &am.AppliedAt, &am.Dirty); err != nil {
+			return nil, errors.WithStack(err)
+		}
+		res = append(res, am)
+	}
+	return res, errors.WithStack(rows.Err())
+}
+
+// verifyAppliedMigrations checks that applied migrations are known, not dirty, and not changed.
+// Migrations applied by old versions without checksums get them.
+func (m *migrator) verifyAppliedMigrations(ctx context.Context, applied []AppliedMigration) error {
+	for i, am := range applied {
+		if am.Version != i+1 {
+			return errors.Errorf("database schema version %d is missing", i+1)
+		}
+		if am.Version > len(m.migrations) {
+			return errors.Errorf("database schema version %d is newer than the latest known version %d", am.Version, len(m.migrations))
+		}
+		if am.Dirty {
+			return errors.Errorf("database schema version %d is dirty: migration failed halfway; "+
+				"fix schema manually, then update or delete that row in schema_migrations table", am.Version)
+		}
+
+		checksum := m.migrations[am.Version-1].Checksum()
+		if am.Checksum == "" {
+			m.logf("Storing checksum for schema version %d.", am.Version)
+			q := m.query("UPDATE schema_migrations SET checksum = ? WHERE id = ?")
+			if _, err := m.conn.ExecContext(ctx, q, checksum, am.Version); err != nil {
+				return errors.WithStack(err)
 			}
+			continue
+		}
+		if am.Checksum != checksum {
+			return errors.Errorf("database schema version %d checksum mismatch: migration was changed after it was applied", am.Version)
 		}
 	}
+	return nil
+}
+
+// apply applies migration up or down in a transaction.
+// Migration is marked as dirty first, so a failure is recorded
+// even if the database does not support transactional DDL (like MySQL).
+func (m *migrator) apply(ctx context.Context, migration *Migration, up bool) error {
+	queries := migration.Down
+	mark := "UPDATE schema_migrations SET dirty = ?, applied_at = NULL WHERE id = ?"
+	markArgs := []interface{}{true, migration.Version}
+	if up {
+		queries = migration.Up
+		mark = "INSERT INTO schema_migrations (id, checksum, dirty) VALUES (?, ?, ?)"
+		markArgs = []interface{}{migration.Version, migration.Checksum(), true}
+	}
+	if _, err := m.conn.ExecContext(ctx, m.query(mark), markArgs...); err != nil {
+		return errors.WithStack(err)
+	}
 
-	return db, nil
+	tx, err := m.conn.BeginTx(ctx, nil)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	for _, q := range queries {
+		q = strings.TrimSpace(q)
+		m.logf("\n%s\n", q)
+		if _, err = tx.ExecContext(ctx, q); err != nil {
+			tx.Rollback() //nolint:errcheck
+			return errors.Wrapf(err, "Failed to execute\n%s", q)
+		}
+	}
+	if err = tx.Commit(); err != nil {
+		return errors.WithStack(err)
+	}
+
+	done := "DELETE FROM schema_migrations WHERE id = ?"
+	doneArgs := []interface{}{migration.Version}
+	if up {
+		done = "UPDATE schema_migrations SET dirty = ?, applied_at = ? WHERE id = ?"
+		doneArgs = []interface{}{false, time.Now().UTC(), migration.Version}
+	}
+	_, err = m.conn.ExecContext(ctx, m.query(done), doneArgs...)
+	return errors.WithStack(err)
 }
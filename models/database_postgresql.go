@@ -0,0 +1,255 @@
+// pmm-managed
+// Copyright (C) 2017 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package models
+
+// postgresqlMigrations contains all PostgreSQL schema migrations; migration for version N has index N-1.
+// They should produce the same schema as mysqlMigrations for the same version.
+//
+// Initial sequence values are spaced like AUTO_INCREMENT values for MySQL.
+// PostgreSQL does not have unsigned types, so INTEGER is used for ports.
+var postgresqlMigrations = []Migration{
+	{
+		Version: 1,
+		Up: []string{
+			`CREATE TABLE nodes (
+				id SERIAL NOT NULL,
+				type VARCHAR(255) NOT NULL,
+				name VARCHAR(255) NOT NULL,
+
+				region VARCHAR(255) NOT NULL DEFAULT '', -- NOT NULL for unique index below
+
+				PRIMARY KEY (id),
+				UNIQUE (type, name, region)
+			)`,
+
+			`INSERT INTO nodes (type, name) VALUES ('` + string(PMMServerNodeType) + `', 'PMM Server')`,
+
+			`CREATE TABLE services (
+				id SERIAL NOT NULL,
+				type VARCHAR(255) NOT NULL,
+				node_id INTEGER NOT NULL,
+
+				aws_access_key VARCHAR(255),
+				aws_secret_key VARCHAR(255),
+				address VARCHAR(255),
+				port INTEGER,
+				engine VARCHAR(255),
+				engine_version VARCHAR(255),
+
+				PRIMARY KEY (id),
+				FOREIGN KEY (node_id) REFERENCES nodes (id)
+			)`,
+			`ALTER SEQUENCE services_id_seq RESTART WITH 1000`,
+
+			`CREATE TABLE agents (
+				id SERIAL NOT NULL,
+				type VARCHAR(255) NOT NULL,
+				runs_on_node_id INTEGER NOT NULL,
+
+				service_username VARCHAR(255),
+				service_password VARCHAR(255),
+				listen_port INTEGER,
+				qan_db_instance_uuid VARCHAR(255),
+
+				PRIMARY KEY (id),
+				FOREIGN KEY (runs_on_node_id) REFERENCES nodes (id)
+			)`,
+			`ALTER SEQUENCE agents_id_seq RESTART WITH 1000000`,
+
+			`CREATE TABLE agent_nodes (
+				agent_id INTEGER NOT NULL,
+				node_id INTEGER NOT NULL,
+				FOREIGN KEY (agent_id) REFERENCES agents (id),
+				FOREIGN KEY (node_id) REFERENCES nodes (id),
+				UNIQUE (agent_id, node_id)
+			)`,
+
+			`CREATE TABLE agent_services (
+				agent_id INTEGER NOT NULL,
+				service_id INTEGER NOT NULL,
+				FOREIGN KEY (agent_id) REFERENCES agents (id),
+				FOREIGN KEY (service_id) REFERENCES services (id),
+				UNIQUE (agent_id, service_id)
+			)`,
+		},
+		Down: []string{
+			`DROP TABLE agent_services`,
+			`DROP TABLE agent_nodes`,
+			`DROP TABLE agents`,
+			`DROP TABLE services`,
+			`DROP TABLE nodes`,
+		},
+	},
+
+	{
+		Version: 2,
+		Up: []string{
+			`ALTER TABLE nodes
+				ADD COLUMN aws_dbi_resource_id VARCHAR(255)
+			`,
+		},
+		Down: []string{
+			`ALTER TABLE nodes
+				DROP COLUMN aws_dbi_resource_id
+			`,
+		},
+	},
+
+	{
+		Version: 3,
+		Up: []string{
+			`ALTER TABLE agents
+				ADD COLUMN mysql_disable_tablestats BOOLEAN
+			`,
+		},
+		Down: []string{
+			`ALTER TABLE agents
+				DROP COLUMN mysql_disable_tablestats
+			`,
+		},
+	},
+
+	{
+		Version: 4,
+		Up: []string{
+			`ALTER TABLE nodes
+				DROP COLUMN aws_dbi_resource_id
+			`,
+		},
+		Down: []string{
+			`ALTER TABLE nodes
+				ADD COLUMN aws_dbi_resource_id VARCHAR(255)
+			`,
+		},
+	},
+
+	// wider columns for encrypted secrets, see EncryptSecrets
+	{
+		Version: 5,
+		Up: []string{
+			`ALTER TABLE agents
+				ALTER COLUMN service_password TYPE VARCHAR(1024)
+			`,
+			`ALTER TABLE services
+				ALTER COLUMN aws_secret_key TYPE VARCHAR(1024)
+			`,
+		},
+		Down: []string{
+			`ALTER TABLE agents
+				ALTER COLUMN service_password TYPE VARCHAR(255)
+			`,
+			`ALTER TABLE services
+				ALTER COLUMN aws_secret_key TYPE VARCHAR(255)
+			`,
+		},
+	},
+
+	// allocated Agent listen ports, see ports.Registry
+	{
+		Version: 6,
+		Up: []string{
+			`CREATE TABLE ports (
+				port INTEGER NOT NULL,
+				created_at TIMESTAMP NOT NULL,
+
+				PRIMARY KEY (port)
+			)`,
+
+			`INSERT INTO ports (port, created_at)
+				SELECT DISTINCT listen_port, CURRENT_TIMESTAMP FROM agents
+				WHERE type IN ('` + string(MySQLdExporterAgentType) + `', '` + string(PostgresExporterAgentType) + `') AND listen_port IS NOT NULL`,
+		},
+		Down: []string{
+			`DROP TABLE ports`,
+		},
+	},
+
+	// settings store, see kv.DB
+	{
+		Version: 7,
+		Up: []string{
+			`CREATE TABLE kv (
+				name VARCHAR(255) NOT NULL,
+				value BYTEA NOT NULL,
+
+				PRIMARY KEY (name)
+			)`,
+		},
+		Down: []string{
+			`DROP TABLE kv`,
+		},
+	},
+
+	// audit log, see interceptors.Audit
+	{
+		Version: 8,
+		Up: []string{
+			`CREATE TABLE audit_log (
+				id BIGSERIAL NOT NULL,
+				created_at TIMESTAMP NOT NULL,
+				request_id VARCHAR(255) NOT NULL,
+				method VARCHAR(255) NOT NULL,
+				caller VARCHAR(255) NOT NULL,
+				auth_method VARCHAR(255) NOT NULL,
+				request TEXT NOT NULL,
+				code VARCHAR(255) NOT NULL,
+				error TEXT NOT NULL,
+				duration BIGINT NOT NULL,
+
+				PRIMARY KEY (id)
+			)`,
+
+			`CREATE INDEX audit_log_created_at ON audit_log (created_at)`,
+		},
+		Down: []string{
+			`DROP TABLE audit_log`,
+		},
+	},
+
+	// webhooks, see webhooks.Service
+	{
+		Version: 9,
+		Up: []string{
+			`CREATE TABLE webhooks (
+				id SERIAL NOT NULL,
+				url VARCHAR(2048) NOT NULL,
+				secret VARCHAR(1024) NOT NULL,
+				events VARCHAR(255) NOT NULL,
+				created_at TIMESTAMP NOT NULL,
+
+				PRIMARY KEY (id)
+			)`,
+
+			`CREATE TABLE webhook_dead_letters (
+				id BIGSERIAL NOT NULL,
+				webhook_id INTEGER NOT NULL,
+				created_at TIMESTAMP NOT NULL,
+				event VARCHAR(255) NOT NULL,
+				payload TEXT NOT NULL,
+				attempts INTEGER NOT NULL,
+				last_error TEXT NOT NULL,
+
+				PRIMARY KEY (id),
+				FOREIGN KEY (webhook_id) REFERENCES webhooks (id)
+			)`,
+		},
+		Down: []string{
+			`DROP TABLE webhook_dead_letters`,
+			`DROP TABLE webhooks`,
+		},
+	},
+}
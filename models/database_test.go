@@ -0,0 +1,321 @@
+// pmm-managed
+// Copyright (C) 2017 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package models_test
+
+import (
+	"context"
+	"database/sql"
+	"io/ioutil"
+	"net"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/reform.v1"
+
+	"github.com/percona/pmm-managed/models"
+	"github.com/percona/pmm-managed/utils/tests"
+)
+
+func TestOpenDB(t *testing.T) {
+	// unused port
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := l.Addr().String()
+	require.NoError(t, l.Close())
+
+	t.Run("Retries", func(t *testing.T) {
+		for _, driver := range []string{models.MySQLDriver, models.PostgreSQLDriver} {
+			var retries int
+			logf := func(format string, args ...interface{}) {
+				retries++
+				t.Logf(format, args...)
+			}
+			db, err := models.OpenDB(context.Background(), &models.DBConfig{
+				Driver:         driver,
+				Address:        addr,
+				ConnectRetries: 3,
+				ConnectBackoff: time.Millisecond,
+			}, logf)
+			require.Error(t, err, "%s", driver)
+			assert.Nil(t, db)
+			assert.Contains(t, err.Error(), "Failed to connect to database")
+			assert.Contains(t, err.Error(), "connection refused")
+			assert.Equal(t, 3, retries, "%s", driver)
+		}
+	})
+
+	t.Run("UnknownDriver", func(t *testing.T) {
+		_, err := models.OpenDB(context.Background(), &models.DBConfig{
+			Driver: "sqlite",
+		}, t.Logf)
+		require.EqualError(t, err, `unexpected database driver "sqlite"`)
+	})
+
+	t.Run("SQLite3", func(t *testing.T) {
+		_, err := models.OpenDB(context.Background(), &models.DBConfig{
+			Driver: models.SQLite3Driver,
+		}, t.Logf)
+		require.EqualError(t, err, "database file path is required for SQLite")
+
+		var retries int
+		logf := func(format string, args ...interface{}) {
+			retries++
+			t.Logf(format, args...)
+		}
+		_, err = models.OpenDB(context.Background(), &models.DBConfig{
+			Driver:         models.SQLite3Driver,
+			Name:           "/nonexistent/pmm-managed.sqlite",
+			ConnectRetries: 1,
+			ConnectBackoff: time.Millisecond,
+		}, logf)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Failed to connect to database")
+		assert.Equal(t, 1, retries)
+	})
+
+	t.Run("Canceled", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+		defer cancel()
+		start := time.Now()
+		_, err := models.OpenDB(ctx, &models.DBConfig{
+			Address:        addr,
+			ConnectRetries: 100,
+			ConnectBackoff: time.Second,
+		}, t.Logf)
+		require.Error(t, err)
+		assert.True(t, time.Since(start) < 5*time.Second, "%s", time.Since(start))
+	})
+
+	t.Run("Socket", func(t *testing.T) {
+		_, err := models.OpenDB(context.Background(), &models.DBConfig{
+			Socket: "/nonexistent/mysql.sock",
+		}, t.Logf)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "/nonexistent/mysql.sock")
+	})
+
+	t.Run("InvalidTLSCA", func(t *testing.T) {
+		f, err := ioutil.TempFile("", "pmm-managed-ca-")
+		require.NoError(t, err)
+		defer os.Remove(f.Name()) //nolint:errcheck
+		require.NoError(t, f.Close())
+
+		_, err = models.OpenDB(context.Background(), &models.DBConfig{
+			TLSCAFile: f.Name(),
+		}, t.Logf)
+		require.EqualError(t, err, "no certificates found in "+f.Name())
+	})
+}
+
+// getSchema returns description of all columns of all tables except schema_migrations.
+func getSchema(t *testing.T, driver string, db *sql.DB) []string {
+	t.Helper()
+
+	var q string
+	switch driver {
+	case models.SQLite3Driver:
+		q = `SELECT m.name, p.name, p.type, p."notnull", COALESCE(p.dflt_value, '')
+			FROM sqlite_master m JOIN pragma_table_info(m.name) p
+			WHERE m.type = 'table' AND m.name NOT IN ('schema_migrations', 'sqlite_sequence')
+			ORDER BY m.name, p.cid`
+	default:
+		schema := "DATABASE()"
+		if driver == models.PostgreSQLDriver {
+			schema = "current_schema()"
+		}
+		q = `SELECT table_name, column_name, data_type, is_nullable, COALESCE(column_default, '')
+			FROM information_schema.columns
+			WHERE table_schema = ` + schema + ` AND table_name <> 'schema_migrations'
+			ORDER BY table_name, ordinal_position`
+	}
+	rows, err := db.Query(q)
+	require.NoError(t, err)
+	defer rows.Close()
+
+	var res []string
+	for rows.Next() {
+		var table, column, dataType, nullable, def string
+		require.NoError(t, rows.Scan(&table, &column, &dataType, &nullable, &def))
+		res = append(res, strings.Join([]string{table, column, dataType, nullable, def}, " "))
+	}
+	require.NoError(t, rows.Err())
+	return res
+}
+
+func TestMigrations(t *testing.T) {
+	for _, driver := range models.Drivers {
+		t.Run(driver, func(t *testing.T) {
+			testMigrations(t, driver)
+		})
+	}
+}
+
+func testMigrations(t *testing.T, driver string) {
+	ctx := context.Background()
+	db := tests.OpenTestDBForDriver(t, driver)
+	defer db.Close()
+	dialect, err := models.ReformDialect(driver)
+	require.NoError(t, err)
+
+	latest := models.LatestSchemaVersion()
+	status, err := models.GetSchemaStatus(ctx, db)
+	require.NoError(t, err)
+	assert.Equal(t, latest, status.CurrentVersion)
+	assert.Equal(t, latest, status.LatestVersion)
+	require.Len(t, status.Applied, latest)
+	for i, m := range status.Applied {
+		assert.Equal(t, i+1, m.Version)
+		assert.Len(t, m.Checksum, 64)
+		assert.NotNil(t, m.AppliedAt)
+		assert.False(t, m.Dirty)
+	}
+	expected := getSchema(t, driver, db)
+	require.NotEmpty(t, expected)
+
+	t.Run("DownAndUp", func(t *testing.T) {
+		// reverse migrations down to every version, then apply them again
+		for version := latest - 1; version >= 0; version-- {
+			require.NoError(t, models.MigrateDown(ctx, db, version, t.Logf), "version %d", version)
+			status, err = models.GetSchemaStatus(ctx, db)
+			require.NoError(t, err)
+			assert.Equal(t, version, status.CurrentVersion)
+			assert.Len(t, status.Applied, version)
+
+			require.NoError(t, models.MigrateUp(ctx, db, t.Logf), "version %d", version)
+			assert.Equal(t, expected, getSchema(t, driver, db), "version %d", version)
+		}
+	})
+
+	t.Run("DownToZero", func(t *testing.T) {
+		require.NoError(t, models.MigrateDown(ctx, db, 0, t.Logf))
+		assert.Empty(t, getSchema(t, driver, db))
+
+		err = models.MigrateDown(ctx, db, 1, t.Logf)
+		require.EqualError(t, err, "can't migrate down to schema version 1: current version is 0")
+
+		require.NoError(t, models.MigrateUp(ctx, db, t.Logf))
+		assert.Equal(t, expected, getSchema(t, driver, db))
+	})
+
+	t.Run("PortsFromAgents", func(t *testing.T) {
+		require.NoError(t, models.MigrateDown(ctx, db, 5, t.Logf))
+		_, err = db.Exec(`INSERT INTO agents (type, runs_on_node_id, listen_port) VALUES
+			('mysqld_exporter', 1, 10001), ('postgres_exporter', 1, 10002), ('qan-agent', 1, 9000), ('mysqld_exporter', 1, NULL)`)
+		require.NoError(t, err)
+		require.NoError(t, models.MigrateUp(ctx, db, t.Logf))
+
+		rows, err := db.Query("SELECT port FROM ports ORDER BY port")
+		require.NoError(t, err)
+		var ports []int
+		for rows.Next() {
+			var port int
+			require.NoError(t, rows.Scan(&port))
+			ports = append(ports, port)
+		}
+		require.NoError(t, rows.Err())
+		require.NoError(t, rows.Close())
+		assert.Equal(t, []int{10001, 10002}, ports)
+
+		_, err = db.Exec("DELETE FROM ports")
+		require.NoError(t, err)
+		_, err = db.Exec("DELETE FROM agents")
+		require.NoError(t, err)
+	})
+
+	t.Run("Checksum", func(t *testing.T) {
+		_, err = db.Exec("UPDATE schema_migrations SET checksum = 'changed' WHERE id = 1")
+		require.NoError(t, err)
+		err = models.MigrateUp(ctx, db, t.Logf)
+		require.EqualError(t, err, "database schema version 1 checksum mismatch: migration was changed after it was applied")
+
+		// migrations applied by old versions get checksums
+		_, err = db.Exec("UPDATE schema_migrations SET checksum = ''")
+		require.NoError(t, err)
+		require.NoError(t, models.MigrateUp(ctx, db, t.Logf))
+		status, err = models.GetSchemaStatus(ctx, db)
+		require.NoError(t, err)
+		for _, m := range status.Applied {
+			assert.Len(t, m.Checksum, 64)
+		}
+	})
+
+	t.Run("Dirty", func(t *testing.T) {
+		q := "UPDATE schema_migrations SET dirty = " + dialect.Placeholder(1) + " WHERE id = " + dialect.Placeholder(2)
+		_, err = db.Exec(q, true, latest)
+		require.NoError(t, err)
+		defer db.Exec(q, false, latest) //nolint:errcheck
+
+		err = models.MigrateUp(ctx, db, t.Logf)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "is dirty")
+	})
+}
+
+func TestErrors(t *testing.T) {
+	for _, driver := range models.Drivers {
+		t.Run(driver, func(t *testing.T) {
+			sqlDB := tests.OpenTestDBForDriver(t, driver)
+			defer sqlDB.Close()
+			dialect, err := models.ReformDialect(driver)
+			require.NoError(t, err)
+			db := reform.NewDB(sqlDB, dialect, nil)
+
+			node := &models.RemoteNode{
+				Type:   models.RemoteNodeType,
+				Name:   "test",
+				Region: models.RemoteNodeRegion,
+			}
+			require.NoError(t, db.Insert(node))
+			assert.True(t, node.ID > 1, "%d", node.ID)
+
+			err = db.Insert(&models.RemoteNode{
+				Type:   models.RemoteNodeType,
+				Name:   "test",
+				Region: models.RemoteNodeRegion,
+			})
+			require.Error(t, err)
+			assert.True(t, models.IsUniqueViolation(err), "%#v", err)
+			assert.False(t, models.IsForeignKeyViolation(err), "%#v", err)
+
+			err = db.Insert(&models.Service{
+				Type:   models.MySQLServiceType,
+				NodeID: 42,
+			})
+			require.Error(t, err)
+			assert.False(t, models.IsUniqueViolation(err), "%#v", err)
+			assert.True(t, models.IsForeignKeyViolation(err), "%#v", err)
+
+			service := &models.Service{
+				Type:   models.MySQLServiceType,
+				NodeID: node.ID,
+			}
+			require.NoError(t, db.Insert(service))
+			assert.Equal(t, int32(1000), service.ID)
+			err = db.Delete(node)
+			require.Error(t, err)
+			assert.True(t, models.IsForeignKeyViolation(err), "%#v", err)
+
+			assert.False(t, models.IsUniqueViolation(nil))
+			assert.False(t, models.IsForeignKeyViolation(errors.New("test")))
+		})
+	}
+}
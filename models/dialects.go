@@ -0,0 +1,116 @@
+// pmm-managed
+// Copyright (C) 2017 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package models
+
+import (
+	"database/sql"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/lib/pq"
+	"github.com/mattn/go-sqlite3"
+	"github.com/pkg/errors"
+	"gopkg.in/reform.v1"
+	reformMySQL "gopkg.in/reform.v1/dialects/mysql"
+	reformPostgreSQL "gopkg.in/reform.v1/dialects/postgresql"
+	reformSQLite3 "gopkg.in/reform.v1/dialects/sqlite3"
+)
+
+// Supported database drivers for pmm-managed's own state.
+const (
+	MySQLDriver      = "mysql"
+	PostgreSQLDriver = "postgresql"
+	SQLite3Driver    = "sqlite3" // embedded, for tests and small single-node deployments
+)
+
+// Drivers contains all supported database drivers.
+var Drivers = []string{MySQLDriver, PostgreSQLDriver, SQLite3Driver}
+
+// ReformDialect returns reform dialect for given driver.
+func ReformDialect(driver string) (reform.Dialect, error) {
+	switch driver {
+	case MySQLDriver:
+		return reformMySQL.Dialect, nil
+	case PostgreSQLDriver:
+		return reformPostgreSQL.Dialect, nil
+	case SQLite3Driver:
+		return reformSQLite3.Dialect, nil
+	default:
+		return nil, errors.Errorf("unexpected database driver %q", driver)
+	}
+}
+
+// driverOf returns driver name of opened database.
+func driverOf(db *sql.DB) (string, error) {
+	switch d := db.Driver().(type) {
+	case *mysql.MySQLDriver:
+		return MySQLDriver, nil
+	case *pq.Driver:
+		return PostgreSQLDriver, nil
+	case *sqlite3.SQLiteDriver:
+		return SQLite3Driver, nil
+	default:
+		return "", errors.Errorf("unexpected database driver %T", d)
+	}
+}
+
+// MySQL error numbers, see https://dev.mysql.com/doc/refman/5.7/en/error-messages-server.html
+const (
+	mysqlErrDupEntry    = 1062
+	mysqlErrRowIsRef    = 1451
+	mysqlErrNoRefRow    = 1452
+	mysqlErrRowIsRefOld = 1217
+	mysqlErrNoRefRowOld = 1216
+)
+
+// PostgreSQL error codes, see https://www.postgresql.org/docs/10/static/errcodes-appendix.html
+const (
+	postgresqlErrUniqueViolation     = "23505"
+	postgresqlErrForeignKeyViolation = "23503"
+)
+
+// IsUniqueViolation returns true if error is caused by unique constraint (or primary key) violation.
+func IsUniqueViolation(err error) bool {
+	switch e := errors.Cause(err).(type) {
+	case *mysql.MySQLError:
+		return e.Number == mysqlErrDupEntry
+	case *pq.Error:
+		return e.Code == postgresqlErrUniqueViolation
+	case sqlite3.Error:
+		return e.ExtendedCode == sqlite3.ErrConstraintUnique || e.ExtendedCode == sqlite3.ErrConstraintPrimaryKey
+	default:
+		return false
+	}
+}
+
+// IsForeignKeyViolation returns true if error is caused by foreign key constraint violation:
+// either referenced row does not exist, or row is still referenced.
+func IsForeignKeyViolation(err error) bool {
+	switch e := errors.Cause(err).(type) {
+	case *mysql.MySQLError:
+		switch e.Number {
+		case mysqlErrRowIsRef, mysqlErrNoRefRow, mysqlErrRowIsRefOld, mysqlErrNoRefRowOld:
+			return true
+		}
+		return false
+	case *pq.Error:
+		return e.Code == postgresqlErrForeignKeyViolation
+	case sqlite3.Error:
+		return e.ExtendedCode == sqlite3.ErrConstraintForeignKey
+	default:
+		return false
+	}
+}
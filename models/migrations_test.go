@@ -0,0 +1,45 @@
+// pmm-managed
+// Copyright (C) 2017 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrationsDefinitions(t *testing.T) {
+	require.Len(t, postgresqlMigrations, len(mysqlMigrations))
+	require.Len(t, sqlite3Migrations, len(mysqlMigrations))
+
+	for _, driver := range Drivers {
+		checksums := make(map[string]int)
+		for i, m := range migrationsFor(driver) {
+			assert.Equal(t, i+1, m.Version, "%s: migration with index %d", driver, i)
+			assert.Equal(t, len(m.Up) == 0, len(m.Down) == 0, "%s: migration %d", driver, m.Version)
+			if driver != SQLite3Driver {
+				assert.NotEmpty(t, m.Up, "%s: migration %d", driver, m.Version)
+			}
+
+			c := m.Checksum()
+			assert.Equal(t, c, m.Checksum())
+			assert.NotContains(t, checksums, c, "%s: migration %d", driver, m.Version)
+			checksums[c] = m.Version
+		}
+	}
+}
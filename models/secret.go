@@ -0,0 +1,149 @@
+// pmm-managed
+// Copyright (C) 2017 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package models
+
+import (
+	"database/sql/driver"
+	"sync"
+
+	"github.com/pkg/errors"
+	"gopkg.in/reform.v1"
+
+	"github.com/percona/pmm-managed/utils/secrets"
+)
+
+var (
+	keyringM sync.RWMutex
+	keyring  *secrets.Keyring
+)
+
+// SetKeyring sets keyring used for encryption and decryption of Secret columns.
+// Nil keyring disables encryption of new values.
+func SetKeyring(k *secrets.Keyring) {
+	keyringM.Lock()
+	keyring = k
+	keyringM.Unlock()
+}
+
+func getKeyring() *secrets.Keyring {
+	keyringM.RLock()
+	defer keyringM.RUnlock()
+	return keyring
+}
+
+// Secret is a string value stored encrypted in the database.
+// Encryption and decryption are transparent: Go code always sees plain text.
+type Secret string
+
+// NewSecret returns a pointer to a new Secret with a given value.
+func NewSecret(s string) *Secret {
+	secret := Secret(s)
+	return &secret
+}
+
+// Value implements database/sql/driver.Valuer interface.
+func (s Secret) Value() (driver.Value, error) {
+	return getKeyring().Encrypt(string(s))
+}
+
+// Scan implements database/sql.Scanner interface.
+func (s *Secret) Scan(src interface{}) error {
+	var v string
+	switch src := src.(type) {
+	case string:
+		v = src
+	case []byte:
+		v = string(src)
+	default:
+		return errors.Errorf("unexpected type %T for Secret", src)
+	}
+
+	v, err := getKeyring().Decrypt(v)
+	if err != nil {
+		return err
+	}
+	*s = Secret(v)
+	return nil
+}
+
+// EncryptSecrets encrypts all not yet encrypted secrets in the database,
+// and re-encrypts secrets encrypted with old master keys. It returns a number of changed values.
+// It does nothing if encryption is disabled.
+func EncryptSecrets(q *reform.Querier) (int, error) {
+	k := getKeyring()
+	if k == nil {
+		return 0, nil
+	}
+
+	var res int
+	for _, c := range []struct{ table, column string }{
+		{"agents", "service_password"},
+		{"services", "aws_secret_key"},
+		{"webhooks", "secret"},
+	} {
+		n, err := encryptColumn(q, k, c.table, c.column)
+		res += n
+		if err != nil {
+			return res, err
+		}
+	}
+	return res, nil
+}
+
+func encryptColumn(q *reform.Querier, k *secrets.Keyring, table, column string) (int, error) {
+	type row struct {
+		id    int32
+		value string
+	}
+
+	query := "SELECT id, " + q.QuoteIdentifier(column) + " FROM " + q.QuoteIdentifier(table) +
+		" WHERE " + q.QuoteIdentifier(column) + " IS NOT NULL"
+	rows, err := q.Query(query)
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+	var update []row
+	for rows.Next() {
+		var r row
+		if err = rows.Scan(&r.id, &r.value); err != nil {
+			rows.Close() //nolint:errcheck
+			return 0, errors.WithStack(err)
+		}
+		if k.NeedsEncryption(r.value) {
+			update = append(update, r)
+		}
+	}
+	if err = rows.Close(); err != nil {
+		return 0, errors.WithStack(err)
+	}
+	if err = rows.Err(); err != nil {
+		return 0, errors.WithStack(err)
+	}
+
+	query = "UPDATE " + q.QuoteIdentifier(table) + " SET " + q.QuoteIdentifier(column) + " = " + q.Placeholder(1) +
+		" WHERE id = " + q.Placeholder(2)
+	for i, r := range update {
+		v, _, err := k.Reencrypt(r.value)
+		if err != nil {
+			return i, errors.Wrapf(err, "%s.%s with id %d", table, column, r.id)
+		}
+		if _, err = q.Exec(query, v, r.id); err != nil {
+			return i, errors.WithStack(err)
+		}
+	}
+	return len(update), nil
+}
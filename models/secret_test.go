@@ -0,0 +1,81 @@
+// pmm-managed
+// Copyright (C) 2017 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/percona/pmm-managed/utils/secrets"
+)
+
+func TestSecret(t *testing.T) {
+	defer SetKeyring(nil)
+
+	t.Run("Disabled", func(t *testing.T) {
+		SetKeyring(nil)
+
+		v, err := Secret("password").Value()
+		require.NoError(t, err)
+		assert.Equal(t, "password", v)
+
+		var s Secret
+		require.NoError(t, s.Scan([]byte("password")))
+		assert.Equal(t, Secret("password"), s)
+
+		// plain text with encrypted value prefix is escaped
+		v, err = Secret("enc:v1:password").Value()
+		require.NoError(t, err)
+		assert.Equal(t, "enc:plain:enc:v1:password", v)
+		require.NoError(t, s.Scan(v))
+		assert.Equal(t, Secret("enc:v1:password"), s)
+
+		// and is not broken if stored before escaping
+		require.NoError(t, s.Scan("enc:v1:password"))
+		assert.Equal(t, Secret("enc:v1:password"), s)
+	})
+
+	t.Run("Enabled", func(t *testing.T) {
+		key, err := secrets.GenerateKey()
+		require.NoError(t, err)
+		b, err := secrets.ParseKey(key)
+		require.NoError(t, err)
+		k, err := secrets.NewKeyring(b)
+		require.NoError(t, err)
+		SetKeyring(k)
+
+		v, err := Secret("password").Value()
+		require.NoError(t, err)
+		require.IsType(t, "", v)
+		assert.True(t, secrets.IsEncrypted(v.(string)))
+
+		var s Secret
+		require.NoError(t, s.Scan([]byte(v.(string))))
+		assert.Equal(t, Secret("password"), s)
+
+		// not yet encrypted values are still readable
+		require.NoError(t, s.Scan("plain"))
+		assert.Equal(t, Secret("plain"), s)
+
+		SetKeyring(nil)
+		err = s.Scan(v)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "but encryption is not configured")
+	})
+}
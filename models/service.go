@@ -40,7 +40,7 @@ type RDSService struct {
 	NodeID int32       `reform:"node_id"`
 
 	AWSAccessKey  *string `reform:"aws_access_key"` // may be nil
-	AWSSecretKey  *string `reform:"aws_secret_key"` // may be nil
+	AWSSecretKey  *Secret `reform:"aws_secret_key"` // may be nil
 	Address       *string `reform:"address"`
 	Port          *uint16 `reform:"port"`
 	Engine        *string `reform:"engine"`
@@ -0,0 +1,100 @@
+// pmm-managed
+// Copyright (C) 2017 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package models
+
+import (
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"gopkg.in/reform.v1"
+)
+
+//go:generate reform
+
+// Webhook represents an outbound webhook, see webhooks.Service.
+//
+//reform:webhooks
+type Webhook struct {
+	ID        int32     `reform:"id,pk"`
+	URL       string    `reform:"url"`
+	Secret    Secret    `reform:"secret"`
+	Events    string    `reform:"events"` // comma-separated event types; empty for all
+	CreatedAt time.Time `reform:"created_at"`
+}
+
+// EventTypes returns event types sent to webhook; nil for all.
+func (w *Webhook) EventTypes() []string {
+	if w.Events == "" {
+		return nil
+	}
+	return strings.Split(w.Events, ",")
+}
+
+// SetEventTypes sets event types sent to webhook; nil or empty slice for all.
+func (w *Webhook) SetEventTypes(events []string) {
+	w.Events = strings.Join(events, ",")
+}
+
+// WebhookDeadLetter represents an event which was not delivered to webhook after all retries.
+//
+//reform:webhook_dead_letters
+type WebhookDeadLetter struct {
+	ID        int64     `reform:"id,pk"`
+	WebhookID int32     `reform:"webhook_id"`
+	CreatedAt time.Time `reform:"created_at"`
+	Event     string    `reform:"event"`
+	Payload   string    `reform:"payload"`
+	Attempts  int       `reform:"attempts"`
+	LastError string    `reform:"last_error"`
+}
+
+// FindWebhookDeadLetters returns dead letters of a given webhook (or all if webhookID is zero),
+// from the newest to the oldest.
+func FindWebhookDeadLetters(q *reform.Querier, webhookID int32) ([]WebhookDeadLetter, error) {
+	var tail string
+	var args []interface{}
+	if webhookID != 0 {
+		tail = "WHERE webhook_id = " + q.Placeholder(1) + " "
+		args = append(args, webhookID)
+	}
+	tail += "ORDER BY id DESC"
+
+	structs, err := q.SelectAllFrom(WebhookDeadLetterTable, tail, args...)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	letters := make([]WebhookDeadLetter, len(structs))
+	for i, str := range structs {
+		letters[i] = *str.(*WebhookDeadLetter)
+	}
+	return letters, nil
+}
+
+// PruneWebhookDeadLetters removes all but a given number of the newest dead letters and returns a number of removed ones.
+func PruneWebhookDeadLetters(q *reform.Querier, keep int) (int, error) {
+	var maxID int64
+	err := q.QueryRow("SELECT COALESCE(MAX(id), 0) FROM " + q.QuoteIdentifier(WebhookDeadLetterTable.Name())).Scan(&maxID)
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+	n, err := q.DeleteFrom(WebhookDeadLetterTable, "WHERE id <= "+q.Placeholder(1), maxID-int64(keep))
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+	return int(n), nil
+}
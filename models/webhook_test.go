@@ -0,0 +1,88 @@
+// pmm-managed
+// Copyright (C) 2017 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package models_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/reform.v1"
+
+	"github.com/percona/pmm-managed/models"
+	"github.com/percona/pmm-managed/utils/tests"
+)
+
+func TestWebhooks(t *testing.T) {
+	sqlDB := tests.OpenTestDB(t)
+	defer sqlDB.Close() //nolint:errcheck
+	db := reform.NewDB(sqlDB, tests.TestDBDialect(t), reform.NewPrintfLogger(t.Logf))
+
+	now := time.Now().UTC().Truncate(time.Second)
+	w1 := &models.Webhook{URL: "http://127.0.0.1/1", Secret: "secret1", CreatedAt: now}
+	w1.SetEventTypes([]string{"instance_added", "instance_removed"})
+	require.NoError(t, db.Insert(w1))
+	w2 := &models.Webhook{URL: "http://127.0.0.1/2", Secret: "secret2", CreatedAt: now}
+	require.NoError(t, db.Insert(w2))
+
+	t.Run("EventTypes", func(t *testing.T) {
+		w := &models.Webhook{ID: w1.ID}
+		require.NoError(t, db.Reload(w))
+		assert.Equal(t, []string{"instance_added", "instance_removed"}, w.EventTypes())
+		assert.Equal(t, models.Secret("secret1"), w.Secret)
+
+		w = &models.Webhook{ID: w2.ID}
+		require.NoError(t, db.Reload(w))
+		assert.Nil(t, w.EventTypes())
+	})
+
+	t.Run("DeadLetters", func(t *testing.T) {
+		for i, webhookID := range []int32{w1.ID, w2.ID, w1.ID, w2.ID} {
+			l := &models.WebhookDeadLetter{
+				WebhookID: webhookID,
+				CreatedAt: now,
+				Event:     "target_down",
+				Payload:   "{}",
+				Attempts:  i + 1,
+				LastError: "connection refused",
+			}
+			require.NoError(t, db.Insert(l), "%d", i)
+		}
+
+		attempts := func(webhookID int32) []int {
+			letters, err := models.FindWebhookDeadLetters(db.Querier, webhookID)
+			require.NoError(t, err)
+			res := make([]int, len(letters))
+			for i, l := range letters {
+				res[i] = l.Attempts
+			}
+			return res
+		}
+		assert.Equal(t, []int{4, 3, 2, 1}, attempts(0))
+		assert.Equal(t, []int{3, 1}, attempts(w1.ID))
+
+		n, err := models.PruneWebhookDeadLetters(db.Querier, 3)
+		require.NoError(t, err)
+		assert.Equal(t, 1, n)
+		assert.Equal(t, []int{4, 3, 2}, attempts(0))
+
+		n, err = models.PruneWebhookDeadLetters(db.Querier, 3)
+		require.NoError(t, err)
+		assert.Equal(t, 0, n)
+	})
+}
@@ -0,0 +1,258 @@
+// pmm-managed
+// Copyright (C) 2017 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+// Package agents contains business logic of working with Agents runtime status.
+package agents
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"gopkg.in/reform.v1"
+
+	"github.com/percona/pmm-managed/models"
+	"github.com/percona/pmm-managed/services"
+	"github.com/percona/pmm-managed/utils/logger"
+	"github.com/percona/pmm-managed/utils/ports"
+)
+
+// maximum time for checking /metrics endpoint of a single Agent
+const metricsCheckTimeout = 3 * time.Second
+
+// Health represents aggregated health of Agents.
+type Health string
+
+// Aggregated health values.
+const (
+	HealthUnknown  Health = "unknown"  // there are no Agents, or their status can't be determined
+	HealthDown     Health = "down"     // all Agents are down
+	HealthDegraded Health = "degraded" // some Agents are down
+	HealthUp       Health = "up"       // all Agents are up
+)
+
+// ServiceConfig contains configuration for agents.Service
+type ServiceConfig struct {
+	DB            *reform.DB
+	Supervisor    services.Supervisor
+	PortsRegistry *ports.Registry
+}
+
+// Service is responsible for Agents runtime status.
+type Service struct {
+	*ServiceConfig
+	httpClient *http.Client
+}
+
+// NewService creates a new service.
+func NewService(config *ServiceConfig) *Service {
+	return &Service{
+		ServiceConfig: config,
+		httpClient: &http.Client{
+			Timeout: metricsCheckTimeout,
+		},
+	}
+}
+
+// Status contains Agent runtime status.
+type Status struct {
+	Agent   models.Agent
+	Process services.ProcessStatus
+
+	// MetricsUp is true if Agent answers on /metrics endpoint.
+	// It is always false for Agents without such endpoint.
+	MetricsUp bool
+}
+
+// Up returns true if Agent process is running and, for exporters, /metrics endpoint answers.
+func (s *Status) Up() bool {
+	if s.Process.State != services.ProcessRunning {
+		return false
+	}
+	if !hasMetrics(s.Agent.Type) {
+		return true
+	}
+	return s.MetricsUp
+}
+
+// hasMetrics returns true if Agent of given type exposes /metrics endpoint.
+func hasMetrics(typ models.AgentType) bool {
+	switch typ {
+	case models.MySQLdExporterAgentType, models.PostgresExporterAgentType, models.RDSExporterAgentType:
+		return true
+	default:
+		return false
+	}
+}
+
+// List returns runtime status of all Agents.
+func (svc *Service) List(ctx context.Context) ([]Status, error) {
+	structs, err := svc.DB.SelectAllFrom(models.AgentTable, "ORDER BY id")
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	agents := make([]models.Agent, len(structs))
+	for i, str := range structs {
+		agents[i] = *str.(*models.Agent)
+	}
+	return svc.Status(ctx, agents), nil
+}
+
+// Status returns runtime status of given Agents.
+// All Agents are checked concurrently, so it takes about as long as the slowest check.
+func (svc *Service) Status(ctx context.Context, agents []models.Agent) []Status {
+	res := make([]Status, len(agents))
+	var wg sync.WaitGroup
+	for i, agent := range agents {
+		res[i].Agent = agent
+		if agent.ListenPort == nil {
+			res[i].Process.State = services.ProcessUnknown
+			continue
+		}
+
+		wg.Add(1)
+		go func(s *Status) {
+			defer wg.Done()
+
+			name := models.NameForSupervisor(s.Agent.Type, *s.Agent.ListenPort)
+			s.Process = *svc.Supervisor.ProcessStatus(ctx, name)
+			if hasMetrics(s.Agent.Type) && s.Process.State == services.ProcessRunning {
+				s.MetricsUp = svc.checkMetrics(ctx, *s.Agent.ListenPort)
+			}
+		}(&res[i])
+	}
+	wg.Wait()
+	return res
+}
+
+// Health returns aggregated health for each given group of Agents (for example, Agents of a single instance).
+// Agents of all groups are checked by a single Status call.
+func (svc *Service) Health(ctx context.Context, groups [][]models.Agent) []Health {
+	var all []models.Agent
+	for _, g := range groups {
+		all = append(all, g...)
+	}
+	statuses := svc.Status(ctx, all)
+
+	res := make([]Health, len(groups))
+	for i, g := range groups {
+		res[i] = AggregateHealth(statuses[:len(g)])
+		statuses = statuses[len(g):]
+	}
+	return res
+}
+
+// ProcessStates returns process states of all Agents by their IDs.
+// Unlike List, it does not check /metrics endpoints.
+func (svc *Service) ProcessStates(ctx context.Context) (map[int32]services.ProcessState, error) {
+	structs, err := svc.DB.SelectAllFrom(models.AgentTable, "")
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	res := make(map[int32]services.ProcessState, len(structs))
+	for _, str := range structs {
+		agent := str.(*models.Agent)
+		state := services.ProcessUnknown
+		if agent.ListenPort != nil {
+			state = svc.Supervisor.ProcessStatus(ctx, models.NameForSupervisor(agent.Type, *agent.ListenPort)).State
+		}
+		res[agent.ID] = state
+	}
+	return res, nil
+}
+
+// Ports returns Agent listen ports allocations and conflicts between them and Agents.
+func (svc *Service) Ports(ctx context.Context) (*ports.Diagnostics, error) {
+	var res *ports.Diagnostics
+	err := svc.DB.InTransaction(func(tx *reform.TX) error {
+		var e error
+		res, e = svc.PortsRegistry.Diagnose(tx.Querier)
+		return e
+	})
+	return res, err
+}
+
+// checkMetrics returns true if /metrics endpoint on given port answers.
+func (svc *Service) checkMetrics(ctx context.Context, port uint16) bool {
+	l := logger.Get(ctx).WithField("component", "agents")
+
+	req, err := http.NewRequest("GET", fmt.Sprintf("http://127.0.0.1:%d/metrics", port), nil)
+	if err != nil {
+		l.Error(err)
+		return false
+	}
+	resp, err := svc.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		l.Warnf("Failed to check /metrics on port %d: %s.", port, err)
+		return false
+	}
+	resp.Body.Close() //nolint:errcheck
+
+	if resp.StatusCode != http.StatusOK {
+		l.Warnf("Failed to check /metrics on port %d: status code %d.", port, resp.StatusCode)
+		return false
+	}
+	return true
+}
+
+// AggregateHealth returns aggregated health of given Agents.
+func AggregateHealth(statuses []Status) Health {
+	if len(statuses) == 0 {
+		return HealthUnknown
+	}
+
+	var up int
+	for _, s := range statuses {
+		if s.Up() {
+			up++
+		}
+	}
+	switch up {
+	case 0:
+		return HealthDown
+	case len(statuses):
+		return HealthUp
+	default:
+		return HealthDegraded
+	}
+}
+
+// ForInstance returns Agents for given node and service.
+func ForInstance(q *reform.Querier, nodeID, serviceID int32) ([]models.Agent, error) {
+	agentsForNode, err := models.AgentsForNodeID(q, nodeID)
+	if err != nil {
+		return nil, err
+	}
+	agentsForService, err := models.AgentsForServiceID(q, serviceID)
+	if err != nil {
+		return nil, err
+	}
+
+	// a single agent may do several jobs and be returned twice
+	seen := make(map[int32]struct{}, len(agentsForNode)+len(agentsForService))
+	res := make([]models.Agent, 0, len(agentsForNode)+len(agentsForService))
+	for _, agent := range append(agentsForNode, agentsForService...) {
+		if _, ok := seen[agent.ID]; ok {
+			continue
+		}
+		seen[agent.ID] = struct{}{}
+		res = append(res, agent)
+	}
+	return res, nil
+}
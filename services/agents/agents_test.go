@@ -0,0 +1,89 @@
+// pmm-managed
+// Copyright (C) 2017 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package agents
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/AlekSi/pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/percona/pmm-managed/models"
+	"github.com/percona/pmm-managed/services"
+	"github.com/percona/pmm-managed/services/mocks"
+	"github.com/percona/pmm-managed/utils/logger"
+)
+
+func TestStatus(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/metrics" {
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+	_, p, err := net.SplitHostPort(ts.Listener.Addr().String())
+	require.NoError(t, err)
+	port, err := strconv.ParseUint(p, 10, 16)
+	require.NoError(t, err)
+
+	ctx, _ := logger.Set(context.Background(), t.Name())
+	supervisor := &mocks.Supervisor{}
+	defer supervisor.AssertExpectations(t)
+	svc := NewService(&ServiceConfig{
+		Supervisor: supervisor,
+	})
+
+	up := models.Agent{ID: 1, Type: models.MySQLdExporterAgentType, ListenPort: pointer.ToUint16(uint16(port))}
+	running := &services.ProcessStatus{State: services.ProcessRunning, PID: 42}
+	supervisor.On("ProcessStatus", ctx, models.NameForSupervisor(up.Type, *up.ListenPort)).Return(running)
+
+	// running, but does not answer on /metrics
+	noMetrics := models.Agent{ID: 2, Type: models.PostgresExporterAgentType, ListenPort: pointer.ToUint16(1)}
+	supervisor.On("ProcessStatus", ctx, models.NameForSupervisor(noMetrics.Type, *noMetrics.ListenPort)).Return(running)
+
+	// running, does not have /metrics
+	qan := models.Agent{ID: 3, Type: models.QanAgentAgentType, ListenPort: pointer.ToUint16(2)}
+	supervisor.On("ProcessStatus", ctx, models.NameForSupervisor(qan.Type, *qan.ListenPort)).Return(running)
+
+	failed := models.Agent{ID: 4, Type: models.RDSExporterAgentType, ListenPort: pointer.ToUint16(uint16(port))}
+	failedStatus := &services.ProcessStatus{State: services.ProcessFailed, Restarts: 3, LastError: "exit status 1"}
+	supervisor.On("ProcessStatus", ctx, models.NameForSupervisor(failed.Type, *failed.ListenPort)).Return(failedStatus)
+
+	actual := svc.Status(ctx, []models.Agent{up, noMetrics, qan, failed})
+	expected := []Status{
+		{Agent: up, Process: *running, MetricsUp: true},
+		{Agent: noMetrics, Process: *running},
+		{Agent: qan, Process: *running},
+		{Agent: failed, Process: *failedStatus},
+	}
+	assert.Equal(t, expected, actual)
+
+	assert.Equal(t, HealthUp, AggregateHealth(actual[0:1]))
+	assert.Equal(t, HealthDown, AggregateHealth(actual[1:2]))
+	assert.Equal(t, HealthUp, AggregateHealth(actual[2:3]))
+	assert.Equal(t, HealthDegraded, AggregateHealth(actual))
+	assert.Equal(t, HealthUnknown, AggregateHealth(nil))
+
+	groups := [][]models.Agent{{up}, {noMetrics}, nil, {qan, failed}}
+	assert.Equal(t, []Health{HealthUp, HealthDown, HealthUnknown, HealthDegraded}, svc.Health(ctx, groups))
+}
@@ -19,6 +19,7 @@ package consul
 
 import (
 	"path"
+	"strings"
 
 	"github.com/hashicorp/consul/api"
 	"github.com/pkg/errors"
@@ -86,3 +87,19 @@ func (c *Client) DeleteKV(key string) error {
 	_, err := c.c.KV().Delete(key, nil)
 	return errors.Wrapf(err, "cannot delete key %q", key)
 }
+
+// ListKV returns all key/value pairs from Consul without prefix.
+func (c *Client) ListKV() (map[string][]byte, error) {
+	pairs, _, err := c.c.KV().List(prefix, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot list keys")
+	}
+	res := make(map[string][]byte, len(pairs))
+	for _, pair := range pairs {
+		if strings.HasSuffix(pair.Key, "/") {
+			continue // folder
+		}
+		res[strings.TrimPrefix(pair.Key, prefix)] = pair.Value
+	}
+	return res, nil
+}
@@ -0,0 +1,68 @@
+// pmm-managed
+// Copyright (C) 2017 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package events
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/percona/pmm-managed/services"
+	"github.com/percona/pmm-managed/services/agents"
+	"github.com/percona/pmm-managed/utils/logger"
+)
+
+// AgentsCheckInterval is a default interval between Agents process state checks in WatchAgents.
+const AgentsCheckInterval = 5 * time.Second
+
+// WatchAgents publishes AgentStateChanged events when Agents process states change, until ctx is canceled.
+// Added and removed Agents are reported by services with AgentAdded and AgentRemoved events instead.
+func (b *Bus) WatchAgents(ctx context.Context, svc *agents.Service, interval time.Duration) {
+	l := logger.Get(ctx).WithField("component", "events")
+
+	t := time.NewTicker(interval)
+	defer t.Stop()
+
+	var prev map[int32]services.ProcessState
+	for {
+		states, err := svc.ProcessStates(ctx)
+		if err != nil {
+			l.Errorf("Failed to get Agents process states: %+v", err)
+		} else {
+			b.Publish(stateChanges(prev, states)...)
+			prev = states
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+	}
+}
+
+// stateChanges returns AgentStateChanged events for Agents present in both maps with different states.
+func stateChanges(prev, current map[int32]services.ProcessState) []Event {
+	var res []Event
+	for id, state := range current {
+		if p, ok := prev[id]; ok && p != state {
+			res = append(res, Event{Type: AgentStateChanged, AgentID: id, AgentState: state})
+		}
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].AgentID < res[j].AgentID })
+	return res
+}
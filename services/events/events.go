@@ -0,0 +1,257 @@
+// pmm-managed
+// Copyright (C) 2017 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+// Package events contains publish/subscribe bus for inventory and configuration change events.
+package events
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/percona/pmm-managed/models"
+	"github.com/percona/pmm-managed/services"
+)
+
+// Type represents event type.
+type Type string
+
+// Event types.
+const (
+	NodeAdded           Type = "node_added"
+	NodeRemoved         Type = "node_removed"
+	ServiceAdded        Type = "service_added"
+	ServiceRemoved      Type = "service_removed"
+	AgentAdded          Type = "agent_added"
+	AgentRemoved        Type = "agent_removed"
+	AgentStateChanged   Type = "agent_state_changed"
+	ScrapeConfigCreated Type = "scrape_config_created"
+	ScrapeConfigUpdated Type = "scrape_config_updated"
+	ScrapeConfigDeleted Type = "scrape_config_deleted"
+	PrometheusReloaded  Type = "prometheus_reloaded"
+	TargetDown          Type = "target_down"
+)
+
+// Event represents a single change. Only fields relevant to event type are set.
+type Event struct {
+	StreamID string    // set by Bus.Publish
+	Seq      uint64    // set by Bus.Publish
+	Time     time.Time // set by Bus.Publish
+	Type     Type
+
+	// for node, service and agent events
+	NodeID      int32
+	NodeType    models.NodeType
+	NodeName    string
+	ServiceID   int32
+	ServiceType models.ServiceType
+
+	// for agent events
+	AgentID    int32
+	AgentType  models.AgentType
+	AgentState services.ProcessState // for AgentStateChanged only
+
+	// for scrape config and TargetDown events
+	JobName string
+
+	// for TargetDown events
+	Instance string
+}
+
+const (
+	// DefaultHistorySize is a default number of last events kept for resuming subscriptions.
+	DefaultHistorySize = 10000
+
+	// maximum number of events queued for a single subscriber
+	maxQueueSize = 10000
+)
+
+var (
+	// ErrUnavailable is returned by Bus.Subscribe when events after given sequence number are no longer
+	// (or not yet) available: they were dropped from history.
+	ErrUnavailable = errors.New("events after given sequence number are not available")
+
+	// ErrReset is returned by Bus.Subscribe when given stream ID does not match bus stream ID:
+	// pmm-managed was restarted, and sequence numbers started over.
+	ErrReset = errors.New("event stream was reset")
+
+	// ErrOverflow is returned by Subscription.Next when subscriber does not keep up with published events.
+	ErrOverflow = errors.New("too many events are queued for subscriber")
+
+	// ErrClosed is returned by Subscription.Next after subscription is closed.
+	ErrClosed = errors.New("subscription is closed")
+)
+
+// Bus delivers published events to subscribers and keeps last events for resuming subscriptions.
+// Nil *Bus is valid: published events are discarded.
+type Bus struct {
+	id      string // stream ID, unique for each bus
+	m       sync.Mutex
+	seq     uint64
+	history []Event // last events, oldest first
+	size    int
+	subs    map[*Subscription]struct{}
+}
+
+// NewBus creates a new bus keeping given number of last events.
+func NewBus(historySize int) *Bus {
+	return &Bus{
+		id:   fmt.Sprintf("%016x", time.Now().UnixNano()),
+		size: historySize,
+		subs: make(map[*Subscription]struct{}),
+	}
+}
+
+// Publish sets sequence numbers and times of given events and delivers them to all subscribers.
+// It should be called after changes are committed.
+func (b *Bus) Publish(events ...Event) {
+	if b == nil || len(events) == 0 {
+		return
+	}
+
+	b.m.Lock()
+	defer b.m.Unlock()
+
+	now := time.Now().UTC()
+	for i := range events {
+		b.seq++
+		events[i].StreamID = b.id
+		events[i].Seq = b.seq
+		events[i].Time = now
+	}
+
+	b.history = append(b.history, events...)
+	if n := len(b.history) - b.size; n > 0 {
+		b.history = append(b.history[:0:0], b.history[n:]...)
+	}
+
+	for s := range b.subs {
+		s.push(events)
+	}
+}
+
+// StreamID returns bus stream ID. Sequence numbers are meaningful only within a single stream.
+func (b *Bus) StreamID() string {
+	return b.id
+}
+
+// Subscribe returns a new subscription. If sinceSeq is not zero, events after it are returned first;
+// it is typically a sequence number and stream ID of the last event received by the previous subscription.
+// ErrReset is returned if stream ID does not match, and ErrUnavailable if some of those events are not available.
+func (b *Bus) Subscribe(streamID string, sinceSeq uint64) (*Subscription, error) {
+	b.m.Lock()
+	defer b.m.Unlock()
+
+	s := &Subscription{
+		b:      b,
+		notify: make(chan struct{}, 1),
+	}
+	if sinceSeq != 0 {
+		if streamID != b.id {
+			return nil, ErrReset
+		}
+		if sinceSeq > b.seq {
+			return nil, ErrUnavailable
+		}
+		first := b.seq + 1
+		if len(b.history) != 0 {
+			first = b.history[0].Seq
+		}
+		if sinceSeq+1 < first {
+			return nil, ErrUnavailable
+		}
+		s.push(b.history[len(b.history)-int(b.seq-sinceSeq):])
+	}
+
+	b.subs[s] = struct{}{}
+	return s, nil
+}
+
+// Subscription receives events published after its creation.
+type Subscription struct {
+	b      *Bus
+	notify chan struct{}
+
+	m     sync.Mutex
+	queue []Event
+	err   error
+}
+
+// push queues given events. Caller should hold bus lock.
+func (s *Subscription) push(events []Event) {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	if s.err != nil || len(events) == 0 {
+		return
+	}
+	if len(s.queue)+len(events) > maxQueueSize {
+		s.queue = nil
+		s.err = ErrOverflow
+	} else {
+		s.queue = append(s.queue, events...)
+	}
+
+	select {
+	case s.notify <- struct{}{}:
+	default:
+	}
+}
+
+// Next returns the next event, waiting for it if necessary.
+// It returns error if ctx is canceled, subscription is closed, or on overflow.
+func (s *Subscription) Next(ctx context.Context) (*Event, error) {
+	for {
+		s.m.Lock()
+		if len(s.queue) != 0 {
+			e := s.queue[0]
+			s.queue = s.queue[1:]
+			s.m.Unlock()
+			return &e, nil
+		}
+		err := s.err
+		s.m.Unlock()
+		if err != nil {
+			return nil, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-s.notify:
+		}
+	}
+}
+
+// Close closes subscription. Queued events are discarded.
+func (s *Subscription) Close() {
+	s.b.m.Lock()
+	delete(s.b.subs, s)
+	s.b.m.Unlock()
+
+	s.m.Lock()
+	s.queue = nil
+	s.err = ErrClosed
+	s.m.Unlock()
+
+	select {
+	case s.notify <- struct{}{}:
+	default:
+	}
+}
@@ -0,0 +1,205 @@
+// pmm-managed
+// Copyright (C) 2017 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package events
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/reform.v1"
+
+	"github.com/percona/pmm-managed/models"
+	"github.com/percona/pmm-managed/services"
+	"github.com/percona/pmm-managed/utils/tests"
+)
+
+func next(t *testing.T, s *Subscription) *Event {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	e, err := s.Next(ctx)
+	require.NoError(t, err)
+	return e
+}
+
+func TestBus(t *testing.T) {
+	t.Run("Normal", func(t *testing.T) {
+		b := NewBus(DefaultHistorySize)
+		s, err := b.Subscribe("", 0)
+		require.NoError(t, err)
+		defer s.Close()
+
+		b.Publish(Event{Type: NodeAdded, NodeID: 1}, Event{Type: ServiceAdded, ServiceID: 2})
+		e := next(t, s)
+		assert.Equal(t, b.StreamID(), e.StreamID)
+		assert.Equal(t, uint64(1), e.Seq)
+		assert.Equal(t, NodeAdded, e.Type)
+		assert.Equal(t, int32(1), e.NodeID)
+		assert.False(t, e.Time.IsZero())
+		e = next(t, s)
+		assert.Equal(t, uint64(2), e.Seq)
+		assert.Equal(t, ServiceAdded, e.Type)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		_, err = s.Next(ctx)
+		assert.Equal(t, context.DeadlineExceeded, err)
+	})
+
+	t.Run("Resume", func(t *testing.T) {
+		b := NewBus(1)
+		b.Publish(Event{Type: NodeAdded}, Event{Type: ServiceAdded}, Event{Type: AgentAdded})
+
+		_, err := b.Subscribe(b.StreamID(), 1)
+		assert.Equal(t, ErrUnavailable, err, "event 2 was dropped from history")
+		_, err = b.Subscribe(b.StreamID(), 4)
+		assert.Equal(t, ErrUnavailable, err, "event 4 was not published yet")
+
+		s, err := b.Subscribe(b.StreamID(), 2)
+		require.NoError(t, err)
+		defer s.Close()
+		assert.Equal(t, uint64(3), next(t, s).Seq)
+
+		s2, err := b.Subscribe(b.StreamID(), 3)
+		require.NoError(t, err)
+		defer s2.Close()
+		b.Publish(Event{Type: PrometheusReloaded})
+		assert.Equal(t, uint64(4), next(t, s).Seq)
+		assert.Equal(t, uint64(4), next(t, s2).Seq)
+	})
+
+	t.Run("Reset", func(t *testing.T) {
+		b := NewBus(DefaultHistorySize)
+		b.Publish(Event{Type: NodeAdded}, Event{Type: ServiceAdded})
+
+		_, err := b.Subscribe("other", 1)
+		assert.Equal(t, ErrReset, err, "sequence numbers from other stream are meaningless")
+		_, err = b.Subscribe("", 1)
+		assert.Equal(t, ErrReset, err, "stream ID is required")
+
+		s, err := b.Subscribe("other", 0)
+		require.NoError(t, err, "stream ID is ignored without sequence number")
+		s.Close()
+	})
+
+	t.Run("Overflow", func(t *testing.T) {
+		b := NewBus(DefaultHistorySize)
+		s, err := b.Subscribe("", 0)
+		require.NoError(t, err)
+		defer s.Close()
+
+		for i := 0; i <= maxQueueSize; i++ {
+			b.Publish(Event{Type: PrometheusReloaded})
+		}
+		_, err = s.Next(context.Background())
+		assert.Equal(t, ErrOverflow, err)
+	})
+
+	t.Run("Close", func(t *testing.T) {
+		b := NewBus(DefaultHistorySize)
+		s, err := b.Subscribe("", 0)
+		require.NoError(t, err)
+
+		b.Publish(Event{Type: PrometheusReloaded})
+		s.Close()
+		b.Publish(Event{Type: PrometheusReloaded})
+		_, err = s.Next(context.Background())
+		assert.Equal(t, ErrClosed, err)
+		assert.Empty(t, b.subs)
+	})
+
+	t.Run("Nil", func(t *testing.T) {
+		var b *Bus
+		b.Publish(Event{Type: PrometheusReloaded})
+	})
+}
+
+func TestStateChanges(t *testing.T) {
+	prev := map[int32]services.ProcessState{
+		1: services.ProcessRunning,
+		2: services.ProcessRunning,
+		3: services.ProcessStopped,
+	}
+	current := map[int32]services.ProcessState{
+		2: services.ProcessFailed,
+		3: services.ProcessRunning,
+		4: services.ProcessRunning,
+	}
+	expected := []Event{
+		{Type: AgentStateChanged, AgentID: 2, AgentState: services.ProcessFailed},
+		{Type: AgentStateChanged, AgentID: 3, AgentState: services.ProcessRunning},
+	}
+	assert.Equal(t, expected, stateChanges(prev, current))
+	assert.Empty(t, stateChanges(nil, current))
+	assert.Empty(t, stateChanges(current, current))
+}
+
+func TestInstance(t *testing.T) {
+	sqlDB := tests.OpenTestDB(t)
+	defer sqlDB.Close() //nolint:errcheck
+	db := reform.NewDB(sqlDB, tests.TestDBDialect(t), reform.NewPrintfLogger(t.Logf))
+
+	node := &models.Node{Type: models.RemoteNodeType, Name: t.Name()}
+	require.NoError(t, db.Insert(node))
+	service := &models.Service{Type: models.MySQLServiceType, NodeID: node.ID}
+	require.NoError(t, db.Insert(service))
+	agent := &models.Agent{Type: models.MySQLdExporterAgentType, RunsOnNodeID: node.ID}
+	require.NoError(t, db.Insert(agent))
+	require.NoError(t, db.Insert(&models.AgentService{AgentID: agent.ID, ServiceID: service.ID}))
+
+	instance := Event{
+		NodeID:      node.ID,
+		NodeType:    models.RemoteNodeType,
+		NodeName:    t.Name(),
+		ServiceID:   service.ID,
+		ServiceType: models.MySQLServiceType,
+	}
+	withType := func(typ Type, agent *models.Agent) Event {
+		e := instance
+		e.Type = typ
+		if agent != nil {
+			e.AgentID = agent.ID
+			e.AgentType = agent.Type
+		}
+		return e
+	}
+
+	actual, err := InstanceAdded(db.Querier, node.ID, service.ID)
+	require.NoError(t, err)
+	expected := []Event{
+		withType(NodeAdded, nil),
+		withType(ServiceAdded, nil),
+		withType(AgentAdded, agent),
+	}
+	assert.Equal(t, expected, actual)
+
+	actual, err = InstanceRemoved(db.Querier, node.ID, service.ID)
+	require.NoError(t, err)
+	expected = []Event{
+		withType(AgentRemoved, agent),
+		withType(ServiceRemoved, nil),
+		withType(NodeRemoved, nil),
+	}
+	assert.Equal(t, expected, actual)
+
+	_, err = InstanceAdded(db.Querier, node.ID+1, service.ID)
+	assert.Error(t, err)
+}
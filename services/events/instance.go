@@ -0,0 +1,93 @@
+// pmm-managed
+// Copyright (C) 2017 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package events
+
+import (
+	"sort"
+
+	"github.com/pkg/errors"
+	"gopkg.in/reform.v1"
+
+	"github.com/percona/pmm-managed/models"
+	"github.com/percona/pmm-managed/services/agents"
+)
+
+// InstanceAdded returns events for added node, its service and their Agents.
+// It should be called in the same transaction after all records are inserted.
+func InstanceAdded(q *reform.Querier, nodeID, serviceID int32) ([]Event, error) {
+	node, service, agents, err := loadInstance(q, nodeID, serviceID)
+	if err != nil {
+		return nil, err
+	}
+
+	res := []Event{
+		{Type: NodeAdded},
+		{Type: ServiceAdded},
+	}
+	for _, agent := range agents {
+		res = append(res, Event{Type: AgentAdded, AgentID: agent.ID, AgentType: agent.Type})
+	}
+	return fillInstance(res, node, service), nil
+}
+
+// InstanceRemoved returns events for removed node, its service and their Agents.
+// It should be called in the same transaction before any records are deleted.
+func InstanceRemoved(q *reform.Querier, nodeID, serviceID int32) ([]Event, error) {
+	node, service, agents, err := loadInstance(q, nodeID, serviceID)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []Event
+	for _, agent := range agents {
+		res = append(res, Event{Type: AgentRemoved, AgentID: agent.ID, AgentType: agent.Type})
+	}
+	res = append(res, Event{Type: ServiceRemoved}, Event{Type: NodeRemoved})
+	return fillInstance(res, node, service), nil
+}
+
+// loadInstance returns node, service, and all their Agents ordered by ID.
+func loadInstance(q *reform.Querier, nodeID, serviceID int32) (*models.Node, *models.Service, []models.Agent, error) {
+	node := &models.Node{ID: nodeID}
+	if err := q.Reload(node); err != nil {
+		return nil, nil, nil, errors.WithStack(err)
+	}
+	service := &models.Service{ID: serviceID}
+	if err := q.Reload(service); err != nil {
+		return nil, nil, nil, errors.WithStack(err)
+	}
+
+	res, err := agents.ForInstance(q, nodeID, serviceID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].ID < res[j].ID })
+
+	return node, service, res, nil
+}
+
+// fillInstance sets node and service fields of given events.
+func fillInstance(events []Event, node *models.Node, service *models.Service) []Event {
+	for i := range events {
+		events[i].NodeID = node.ID
+		events[i].NodeType = node.Type
+		events[i].NodeName = node.Name
+		events[i].ServiceID = service.ID
+		events[i].ServiceType = service.Type
+	}
+	return events
+}
@@ -0,0 +1,104 @@
+// pmm-managed
+// Copyright (C) 2017 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package grafana
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/percona/pmm-managed/utils/logger"
+)
+
+// Event types of automatic annotations.
+const (
+	EventInstanceAdded       = "instance_added"
+	EventInstanceRemoved     = "instance_removed"
+	EventScrapeConfigCreated = "scrape_config_created"
+	EventScrapeConfigUpdated = "scrape_config_updated"
+	EventScrapeConfigDeleted = "scrape_config_deleted"
+)
+
+const annotateTimeout = 5 * time.Second
+
+// EventTag returns annotation tag for event of given type.
+func EventTag(event string) string {
+	return "event:" + event
+}
+
+// JobTag returns annotation tag for scrape job with given name.
+func JobTag(name string) string {
+	return "job:" + name
+}
+
+// Annotator creates Grafana annotations for inventory and configuration events.
+// Annotations are created in the background; errors are logged and never returned,
+// so Grafana being unavailable does not affect the caller.
+// Nil *Annotator is valid and does nothing, that's how automatic annotations are disabled.
+type Annotator struct {
+	client *Client
+	wg     sync.WaitGroup
+}
+
+// NewAnnotator creates a new annotator using given Grafana client.
+func NewAnnotator(client *Client) *Annotator {
+	return &Annotator{
+		client: client,
+	}
+}
+
+// Annotate creates annotation with given text tagged with event type and given additional tags.
+// It should be called only after the change is committed.
+func (a *Annotator) Annotate(ctx context.Context, event string, text string, tags ...string) {
+	if a == nil {
+		return
+	}
+
+	l := logger.Get(ctx).WithField("component", "grafana")
+	annotation := &Annotation{
+		Time: time.Now(),
+		Tags: append([]string{EventTag(event)}, tags...),
+		Text: text,
+	}
+
+	a.wg.Add(1)
+	go func() {
+		defer a.wg.Done()
+
+		// do not use request's context: it is canceled when request is finished
+		ctx, cancel := context.WithTimeout(context.Background(), annotateTimeout)
+		defer cancel()
+		if _, err := a.client.CreateAnnotation(ctx, annotation); err != nil {
+			l.Warnf("Failed to create annotation %q: %s", text, err)
+			return
+		}
+		l.Debugf("Annotation %q created.", text)
+	}()
+}
+
+// AnnotateInstance creates annotation for instance event.
+func (a *Annotator) AnnotateInstance(ctx context.Context, event string, instanceName string, text string) {
+	a.Annotate(ctx, event, text, InstanceTag(instanceName))
+}
+
+// Wait waits for all pending annotations to be created.
+func (a *Annotator) Wait() {
+	if a == nil {
+		return
+	}
+	a.wg.Wait()
+}
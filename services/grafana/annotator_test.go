@@ -0,0 +1,73 @@
+// pmm-managed
+// Copyright (C) 2017 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package grafana
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/percona/pmm-managed/utils/logger"
+)
+
+func TestAnnotator(t *testing.T) {
+	ctx, _ := logger.Set(context.Background(), t.Name())
+
+	t.Run("Normal", func(t *testing.T) {
+		var m sync.Mutex
+		var requests []annotation
+		ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+			b, _ := ioutil.ReadAll(req.Body)
+			var a annotation
+			assert.NoError(t, json.Unmarshal(b, &a))
+			m.Lock()
+			requests = append(requests, a)
+			m.Unlock()
+			rw.Write([]byte(`{"message":"Annotation added","id":1}`)) //nolint:errcheck
+		}))
+		defer ts.Close()
+
+		a := NewAnnotator(NewClient(strings.TrimPrefix(ts.URL, "http://")))
+		a.AnnotateInstance(ctx, EventInstanceAdded, "db1", `MySQL instance "db1" added.`)
+		a.Wait()
+
+		require.Len(t, requests, 1)
+		assert.Equal(t, []string{"pmm_annotation", "event:instance_added", "instance:db1"}, requests[0].Tags)
+		assert.Equal(t, `MySQL instance "db1" added.`, requests[0].Text)
+		assert.NotZero(t, requests[0].Time)
+	})
+
+	t.Run("GrafanaDown", func(t *testing.T) {
+		a := NewAnnotator(NewClient("127.0.0.1:1"))
+		a.Annotate(ctx, EventScrapeConfigDeleted, `Scrape config "job1" deleted.`, JobTag("job1"))
+		a.Wait()
+	})
+
+	t.Run("Disabled", func(t *testing.T) {
+		var a *Annotator
+		a.AnnotateInstance(ctx, EventInstanceRemoved, "db1", `MySQL instance "db1" removed.`)
+		a.Wait()
+	})
+}
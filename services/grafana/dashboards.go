@@ -0,0 +1,250 @@
+// pmm-managed
+// Copyright (C) 2017 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package grafana
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Folder represents Grafana dashboards folder.
+type Folder struct {
+	ID    int64  `json:"id"`
+	UID   string `json:"uid"`
+	Title string `json:"title"`
+}
+
+// DashboardInfo represents dashboard returned by search.
+type DashboardInfo struct {
+	ID          int64    `json:"id"`
+	UID         string   `json:"uid"`
+	Title       string   `json:"title"`
+	URL         string   `json:"url"`
+	Tags        []string `json:"tags"`
+	FolderID    int64    `json:"folderId"`
+	FolderUID   string   `json:"folderUid"`
+	FolderTitle string   `json:"folderTitle"`
+}
+
+// ImportedDashboard represents result of dashboard import.
+type ImportedDashboard struct {
+	ID      int64  `json:"id"`
+	UID     string `json:"uid"`
+	URL     string `json:"url"`
+	Version int64  `json:"version"`
+}
+
+// ListFolders returns all dashboards folders.
+func (c *Client) ListFolders(ctx context.Context) ([]Folder, error) {
+	// http://docs.grafana.org/http_api/folder/#get-all-folders
+
+	var res []Folder
+	if err := c.do(ctx, "GET", "/api/folders", nil, nil, &res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// GetFolder returns dashboards folder by UID.
+// Errors: NotFound(5) if folder does not exist.
+func (c *Client) GetFolder(ctx context.Context, uid string) (*Folder, error) {
+	// http://docs.grafana.org/http_api/folder/#get-folder-by-uid
+
+	if uid == "" {
+		return nil, status.Error(codes.InvalidArgument, "Folder UID is not given.")
+	}
+	var res Folder
+	if err := c.do(ctx, "GET", "/api/folders/"+url.PathEscape(uid), nil, nil, &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// CreateFolder creates dashboards folder with given UID (generated by Grafana if empty) and title.
+// Errors: AlreadyExists(6) if folder with the same UID or title exists.
+func (c *Client) CreateFolder(ctx context.Context, uid, title string) (*Folder, error) {
+	// http://docs.grafana.org/http_api/folder/#create-folder
+
+	if title == "" {
+		return nil, status.Error(codes.InvalidArgument, "Folder title is not given.")
+	}
+	request := &Folder{
+		UID:   uid,
+		Title: title,
+	}
+	var res Folder
+	if err := c.do(ctx, "POST", "/api/folders", nil, request, &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// DeleteFolder deletes dashboards folder with given UID together with all dashboards in it.
+func (c *Client) DeleteFolder(ctx context.Context, uid string) error {
+	// http://docs.grafana.org/http_api/folder/#delete-folder
+
+	if uid == "" {
+		return status.Error(codes.InvalidArgument, "Folder UID is not given.")
+	}
+	return c.do(ctx, "DELETE", "/api/folders/"+url.PathEscape(uid), nil, nil, nil)
+}
+
+// folderID returns ID of folder with given UID, or 0 (General folder) for empty UID.
+func (c *Client) folderID(ctx context.Context, uid string) (int64, error) {
+	if uid == "" {
+		return 0, nil
+	}
+	f, err := c.GetFolder(ctx, uid)
+	if err != nil {
+		return 0, err
+	}
+	return f.ID, nil
+}
+
+// ListDashboards returns dashboards in folder with given UID, or all dashboards for empty UID.
+func (c *Client) ListDashboards(ctx context.Context, folderUID string) ([]DashboardInfo, error) {
+	// http://docs.grafana.org/http_api/folder_dashboard_search/
+
+	query := url.Values{
+		"type": []string{"dash-db"},
+	}
+	if folderUID != "" {
+		id, err := c.folderID(ctx, folderUID)
+		if err != nil {
+			return nil, err
+		}
+		query.Set("folderIds", strconv.FormatInt(id, 10))
+	}
+
+	var res []DashboardInfo
+	if err := c.do(ctx, "GET", "/api/search", query, nil, &res); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// ExportDashboard returns JSON model of dashboard with given UID.
+// Errors: NotFound(5) if dashboard does not exist.
+func (c *Client) ExportDashboard(ctx context.Context, uid string) (json.RawMessage, error) {
+	// http://docs.grafana.org/http_api/dashboard/#get-dashboard-by-uid
+
+	if uid == "" {
+		return nil, status.Error(codes.InvalidArgument, "Dashboard UID is not given.")
+	}
+	var res struct {
+		Dashboard json.RawMessage `json:"dashboard"`
+	}
+	if err := c.do(ctx, "GET", "/api/dashboards/uid/"+url.PathEscape(uid), nil, nil, &res); err != nil {
+		return nil, err
+	}
+	return res.Dashboard, nil
+}
+
+// ImportDashboard creates or updates dashboard from given JSON model in folder with given UID
+// (General folder for empty UID). Dashboard is matched by UID; numeric ID is ignored so that
+// dashboards exported from other Grafana instances can be imported.
+// Errors: AlreadyExists(6) if dashboard with the same UID or title exists and overwrite is false.
+func (c *Client) ImportDashboard(ctx context.Context, dashboard json.RawMessage, folderUID string, overwrite bool) (*ImportedDashboard, error) {
+	// http://docs.grafana.org/http_api/dashboard/#create-update-dashboard
+
+	var model map[string]interface{}
+	if err := json.Unmarshal(dashboard, &model); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid dashboard JSON: %s.", err)
+	}
+	if title, _ := model["title"].(string); strings.TrimSpace(title) == "" {
+		return nil, status.Error(codes.InvalidArgument, "Dashboard title is not given.")
+	}
+	model["id"] = nil
+
+	folderID, err := c.folderID(ctx, folderUID)
+	if err != nil {
+		return nil, err
+	}
+
+	request := map[string]interface{}{
+		"dashboard": model,
+		"folderId":  folderID,
+		"overwrite": overwrite,
+	}
+	var res ImportedDashboard
+	if err = c.do(ctx, "POST", "/api/dashboards/db", nil, request, &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// DeleteDashboard deletes dashboard with given UID.
+func (c *Client) DeleteDashboard(ctx context.Context, uid string) error {
+	// http://docs.grafana.org/http_api/dashboard/#delete-dashboard-by-uid
+
+	if uid == "" {
+		return status.Error(codes.InvalidArgument, "Dashboard UID is not given.")
+	}
+	return c.do(ctx, "DELETE", "/api/dashboards/uid/"+url.PathEscape(uid), nil, nil, nil)
+}
+
+// normalizeURL returns URL without trailing slash for comparison.
+func normalizeURL(u string) string {
+	return strings.TrimRight(u, "/")
+}
+
+// EnsurePrometheusDatasource creates or updates Prometheus datasource with given name so that it points to given URL.
+// It returns true if datasource was created or changed.
+func (c *Client) EnsurePrometheusDatasource(ctx context.Context, name, prometheusURL string) (bool, error) {
+	// http://docs.grafana.org/http_api/data_source/
+
+	var ds map[string]interface{}
+	err := c.do(ctx, "GET", "/api/datasources/name/"+url.PathEscape(name), nil, nil, &ds)
+	switch status.Code(err) {
+	case codes.OK:
+		// update below
+	case codes.NotFound:
+		request := map[string]interface{}{
+			"name":      name,
+			"type":      "prometheus",
+			"url":       prometheusURL,
+			"access":    "proxy",
+			"isDefault": true,
+		}
+		if err = c.do(ctx, "POST", "/api/datasources", nil, request, nil); err != nil {
+			return false, err
+		}
+		return true, nil
+	default:
+		return false, err
+	}
+
+	if u, _ := ds["url"].(string); normalizeURL(u) == normalizeURL(prometheusURL) {
+		return false, nil
+	}
+	id, ok := ds["id"].(float64)
+	if !ok {
+		return false, errors.Errorf("unexpected datasource: %v", ds)
+	}
+	ds["url"] = prometheusURL
+	if err = c.do(ctx, "PUT", "/api/datasources/"+strconv.FormatInt(int64(id), 10), nil, ds, nil); err != nil {
+		return false, err
+	}
+	return true, nil
+}
@@ -0,0 +1,344 @@
+// pmm-managed
+// Copyright (C) 2017 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package grafana
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/percona/pmm-managed/utils/logger"
+)
+
+// fakeGrafana implements a small subset of Grafana HTTP API in memory.
+type fakeGrafana struct {
+	m          sync.Mutex
+	folders    []Folder
+	dashboards map[string]map[string]interface{} // by UID
+	inFolder   map[string]int64                  // dashboard UID -> folder ID
+	datasource map[string]interface{}
+}
+
+func newFakeGrafana() *fakeGrafana {
+	return &fakeGrafana{
+		dashboards: make(map[string]map[string]interface{}),
+		inFolder:   make(map[string]int64),
+	}
+}
+
+func (f *fakeGrafana) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	f.m.Lock()
+	defer f.m.Unlock()
+
+	respond := func(code int, v interface{}) {
+		rw.WriteHeader(code)
+		json.NewEncoder(rw).Encode(v) //nolint:errcheck
+	}
+	notFound := func() { respond(404, map[string]string{"message": "Not found"}) }
+	var body map[string]interface{}
+	if b, _ := ioutil.ReadAll(req.Body); len(b) > 0 {
+		if err := json.Unmarshal(b, &body); err != nil {
+			respond(400, map[string]string{"message": err.Error()})
+			return
+		}
+	}
+
+	path := req.URL.Path
+	switch {
+	case req.Method == "GET" && path == "/api/folders":
+		respond(200, f.folders)
+
+	case req.Method == "POST" && path == "/api/folders":
+		for _, folder := range f.folders {
+			if folder.UID == body["uid"] {
+				respond(409, map[string]string{"message": "a folder with the same uid already exists"})
+				return
+			}
+		}
+		folder := Folder{ID: int64(len(f.folders) + 1), UID: body["uid"].(string), Title: body["title"].(string)}
+		f.folders = append(f.folders, folder)
+		respond(200, folder)
+
+	case strings.HasPrefix(path, "/api/folders/"):
+		uid := strings.TrimPrefix(path, "/api/folders/")
+		for i, folder := range f.folders {
+			if folder.UID != uid {
+				continue
+			}
+			if req.Method == "DELETE" {
+				f.folders = append(f.folders[:i], f.folders[i+1:]...)
+			}
+			respond(200, folder)
+			return
+		}
+		notFound()
+
+	case req.Method == "GET" && path == "/api/search":
+		res := []DashboardInfo{}
+		for uid, d := range f.dashboards {
+			folderID := f.inFolder[uid]
+			if ids := req.URL.Query().Get("folderIds"); ids != "" && ids != strconv.FormatInt(folderID, 10) {
+				continue
+			}
+			res = append(res, DashboardInfo{UID: uid, Title: d["title"].(string), FolderID: folderID})
+		}
+		respond(200, res)
+
+	case req.Method == "POST" && path == "/api/dashboards/db":
+		d := body["dashboard"].(map[string]interface{})
+		if d["id"] != nil {
+			respond(404, map[string]string{"message": "Dashboard not found"})
+			return
+		}
+		uid, _ := d["uid"].(string)
+		if _, ok := f.dashboards[uid]; ok && body["overwrite"] != true {
+			respond(412, map[string]string{"message": "A dashboard with the same uid already exists"})
+			return
+		}
+		f.dashboards[uid] = d
+		f.inFolder[uid] = int64(body["folderId"].(float64))
+		respond(200, ImportedDashboard{ID: 1, UID: uid, URL: "/d/" + uid, Version: 1})
+
+	case strings.HasPrefix(path, "/api/dashboards/uid/"):
+		uid := strings.TrimPrefix(path, "/api/dashboards/uid/")
+		d, ok := f.dashboards[uid]
+		if !ok {
+			notFound()
+			return
+		}
+		if req.Method == "DELETE" {
+			delete(f.dashboards, uid)
+		}
+		respond(200, map[string]interface{}{"dashboard": d})
+
+	case req.Method == "GET" && strings.HasPrefix(path, "/api/datasources/name/"):
+		if f.datasource == nil || f.datasource["name"] != strings.TrimPrefix(path, "/api/datasources/name/") {
+			notFound()
+			return
+		}
+		respond(200, f.datasource)
+
+	case req.Method == "POST" && path == "/api/datasources":
+		body["id"] = float64(1)
+		f.datasource = body
+		respond(200, map[string]string{"message": "Datasource added"})
+
+	case req.Method == "PUT" && path == "/api/datasources/1":
+		f.datasource = body
+		respond(200, map[string]string{"message": "Datasource updated"})
+
+	default:
+		notFound()
+	}
+}
+
+func setupFakeGrafana(t *testing.T) (*fakeGrafana, *Client, func()) {
+	f := newFakeGrafana()
+	ts := httptest.NewServer(f)
+	return f, NewClient(strings.TrimPrefix(ts.URL, "http://")), ts.Close
+}
+
+func TestDashboards(t *testing.T) {
+	ctx, _ := logger.Set(context.Background(), t.Name())
+	f, c, teardown := setupFakeGrafana(t)
+	defer teardown()
+
+	folder, err := c.CreateFolder(ctx, "test-folder", "Test")
+	require.NoError(t, err)
+	assert.Equal(t, &Folder{ID: 1, UID: "test-folder", Title: "Test"}, folder)
+	_, err = c.CreateFolder(ctx, "test-folder", "Test")
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	folders, err := c.ListFolders(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []Folder{*folder}, folders)
+
+	// exported dashboard contains ID from another Grafana instance
+	model := json.RawMessage(`{"id": 42, "uid": "test-dashboard", "title": "Test Dashboard", "panels": []}`)
+	d, err := c.ImportDashboard(ctx, model, "test-folder", false)
+	require.NoError(t, err)
+	assert.Equal(t, "test-dashboard", d.UID)
+	assert.Equal(t, int64(1), f.inFolder["test-dashboard"])
+
+	_, err = c.ImportDashboard(ctx, model, "test-folder", false)
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+	_, err = c.ImportDashboard(ctx, model, "test-folder", true)
+	assert.NoError(t, err)
+	_, err = c.ImportDashboard(ctx, model, "no-such-folder", true)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = c.ImportDashboard(ctx, json.RawMessage(`{"uid": "x"}`), "", true)
+	assert.Equal(t, status.Error(codes.InvalidArgument, "Dashboard title is not given."), err)
+	_, err = c.ImportDashboard(ctx, json.RawMessage(`[`), "", true)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	dashboards, err := c.ListDashboards(ctx, "test-folder")
+	require.NoError(t, err)
+	require.Len(t, dashboards, 1)
+	assert.Equal(t, "Test Dashboard", dashboards[0].Title)
+
+	exported, err := c.ExportDashboard(ctx, "test-dashboard")
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"id": null, "uid": "test-dashboard", "title": "Test Dashboard", "panels": []}`, string(exported))
+
+	require.NoError(t, c.DeleteDashboard(ctx, "test-dashboard"))
+	_, err = c.ExportDashboard(ctx, "test-dashboard")
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	require.NoError(t, c.DeleteFolder(ctx, "test-folder"))
+	err = c.DeleteFolder(ctx, "")
+	assert.Equal(t, status.Error(codes.InvalidArgument, "Folder UID is not given."), err)
+
+	// UIDs are escaped
+	_, err = c.CreateFolder(ctx, "test?folder/1", "Test 1")
+	require.NoError(t, err)
+	folder, err = c.GetFolder(ctx, "test?folder/1")
+	require.NoError(t, err)
+	assert.Equal(t, "test?folder/1", folder.UID)
+	require.NoError(t, c.DeleteFolder(ctx, "test?folder/1"))
+	_, err = c.GetFolder(ctx, "test?folder/1")
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestEnsurePrometheusDatasource(t *testing.T) {
+	ctx, _ := logger.Set(context.Background(), t.Name())
+	f, c, teardown := setupFakeGrafana(t)
+	defer teardown()
+
+	changed, err := c.EnsurePrometheusDatasource(ctx, PrometheusDatasource, "http://127.0.0.1:9090/prometheus/")
+	require.NoError(t, err)
+	assert.True(t, changed)
+	assert.Equal(t, "prometheus", f.datasource["type"])
+
+	changed, err = c.EnsurePrometheusDatasource(ctx, PrometheusDatasource, "http://127.0.0.1:9090/prometheus")
+	require.NoError(t, err)
+	assert.False(t, changed)
+
+	f.datasource["jsonData"] = map[string]interface{}{"timeInterval": "1s"}
+	changed, err = c.EnsurePrometheusDatasource(ctx, PrometheusDatasource, "http://127.0.0.1:9091/")
+	require.NoError(t, err)
+	assert.True(t, changed)
+	assert.Equal(t, "http://127.0.0.1:9091/", f.datasource["url"])
+	assert.Equal(t, map[string]interface{}{"timeInterval": "1s"}, f.datasource["jsonData"], "other fields should be kept")
+}
+
+func TestProvisioner(t *testing.T) {
+	ctx, _ := logger.Set(context.Background(), t.Name())
+	f, c, teardown := setupFakeGrafana(t)
+	defer teardown()
+
+	dir, err := ioutil.TempDir("", "pmm-managed-dashboards-")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir) //nolint:errcheck
+	require.NoError(t, os.Mkdir(filepath.Join(dir, InstanceTypeMySQL), 0750))
+	for _, uid := range []string{"mysql-overview", "mysql-innodb"} {
+		b := []byte(`{"uid": "` + uid + `", "title": "` + uid + `"}`)
+		require.NoError(t, ioutil.WriteFile(filepath.Join(dir, InstanceTypeMySQL, uid+".json"), b, 0640))
+	}
+
+	p := NewProvisioner(c, dir, "http://127.0.0.1:9090/prometheus/")
+	require.NoError(t, p.Check())
+
+	t.Run("Normal", func(t *testing.T) {
+		n, err := p.provision(ctx, InstanceTypeMySQL)
+		require.NoError(t, err)
+		assert.Equal(t, 2, n)
+		assert.Equal(t, []Folder{{ID: 1, UID: "pmm-mysql", Title: "MySQL"}}, f.folders)
+		assert.Equal(t, "http://127.0.0.1:9090/prometheus/", f.datasource["url"])
+
+		// second instance of the same type
+		n, err = p.provision(ctx, InstanceTypeMySQL)
+		require.NoError(t, err)
+		assert.Equal(t, 0, n)
+	})
+
+	t.Run("NoDashboards", func(t *testing.T) {
+		n, err := p.provision(ctx, InstanceTypePostgreSQL)
+		require.NoError(t, err)
+		assert.Equal(t, 0, n)
+		assert.Len(t, f.folders, 1)
+	})
+
+	t.Run("PartiallyProvisioned", func(t *testing.T) {
+		// previous provisioning failed after creating folder
+		delete(f.dashboards, "mysql-innodb")
+
+		n, err := p.provision(ctx, InstanceTypeMySQL)
+		require.NoError(t, err)
+		assert.Equal(t, 1, n)
+		assert.Len(t, f.dashboards, 2)
+		assert.Len(t, f.folders, 1)
+	})
+
+	t.Run("Background", func(t *testing.T) {
+		require.NoError(t, c.DeleteFolder(ctx, "pmm-mysql"))
+		delete(f.dashboards, "mysql-innodb")
+
+		// mysql-overview exists and is skipped
+		p.InstanceAdded(ctx, InstanceTypeMySQL)
+		p.wg.Wait()
+		assert.Len(t, f.folders, 1)
+		assert.Len(t, f.dashboards, 2)
+	})
+
+	t.Run("Stopped", func(t *testing.T) {
+		runCtx, cancel := context.WithCancel(ctx)
+		done := make(chan struct{})
+		go func() {
+			p.Run(runCtx)
+			close(done)
+		}()
+
+		// calls during shutdown are either completed by Run or ignored
+		var wg sync.WaitGroup
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				p.InstanceAdded(ctx, InstanceTypeMySQL)
+			}()
+		}
+		cancel()
+		<-done
+		wg.Wait()
+
+		// calls after shutdown are ignored
+		delete(f.dashboards, "mysql-innodb")
+		p.InstanceAdded(ctx, InstanceTypeMySQL)
+		p.wg.Wait()
+		assert.Len(t, f.dashboards, 1)
+	})
+
+	t.Run("Disabled", func(t *testing.T) {
+		var p *Provisioner
+		require.NoError(t, p.Check())
+		p.InstanceAdded(ctx, InstanceTypeMySQL)
+		p.Run(ctx)
+	})
+}
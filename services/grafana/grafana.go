@@ -21,16 +21,24 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
 	"net/http"
 	"net/url"
+	"sort"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
-
-	"github.com/percona/pmm-managed/utils/logger"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
+// pmmTag is added to all annotations created by pmm-managed.
+const pmmTag = "pmm_annotation"
+
 // Client represents a client for Grafana API.
 type Client struct {
 	addr string
@@ -45,100 +53,320 @@ func NewClient(addr string) *Client {
 	}
 }
 
-type annotation struct {
-	Time time.Time `json:"-"`
-	Tags []string  `json:"tags,omitempty"`
-	Text string    `json:"text,omitempty"`
+// InstanceTag returns annotation tag for instance with given name.
+func InstanceTag(name string) string {
+	return "instance:" + name
+}
 
-	TimeInt int64 `json:"time,omitempty"`
+// NodeTag returns annotation tag for node with given name.
+func NodeTag(name string) string {
+	return "node:" + name
 }
 
-// encode annotation before sending request.
-func (a *annotation) encode() {
-	var t int64
-	if !a.Time.IsZero() {
-		t = a.Time.UnixNano() / int64(time.Millisecond)
+// errorCode maps Grafana HTTP response status code to gRPC code.
+func errorCode(statusCode int) codes.Code {
+	switch statusCode {
+	case http.StatusBadRequest:
+		return codes.InvalidArgument
+	case http.StatusUnauthorized:
+		return codes.Unauthenticated
+	case http.StatusForbidden:
+		return codes.PermissionDenied
+	case http.StatusNotFound:
+		return codes.NotFound
+	case http.StatusConflict, http.StatusPreconditionFailed:
+		return codes.AlreadyExists
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return codes.Unavailable
+	default:
+		return codes.Internal
 	}
-	a.TimeInt = t
 }
 
-// decode annotation after receiving response.
-func (a *annotation) decode() {
-	var t time.Time
-	if a.TimeInt != 0 {
-		t = time.Unix(0, a.TimeInt*int64(time.Millisecond))
+// do makes HTTP request to Grafana API with given method, escaped path, query and JSON body (may be nil),
+// and decodes JSON response into response (may be nil).
+// Non-2xx responses are returned as gRPC status errors with mapped codes.
+func (c *Client) do(ctx context.Context, method, path string, query url.Values, body, response interface{}) error {
+	unescaped, err := url.PathUnescape(path)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	u := url.URL{
+		Scheme:   "http",
+		Host:     c.addr,
+		Path:     unescaped,
+		RawPath:  path,
+		RawQuery: query.Encode(),
+	}
+
+	var reqBody io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return errors.Wrap(err, "failed to marshal request")
+		}
+		reqBody = bytes.NewReader(b)
+	}
+	req, err := http.NewRequest(method, u.String(), reqBody)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	req = req.WithContext(ctx)
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return status.Errorf(codes.Unavailable, "Failed to connect to Grafana: %s.", err)
+	}
+	defer resp.Body.Close()
+
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return errors.Wrap(err, "failed to read response")
 	}
-	a.Time = t
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		var e struct {
+			Message string `json:"message"`
+		}
+		msg := strings.TrimSpace(string(b))
+		if json.Unmarshal(b, &e) == nil && e.Message != "" {
+			msg = e.Message
+		}
+		if msg == "" {
+			msg = http.StatusText(resp.StatusCode)
+		}
+		return status.Errorf(errorCode(resp.StatusCode), "Grafana responded with status %d: %s.", resp.StatusCode, msg)
+	}
+
+	if response == nil {
+		return nil
+	}
+	if err = json.Unmarshal(b, response); err != nil {
+		return errors.Wrap(err, "failed to decode JSON response")
+	}
+	return nil
 }
 
-// CreateAnnotation creates annotation with given text and tags ("pmm_annotation" is added automatically)
-// and returns Grafana's response text which is typically "Annotation added" or "Failed to save annotation".
-func (c *Client) CreateAnnotation(ctx context.Context, tags []string, text string) (string, error) {
-	// http://docs.grafana.org/http_api/annotations/#create-annotation
+// Annotation represents Grafana annotation.
+type Annotation struct {
+	ID      int64
+	Time    time.Time // annotation (or region start) time
+	TimeEnd time.Time // region end time, zero for point annotations
+	Tags    []string
+	Text    string
+}
+
+// IsRegion returns true if annotation has time range.
+func (a Annotation) IsRegion() bool {
+	return !a.TimeEnd.IsZero()
+}
 
-	request := &annotation{
-		Tags: append([]string{"pmm_annotation"}, tags...),
-		Text: text,
+// String returns human-readable annotation representation for debugging.
+func (a Annotation) String() string {
+	return fmt.Sprintf("%d: %s - %s %v %q", a.ID, a.Time, a.TimeEnd, a.Tags, a.Text)
+}
+
+// annotation is Grafana annotation JSON representation.
+type annotation struct {
+	ID       int64    `json:"id,omitempty"`
+	Time     int64    `json:"time,omitempty"`
+	TimeEnd  int64    `json:"timeEnd,omitempty"`
+	IsRegion bool     `json:"isRegion,omitempty"`
+	RegionID int64    `json:"regionId,omitempty"`
+	Tags     []string `json:"tags,omitempty"`
+	Text     string   `json:"text,omitempty"`
+}
+
+func toMillis(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
 	}
-	request.encode()
-	var buf bytes.Buffer
-	if err := json.NewEncoder(&buf).Encode(request); err != nil {
-		return "", errors.Wrap(err, "failed to marhal request")
+	return t.UnixNano() / int64(time.Millisecond)
+}
+
+func fromMillis(ms int64) time.Time {
+	if ms == 0 {
+		return time.Time{}
 	}
+	return time.Unix(0, ms*int64(time.Millisecond))
+}
 
-	u := url.URL{
-		Scheme: "http",
-		Host:   c.addr,
-		Path:   "/api/annotations",
+// encode converts annotation to JSON representation before sending request.
+func encode(a *Annotation) *annotation {
+	return &annotation{
+		ID:       a.ID,
+		Time:     toMillis(a.Time),
+		TimeEnd:  toMillis(a.TimeEnd),
+		IsRegion: a.IsRegion(),
+		Tags:     a.Tags,
+		Text:     a.Text,
 	}
-	resp, err := c.http.Post(u.String(), "application/json", &buf)
-	if err != nil {
-		return "", errors.Wrap(err, "failed to make request")
+}
+
+// decode converts JSON representation to annotation after receiving response.
+func (a *annotation) decode() Annotation {
+	res := Annotation{
+		ID:   a.ID,
+		Time: fromMillis(a.Time),
+		Tags: a.Tags,
+		Text: a.Text,
 	}
-	defer resp.Body.Close()
+	if a.TimeEnd != a.Time {
+		res.TimeEnd = fromMillis(a.TimeEnd)
+	}
+	return res
+}
 
-	if resp.StatusCode != 200 {
-		logger.Get(ctx).Warnf("Grafana responded with status %d.", resp.StatusCode)
+// validateAnnotation checks annotation fields before sending request.
+func validateAnnotation(a *Annotation) error {
+	if a.Text == "" {
+		return status.Error(codes.InvalidArgument, "Annotation text is empty.")
+	}
+	if a.IsRegion() {
+		if a.Time.IsZero() {
+			return status.Error(codes.InvalidArgument, "Annotation region start time is not given.")
+		}
+		if a.TimeEnd.Before(a.Time) {
+			return status.Error(codes.InvalidArgument, "Annotation region end time is before start time.")
+		}
 	}
+	return nil
+}
+
+// withPMMTag returns tags with "pmm_annotation" tag added as the first one (if not present).
+func withPMMTag(tags []string) []string {
+	for _, t := range tags {
+		if t == pmmTag {
+			return tags
+		}
+	}
+	return append([]string{pmmTag}, tags...)
+}
+
+// CreateAnnotation creates annotation ("pmm_annotation" tag is added automatically).
+// Zero Time means "now"; non-zero TimeEnd creates region annotation.
+// It sets a.ID and returns Grafana's response text which is typically "Annotation added".
+func (c *Client) CreateAnnotation(ctx context.Context, a *Annotation) (string, error) {
+	// http://docs.grafana.org/http_api/annotations/#create-annotation
+
+	if err := validateAnnotation(a); err != nil {
+		return "", err
+	}
+	request := encode(a)
+	request.ID = 0
+	request.Tags = withPMMTag(a.Tags)
 
 	var response struct {
 		Message string `json:"message"`
+		ID      int64  `json:"id"`
 	}
-	if err = json.NewDecoder(resp.Body).Decode(&response); err != nil {
-		return "", errors.Wrap(err, "failed to decode JSON response")
+	if err := c.do(ctx, "POST", "/api/annotations", nil, request, &response); err != nil {
+		return "", err
 	}
+	a.ID = response.ID
 	return response.Message, nil
 }
 
-func (c *Client) findAnnotations(ctx context.Context, from, to time.Time) ([]annotation, error) {
+// AnnotationsQuery represents parameters for FindAnnotations.
+type AnnotationsQuery struct {
+	From  time.Time // zero means no lower bound
+	To    time.Time // zero means no upper bound
+	Tags  []string  // annotations should have all of them
+	Limit int       // zero means Grafana's default
+}
+
+// FindAnnotations returns annotations matching given query sorted by time (newest first).
+// Only annotations created by pmm-managed are returned.
+func (c *Client) FindAnnotations(ctx context.Context, q *AnnotationsQuery) ([]Annotation, error) {
 	// http://docs.grafana.org/http_api/annotations/#find-annotations
 
-	u := &url.URL{
-		Scheme: "http",
-		Host:   c.addr,
-		Path:   "/api/annotations",
-		RawQuery: url.Values{
-			"from": []string{strconv.FormatInt(from.UnixNano()/int64(time.Millisecond), 10)},
-			"to":   []string{strconv.FormatInt(to.UnixNano()/int64(time.Millisecond), 10)},
-		}.Encode(),
+	query := url.Values{
+		"type": []string{"annotation"},
+		"tags": withPMMTag(q.Tags),
 	}
-	resp, err := c.http.Get(u.String())
-	if err != nil {
-		return nil, errors.Wrap(err, "failed to make request")
+	if !q.From.IsZero() {
+		query.Set("from", strconv.FormatInt(toMillis(q.From), 10))
 	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != 200 {
-		logger.Get(ctx).Warnf("Grafana responded with status %d.", resp.StatusCode)
+	if !q.To.IsZero() {
+		query.Set("to", strconv.FormatInt(toMillis(q.To), 10))
+	}
+	if q.Limit > 0 {
+		query.Set("limit", strconv.Itoa(q.Limit))
 	}
 
 	var response []annotation
-	if err = json.NewDecoder(resp.Body).Decode(&response); err != nil {
-		return nil, errors.Wrap(err, "failed to decode JSON response")
+	if err := c.do(ctx, "GET", "/api/annotations", query, nil, &response); err != nil {
+		return nil, err
+	}
+	return mergeRegions(response), nil
+}
+
+// mergeRegions decodes annotations and merges regions stored by older Grafana versions
+// as two separate annotations (start and end) with the same region ID.
+func mergeRegions(annotations []annotation) []Annotation {
+	res := make([]Annotation, 0, len(annotations))
+	regions := make(map[int64]int) // region ID -> index in res
+	for _, a := range annotations {
+		if a.RegionID == 0 {
+			res = append(res, a.decode())
+			continue
+		}
+
+		i, ok := regions[a.RegionID]
+		if !ok {
+			d := a.decode()
+			d.ID = a.RegionID
+			if d.TimeEnd.IsZero() {
+				d.TimeEnd = d.Time
+			}
+			regions[a.RegionID] = len(res)
+			res = append(res, d)
+			continue
+		}
+
+		t := fromMillis(a.Time)
+		if t.Before(res[i].Time) {
+			res[i].Time = t
+		}
+		if t.After(res[i].TimeEnd) {
+			res[i].TimeEnd = t
+		}
+	}
+
+	sort.SliceStable(res, func(i, j int) bool { return res[i].Time.After(res[j].Time) })
+	return res
+}
+
+// UpdateAnnotation updates annotation with given ID ("pmm_annotation" tag is kept automatically).
+func (c *Client) UpdateAnnotation(ctx context.Context, a *Annotation) error {
+	// http://docs.grafana.org/http_api/annotations/#update-annotation
+
+	if a.ID == 0 {
+		return status.Error(codes.InvalidArgument, "Annotation ID is not given.")
+	}
+	if a.Time.IsZero() {
+		return status.Error(codes.InvalidArgument, "Annotation time is not given.")
+	}
+	if err := validateAnnotation(a); err != nil {
+		return err
 	}
-	for i, r := range response {
-		r.decode()
-		response[i] = r
+	request := encode(a)
+	request.Tags = withPMMTag(a.Tags)
+
+	path := "/api/annotations/" + strconv.FormatInt(a.ID, 10)
+	return c.do(ctx, "PUT", path, nil, request, nil)
+}
+
+// DeleteAnnotation deletes annotation (or region) with given ID.
+func (c *Client) DeleteAnnotation(ctx context.Context, id int64) error {
+	// http://docs.grafana.org/http_api/annotations/#delete-annotation-by-id
+
+	if id == 0 {
+		return status.Error(codes.InvalidArgument, "Annotation ID is not given.")
 	}
-	return response, nil
+	path := "/api/annotations/" + strconv.FormatInt(id, 10)
+	return c.do(ctx, "DELETE", path, nil, nil, nil)
 }
@@ -18,57 +18,220 @@ package grafana
 
 import (
 	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/percona/pmm-managed/utils/logger"
 )
 
-func TestCreateAnnotation(t *testing.T) {
+func findAnnotation(t *testing.T, annotations []Annotation, text string) *Annotation {
+	for _, a := range annotations {
+		if a.Text == text {
+			return &a
+		}
+	}
+	require.Fail(t, "annotation not found", "%s", annotations)
+	return nil
+}
+
+func TestAnnotations(t *testing.T) {
 	from := time.Now()
 	ctx, _ := logger.Set(context.Background(), t.Name())
 	c := NewClient("127.0.0.1:3000")
 
 	t.Run("Normal", func(t *testing.T) {
-		msg, err := c.CreateAnnotation(ctx, []string{"tag1", "tag2"}, "Normal")
+		a := &Annotation{Tags: []string{"tag1", "tag2"}, Text: "Normal"}
+		msg, err := c.CreateAnnotation(ctx, a)
 		require.NoError(t, err)
 		assert.Equal(t, "Annotation added", msg)
+		assert.NotZero(t, a.ID)
 
-		annotations, err := c.findAnnotations(ctx, from, from.Add(time.Second))
+		annotations, err := c.FindAnnotations(ctx, &AnnotationsQuery{From: from, To: from.Add(time.Second)})
 		require.NoError(t, err)
-		for _, a := range annotations {
-			if a.Text == "Normal" {
-				assert.Equal(t, []string{"pmm_annotation", "tag1", "tag2"}, a.Tags)
-				assert.InDelta(t, from.Unix(), a.Time.Unix(), 1)
-				return
-			}
-		}
-		assert.Fail(t, "annotation not found", "%s", annotations)
+		actual := findAnnotation(t, annotations, "Normal")
+		assert.Equal(t, a.ID, actual.ID)
+		assert.Equal(t, []string{"pmm_annotation", "tag1", "tag2"}, actual.Tags)
+		assert.InDelta(t, from.Unix(), actual.Time.Unix(), 1)
+		assert.False(t, actual.IsRegion())
 	})
 
 	t.Run("Empty", func(t *testing.T) {
-		msg, err := c.CreateAnnotation(ctx, nil, "")
-		require.NoError(t, err)
-		assert.Equal(t, "Failed to save annotation", msg)
+		_, err := c.CreateAnnotation(ctx, &Annotation{})
+		assert.Equal(t, status.Error(codes.InvalidArgument, "Annotation text is empty."), err)
 	})
 
 	t.Run("No tags", func(t *testing.T) {
-		msg, err := c.CreateAnnotation(ctx, nil, "No tags")
+		msg, err := c.CreateAnnotation(ctx, &Annotation{Text: "No tags"})
+		require.NoError(t, err)
+		assert.Equal(t, "Annotation added", msg)
+
+		annotations, err := c.FindAnnotations(ctx, &AnnotationsQuery{From: from, To: from.Add(time.Second)})
+		require.NoError(t, err)
+		actual := findAnnotation(t, annotations, "No tags")
+		assert.Equal(t, []string{"pmm_annotation"}, actual.Tags)
+		assert.InDelta(t, from.Unix(), actual.Time.Unix(), 1)
+	})
+
+	t.Run("RegionUpdateDelete", func(t *testing.T) {
+		start := from.Add(-time.Hour).Truncate(time.Second)
+		end := start.Add(10 * time.Minute)
+		a := &Annotation{Time: start, TimeEnd: end, Tags: []string{"deploy"}, Text: "Region"}
+		_, err := c.CreateAnnotation(ctx, a)
+		require.NoError(t, err)
+
+		q := &AnnotationsQuery{From: start.Add(-time.Minute), To: end.Add(time.Minute), Tags: []string{"deploy"}}
+		annotations, err := c.FindAnnotations(ctx, q)
+		require.NoError(t, err)
+		actual := findAnnotation(t, annotations, "Region")
+		assert.Equal(t, start, actual.Time)
+		assert.Equal(t, end, actual.TimeEnd)
+
+		a.Text = "Region updated"
+		require.NoError(t, c.UpdateAnnotation(ctx, a))
+		annotations, err = c.FindAnnotations(ctx, q)
+		require.NoError(t, err)
+		findAnnotation(t, annotations, "Region updated")
+
+		require.NoError(t, c.DeleteAnnotation(ctx, a.ID))
+		err = c.DeleteAnnotation(ctx, 0)
+		assert.Equal(t, status.Error(codes.InvalidArgument, "Annotation ID is not given."), err)
+	})
+}
+
+func TestClient(t *testing.T) {
+	ctx, _ := logger.Set(context.Background(), t.Name())
+
+	var lastRequest *http.Request
+	var lastBody []byte
+	var code int
+	var body string
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		lastRequest = req
+		lastBody, _ = ioutil.ReadAll(req.Body)
+		rw.WriteHeader(code)
+		rw.Write([]byte(body)) //nolint:errcheck
+	}))
+	defer ts.Close()
+	c := NewClient(strings.TrimPrefix(ts.URL, "http://"))
+	respond := func(statusCode int, responseBody string) *Client {
+		code, body = statusCode, responseBody
+		return c
+	}
+
+	t.Run("CreateRegion", func(t *testing.T) {
+		c := respond(200, `{"message":"Annotation added","id":42,"endId":43}`)
+		start := time.Unix(1500000000, 0)
+		a := &Annotation{Time: start, TimeEnd: start.Add(time.Minute), Tags: []string{InstanceTag("db1")}, Text: "Deploy"}
+		msg, err := c.CreateAnnotation(ctx, a)
 		require.NoError(t, err)
 		assert.Equal(t, "Annotation added", msg)
+		assert.Equal(t, int64(42), a.ID)
+
+		assert.Equal(t, "POST", lastRequest.Method)
+		assert.Equal(t, "/api/annotations", lastRequest.URL.Path)
+		var body map[string]interface{}
+		require.NoError(t, json.Unmarshal(lastBody, &body))
+		expected := map[string]interface{}{
+			"time":     float64(1500000000000),
+			"timeEnd":  float64(1500000060000),
+			"isRegion": true,
+			"tags":     []interface{}{"pmm_annotation", "instance:db1"},
+			"text":     "Deploy",
+		}
+		assert.Equal(t, expected, body)
+	})
+
+	t.Run("InvalidRegion", func(t *testing.T) {
+		c := respond(200, `{}`)
+		start := time.Unix(1500000000, 0)
+		_, err := c.CreateAnnotation(ctx, &Annotation{Time: start, TimeEnd: start.Add(-time.Minute), Text: "Deploy"})
+		assert.Equal(t, status.Error(codes.InvalidArgument, "Annotation region end time is before start time."), err)
+	})
+
+	t.Run("Find", func(t *testing.T) {
+		// old Grafana versions return regions as two annotations with the same region ID,
+		// new ones return a single annotation with timeEnd
+		c := respond(200, `[
+			{"id": 3, "time": 1500000120000, "timeEnd": 1500000120000, "tags": ["pmm_annotation"], "text": "Point"},
+			{"id": 2, "regionId": 1, "time": 1500000060000, "tags": ["pmm_annotation"], "text": "Old region"},
+			{"id": 1, "regionId": 1, "time": 1500000000000, "tags": ["pmm_annotation"], "text": "Old region"},
+			{"id": 4, "time": 1500000030000, "timeEnd": 1500000090000, "tags": ["pmm_annotation"], "text": "New region"}
+		]`)
+		from := time.Unix(1500000000, 0)
+		annotations, err := c.FindAnnotations(ctx, &AnnotationsQuery{
+			From:  from,
+			To:    from.Add(time.Hour),
+			Tags:  []string{"deploy"},
+			Limit: 10,
+		})
+		require.NoError(t, err)
 
-		annotations, err := c.findAnnotations(ctx, from, from.Add(time.Second))
+		assert.Equal(t, "GET", lastRequest.Method)
+		q := lastRequest.URL.Query()
+		assert.Equal(t, []string{"pmm_annotation", "deploy"}, q["tags"])
+		assert.Equal(t, "1500000000000", q.Get("from"))
+		assert.Equal(t, "1500003600000", q.Get("to"))
+		assert.Equal(t, "10", q.Get("limit"))
+		assert.Equal(t, "annotation", q.Get("type"))
+
+		expected := []Annotation{
+			{ID: 3, Time: time.Unix(1500000120, 0), Tags: []string{"pmm_annotation"}, Text: "Point"},
+			{ID: 4, Time: time.Unix(1500000030, 0), TimeEnd: time.Unix(1500000090, 0), Tags: []string{"pmm_annotation"}, Text: "New region"},
+			{ID: 1, Time: time.Unix(1500000000, 0), TimeEnd: time.Unix(1500000060, 0), Tags: []string{"pmm_annotation"}, Text: "Old region"},
+		}
+		assert.Equal(t, expected, annotations)
+	})
+
+	t.Run("Update", func(t *testing.T) {
+		c := respond(200, `{"message":"Annotation updated"}`)
+		err := c.UpdateAnnotation(ctx, &Annotation{ID: 5, Time: time.Unix(1500000000, 0), Tags: []string{"pmm_annotation", "t"}, Text: "X"})
 		require.NoError(t, err)
-		for _, a := range annotations {
-			if a.Text == "No tags" {
-				assert.Equal(t, []string{"pmm_annotation"}, a.Tags)
-				assert.InDelta(t, from.Unix(), a.Time.Unix(), 1)
-				return
-			}
+		assert.Equal(t, "PUT", lastRequest.Method)
+		assert.Equal(t, "/api/annotations/5", lastRequest.URL.Path)
+		assert.JSONEq(t, `{"id": 5, "time": 1500000000000, "tags": ["pmm_annotation", "t"], "text": "X"}`, string(lastBody))
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		c := respond(200, `{"message":"Annotation deleted"}`)
+		require.NoError(t, c.DeleteAnnotation(ctx, 5))
+		assert.Equal(t, "DELETE", lastRequest.Method)
+		assert.Equal(t, "/api/annotations/5", lastRequest.URL.Path)
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		for _, tc := range []struct {
+			code     int
+			body     string
+			expected error
+		}{
+			{400, `{"message":"bad request data"}`, status.Error(codes.InvalidArgument, "Grafana responded with status 400: bad request data.")},
+			{401, `{"message":"Unauthorized"}`, status.Error(codes.Unauthenticated, "Grafana responded with status 401: Unauthorized.")},
+			{403, `{"message":"Access denied"}`, status.Error(codes.PermissionDenied, "Grafana responded with status 403: Access denied.")},
+			{404, `{"message":"Annotation not found"}`, status.Error(codes.NotFound, "Grafana responded with status 404: Annotation not found.")},
+			{500, `{"message":"Failed to save annotation"}`, status.Error(codes.Internal, "Grafana responded with status 500: Failed to save annotation.")},
+			{502, `upstream error`, status.Error(codes.Unavailable, "Grafana responded with status 502: upstream error.")},
+			{503, ``, status.Error(codes.Unavailable, "Grafana responded with status 503: Service Unavailable.")},
+		} {
+			c := respond(tc.code, tc.body)
+			err := c.DeleteAnnotation(ctx, 1)
+			assert.Equal(t, tc.expected, err, "%d", tc.code)
 		}
-		assert.Fail(t, "annotation not found", "%s", annotations)
+	})
+
+	t.Run("Unavailable", func(t *testing.T) {
+		c := NewClient("127.0.0.1:1")
+		err := c.DeleteAnnotation(ctx, 1)
+		require.Error(t, err)
+		assert.Equal(t, codes.Unavailable, status.Code(err))
 	})
 }
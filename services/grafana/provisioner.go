@@ -0,0 +1,279 @@
+// pmm-managed
+// Copyright (C) 2017 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package grafana
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/percona/pmm-managed/utils/logger"
+)
+
+// Instance types for dashboards provisioning.
+const (
+	InstanceTypeMySQL      = "mysql"
+	InstanceTypePostgreSQL = "postgresql"
+	InstanceTypeRDS        = "rds"
+)
+
+// PrometheusDatasource is a name of Grafana datasource used by PMM dashboards.
+const PrometheusDatasource = "Prometheus"
+
+const (
+	provisionTimeout    = 30 * time.Second
+	datasourceRetryTime = 10 * time.Second
+)
+
+// folderTitles contains titles of folders for known instance types.
+var folderTitles = map[string]string{
+	InstanceTypeMySQL:      "MySQL",
+	InstanceTypePostgreSQL: "PostgreSQL",
+	InstanceTypeRDS:        "Amazon RDS",
+}
+
+// FolderUID returns UID of provisioned dashboards folder for given instance type.
+func FolderUID(instanceType string) string {
+	return "pmm-" + instanceType
+}
+
+// Provisioner provisions Grafana datasource and dashboards.
+// Dashboards for instance type are imported from JSON files in <dashboards dir>/<instance type>/
+// into a separate folder when instance of that type is added; dashboards that already exist (matched by UID)
+// are skipped, so partially failed provisioning is completed next time. Errors are logged and never returned
+// to the caller.
+// Nil *Provisioner is valid and does nothing.
+type Provisioner struct {
+	client        *Client
+	dashboardsDir string
+	prometheusURL string
+	l             *logrus.Entry
+
+	m       sync.Mutex
+	stopped bool // set by Run before waiting for pending provisioning
+	wg      sync.WaitGroup
+}
+
+// NewProvisioner creates a new provisioner. Empty dashboardsDir disables dashboards provisioning.
+func NewProvisioner(client *Client, dashboardsDir, prometheusURL string) *Provisioner {
+	return &Provisioner{
+		client:        client,
+		dashboardsDir: dashboardsDir,
+		prometheusURL: prometheusURL,
+		l:             logrus.WithField("component", "grafana/provisioner"),
+	}
+}
+
+// Run ensures that Prometheus datasource points to pmm-managed's Prometheus URL.
+// It retries until success or until context is canceled, then waits for pending provisioning.
+func (p *Provisioner) Run(ctx context.Context) {
+	if p == nil {
+		return
+	}
+	defer func() {
+		// wg.Add should not be called concurrently with wg.Wait
+		p.m.Lock()
+		p.stopped = true
+		p.m.Unlock()
+		p.wg.Wait()
+	}()
+
+	for {
+		err := p.ensureDatasource(ctx)
+		if err == nil {
+			break
+		}
+		p.l.Warnf("Failed to ensure Prometheus datasource, will retry: %s", err)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(datasourceRetryTime):
+		}
+	}
+
+	<-ctx.Done()
+}
+
+func (p *Provisioner) ensureDatasource(ctx context.Context) error {
+	changed, err := p.client.EnsurePrometheusDatasource(ctx, PrometheusDatasource, p.prometheusURL)
+	if err != nil {
+		return err
+	}
+	if changed {
+		p.l.Infof("Prometheus datasource %q set to %s.", PrometheusDatasource, p.prometheusURL)
+	}
+	return nil
+}
+
+// InstanceAdded provisions dashboards for given instance type in the background if they are not provisioned yet.
+// Calls made after Run is finished (during shutdown) are ignored.
+func (p *Provisioner) InstanceAdded(ctx context.Context, instanceType string) {
+	if p == nil || p.dashboardsDir == "" {
+		return
+	}
+
+	l := logger.Get(ctx).WithField("component", "grafana/provisioner")
+	p.m.Lock()
+	defer p.m.Unlock()
+	if p.stopped {
+		l.Warnf("Shutting down, %s dashboards are not provisioned.", instanceType)
+		return
+	}
+	p.wg.Add(1)
+	go func() {
+		defer p.wg.Done()
+
+		// do not use request's context: it is canceled when request is finished
+		ctx, cancel := context.WithTimeout(context.Background(), provisionTimeout)
+		defer cancel()
+		n, err := p.provision(ctx, instanceType)
+		if err != nil {
+			l.Warnf("Failed to provision %s dashboards: %s", instanceType, err)
+			return
+		}
+		if n > 0 {
+			l.Infof("Provisioned %d %s dashboards.", n, instanceType)
+		}
+	}()
+}
+
+// dashboardFiles returns sorted paths of dashboards JSON files for given instance type.
+func (p *Provisioner) dashboardFiles(instanceType string) ([]string, error) {
+	files, err := filepath.Glob(filepath.Join(p.dashboardsDir, instanceType, "*.json"))
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	sort.Strings(files)
+	return files, nil
+}
+
+// ensureFolder creates dashboards folder for given instance type if it does not exist.
+func (p *Provisioner) ensureFolder(ctx context.Context, instanceType string) error {
+	uid := FolderUID(instanceType)
+	_, err := p.client.GetFolder(ctx, uid)
+	switch status.Code(err) {
+	case codes.OK:
+		return nil
+	case codes.NotFound:
+		// create below
+	default:
+		return err
+	}
+
+	title := folderTitles[instanceType]
+	if title == "" {
+		title = instanceType
+	}
+	_, err = p.client.CreateFolder(ctx, uid, title)
+	if status.Code(err) == codes.AlreadyExists {
+		return nil // created concurrently
+	}
+	return err
+}
+
+// dashboardExists returns true if dashboard from given JSON model has UID and exists.
+func (p *Provisioner) dashboardExists(ctx context.Context, b []byte) (bool, error) {
+	var model struct {
+		UID string `json:"uid"`
+	}
+	if err := json.Unmarshal(b, &model); err != nil || model.UID == "" {
+		return false, nil // let ImportDashboard handle that
+	}
+
+	_, err := p.client.ExportDashboard(ctx, model.UID)
+	switch status.Code(err) {
+	case codes.OK:
+		return true, nil
+	case codes.NotFound:
+		return false, nil
+	default:
+		return false, err
+	}
+}
+
+// provision imports missing dashboards for given instance type and returns a number of imported dashboards.
+func (p *Provisioner) provision(ctx context.Context, instanceType string) (int, error) {
+	files, err := p.dashboardFiles(instanceType)
+	if err != nil {
+		return 0, err
+	}
+
+	var n int
+	var prepared bool
+	uid := FolderUID(instanceType)
+	for _, file := range files {
+		b, err := ioutil.ReadFile(file) //nolint:gosec
+		if err != nil {
+			return n, errors.WithStack(err)
+		}
+		exists, err := p.dashboardExists(ctx, b)
+		if err != nil {
+			return n, errors.Wrap(err, filepath.Base(file))
+		}
+		if exists {
+			continue
+		}
+
+		if !prepared {
+			if err = p.ensureDatasource(ctx); err != nil {
+				return n, err
+			}
+			if err = p.ensureFolder(ctx, instanceType); err != nil {
+				return n, err
+			}
+			prepared = true
+		}
+
+		// do not overwrite dashboards installed by other means
+		_, err = p.client.ImportDashboard(ctx, b, uid, false)
+		switch status.Code(err) {
+		case codes.OK:
+			n++
+		case codes.AlreadyExists:
+			p.l.Infof("Dashboard %s already exists, skipping: %s", filepath.Base(file), err)
+		default:
+			return n, errors.Wrap(err, filepath.Base(file))
+		}
+	}
+	return n, nil
+}
+
+// Check checks that dashboards directory exists if it is given.
+func (p *Provisioner) Check() error {
+	if p == nil || p.dashboardsDir == "" {
+		return nil
+	}
+	fi, err := os.Stat(p.dashboardsDir)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if !fi.IsDir() {
+		return errors.Errorf("%s is not a directory", p.dashboardsDir)
+	}
+	return nil
+}
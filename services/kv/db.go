@@ -0,0 +1,80 @@
+// pmm-managed
+// Copyright (C) 2017 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package kv
+
+import (
+	"github.com/pkg/errors"
+	"gopkg.in/reform.v1"
+
+	"github.com/percona/pmm-managed/models"
+)
+
+// DB is a Store backed by pmm-managed's own database.
+type DB struct {
+	db *reform.DB
+}
+
+// NewDB creates a new store for a given database.
+func NewDB(db *reform.DB) *DB {
+	return &DB{
+		db: db,
+	}
+}
+
+// GetKV returns value for a given key, or nil, if key does not exist.
+func (s *DB) GetKV(key string) ([]byte, error) {
+	var pair models.KVPair
+	err := s.db.FindByPrimaryKeyTo(&pair, key)
+	if err == reform.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot get key %q", key)
+	}
+	return pair.Value, nil
+}
+
+// PutKV puts given key/value pair into the database.
+func (s *DB) PutKV(key string, value []byte) error {
+	if value == nil {
+		value = []byte{} // column is NOT NULL
+	}
+	err := s.db.InTransaction(func(tx *reform.TX) error {
+		return tx.Save(&models.KVPair{Name: key, Value: value})
+	})
+	return errors.Wrapf(err, "cannot put key %q", key)
+}
+
+// ListKV returns all key/value pairs from the database.
+func (s *DB) ListKV() (map[string][]byte, error) {
+	structs, err := s.db.SelectAllFrom(models.KVPairTable, "")
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot list keys")
+	}
+	res := make(map[string][]byte, len(structs))
+	for _, str := range structs {
+		pair := str.(*models.KVPair)
+		res[pair.Name] = pair.Value
+	}
+	return res, nil
+}
+
+// DeleteKV deletes given key from the database.
+func (s *DB) DeleteKV(key string) error {
+	_, err := s.db.DeleteFrom(models.KVPairTable, "WHERE name = "+s.db.Placeholder(1), key)
+	return errors.Wrapf(err, "cannot delete key %q", key)
+}
@@ -0,0 +1,134 @@
+// pmm-managed
+// Copyright (C) 2017 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package kv
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// File is a Store backed by a local JSON file. All pairs are kept in memory;
+// the file is rewritten atomically on every change.
+type File struct {
+	path string
+	rw   sync.RWMutex
+	m    map[string][]byte
+}
+
+// NewFile creates a new store for a given file path, loading existing pairs from it.
+// File is created on the first change if it does not exist.
+func NewFile(path string) (*File, error) {
+	m := make(map[string][]byte)
+	b, err := ioutil.ReadFile(path) //nolint:gosec
+	switch {
+	case err == nil:
+		if err = json.Unmarshal(b, &m); err != nil {
+			return nil, errors.Wrapf(err, "cannot parse %s", path)
+		}
+	case os.IsNotExist(err):
+		// nothing
+	default:
+		return nil, errors.WithStack(err)
+	}
+
+	return &File{
+		path: path,
+		m:    m,
+	}, nil
+}
+
+// GetKV returns value for a given key, or nil, if key does not exist.
+func (f *File) GetKV(key string) ([]byte, error) {
+	f.rw.RLock()
+	defer f.rw.RUnlock()
+
+	v, ok := f.m[key]
+	if !ok {
+		return nil, nil
+	}
+	res := make([]byte, len(v))
+	copy(res, v)
+	return res, nil
+}
+
+// PutKV puts given key/value pair into the file.
+func (f *File) PutKV(key string, value []byte) error {
+	f.rw.Lock()
+	defer f.rw.Unlock()
+
+	v := make([]byte, len(value))
+	copy(v, value)
+	old, ok := f.m[key]
+	f.m[key] = v
+	err := f.save()
+	if err != nil {
+		if ok {
+			f.m[key] = old
+		} else {
+			delete(f.m, key)
+		}
+	}
+	return errors.Wrapf(err, "cannot put key %q", key)
+}
+
+// ListKV returns all key/value pairs from the file.
+func (f *File) ListKV() (map[string][]byte, error) {
+	f.rw.RLock()
+	defer f.rw.RUnlock()
+
+	res := make(map[string][]byte, len(f.m))
+	for k, v := range f.m {
+		res[k] = make([]byte, len(v))
+		copy(res[k], v)
+	}
+	return res, nil
+}
+
+// DeleteKV deletes given key from the file.
+func (f *File) DeleteKV(key string) error {
+	f.rw.Lock()
+	defer f.rw.Unlock()
+
+	old, ok := f.m[key]
+	if !ok {
+		return nil
+	}
+	delete(f.m, key)
+	err := f.save()
+	if err != nil {
+		f.m[key] = old
+	}
+	return errors.Wrapf(err, "cannot delete key %q", key)
+}
+
+// save writes all pairs to a temporary file and renames it. Caller should hold write lock.
+// File may contain encrypted secrets, so it is readable only by the owner.
+func (f *File) save() error {
+	b, err := json.MarshalIndent(f.m, "", "  ")
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	tmp := f.path + ".tmp"
+	if err = ioutil.WriteFile(tmp, append(b, '\n'), 0600); err != nil {
+		return errors.WithStack(err)
+	}
+	return errors.WithStack(os.Rename(tmp, f.path))
+}
@@ -0,0 +1,45 @@
+// pmm-managed
+// Copyright (C) 2017 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+// Package kv provides key-value stores for pmm-managed settings.
+package kv
+
+import (
+	"github.com/percona/pmm-managed/services/consul"
+)
+
+// Store is a key-value store for pmm-managed settings (Prometheus scrape configs, telemetry UUID, etc.).
+type Store interface {
+	// GetKV returns value for a given key, or nil, if key does not exist.
+	GetKV(key string) ([]byte, error)
+
+	// PutKV puts given key/value pair.
+	PutKV(key string, value []byte) error
+
+	// DeleteKV deletes given key. It is not an error to delete a key which does not exist.
+	DeleteKV(key string) error
+}
+
+// check interfaces
+var (
+	_ Store = (*consul.Client)(nil)
+	_ Store = (*DB)(nil)
+	_ Store = (*File)(nil)
+
+	_ Lister    = (*consul.Client)(nil)
+	_ ListStore = (*DB)(nil)
+	_ ListStore = (*File)(nil)
+)
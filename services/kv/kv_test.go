@@ -0,0 +1,172 @@
+// pmm-managed
+// Copyright (C) 2017 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package kv
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/reform.v1"
+
+	"github.com/percona/pmm-managed/utils/tests"
+)
+
+func testStore(t *testing.T, s ListStore) {
+	t.Helper()
+
+	v, err := s.GetKV("foo/bar")
+	require.NoError(t, err)
+	assert.Nil(t, v)
+
+	require.NoError(t, s.PutKV("foo/bar", []byte("baz")))
+	v, err = s.GetKV("foo/bar")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("baz"), v)
+
+	require.NoError(t, s.PutKV("foo/bar", []byte("qux")))
+	v, err = s.GetKV("foo/bar")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("qux"), v)
+
+	require.NoError(t, s.PutKV("empty", nil))
+	v, err = s.GetKV("empty")
+	require.NoError(t, err)
+	assert.Equal(t, []byte{}, v)
+
+	require.NoError(t, s.DeleteKV("foo/bar"))
+	require.NoError(t, s.DeleteKV("foo/bar"))
+	v, err = s.GetKV("foo/bar")
+	require.NoError(t, err)
+	assert.Nil(t, v)
+
+	pairs, err := s.ListKV()
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]byte{"empty": {}}, pairs)
+}
+
+func TestDB(t *testing.T) {
+	sqlDB := tests.OpenTestDB(t)
+	defer sqlDB.Close() //nolint:errcheck
+	db := reform.NewDB(sqlDB, tests.TestDBDialect(t), reform.NewPrintfLogger(t.Logf))
+
+	testStore(t, NewDB(db))
+}
+
+func TestFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "pmm-managed-kv-")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir) //nolint:errcheck
+	path := filepath.Join(dir, "kv.json")
+
+	f, err := NewFile(path)
+	require.NoError(t, err)
+	testStore(t, f)
+
+	t.Run("Reload", func(t *testing.T) {
+		require.NoError(t, f.PutKV("telemetry/uuid", []byte("uuid")))
+
+		fi, err := os.Stat(path)
+		require.NoError(t, err)
+		assert.Equal(t, os.FileMode(0600), fi.Mode().Perm())
+
+		f, err := NewFile(path)
+		require.NoError(t, err)
+		v, err := f.GetKV("telemetry/uuid")
+		require.NoError(t, err)
+		assert.Equal(t, []byte("uuid"), v)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		require.NoError(t, ioutil.WriteFile(path, []byte("{"), 0600))
+		_, err := NewFile(path)
+		assert.Error(t, err)
+	})
+}
+
+type testLister map[string][]byte
+
+func (l testLister) ListKV() (map[string][]byte, error) {
+	if l == nil {
+		return nil, errors.New("unavailable")
+	}
+	return l, nil
+}
+
+func TestMigrateFromConsul(t *testing.T) {
+	dir, err := ioutil.TempDir("", "pmm-managed-kv-")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir) //nolint:errcheck
+
+	to, err := NewFile(filepath.Join(dir, "kv.json"))
+	require.NoError(t, err)
+	require.NoError(t, to.PutKV("telemetry/uuid", []byte("new")))
+
+	_, err = MigrateFromConsul(testLister(nil), to)
+	assert.EqualError(t, err, "unavailable: source store is unavailable")
+	assert.Equal(t, ErrSourceUnavailable, errors.Cause(err))
+
+	from := testLister{
+		"prometheus/scrape_configs": []byte(`{"ScrapeConfigs":[]}`),
+		"telemetry/uuid":            []byte("old"),
+	}
+	n, err := MigrateFromConsul(from, to)
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+
+	v, err := to.GetKV("prometheus/scrape_configs")
+	require.NoError(t, err)
+	assert.Equal(t, []byte(`{"ScrapeConfigs":[]}`), v)
+	v, err = to.GetKV("telemetry/uuid")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("new"), v, "existing key should not be overwritten")
+
+	// second migration is no-op
+	require.NoError(t, to.DeleteKV("prometheus/scrape_configs"))
+	n, err = MigrateFromConsul(from, to)
+	require.NoError(t, err)
+	assert.Equal(t, 0, n)
+	v, err = to.GetKV("prometheus/scrape_configs")
+	require.NoError(t, err)
+	assert.Nil(t, v)
+}
+
+func TestMarkMigrated(t *testing.T) {
+	dir, err := ioutil.TempDir("", "pmm-managed-kv-")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir) //nolint:errcheck
+
+	to, err := NewFile(filepath.Join(dir, "kv.json"))
+	require.NoError(t, err)
+	require.NoError(t, to.PutKV("telemetry/uuid", []byte("new")))
+	assert.EqualError(t, MarkMigrated(to), "store is not empty (1 key(s)), but not marked as migrated")
+
+	require.NoError(t, to.DeleteKV("telemetry/uuid"))
+	require.NoError(t, MarkMigrated(to))
+
+	// migration is done, so nothing is copied even if source is available
+	from := testLister{
+		"telemetry/uuid": []byte("old"),
+	}
+	n, err := MigrateFromConsul(from, to)
+	require.NoError(t, err)
+	assert.Equal(t, 0, n)
+}
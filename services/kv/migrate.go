@@ -0,0 +1,100 @@
+// pmm-managed
+// Copyright (C) 2017 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package kv
+
+import (
+	"sort"
+
+	"github.com/pkg/errors"
+)
+
+// migratedKey is set in the destination store once migration is done.
+const migratedKey = "kv/migrated-from-consul"
+
+// ErrSourceUnavailable is returned by MigrateFromConsul when source store can't be listed.
+// Nothing is copied in that case.
+var ErrSourceUnavailable = errors.New("source store is unavailable")
+
+// Lister is a store that can list all its key/value pairs; consul.Client, DB and File implement it.
+type Lister interface {
+	ListKV() (map[string][]byte, error)
+}
+
+// ListStore is a Store that can list all its key/value pairs; DB and File implement it.
+type ListStore interface {
+	Store
+	Lister
+}
+
+// MigrateFromConsul copies all key/value pairs from Consul to a given store once.
+// Keys already present in the destination store are not overwritten.
+// It returns a number of copied keys.
+func MigrateFromConsul(from Lister, to Store) (int, error) {
+	done, err := to.GetKV(migratedKey)
+	if err != nil {
+		return 0, err
+	}
+	if done != nil {
+		return 0, nil
+	}
+
+	pairs, err := from.ListKV()
+	if err != nil {
+		return 0, errors.Wrap(ErrSourceUnavailable, err.Error())
+	}
+	keys := make([]string, 0, len(pairs))
+	for k := range pairs {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var copied int
+	for _, k := range keys {
+		if k == migratedKey {
+			continue
+		}
+		v, err := to.GetKV(k)
+		if err != nil {
+			return copied, err
+		}
+		if v != nil {
+			continue
+		}
+		if err = to.PutKV(k, pairs[k]); err != nil {
+			return copied, err
+		}
+		copied++
+	}
+
+	err = to.PutKV(migratedKey, []byte("1"))
+	return copied, errors.WithStack(err)
+}
+
+// MarkMigrated marks an empty destination store as migrated without copying anything.
+// It is used when Consul is not available at all; non-empty store is not marked, and error is returned:
+// its keys may be left by the migration which failed partway.
+func MarkMigrated(to ListStore) error {
+	pairs, err := to.ListKV()
+	if err != nil {
+		return err
+	}
+	if len(pairs) != 0 {
+		return errors.Errorf("store is not empty (%d key(s)), but not marked as migrated", len(pairs))
+	}
+
+	return errors.WithStack(to.PutKV(migratedKey, []byte("1")))
+}
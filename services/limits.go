@@ -0,0 +1,146 @@
+// pmm-managed
+// Copyright (C) 2017 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package services
+
+import (
+	"strconv"
+	"strings"
+
+	servicelib "github.com/percona/kardianos-service"
+	"github.com/pkg/errors"
+)
+
+// Resource limits keys in servicelib.Config.Option. They are handled by supervisor.
+const (
+	OptionMemoryLimit = "PMMMemoryLimit" // uint64, bytes
+	OptionCPUQuota    = "PMMCPUQuota"    // int, percents of a single CPU
+	OptionNice        = "PMMNice"        // int
+	OptionNoFile      = "PMMNoFile"      // int
+)
+
+// ResourceLimits contains resource limits for Agent process. Zero values mean "no limit".
+type ResourceLimits struct {
+	MemoryLimit uint64 // bytes
+	CPUQuota    int    // percents of a single CPU, may be greater than 100
+	Nice        int
+	NoFile      int
+	User        string
+}
+
+// ParseResourceLimits parses resource limits in the form "memory=512M,cpu=50,nice=10,nofile=4096,user=pmm".
+// Memory limit may have K, M, or G suffix. Empty string means no limits.
+func ParseResourceLimits(s string) (*ResourceLimits, error) {
+	res := new(ResourceLimits)
+	for _, kv := range strings.Split(s, ",") {
+		kv = strings.TrimSpace(kv)
+		if kv == "" {
+			continue
+		}
+		parts := strings.SplitN(kv, "=", 2)
+		if len(parts) != 2 {
+			return nil, errors.Errorf("invalid resource limit %q: expected key=value", kv)
+		}
+		key, value := strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1])
+
+		var err error
+		switch key {
+		case "memory":
+			res.MemoryLimit, err = parseBytes(value)
+		case "cpu":
+			res.CPUQuota, err = strconv.Atoi(strings.TrimSuffix(value, "%"))
+			if err == nil && res.CPUQuota < 0 {
+				err = errors.New("must not be negative")
+			}
+		case "nice":
+			res.Nice, err = strconv.Atoi(value)
+			if err == nil && (res.Nice < -20 || res.Nice > 19) {
+				err = errors.New("must be in range [-20, 19]")
+			}
+		case "nofile":
+			res.NoFile, err = strconv.Atoi(value)
+			if err == nil && res.NoFile < 0 {
+				err = errors.New("must not be negative")
+			}
+		case "user":
+			res.User = value
+		default:
+			return nil, errors.Errorf("unknown resource limit %q", key)
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid resource limit %q", kv)
+		}
+	}
+	return res, nil
+}
+
+func parseBytes(s string) (uint64, error) {
+	multiplier := uint64(1)
+	switch {
+	case strings.HasSuffix(s, "K"):
+		multiplier = 1 << 10
+	case strings.HasSuffix(s, "M"):
+		multiplier = 1 << 20
+	case strings.HasSuffix(s, "G"):
+		multiplier = 1 << 30
+	}
+	if multiplier != 1 {
+		s = s[:len(s)-1]
+	}
+	v, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+	return v * multiplier, nil
+}
+
+// Apply sets limits in servicelib.Config.
+func (l *ResourceLimits) Apply(config *servicelib.Config) {
+	if l == nil {
+		return
+	}
+
+	if config.Option == nil {
+		config.Option = make(servicelib.KeyValue)
+	}
+	if l.MemoryLimit != 0 {
+		config.Option[OptionMemoryLimit] = l.MemoryLimit
+	}
+	if l.CPUQuota != 0 {
+		config.Option[OptionCPUQuota] = l.CPUQuota
+	}
+	if l.Nice != 0 {
+		config.Option[OptionNice] = l.Nice
+	}
+	if l.NoFile != 0 {
+		config.Option[OptionNoFile] = l.NoFile
+	}
+	if l.User != "" {
+		config.UserName = l.User
+	}
+}
+
+// ResourceLimitsFromConfig returns limits set by Apply.
+func ResourceLimitsFromConfig(config *servicelib.Config) *ResourceLimits {
+	res := &ResourceLimits{
+		User: config.UserName,
+	}
+	res.MemoryLimit, _ = config.Option[OptionMemoryLimit].(uint64)
+	res.CPUQuota, _ = config.Option[OptionCPUQuota].(int)
+	res.Nice, _ = config.Option[OptionNice].(int)
+	res.NoFile, _ = config.Option[OptionNoFile].(int)
+	return res
+}
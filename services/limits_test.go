@@ -0,0 +1,65 @@
+// pmm-managed
+// Copyright (C) 2017 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package services
+
+import (
+	"testing"
+
+	servicelib "github.com/percona/kardianos-service"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseResourceLimits(t *testing.T) {
+	for s, expected := range map[string]*ResourceLimits{
+		"":                         {},
+		"memory=512M":              {MemoryLimit: 512 * 1024 * 1024},
+		"memory=2G, cpu=50%":       {MemoryLimit: 2 * 1024 * 1024 * 1024, CPUQuota: 50},
+		"memory=1000,nice=-5":      {MemoryLimit: 1000, Nice: -5},
+		"nofile=4096,user=pmm,":    {NoFile: 4096, User: "pmm"},
+		"memory=1K,cpu=200,nice=1": {MemoryLimit: 1024, CPUQuota: 200, Nice: 1},
+	} {
+		actual, err := ParseResourceLimits(s)
+		require.NoError(t, err, "%q", s)
+		assert.Equal(t, expected, actual, "%q", s)
+	}
+
+	for s, expected := range map[string]string{
+		"memory":       `invalid resource limit "memory": expected key=value`,
+		"memory=1T":    `invalid resource limit "memory=1T": strconv.ParseUint: parsing "1T": invalid syntax`,
+		"cpu=-1":       `invalid resource limit "cpu=-1": must not be negative`,
+		"nice=20":      `invalid resource limit "nice=20": must be in range [-20, 19]`,
+		"swap=1G":      `unknown resource limit "swap"`,
+		"nofile=a lot": `invalid resource limit "nofile=a lot": strconv.Atoi: parsing "a lot": invalid syntax`,
+	} {
+		_, err := ParseResourceLimits(s)
+		assert.EqualError(t, err, expected, "%q", s)
+	}
+}
+
+func TestApplyResourceLimits(t *testing.T) {
+	limits := &ResourceLimits{MemoryLimit: 1024, CPUQuota: 50, Nice: 10, NoFile: 4096, User: "pmm"}
+	config := &servicelib.Config{Name: "test"}
+	limits.Apply(config)
+	assert.Equal(t, "pmm", config.UserName)
+	assert.Equal(t, limits, ResourceLimitsFromConfig(config))
+
+	config = &servicelib.Config{Name: "test"}
+	(*ResourceLimits)(nil).Apply(config)
+	assert.Equal(t, &servicelib.Config{Name: "test"}, config)
+	assert.Equal(t, &ResourceLimits{}, ResourceLimitsFromConfig(config))
+}
@@ -0,0 +1,344 @@
+// pmm-managed
+// Copyright (C) 2017 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package logs
+
+import (
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/percona/pmm-managed/utils/logger"
+)
+
+const (
+	// default timeout for collecting a single source
+	defaultSourceTimeout = 10 * time.Second
+
+	// how long to wait for partial results after source timeout
+	sourceTimeoutGrace = time.Second
+
+	// summaryName is a name of the file with collection summary in logs archive.
+	summaryName = "summary.json"
+)
+
+// BundleOptions contains options for Collect and Bundle.
+type BundleOptions struct {
+	Sources []string      // names of sources to include (log file names, systemd unit names, Agent's supervisor names); all if empty
+	Since   time.Time     // include only log lines after that time, if not zero
+	Until   time.Time     // include only log lines before that time, if not zero
+	Lines   int           // maximum number of last lines for each log; 1000 if zero
+	Timeout time.Duration // timeout for each source; 10s if zero
+}
+
+func (opts *BundleOptions) lines() int {
+	if opts.Lines > 0 {
+		return opts.Lines
+	}
+	return lastLines
+}
+
+func (opts *BundleOptions) timeout() time.Duration {
+	if opts.Timeout > 0 {
+		return opts.Timeout
+	}
+	return defaultSourceTimeout
+}
+
+// selectLogs returns logs for given source names, or all logs if names are empty.
+func (l *Logs) selectLogs(ctx context.Context, names []string) ([]Log, error) {
+	logs := l.sources()
+	agentLogs, err := l.agentLogs()
+	if err != nil {
+		if len(names) != 0 {
+			return nil, err
+		}
+		logger.Get(ctx).WithField("component", "logs").Errorf("Failed to get agents logs: %s.", err)
+	}
+	logs = append(logs, agentLogs...)
+
+	if len(names) == 0 {
+		return logs, nil
+	}
+
+	res := make([]Log, 0, len(names))
+	for _, name := range names {
+		var found bool
+		for _, log := range logs {
+			if log.matches(name) {
+				res = append(res, log)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, status.Errorf(codes.InvalidArgument, "Unknown source %q.", name)
+		}
+	}
+	return res, nil
+}
+
+// Collect reads selected logs concurrently, each with own timeout.
+// Errors for individual sources are returned in File.Err.
+func (l *Logs) Collect(ctx context.Context, opts *BundleOptions) ([]File, error) {
+	logs, err := l.selectLogs(ctx, opts.Sources)
+	if err != nil {
+		return nil, err
+	}
+
+	files := make([]File, len(logs))
+	var wg sync.WaitGroup
+	for i := range logs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			files[i] = l.collectOne(ctx, &logs[i], opts)
+		}(i)
+	}
+	wg.Wait()
+
+	sort.Slice(files, func(i, j int) bool { return files[i].Name < files[j].Name })
+	return files, nil
+}
+
+// collectOne reads a single log with a timeout.
+func (l *Logs) collectOne(ctx context.Context, log *Log, opts *BundleOptions) File {
+	timeout := opts.timeout()
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	start := time.Now()
+	ch := make(chan File, 1)
+	go func() {
+		var f File
+		f.Data, f.Truncated, f.Err = l.readLog(ctx, log, opts)
+		ch <- f
+	}()
+
+	var f File
+	select {
+	case f = <-ch:
+	case <-ctx.Done():
+		// commands are killed by context, so wait a bit for partial output;
+		// some extractors do not support context, so do not wait forever
+		t := time.NewTimer(sourceTimeoutGrace)
+		select {
+		case f = <-ch:
+		case <-t.C:
+		}
+		t.Stop()
+	}
+	if ctx.Err() == context.DeadlineExceeded {
+		f.Truncated = true
+		f.Err = errors.Errorf("timeout after %s (%v)", timeout, f.Err)
+	}
+
+	f.Name = l.logName(log)
+	f.Duration = time.Since(start)
+	l.redact(&f)
+	f.Lines = countLines(f.Data)
+	return f
+}
+
+// countLines returns a number of lines in data.
+func countLines(data []byte) int {
+	n := bytes.Count(data, []byte("\n"))
+	if len(data) > 0 && data[len(data)-1] != '\n' {
+		n++
+	}
+	return n
+}
+
+// readLines reads last lines of log within time window.
+func (l *Logs) readLines(ctx context.Context, log *Log, opts *BundleOptions) ([]byte, bool, error) {
+	max := opts.lines()
+	tailOpts := &TailOptions{
+		Since: opts.Since,
+		Until: opts.Until,
+	}
+	if tailOpts.Since.IsZero() && tailOpts.Until.IsZero() {
+		// read one more line to detect truncation
+		tailOpts.Lines = max + 1
+	}
+
+	cmd := l.tailCmd(ctx, log, tailOpts)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, false, errors.WithStack(err)
+	}
+	if err = cmd.Start(); err != nil {
+		return nil, false, errors.WithStack(err)
+	}
+
+	// keep last lines in ring buffer
+	ring := make([]string, max)
+	var total int
+	inWindow := tailOpts.Since.IsZero()
+	s := bufio.NewScanner(stdout)
+	for s.Scan() {
+		line := s.Text()
+		if inWindow = tailOpts.inWindow(line, inWindow); !inWindow {
+			continue
+		}
+		ring[total%max] = line
+		total++
+	}
+	scanErr := s.Err()
+	waitErr := cmd.Wait()
+
+	n := total
+	if n > max {
+		n = max
+	}
+	var buf bytes.Buffer
+	for i := total - n; i < total; i++ {
+		buf.WriteString(ring[i%max])
+		buf.WriteByte('\n')
+	}
+	truncated := total > max
+
+	if waitErr != nil {
+		return buf.Bytes(), truncated, fmt.Errorf("%s: %s: %s", strings.Join(cmd.Args, " "), waitErr, stderr.String())
+	}
+	return buf.Bytes(), truncated, errors.WithStack(scanErr)
+}
+
+type summarySource struct {
+	Name      string  `json:"name"`
+	Duration  float64 `json:"duration_seconds"`
+	Lines     int     `json:"lines"`
+	Truncated bool    `json:"truncated"`
+	Error     string  `json:"error,omitempty"`
+}
+
+type summary struct {
+	Since   *time.Time      `json:"since,omitempty"`
+	Until   *time.Time      `json:"until,omitempty"`
+	Lines   int             `json:"max_lines"`
+	Timeout float64         `json:"timeout_seconds"`
+	Sources []summarySource `json:"sources"`
+}
+
+// Bundle creates .zip archive with selected logs, collection summary and redaction manifest.
+// It returns collected files.
+func (l *Logs) Bundle(ctx context.Context, w io.Writer, opts *BundleOptions) ([]File, error) {
+	files, err := l.Collect(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	zw := zip.NewWriter(w)
+	write := func(name string, data []byte) error {
+		f, err := zw.CreateHeader(&zip.FileHeader{
+			Name:     name,
+			Method:   zip.Deflate,
+			Modified: now,
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to create zip file header")
+		}
+		_, err = f.Write(data)
+		return errors.Wrap(err, "failed to write zip file data")
+	}
+
+	s := summary{
+		Lines:   opts.lines(),
+		Timeout: opts.timeout().Seconds(),
+		Sources: make([]summarySource, 0, len(files)),
+	}
+	if !opts.Since.IsZero() {
+		s.Since = &opts.Since
+	}
+	if !opts.Until.IsZero() {
+		s.Until = &opts.Until
+	}
+	m := manifest{
+		Patterns:      l.redactor.Patterns(),
+		RedactedFiles: []manifestFile{},
+	}
+	for _, file := range files {
+		if file.Name == "" {
+			continue
+		}
+
+		ss := summarySource{
+			Name:      file.Name,
+			Duration:  file.Duration.Seconds(),
+			Lines:     file.Lines,
+			Truncated: file.Truncated,
+		}
+		if len(file.Redacted) != 0 {
+			m.RedactedFiles = append(m.RedactedFiles, manifestFile{
+				Name:     file.Name,
+				Redacted: file.Redacted,
+			})
+		}
+
+		data := file.Data
+		if file.Err != nil {
+			logger.Get(ctx).WithField("component", "logs").Error(file.Err)
+			ss.Error = file.Err.Error()
+
+			// do not let a single error break the whole archive
+			data = append([]byte(nil), data...)
+			if len(data) > 0 {
+				data = append(data, "\n\n"...)
+			}
+			data = append(data, file.Err.Error()...)
+		}
+		s.Sources = append(s.Sources, ss)
+
+		if err = write(file.Name, data); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, f := range []struct {
+		name string
+		v    interface{}
+	}{
+		{summaryName, s},
+		{manifestName, m},
+	} {
+		b, err := json.MarshalIndent(f.v, "", "  ")
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to marshal %s", f.name)
+		}
+		if err = write(f.name, b); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = zw.Close(); err != nil {
+		return nil, errors.Wrap(err, "failed to close zip file")
+	}
+	return files, nil
+}
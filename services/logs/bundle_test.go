@@ -0,0 +1,154 @@
+// pmm-managed
+// Copyright (C) 2017 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package logs
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/percona/pmm-managed/utils/logger"
+	"github.com/percona/pmm-managed/utils/tests"
+)
+
+func TestCollectOne(t *testing.T) {
+	ctx, _ := logger.Set(context.Background(), t.Name())
+	tmpDir, err := ioutil.TempDir("", "pmm-managed-test-logs-")
+	require.NoError(t, err)
+	defer os.RemoveAll(tmpDir) //nolint:errcheck
+
+	logFile := filepath.Join(tmpDir, "test.log")
+	content := "" +
+		`time="2018-10-19T10:00:00Z" level=info msg="one"` + "\n" +
+		`time="2018-10-19T11:00:00Z" level=info msg="two"` + "\n" +
+		"  continuation\n" +
+		`time="2018-10-19T12:00:00Z" level=info msg="three"` + "\n" +
+		`time="2018-10-19T13:00:00Z" level=info msg="four"` + "\n"
+	require.NoError(t, ioutil.WriteFile(logFile, []byte(content), 0600))
+	l := New("1.2.3", nil, nil, nil, nil, nil)
+	l.journalctlPath = ""
+	log := &Log{logFile, "", nil}
+
+	t.Run("All", func(t *testing.T) {
+		f := l.collectOne(ctx, log, &BundleOptions{})
+		assert.NoError(t, f.Err)
+		assert.Equal(t, "test.log", f.Name)
+		assert.Equal(t, content, string(f.Data))
+		assert.Equal(t, 5, f.Lines)
+		assert.False(t, f.Truncated)
+	})
+
+	t.Run("Lines", func(t *testing.T) {
+		f := l.collectOne(ctx, log, &BundleOptions{Lines: 2})
+		assert.NoError(t, f.Err)
+		expected := `time="2018-10-19T12:00:00Z" level=info msg="three"` + "\n" +
+			`time="2018-10-19T13:00:00Z" level=info msg="four"` + "\n"
+		assert.Equal(t, expected, string(f.Data))
+		assert.Equal(t, 2, f.Lines)
+		assert.True(t, f.Truncated)
+	})
+
+	t.Run("Window", func(t *testing.T) {
+		f := l.collectOne(ctx, log, &BundleOptions{
+			Since: time.Date(2018, 10, 19, 10, 30, 0, 0, time.UTC),
+			Until: time.Date(2018, 10, 19, 12, 0, 0, 0, time.UTC),
+		})
+		assert.NoError(t, f.Err)
+		expected := `time="2018-10-19T11:00:00Z" level=info msg="two"` + "\n" +
+			"  continuation\n" +
+			`time="2018-10-19T12:00:00Z" level=info msg="three"` + "\n"
+		assert.Equal(t, expected, string(f.Data))
+		assert.False(t, f.Truncated)
+
+		f = l.collectOne(ctx, log, &BundleOptions{
+			Since: time.Date(2018, 10, 19, 10, 30, 0, 0, time.UTC),
+			Lines: 1,
+		})
+		assert.NoError(t, f.Err)
+		assert.Equal(t, `time="2018-10-19T13:00:00Z" level=info msg="four"`+"\n", string(f.Data))
+		assert.True(t, f.Truncated)
+	})
+
+	t.Run("Timeout", func(t *testing.T) {
+		script := filepath.Join(tmpDir, "slow.sh")
+		require.NoError(t, ioutil.WriteFile(script, []byte("echo partial\nexec sleep 10\n"), 0600))
+
+		start := time.Now()
+		f := l.collectOne(ctx, &Log{script, "", []string{"exec", "/bin/sh"}}, &BundleOptions{
+			Timeout: 100 * time.Millisecond,
+		})
+		assert.True(t, time.Since(start) < 5*time.Second, "%s", time.Since(start))
+		require.Error(t, f.Err)
+		assert.True(t, strings.HasPrefix(f.Err.Error(), "timeout after 100ms"), "%s", f.Err)
+		assert.Equal(t, "slow.sh", f.Name)
+		assert.Equal(t, "partial\n", string(f.Data))
+		assert.True(t, f.Truncated)
+	})
+}
+
+func TestBundle(t *testing.T) {
+	ctx, consulClient, db, logFileName := setup(t)
+	defer teardown(t, db, logFileName)
+
+	logs := []Log{
+		{logFileName, "", nil},
+		{"pmm-version.txt", "", []string{"pmmVersion", ""}},
+	}
+	l := New("1.2.3", consulClient, db, nil, logs, nil)
+
+	buf := new(bytes.Buffer)
+	files, err := l.Bundle(ctx, buf, &BundleOptions{Sources: []string{"pmm-version.txt"}, Lines: 10})
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	assert.Equal(t, "pmm-version.txt", files[0].Name)
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	require.NoError(t, err)
+	var names []string
+	for _, f := range zr.File {
+		names = append(names, f.Name)
+		if f.Name != summaryName {
+			continue
+		}
+		r, err := f.Open()
+		require.NoError(t, err)
+		var s summary
+		require.NoError(t, json.NewDecoder(r).Decode(&s))
+		require.NoError(t, r.Close())
+		assert.Equal(t, 10, s.Lines)
+		require.Len(t, s.Sources, 1)
+		assert.Equal(t, "pmm-version.txt", s.Sources[0].Name)
+		assert.Equal(t, 1, s.Sources[0].Lines)
+		assert.Empty(t, s.Sources[0].Error)
+	}
+	assert.Equal(t, []string{"pmm-version.txt", summaryName, manifestName}, names)
+
+	_, err = l.Bundle(ctx, buf, &BundleOptions{Sources: []string{"no-such.log"}})
+	tests.AssertGRPCError(t, status.New(codes.InvalidArgument, `Unknown source "no-such.log".`), err)
+}
@@ -0,0 +1,196 @@
+// pmm-managed
+// Copyright (C) 2017 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package logs
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+)
+
+// Extractor extracts diagnostic data for log sources with Log.Extractor[0] equal to extractor's registered name.
+// The rest of Log.Extractor are extractor-specific arguments.
+type Extractor interface {
+	Extract(ctx context.Context, log *Log) ([]byte, error)
+}
+
+// ExtractorFunc is an adapter to allow the use of ordinary functions as Extractor.
+type ExtractorFunc func(ctx context.Context, log *Log) ([]byte, error)
+
+// Extract calls f(ctx, log).
+func (f ExtractorFunc) Extract(ctx context.Context, log *Log) ([]byte, error) {
+	return f(ctx, log)
+}
+
+// argument returns extractor argument with a given index (starting from 1), or error.
+func argument(log *Log, i int) (string, error) {
+	if len(log.Extractor) <= i || log.Extractor[i] == "" {
+		return "", errors.Errorf("extractor %q: argument %d is not given", log.Extractor[0], i)
+	}
+	return log.Extractor[i], nil
+}
+
+// builtinExtractors returns extractors used by default logs.
+func (l *Logs) builtinExtractors() map[string]Extractor {
+	return map[string]Extractor{
+		// runs command with file path as the last argument, or just command if path is a file name
+		"exec": ExtractorFunc(func(ctx context.Context, log *Log) ([]byte, error) {
+			command, err := argument(log, 1)
+			if err != nil {
+				return nil, err
+			}
+			return l.collectExec(ctx, log.FilePath, command)
+		}),
+
+		// reads file
+		"cat": ExtractorFunc(func(ctx context.Context, log *Log) ([]byte, error) {
+			return ioutil.ReadFile(log.FilePath)
+		}),
+
+		// reads URL with PMM credentials
+		"http": ExtractorFunc(func(ctx context.Context, log *Log) ([]byte, error) {
+			command, err := argument(log, 1)
+			if err != nil {
+				return nil, err
+			}
+			s := strings.Split(command, "//")
+			credential, err1 := getCredential()
+			if len(s) > 1 && len(credential) > 1 {
+				command = fmt.Sprintf("%s//%s@%s", s[0], credential, s[1])
+			}
+			data, err := l.readURL(ctx, command)
+			if err1 != nil {
+				err = fmt.Errorf("%v; %v", err1, err)
+			}
+			return data, err
+		}),
+
+		"consul": ExtractorFunc(func(ctx context.Context, log *Log) ([]byte, error) {
+			return l.getConsulNodes()
+		}),
+
+		"rds": ExtractorFunc(func(ctx context.Context, log *Log) ([]byte, error) {
+			return l.getRDSInstances(ctx)
+		}),
+
+		"pmmVersion": ExtractorFunc(func(ctx context.Context, log *Log) ([]byte, error) {
+			return []byte(l.pmmVersion), nil
+		}),
+	}
+}
+
+// RegisterExtractor registers extractor with a given name.
+// Log sources can use it by setting Log.Extractor[0] to that name.
+func (l *Logs) RegisterExtractor(name string, e Extractor) error {
+	if name == "" {
+		return errors.New("extractor name is empty")
+	}
+
+	l.rw.Lock()
+	defer l.rw.Unlock()
+
+	if l.extractors[name] != nil {
+		return errors.Errorf("extractor %q is already registered", name)
+	}
+	l.extractors[name] = e
+	return nil
+}
+
+// AddLogs adds log sources. Used extractors should be registered before.
+func (l *Logs) AddLogs(logs ...Log) error {
+	l.rw.Lock()
+	defer l.rw.Unlock()
+
+	for _, log := range logs {
+		if log.FilePath == "" && log.UnitName == "" {
+			return errors.New("log source has neither path nor unit")
+		}
+		if len(log.Extractor) != 0 && l.extractors[log.Extractor[0]] == nil {
+			return errors.Errorf("log source %q uses unknown extractor %q", log.FilePath, log.Extractor[0])
+		}
+		for _, existing := range l.logs {
+			if log.FilePath != "" && existing.matches(filepath.Base(log.FilePath)) {
+				return errors.Errorf("log source %q already exists", log.FilePath)
+			}
+		}
+		l.logs = append(l.logs, log)
+	}
+	return nil
+}
+
+// AddSource is a shortcut for contributing diagnostics from other services:
+// it registers extractor with a given file name and adds log source for it.
+func (l *Logs) AddSource(name string, f func(ctx context.Context) ([]byte, error)) error {
+	e := ExtractorFunc(func(ctx context.Context, _ *Log) ([]byte, error) {
+		return f(ctx)
+	})
+	if err := l.RegisterExtractor(name, e); err != nil {
+		return err
+	}
+	return l.AddLogs(Log{FilePath: name, Extractor: []string{name}})
+}
+
+// sources returns a copy of configured log sources.
+func (l *Logs) sources() []Log {
+	l.rw.RLock()
+	defer l.rw.RUnlock()
+
+	return append([]Log(nil), l.logs...)
+}
+
+type sourcesConfig struct {
+	Sources []struct {
+		Path      string   `yaml:"path"`
+		Unit      string   `yaml:"unit"`
+		Extractor []string `yaml:"extractor"`
+	} `yaml:"sources"`
+}
+
+// LoadLogs reads additional log sources from YAML file:
+//
+//	sources:
+//	- path: /var/log/pmm-agent.log
+//	  unit: pmm-agent
+//	- path: iostat.txt
+//	  extractor: [exec, "iostat -x"]
+//	- path: /etc/my.cnf
+//	  extractor: [cat]
+func LoadLogs(path string) ([]Log, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	var config sourcesConfig
+	if err = yaml.Unmarshal(b, &config); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse %s", path)
+	}
+
+	logs := make([]Log, len(config.Sources))
+	for i, s := range config.Sources {
+		logs[i] = Log{
+			FilePath:  s.Path,
+			UnitName:  s.Unit,
+			Extractor: s.Extractor,
+		}
+	}
+	return logs, nil
+}
@@ -0,0 +1,108 @@
+// pmm-managed
+// Copyright (C) 2017 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package logs
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/percona/pmm-managed/utils/logger"
+)
+
+func TestExtractors(t *testing.T) {
+	ctx, _ := logger.Set(context.Background(), t.Name())
+	l := New("1.2.3", nil, nil, nil, []Log{
+		{"pmm-version.txt", "", []string{"pmmVersion", ""}},
+	}, nil)
+
+	t.Run("Builtin", func(t *testing.T) {
+		f := l.collectOne(ctx, &Log{"pmm-version.txt", "", []string{"pmmVersion", ""}}, &BundleOptions{})
+		assert.NoError(t, f.Err)
+		assert.Equal(t, "1.2.3", string(f.Data))
+
+		f = l.collectOne(ctx, &Log{"echo.txt", "", []string{"exec"}}, &BundleOptions{})
+		assert.EqualError(t, f.Err, `extractor "exec": argument 1 is not given`)
+
+		f = l.collectOne(ctx, &Log{"consul_nodes.json", "", []string{"consul"}}, &BundleOptions{})
+		assert.EqualError(t, f.Err, "Consul is not used")
+
+		f = l.collectOne(ctx, &Log{"unknown.txt", "", []string{"unknown"}}, &BundleOptions{})
+		assert.EqualError(t, f.Err, `unknown extractor "unknown"`)
+	})
+
+	t.Run("Register", func(t *testing.T) {
+		e := ExtractorFunc(func(ctx context.Context, log *Log) ([]byte, error) {
+			return []byte("args: " + log.Extractor[1]), nil
+		})
+		require.NoError(t, l.RegisterExtractor("test", e))
+		assert.EqualError(t, l.RegisterExtractor("test", e), `extractor "test" is already registered`)
+		assert.EqualError(t, l.RegisterExtractor("", e), `extractor name is empty`)
+
+		assert.EqualError(t, l.AddLogs(Log{"other.txt", "", []string{"other"}}), `log source "other.txt" uses unknown extractor "other"`)
+		assert.EqualError(t, l.AddLogs(Log{"/tmp/pmm-version.txt", "", nil}), `log source "/tmp/pmm-version.txt" already exists`)
+		require.NoError(t, l.AddLogs(Log{"test.txt", "", []string{"test", "foo"}}))
+
+		f := l.collectOne(ctx, &l.sources()[1], &BundleOptions{})
+		assert.NoError(t, f.Err)
+		assert.Equal(t, "test.txt", f.Name)
+		assert.Equal(t, "args: foo", string(f.Data))
+	})
+
+	t.Run("AddSource", func(t *testing.T) {
+		err := l.AddSource("contributed.txt", func(ctx context.Context) ([]byte, error) {
+			return []byte("password: secret\n"), nil
+		})
+		require.NoError(t, err)
+		err = l.AddSource("contributed.txt", nil)
+		assert.EqualError(t, err, `extractor "contributed.txt" is already registered`)
+
+		sources := l.sources()
+		require.Len(t, sources, 3)
+		f := l.collectOne(ctx, &sources[2], &BundleOptions{})
+		assert.NoError(t, f.Err)
+		assert.Equal(t, "contributed.txt", f.Name)
+		assert.Equal(t, "password: [REDACTED]\n", string(f.Data))
+	})
+}
+
+func TestLoadLogs(t *testing.T) {
+	f, err := ioutil.TempFile("", "pmm-managed-test-logs-")
+	require.NoError(t, err)
+	defer os.Remove(f.Name()) //nolint:errcheck
+	_, err = f.WriteString(`
+sources:
+- path: /var/log/pmm-agent.log
+  unit: pmm-agent
+- path: iostat.txt
+  extractor: [exec, "iostat -x"]
+`)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	logs, err := LoadLogs(f.Name())
+	require.NoError(t, err)
+	expected := []Log{
+		{"/var/log/pmm-agent.log", "pmm-agent", nil},
+		{"iostat.txt", "", []string{"exec", "iostat -x"}},
+	}
+	assert.Equal(t, expected, logs)
+}
@@ -17,7 +17,6 @@
 package logs
 
 import (
-	"archive/zip"
 	"bytes"
 	"context"
 	"encoding/json"
@@ -29,8 +28,8 @@ import (
 	"os/exec"
 	"path/filepath"
 	"sort"
-	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	servicelib "github.com/percona/kardianos-service"
@@ -46,9 +45,14 @@ import (
 
 // File represents log file content.
 type File struct {
-	Name string
-	Data []byte
-	Err  error
+	Name     string
+	Data     []byte
+	Err      error
+	Redacted map[string]int // number of redacted secrets by pattern name
+
+	Duration  time.Duration // time spent collecting file
+	Lines     int           // number of lines in Data
+	Truncated bool          // true if some lines were dropped due to line limit or timeout
 }
 
 type Log struct {
@@ -57,8 +61,16 @@ type Log struct {
 	Extractor []string
 }
 
+// matches returns true if log has a given name: file name or systemd unit name.
+func (log *Log) matches(name string) bool {
+	return filepath.Base(log.FilePath) == name || (log.UnitName != "" && log.UnitName == name)
+}
+
 const lastLines = 1000
 
+// manifestName is a name of the file with redaction manifest in logs archive.
+const manifestName = "redaction_manifest.json"
+
 // overridden in tests
 var logsRootDir = "/var/log/"
 
@@ -93,7 +105,6 @@ var defaultLogs = []Log{
 	{"/etc/prometheus.yml", "", []string{"cat", ""}},
 	{"/etc/supervisord.d/pmm.ini", "", []string{"cat", ""}},
 	{"/etc/nginx/conf.d/pmm.conf", "", []string{"cat", ""}},
-	{"prometheus_targets.html", "", []string{"http", "http://localhost/prometheus/targets"}},
 	{"consul_nodes.json", "", []string{"consul"}},
 	{"qan-api_instances.json", "", []string{"http", "http://localhost/qan-api/instances"}},
 	{"managed_RDS-Aurora.json", "", []string{"rds"}},
@@ -103,10 +114,14 @@ var defaultLogs = []Log{
 // Logs is responsible for interactions with logs.
 type Logs struct {
 	pmmVersion string
-	consul     *consul.Client
+	consul     *consul.Client // may be nil
 	db         *reform.DB
 	rds        *rds.Service
+	redactor   *Redactor
+
+	rw         sync.RWMutex
 	logs       []Log
+	extractors map[string]Extractor
 
 	journalctlPath string
 }
@@ -148,19 +163,27 @@ func getCredential() (string, error) {
 }
 
 // New creates a new Logs service.
-// n is a number of last lines of log to read.
-func New(pmmVersion string, consul *consul.Client, db *reform.DB, rds *rds.Service, logs []Log) *Logs {
+// If logs is nil, default logs are used. If redactor is nil, it uses default redact patterns.
+func New(pmmVersion string, consul *consul.Client, db *reform.DB, rds *rds.Service, logs []Log, redactor *Redactor) *Logs {
 	if logs == nil {
 		logs = defaultLogs
 	}
+	if redactor == nil {
+		var err error
+		if redactor, err = NewRedactor(DefaultRedactPatterns()); err != nil {
+			panic(err)
+		}
+	}
 
 	l := &Logs{
 		pmmVersion: pmmVersion,
 		consul:     consul,
 		db:         db,
 		rds:        rds,
-		logs:       logs,
+		redactor:   redactor,
+		logs:       append([]Log(nil), logs...),
 	}
+	l.extractors = l.builtinExtractors()
 
 	// PMM Server Docker image contails journalctl, so we can't use exec.LookPath("journalctl") alone for detection.
 	// TODO Probably, that check should be moved to supervisor service.
@@ -174,14 +197,16 @@ func New(pmmVersion string, consul *consul.Client, db *reform.DB, rds *rds.Servi
 
 // Files returns list of logs and their content.
 func (l *Logs) Files(ctx context.Context) []File {
-	files := make([]File, 0, len(l.logs))
-
-	for _, log := range l.logs {
-		var f File
-		f.Name, f.Data, f.Err = l.readLog(ctx, &log)
-		files = append(files, f)
+	files, err := l.Collect(ctx, new(BundleOptions))
+	if err != nil {
+		// that should not happen without explicitly selected sources
+		logger.Get(ctx).WithField("component", "logs").Errorf("%+v", err)
 	}
+	return files
+}
 
+// agentLogs returns logs of Agents running in PMM Server.
+func (l *Logs) agentLogs() ([]Log, error) {
 	var agents []reform.Struct
 	err := l.db.InTransaction(func(tx *reform.TX) error {
 		var node models.Node
@@ -194,7 +219,7 @@ func (l *Logs) Files(ctx context.Context) []File {
 		return errors.Wrap(err, "failed to get agents running in PMM Server")
 	})
 	if err != nil {
-		logger.Get(ctx).WithField("component", "logs").Errorf("Failed to get RDS agents: %s.", err)
+		return nil, err
 	}
 
 	// collect names in set, not in slice,
@@ -202,139 +227,91 @@ func (l *Logs) Files(ctx context.Context) []File {
 	names := make(map[string]struct{}, len(agents))
 	for _, a := range agents {
 		agent := a.(*models.Agent)
+		if agent.ListenPort == nil {
+			continue
+		}
 		name := models.NameForSupervisor(agent.Type, *agent.ListenPort)
 		names[name] = struct{}{}
 	}
 
+	logs := make([]Log, 0, len(names))
 	for name := range names {
-		log := Log{
+		logs = append(logs, Log{
 			FilePath: logsRootDir + name + ".log",
 			UnitName: name,
-		}
-		var f File
-		f.Name, f.Data, f.Err = l.readLog(ctx, &log)
-		files = append(files, f)
-	}
-
-	sort.Slice(files, func(i, j int) bool { return files[i].Name < files[j].Name })
-	return files
-}
-
-// Zip creates .zip archive with all logs.
-func (l *Logs) Zip(ctx context.Context, w io.Writer) error {
-	zw := zip.NewWriter(w)
-	now := time.Now().UTC()
-	for _, file := range l.Files(ctx) {
-		if file.Name == "" {
-			continue
-		}
-
-		if file.Err != nil {
-			logger.Get(ctx).WithField("component", "logs").Error(file.Err)
-
-			// do not let a single error break the whole archive
-			if len(file.Data) > 0 {
-				file.Data = append(file.Data, "\n\n"...)
-			}
-			file.Data = append(file.Data, []byte(file.Err.Error())...)
-		}
-
-		f, err := zw.CreateHeader(&zip.FileHeader{
-			Name:     file.Name,
-			Method:   zip.Deflate,
-			Modified: now,
 		})
-		if err != nil {
-			return errors.Wrap(err, "failed to create zip file header")
-		}
-		if _, err = f.Write(file.Data); err != nil {
-			return errors.Wrap(err, "failed to write zip file data")
-		}
 	}
-	return errors.Wrap(zw.Close(), "failed to close zip file")
+	sort.Slice(logs, func(i, j int) bool { return logs[i].UnitName < logs[j].UnitName })
+	return logs, nil
 }
 
-// readLog reads last lines from defined Log configuration.
-func (l *Logs) readLog(ctx context.Context, log *Log) (name string, data []byte, err error) {
-	if log.Extractor != nil {
-		return l.readWithExtractor(ctx, log)
-	}
-
-	if log.UnitName != "" && l.journalctlPath != "" {
-		name = log.UnitName
-		data, err = l.journalctlN(ctx, log.UnitName)
+// redact replaces secrets in file data and error message.
+func (l *Logs) redact(f *File) {
+	f.Data, f.Redacted = l.redactor.Redact(f.Data)
+	if f.Err == nil {
 		return
 	}
 
-	if log.FilePath != "" {
-		name = filepath.Base(log.FilePath)
-		data, err = l.tailN(ctx, log.FilePath)
+	// error messages may contain URLs with credentials
+	msg, redacted := l.redactor.Redact([]byte(f.Err.Error()))
+	if len(redacted) == 0 {
 		return
 	}
-
-	return
+	f.Err = errors.New(string(msg))
+	if f.Redacted == nil {
+		f.Redacted = make(map[string]int)
+	}
+	for name, n := range redacted {
+		f.Redacted[name] += n
+	}
 }
 
-func (l *Logs) readWithExtractor(ctx context.Context, log *Log) (name string, data []byte, err error) {
-	name = filepath.Base(log.FilePath)
-
-	switch log.Extractor[0] {
-	case "exec":
-		data, err = l.collectExec(ctx, log.FilePath, log.Extractor[1])
-
-	case "pmmVersion":
-		data = []byte(l.pmmVersion)
+type manifestFile struct {
+	Name     string         `json:"name"`
+	Redacted map[string]int `json:"redacted"`
+}
 
-	case "consul":
-		data, err = l.getConsulNodes()
+type manifest struct {
+	Patterns      []string       `json:"patterns"`
+	RedactedFiles []manifestFile `json:"redacted_files"`
+}
 
-	case "rds":
-		data, err = l.getRDSInstances(ctx)
+// Zip creates .zip archive with all logs, collection summary and redaction manifest.
+func (l *Logs) Zip(ctx context.Context, w io.Writer) error {
+	_, err := l.Bundle(ctx, w, new(BundleOptions))
+	return err
+}
 
-	case "http":
-		command := log.Extractor[1]
-		s := strings.Split(command, "//")
-		credential, err1 := getCredential()
-		if len(s) > 1 && len(credential) > 1 {
-			command = fmt.Sprintf("%s//%s@%s", s[0], credential, s[1])
-		}
-		data, err = l.readURL(command)
-		if err1 != nil {
-			err = fmt.Errorf("%v; %v", err1, err)
-		}
+// logName returns log name as used by Files.
+func (l *Logs) logName(log *Log) string {
+	if log.Extractor == nil && log.UnitName != "" && l.journalctlPath != "" {
+		return log.UnitName
+	}
+	return filepath.Base(log.FilePath)
+}
 
-	case "cat":
-		data, err = ioutil.ReadFile(log.FilePath)
+// readLog reads last lines from defined Log configuration.
+func (l *Logs) readLog(ctx context.Context, log *Log, opts *BundleOptions) (data []byte, truncated bool, err error) {
+	if log.Extractor != nil {
+		data, err = l.readWithExtractor(ctx, log)
+		return
+	}
 
-	default:
-		panic("unhandled extractor")
+	if (log.UnitName != "" && l.journalctlPath != "") || log.FilePath != "" {
+		return l.readLines(ctx, log, opts)
 	}
 
 	return
 }
 
-// journalctlN reads last lines from systemd unit u using `journalctl` command.
-func (l *Logs) journalctlN(ctx context.Context, u string) ([]byte, error) {
-	cmd := exec.CommandContext(ctx, l.journalctlPath, "-n", strconv.Itoa(lastLines), "-u", u)
-	var stderr bytes.Buffer
-	cmd.Stderr = &stderr
-	b, err := cmd.Output()
-	if err != nil {
-		return b, fmt.Errorf("%s: %s: %s", strings.Join(cmd.Args, " "), err, stderr.String())
-	}
-	return b, nil
-}
-
-// tailN reads last lines from log file at given path using `tail` command.
-func (l *Logs) tailN(ctx context.Context, path string) ([]byte, error) {
-	cmd := exec.CommandContext(ctx, "/usr/bin/tail", "-n", strconv.Itoa(lastLines), path)
-	var stderr bytes.Buffer
-	cmd.Stderr = &stderr
-	b, err := cmd.Output()
-	if err != nil {
-		return b, fmt.Errorf("%s: %s: %s", strings.Join(cmd.Args, " "), err, stderr.String())
+func (l *Logs) readWithExtractor(ctx context.Context, log *Log) ([]byte, error) {
+	l.rw.RLock()
+	e := l.extractors[log.Extractor[0]]
+	l.rw.RUnlock()
+	if e == nil {
+		return nil, errors.Errorf("unknown extractor %q", log.Extractor[0])
 	}
-	return b, nil
+	return e.Extract(ctx, log)
 }
 
 // collectExec collects output from various commands
@@ -356,8 +333,12 @@ func (l *Logs) collectExec(ctx context.Context, path string, command string) ([]
 }
 
 // readUrl reads content of a page
-func (l *Logs) readURL(url string) ([]byte, error) {
-	u, err := http.Get(url)
+func (l *Logs) readURL(ctx context.Context, url string) ([]byte, error) {
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return nil, err
+	}
+	u, err := http.DefaultClient.Do(req.WithContext(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -371,6 +352,10 @@ func (l *Logs) readURL(url string) ([]byte, error) {
 
 // getConsulNodes gets list of nodes
 func (l *Logs) getConsulNodes() ([]byte, error) {
+	if l.consul == nil {
+		return nil, errors.New("Consul is not used")
+	}
+
 	nodes, err := l.consul.GetNodes()
 	if err != nil {
 		return nil, err
@@ -0,0 +1,259 @@
+// pmm-managed
+// Copyright (C) 2017 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package logs
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/percona/pmm-managed/utils/logger"
+)
+
+const (
+	// maximum number of lines in a single batch passed to send function
+	tailBatchLines = 100
+
+	// maximum delay before sending incomplete batch
+	tailBatchDelay = 200 * time.Millisecond
+)
+
+// TailOptions contains options for Tail.
+type TailOptions struct {
+	Follow bool           // stream new lines as they arrive until context is canceled
+	Since  time.Time      // return only lines after that time, if not zero
+	Until  time.Time      // return only lines before that time, if not zero
+	Filter *regexp.Regexp // return only lines matching that expression, if not nil
+	Lines  int            // number of last lines to return first; 1000 if zero and Since and Until are zero, all otherwise
+}
+
+// inWindow returns true if line is within Since-Until time window.
+// For lines without own timestamps, it returns decision for the previous line.
+func (opts *TailOptions) inWindow(line string, prev bool) bool {
+	if opts.Since.IsZero() && opts.Until.IsZero() {
+		return true
+	}
+	lt, ok := lineTime(line)
+	if !ok {
+		return prev
+	}
+	return !lt.Before(opts.Since) && (opts.Until.IsZero() || !lt.After(opts.Until))
+}
+
+// timestampRE matches common timestamps at the beginning of log lines: logrus, Prometheus, Grafana, journalctl -o short-iso, etc.
+var timestampRE = regexp.MustCompile(`\d{4}-\d{2}-\d{2}[T ]\d{2}:\d{2}:\d{2}(\.\d+)?(Z|[+-]\d{2}:?\d{2})?`)
+
+// lineTime returns timestamp of log line, if it can be determined.
+func lineTime(line string) (time.Time, bool) {
+	if len(line) > 100 {
+		line = line[:100]
+	}
+	s := timestampRE.FindString(line)
+	if s == "" {
+		return time.Time{}, false
+	}
+	for _, layout := range []string{
+		"2006-01-02T15:04:05Z07:00",
+		"2006-01-02T15:04:05Z0700",
+		"2006-01-02 15:04:05Z07:00",
+		"2006-01-02T15:04:05",
+		"2006-01-02 15:04:05",
+	} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// findLog returns log configuration for a given name: log file name (as returned by Files),
+// systemd unit name, or Agent's supervisor name.
+func (l *Logs) findLog(name string) (*Log, error) {
+	for _, log := range l.sources() {
+		if log.Extractor != nil {
+			if filepath.Base(log.FilePath) == name {
+				return nil, status.Errorf(codes.InvalidArgument, "Log %q can't be tailed.", name)
+			}
+			continue
+		}
+		if log.matches(name) {
+			log := log
+			return &log, nil
+		}
+	}
+
+	agentLogs, err := l.agentLogs()
+	if err != nil {
+		return nil, err
+	}
+	for _, log := range agentLogs {
+		if log.matches(name) {
+			log := log
+			return &log, nil
+		}
+	}
+
+	return nil, status.Errorf(codes.NotFound, "Log %q not found.", name)
+}
+
+// tailCmd returns command for reading log.
+func (l *Logs) tailCmd(ctx context.Context, log *Log, opts *TailOptions) *exec.Cmd {
+	lines := opts.Lines
+	if lines == 0 && opts.Since.IsZero() && opts.Until.IsZero() {
+		lines = lastLines
+	}
+
+	if log.UnitName != "" && l.journalctlPath != "" {
+		args := []string{"-u", log.UnitName, "-o", "short-iso", "--no-pager"}
+		if lines != 0 {
+			args = append(args, "-n", strconv.Itoa(lines))
+		}
+		if !opts.Since.IsZero() {
+			args = append(args, "--since", fmt.Sprintf("@%d", opts.Since.Unix()))
+		}
+		if !opts.Until.IsZero() {
+			// journalctl's --until is exclusive and has a second precision
+			args = append(args, "--until", fmt.Sprintf("@%d", opts.Until.Unix()+1))
+		}
+		if opts.Follow {
+			args = append(args, "-f")
+		}
+		return exec.CommandContext(ctx, l.journalctlPath, args...)
+	}
+
+	args := []string{"-n", "+1"}
+	if lines != 0 {
+		args = []string{"-n", strconv.Itoa(lines)}
+	}
+	if opts.Follow {
+		// follow file by name to handle log rotation
+		args = append(args, "-F")
+	}
+	args = append(args, log.FilePath)
+	return exec.CommandContext(ctx, "/usr/bin/tail", args...)
+}
+
+// Tail reads log with a given name and passes lines in batches to send function.
+// If opts.Follow is false, it returns after reading the current log content.
+// Otherwise, it returns when context is canceled or send returns error.
+func (l *Logs) Tail(ctx context.Context, name string, opts *TailOptions, send func(lines []string) error) error {
+	log, err := l.findLog(name)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	cmd := l.tailCmd(ctx, log, opts)
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if err = cmd.Start(); err != nil {
+		return errors.WithStack(err)
+	}
+	logger.Get(ctx).WithField("component", "logs").Debugf("Started %v.", cmd.Args)
+
+	lines := make(chan string)
+	scanErr := make(chan error, 1)
+	go func() {
+		defer close(lines)
+		s := bufio.NewScanner(stdout)
+		for s.Scan() {
+			select {
+			case lines <- s.Text():
+			case <-ctx.Done():
+				scanErr <- nil
+				return
+			}
+		}
+		scanErr <- s.Err()
+	}()
+
+	batch := make([]string, 0, tailBatchLines)
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		err := send(batch)
+		batch = make([]string, 0, tailBatchLines)
+		return err
+	}
+
+	inWindow := opts.Since.IsZero()
+	var eof bool
+	t := time.NewTicker(tailBatchDelay)
+	defer t.Stop()
+loop:
+	for {
+		select {
+		case line, ok := <-lines:
+			if !ok {
+				eof = true
+				break loop
+			}
+
+			// redact before filtering to prevent probing secrets with filter expressions
+			line = l.redactor.RedactString(line)
+			if inWindow = opts.inWindow(line, inWindow); !inWindow || (opts.Filter != nil && !opts.Filter.MatchString(line)) {
+				continue
+			}
+			batch = append(batch, line)
+			if len(batch) < tailBatchLines {
+				continue
+			}
+
+		case <-t.C:
+
+		case <-ctx.Done():
+			break loop
+		}
+
+		if err = flush(); err != nil {
+			break loop
+		}
+	}
+
+	if !eof {
+		// context is canceled (not an error) or send failed; stop command and wait for reader
+		cancel()
+		for range lines {
+		}
+		<-scanErr
+		_ = cmd.Wait()
+		return err
+	}
+
+	if err = <-scanErr; err != nil {
+		_ = cmd.Wait()
+		return errors.WithStack(err)
+	}
+	if err = cmd.Wait(); err != nil {
+		return errors.Wrapf(err, "%v", cmd.Args)
+	}
+	return flush()
+}
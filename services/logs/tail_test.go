@@ -0,0 +1,163 @@
+// pmm-managed
+// Copyright (C) 2017 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package logs
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/percona/pmm-managed/utils/logger"
+	"github.com/percona/pmm-managed/utils/tests"
+)
+
+func TestLineTime(t *testing.T) {
+	for line, expected := range map[string]string{
+		`time="2018-10-19T10:11:12Z" level=info msg="Starting"`:                          "2018-10-19T10:11:12Z",
+		`level=info ts=2018-10-19T10:11:12.345+03:00 caller=main.go:1 msg="Starting"`:    "2018-10-19T10:11:12.345+03:00",
+		`2018-10-19T10:11:12+0300 pmm-server systemd[1]: Started pmm-mysqld_exporter-1.`: "2018-10-19T10:11:12+03:00",
+		`2018/10/19 10:11:12 [error] 7#7: *1 connect() failed`:                           "",
+		`t=2018-10-19 10:11:12 lvl=info msg="Starting Grafana"`:                          "2018-10-19T10:11:12Z",
+		`    at main.go:42`: "",
+	} {
+		actual, ok := lineTime(line)
+		if expected == "" {
+			assert.False(t, ok, "%s", line)
+			continue
+		}
+		e, err := time.Parse(time.RFC3339Nano, expected)
+		require.NoError(t, err)
+		assert.True(t, ok, "%s", line)
+		assert.True(t, e.Equal(actual), "%s: expected %s, got %s", line, e, actual)
+	}
+}
+
+func TestTail(t *testing.T) {
+	ctx, _ := logger.Set(context.Background(), t.Name())
+	tmpDir, err := ioutil.TempDir("", "pmm-managed-test-logs-")
+	require.NoError(t, err)
+	defer os.RemoveAll(tmpDir) //nolint:errcheck
+
+	logFile := filepath.Join(tmpDir, "test.log")
+	content := "" +
+		`time="2018-10-19T10:00:00Z" level=info msg="one"` + "\n" +
+		`time="2018-10-19T11:00:00Z" level=error msg="two"` + "\n" +
+		"  continuation\n" +
+		`time="2018-10-19T12:00:00Z" level=info msg="three"` + "\n"
+	require.NoError(t, ioutil.WriteFile(logFile, []byte(content), 0600))
+	l := New("1.2.3", nil, nil, nil, []Log{
+		{logFile, "", nil},
+		{"pmm-version.txt", "", []string{"pmmVersion", ""}},
+	}, nil)
+	l.journalctlPath = ""
+
+	read := func(t *testing.T, opts *TailOptions) []string {
+		var res []string
+		err := l.Tail(ctx, "test.log", opts, func(lines []string) error {
+			res = append(res, lines...)
+			return nil
+		})
+		require.NoError(t, err)
+		return res
+	}
+
+	t.Run("Normal", func(t *testing.T) {
+		actual := read(t, &TailOptions{})
+		assert.Len(t, actual, 4)
+
+		actual = read(t, &TailOptions{Lines: 1})
+		assert.Equal(t, []string{`time="2018-10-19T12:00:00Z" level=info msg="three"`}, actual)
+	})
+
+	t.Run("Since", func(t *testing.T) {
+		actual := read(t, &TailOptions{Since: time.Date(2018, 10, 19, 10, 30, 0, 0, time.UTC)})
+		expected := []string{
+			`time="2018-10-19T11:00:00Z" level=error msg="two"`,
+			"  continuation",
+			`time="2018-10-19T12:00:00Z" level=info msg="three"`,
+		}
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("Filter", func(t *testing.T) {
+		actual := read(t, &TailOptions{Filter: regexp.MustCompile(`level=error`)})
+		assert.Equal(t, []string{`time="2018-10-19T11:00:00Z" level=error msg="two"`}, actual)
+	})
+
+	t.Run("Follow", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(ctx)
+		var m sync.Mutex
+		var actual []string
+		done := make(chan error)
+		go func() {
+			done <- l.Tail(ctx, "test.log", &TailOptions{Follow: true, Lines: 1}, func(lines []string) error {
+				m.Lock()
+				actual = append(actual, lines...)
+				m.Unlock()
+				return nil
+			})
+		}()
+
+		waitLines := func(n int) {
+			for i := 0; i < 50; i++ {
+				m.Lock()
+				l := len(actual)
+				m.Unlock()
+				if l >= n {
+					return
+				}
+				time.Sleep(100 * time.Millisecond)
+			}
+		}
+		waitLines(1)
+
+		f, err := os.OpenFile(logFile, os.O_WRONLY|os.O_APPEND, 0600)
+		require.NoError(t, err)
+		_, err = f.WriteString("four\n")
+		require.NoError(t, err)
+		require.NoError(t, f.Close())
+
+		expected := []string{`time="2018-10-19T12:00:00Z" level=info msg="three"`, "four"}
+		waitLines(len(expected))
+		cancel()
+		assert.NoError(t, <-done)
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("Redact", func(t *testing.T) {
+		require.NoError(t, ioutil.WriteFile(logFile, []byte("dsn=pmm:secret@tcp(127.0.0.1:3306)/\n"), 0600))
+		actual := read(t, &TailOptions{Filter: regexp.MustCompile(`secret`)})
+		assert.Empty(t, actual)
+		actual = read(t, &TailOptions{})
+		assert.Equal(t, []string{"dsn=pmm:[REDACTED]@tcp(127.0.0.1:3306)/"}, actual)
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		err := l.Tail(ctx, "pmm-version.txt", &TailOptions{}, nil)
+		tests.AssertGRPCError(t, status.New(codes.InvalidArgument, `Log "pmm-version.txt" can't be tailed.`), err)
+	})
+}
@@ -5,12 +5,29 @@ package mocks
 import context "context"
 import mock "github.com/stretchr/testify/mock"
 import service "github.com/percona/kardianos-service"
+import services "github.com/percona/pmm-managed/services"
 
 // Supervisor is an autogenerated mock type for the Supervisor type
 type Supervisor struct {
 	mock.Mock
 }
 
+// ProcessStatus provides a mock function with given fields: ctx, name
+func (_m *Supervisor) ProcessStatus(ctx context.Context, name string) *services.ProcessStatus {
+	ret := _m.Called(ctx, name)
+
+	var r0 *services.ProcessStatus
+	if rf, ok := ret.Get(0).(func(context.Context, string) *services.ProcessStatus); ok {
+		r0 = rf(ctx, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*services.ProcessStatus)
+		}
+	}
+
+	return r0
+}
+
 // Start provides a mock function with given fields: ctx, config
 func (_m *Supervisor) Start(ctx context.Context, config *service.Config) error {
 	ret := _m.Called(ctx, config)
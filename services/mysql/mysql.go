@@ -18,6 +18,7 @@
 package mysql
 
 import (
+	"bytes"
 	"context"
 	"database/sql"
 	"fmt"
@@ -37,6 +38,9 @@ import (
 
 	"github.com/percona/pmm-managed/models"
 	"github.com/percona/pmm-managed/services"
+	"github.com/percona/pmm-managed/services/agents"
+	"github.com/percona/pmm-managed/services/events"
+	"github.com/percona/pmm-managed/services/grafana"
 	"github.com/percona/pmm-managed/services/prometheus"
 	"github.com/percona/pmm-managed/services/qan"
 	"github.com/percona/pmm-managed/utils/logger"
@@ -54,13 +58,18 @@ const (
 var versionRegexp = regexp.MustCompile(`([\d\.]+)-.*`)
 
 type ServiceConfig struct {
-	MySQLdExporterPath string
+	MySQLdExporterPath   string
+	MySQLdExporterLimits *services.ResourceLimits
 
 	Prometheus    *prometheus.Service
 	Supervisor    services.Supervisor
+	Agents        *agents.Service
 	DB            *reform.DB
 	PortsRegistry *ports.Registry
 	QAN           *qan.Service
+	Annotator     *grafana.Annotator
+	Dashboards    *grafana.Provisioner
+	Events        *events.Bus
 }
 
 // Service is responsible for interactions with AWS RDS.
@@ -100,9 +109,12 @@ func NewService(config *ServiceConfig) (*Service, error) {
 type Instance struct {
 	Node    models.RemoteNode
 	Service models.MySQLService
+	Health  agents.Health
 }
 
-func (svc *Service) ApplyPrometheusConfiguration(ctx context.Context, q *reform.Querier) error {
+// ApplyPrometheusConfiguration adds MySQL instances to Prometheus configuration and applies it.
+// Returned events should be published after transaction is committed.
+func (svc *Service) ApplyPrometheusConfiguration(ctx context.Context, q *reform.Querier) ([]events.Event, error) {
 	mySQLHR := &prometheus.ScrapeConfig{
 		JobName:        "remote-mysql-hr",
 		ScrapeInterval: "1s",
@@ -136,17 +148,17 @@ func (svc *Service) ApplyPrometheusConfiguration(ctx context.Context, q *reform.
 
 	nodes, err := q.FindAllFrom(models.RemoteNodeTable, "type", models.RemoteNodeType)
 	if err != nil {
-		return errors.WithStack(err)
+		return nil, errors.WithStack(err)
 	}
 	for _, n := range nodes {
 		node := n.(*models.RemoteNode)
 
-		mySQLServices, err := q.SelectAllFrom(models.MySQLServiceTable, "WHERE node_id = ?", node.ID)
+		mySQLServices, err := q.SelectAllFrom(models.MySQLServiceTable, "WHERE node_id = "+q.Placeholder(1), node.ID)
 		if err != nil {
-			return errors.WithStack(err)
+			return nil, errors.WithStack(err)
 		}
 		if len(mySQLServices) != 1 {
-			return errors.Errorf("expected to fetch 1 record, fetched %d. %v", len(mySQLServices), mySQLServices)
+			return nil, errors.Errorf("expected to fetch 1 record, fetched %d. %v", len(mySQLServices), mySQLServices)
 		}
 		service := mySQLServices[0].(*models.MySQLService)
 		if service.Type != models.MySQLServiceType {
@@ -155,14 +167,14 @@ func (svc *Service) ApplyPrometheusConfiguration(ctx context.Context, q *reform.
 
 		agents, err := models.AgentsForServiceID(q, service.ID)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		for _, agent := range agents {
 			switch agent.Type {
 			case models.MySQLdExporterAgentType:
 				a := models.MySQLdExporter{ID: agent.ID}
 				if e := q.Reload(&a); e != nil {
-					return errors.WithStack(e)
+					return nil, errors.WithStack(e)
 				}
 				logger.Get(ctx).WithField("component", "mysql").Infof("%s %s %s %d", a.Type, node.Name, node.Region, *a.ListenPort)
 
@@ -194,8 +206,9 @@ func (svc *Service) ApplyPrometheusConfiguration(ctx context.Context, q *reform.
 
 func (svc *Service) List(ctx context.Context) ([]Instance, error) {
 	var res []Instance
+	var instanceAgents [][]models.Agent
 	err := svc.DB.InTransaction(func(tx *reform.TX) error {
-		structs, e := tx.SelectAllFrom(models.RemoteNodeTable, "WHERE type = ? ORDER BY id", models.RemoteNodeType)
+		structs, e := tx.SelectAllFrom(models.RemoteNodeTable, "WHERE type = "+tx.Placeholder(1)+" ORDER BY id", models.RemoteNodeType)
 		if e != nil {
 			return e
 		}
@@ -204,7 +217,7 @@ func (svc *Service) List(ctx context.Context) ([]Instance, error) {
 			nodes[i] = *str.(*models.RemoteNode)
 		}
 
-		structs, e = tx.SelectAllFrom(models.MySQLServiceTable, "WHERE type = ? ORDER BY id", models.MySQLServiceType)
+		structs, e = tx.SelectAllFrom(models.MySQLServiceTable, "WHERE type = "+tx.Placeholder(1)+" ORDER BY id", models.MySQLServiceType)
 		if e != nil {
 			return e
 		}
@@ -216,21 +229,37 @@ func (svc *Service) List(ctx context.Context) ([]Instance, error) {
 		for _, node := range nodes {
 			for _, service := range services {
 				if node.ID == service.NodeID {
+					a, e := agents.ForInstance(tx.Querier, node.ID, service.ID)
+					if e != nil {
+						return e
+					}
 					res = append(res, Instance{
 						Node:    node,
 						Service: service,
+						Health:  agents.HealthUnknown,
 					})
+					instanceAgents = append(instanceAgents, a)
 				}
 			}
 		}
 		return nil
 	})
-	return res, err
+	if err != nil {
+		return nil, err
+	}
+
+	// check Agents outside of transaction
+	if svc.Agents != nil {
+		for i, h := range svc.Agents.Health(ctx, instanceAgents) {
+			res[i].Health = h
+		}
+	}
+	return res, nil
 }
 
 func (svc *Service) addMySQLdExporter(ctx context.Context, tx *reform.TX, service *models.MySQLService, username, password string) error {
 	// insert mysqld_exporter agent and association
-	port, err := svc.PortsRegistry.Reserve()
+	port, err := svc.PortsRegistry.Reserve(tx)
 	if err != nil {
 		return err
 	}
@@ -239,7 +268,7 @@ func (svc *Service) addMySQLdExporter(ctx context.Context, tx *reform.TX, servic
 		RunsOnNodeID: svc.pmmServerNode.ID,
 
 		ServiceUsername: &username,
-		ServicePassword: &password,
+		ServicePassword: models.NewSecret(password),
 		ListenPort:      &port,
 	}
 	if err = tx.Insert(agent); err != nil {
@@ -311,7 +340,7 @@ func (svc *Service) mysqlExporterCfg(agent *models.MySQLdExporter, dsn string) *
 	}
 	sort.Strings(arguments)
 
-	return &servicelib.Config{
+	cfg := &servicelib.Config{
 		Name:        name,
 		DisplayName: name,
 		Description: name,
@@ -319,6 +348,8 @@ func (svc *Service) mysqlExporterCfg(agent *models.MySQLdExporter, dsn string) *
 		Arguments:   arguments,
 		Environment: []string{fmt.Sprintf("DATA_SOURCE_NAME=%s", dsn)},
 	}
+	svc.MySQLdExporterLimits.Apply(cfg)
+	return cfg
 }
 
 func (svc *Service) addQanAgent(ctx context.Context, tx *reform.TX, service *models.MySQLService, node *models.RemoteNode, username, password string) error {
@@ -331,7 +362,7 @@ func (svc *Service) addQanAgent(ctx context.Context, tx *reform.TX, service *mod
 		RunsOnNodeID: svc.pmmServerNode.ID,
 
 		ServiceUsername: &username,
-		ServicePassword: &password,
+		ServicePassword: models.NewSecret(password),
 		ListenPort:      pointer.ToUint16(qanAgentPort),
 	}
 	var err error
@@ -378,6 +409,7 @@ func (svc *Service) Add(ctx context.Context, name, address string, port uint32,
 	}
 
 	var id int32
+	var evs, reloaded []events.Event
 	err := svc.DB.InTransaction(func(tx *reform.TX) error {
 		// insert node
 		node := &models.RemoteNode{
@@ -386,7 +418,7 @@ func (svc *Service) Add(ctx context.Context, name, address string, port uint32,
 			Region: models.RemoteNodeRegion,
 		}
 		if err := tx.Insert(node); err != nil {
-			if err, ok := err.(*mysql.MySQLError); ok && err.Number == 0x426 {
+			if models.IsUniqueViolation(err) {
 				return status.Errorf(codes.AlreadyExists, "MySQL instance %q already exists.",
 					node.Name)
 			}
@@ -419,28 +451,45 @@ func (svc *Service) Add(ctx context.Context, name, address string, port uint32,
 		if err = svc.addQanAgent(ctx, tx, service, node, username, password); err != nil {
 			return err
 		}
+		if evs, err = events.InstanceAdded(tx.Querier, node.ID, service.ID); err != nil {
+			return err
+		}
 
-		return svc.ApplyPrometheusConfiguration(ctx, tx.Querier)
+		reloaded, err = svc.ApplyPrometheusConfiguration(ctx, tx.Querier)
+		return err
 	})
+	if err != nil {
+		return 0, err
+	}
 
-	return id, err
+	svc.Events.Publish(append(evs, reloaded...)...)
+
+	svc.Annotator.AnnotateInstance(ctx, grafana.EventInstanceAdded, name, fmt.Sprintf("MySQL instance %q added.", name))
+	svc.Dashboards.InstanceAdded(ctx, grafana.InstanceTypeMySQL)
+	return id, nil
 }
 
 func (svc *Service) Remove(ctx context.Context, id int32) error {
 	var err error
-	return svc.DB.InTransaction(func(tx *reform.TX) error {
+	var name string
+	var evs, reloaded []events.Event
+	err = svc.DB.InTransaction(func(tx *reform.TX) error {
 		var node models.RemoteNode
-		if err = tx.SelectOneTo(&node, "WHERE type = ? AND id = ?", models.RemoteNodeType, id); err != nil {
+		if err = tx.SelectOneTo(&node, "WHERE type = "+tx.Placeholder(1)+" AND id = "+tx.Placeholder(2), models.RemoteNodeType, id); err != nil {
 			if err == reform.ErrNoRows {
 				return status.Errorf(codes.NotFound, "MySQL instance with ID %d not found.", id)
 			}
 			return errors.WithStack(err)
 		}
+		name = node.Name
 
 		var service models.MySQLService
-		if err = tx.SelectOneTo(&service, "WHERE node_id = ? and type = ?", node.ID, models.MySQLServiceType); err != nil {
+		if err = tx.SelectOneTo(&service, "WHERE node_id = "+tx.Placeholder(1)+" and type = "+tx.Placeholder(2), node.ID, models.MySQLServiceType); err != nil {
 			return errors.WithStack(err)
 		}
+		if evs, err = events.InstanceRemoved(tx.Querier, node.ID, service.ID); err != nil {
+			return err
+		}
 
 		// remove associations of the service and agents
 		agentsForService, err := models.AgentsForServiceID(tx.Querier, service.ID)
@@ -449,7 +498,7 @@ func (svc *Service) Remove(ctx context.Context, id int32) error {
 		}
 		for _, agent := range agentsForService {
 			var deleted uint
-			deleted, err = tx.DeleteFrom(models.AgentServiceView, "WHERE service_id = ? AND agent_id = ?", service.ID, agent.ID)
+			deleted, err = tx.DeleteFrom(models.AgentServiceView, "WHERE service_id = "+tx.Placeholder(1)+" AND agent_id = "+tx.Placeholder(2), service.ID, agent.ID)
 			if err != nil {
 				return errors.WithStack(err)
 			}
@@ -465,7 +514,7 @@ func (svc *Service) Remove(ctx context.Context, id int32) error {
 		}
 		for _, agent := range agentsForNode {
 			var deleted uint
-			deleted, err = tx.DeleteFrom(models.AgentNodeView, "WHERE node_id = ? AND agent_id = ?", node.ID, agent.ID)
+			deleted, err = tx.DeleteFrom(models.AgentNodeView, "WHERE node_id = "+tx.Placeholder(1)+" AND agent_id = "+tx.Placeholder(2), node.ID, agent.ID)
 			if err != nil {
 				return errors.WithStack(err)
 			}
@@ -494,6 +543,9 @@ func (svc *Service) Remove(ctx context.Context, id int32) error {
 						return err
 					}
 				}
+				if err = svc.PortsRegistry.Release(tx.Querier, *a.ListenPort); err != nil {
+					return err
+				}
 
 			case models.QanAgentAgentType:
 				a := models.QanAgent{ID: agent.ID}
@@ -522,8 +574,47 @@ func (svc *Service) Remove(ctx context.Context, id int32) error {
 			return errors.WithStack(err)
 		}
 
-		return svc.ApplyPrometheusConfiguration(ctx, tx.Querier)
+		reloaded, err = svc.ApplyPrometheusConfiguration(ctx, tx.Querier)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	svc.Events.Publish(append(evs, reloaded...)...)
+	svc.Annotator.AnnotateInstance(ctx, grafana.EventInstanceRemoved, name, fmt.Sprintf("MySQL instance %q removed.", name))
+	return nil
+}
+
+// ExporterCommands returns effective mysqld_exporter command lines for diagnostics.
+func (svc *Service) ExporterCommands(ctx context.Context) ([]byte, error) {
+	var buf bytes.Buffer
+	err := svc.DB.InTransaction(func(tx *reform.TX) error {
+		structs, err := tx.SelectAllFrom(models.MySQLServiceTable, "WHERE type = "+tx.Placeholder(1)+" ORDER BY id", models.MySQLServiceType)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		for _, str := range structs {
+			service := str.(*models.MySQLService)
+			agents, err := models.AgentsForServiceID(tx.Querier, service.ID)
+			if err != nil {
+				return err
+			}
+			for _, agent := range agents {
+				if agent.Type != models.MySQLdExporterAgentType {
+					continue
+				}
+				a := &models.MySQLdExporter{ID: agent.ID}
+				if err = tx.Reload(a); err != nil {
+					return errors.WithStack(err)
+				}
+				cfg := svc.mysqlExporterCfg(a, a.DSN(service))
+				buf.WriteString(services.FormatConfig(cfg))
+			}
+		}
+		return nil
 	})
+	return buf.Bytes(), err
 }
 
 // Restore configuration from database.
@@ -535,7 +626,7 @@ func (svc *Service) Restore(ctx context.Context, tx *reform.TX) error {
 	for _, n := range nodes {
 		node := n.(*models.RemoteNode)
 
-		mySQLServices, err := tx.SelectAllFrom(models.MySQLServiceTable, "WHERE node_id = ?", node.ID)
+		mySQLServices, err := tx.SelectAllFrom(models.MySQLServiceTable, "WHERE node_id = "+tx.Placeholder(1), node.ID)
 		if err != nil {
 			return errors.WithStack(err)
 		}
@@ -604,7 +695,7 @@ func (svc *Service) engineAndEngineVersion(ctx context.Context, host string, por
 	var versionComment string
 	agent := models.MySQLdExporter{
 		ServiceUsername: pointer.ToString(username),
-		ServicePassword: pointer.ToString(password),
+		ServicePassword: models.NewSecret(password),
 	}
 	service := &models.MySQLService{
 		Address: &host,
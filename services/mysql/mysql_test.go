@@ -37,9 +37,9 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"gopkg.in/reform.v1"
-	"gopkg.in/reform.v1/dialects/mysql"
 
 	"github.com/percona/pmm-managed/models"
+	"github.com/percona/pmm-managed/services/agents"
 	"github.com/percona/pmm-managed/services/mocks"
 	"github.com/percona/pmm-managed/services/prometheus"
 	"github.com/percona/pmm-managed/services/qan"
@@ -123,11 +123,11 @@ func setup(t *testing.T) (context.Context, *Service, *sql.DB, []byte, string, *m
 	ctx, p, before := prometheus.SetupTest(t)
 
 	sqlDB := tests.OpenTestDB(t)
-	db := reform.NewDB(sqlDB, mysql.Dialect, reform.NewPrintfLogger(t.Logf))
-	portsRegistry := ports.NewRegistry(30000, 30999, nil)
+	db := reform.NewDB(sqlDB, tests.TestDBDialect(t), reform.NewPrintfLogger(t.Logf))
+	portsRegistry := ports.NewRegistry(30000, 30999)
 
 	supervisor := &mocks.Supervisor{}
-	qan, err := qan.NewService(ctx, rootDir, supervisor)
+	qan, err := qan.NewService(ctx, rootDir, supervisor, nil)
 	require.NoError(t, err)
 	svc, err := NewService(&ServiceConfig{
 		MySQLdExporterPath: mySQLdExporterPath,
@@ -198,6 +198,7 @@ func TestAddListRemove(t *testing.T) {
 			Engine:        pointer.ToString("Percona Server"),
 			EngineVersion: pointer.ToString("5.7.26"),
 		},
+		Health: agents.HealthUnknown,
 	}}
 	assert.Equal(t, expected, actual)
 
@@ -18,6 +18,7 @@
 package postgresql
 
 import (
+	"bytes"
 	"context"
 	"database/sql"
 	"fmt"
@@ -28,7 +29,6 @@ import (
 	"time"
 
 	"github.com/AlekSi/pointer"
-	"github.com/go-sql-driver/mysql"
 	"github.com/lib/pq"
 	servicelib "github.com/percona/kardianos-service"
 	"github.com/pkg/errors"
@@ -38,6 +38,9 @@ import (
 
 	"github.com/percona/pmm-managed/models"
 	"github.com/percona/pmm-managed/services"
+	"github.com/percona/pmm-managed/services/agents"
+	"github.com/percona/pmm-managed/services/events"
+	"github.com/percona/pmm-managed/services/grafana"
 	"github.com/percona/pmm-managed/services/prometheus"
 	"github.com/percona/pmm-managed/utils/logger"
 	"github.com/percona/pmm-managed/utils/ports"
@@ -57,12 +60,17 @@ var (
 )
 
 type ServiceConfig struct {
-	PostgresExporterPath string
+	PostgresExporterPath   string
+	PostgresExporterLimits *services.ResourceLimits
 
 	Prometheus    *prometheus.Service
 	Supervisor    services.Supervisor
+	Agents        *agents.Service
 	DB            *reform.DB
 	PortsRegistry *ports.Registry
+	Annotator     *grafana.Annotator
+	Dashboards    *grafana.Provisioner
+	Events        *events.Bus
 }
 
 // Service is responsible for interactions with PostgreSQL.
@@ -100,7 +108,8 @@ func NewService(config *ServiceConfig) (*Service, error) {
 }
 
 // ApplyPrometheusConfiguration Adds postgres to prometheus configuration and applies it
-func (svc *Service) ApplyPrometheusConfiguration(ctx context.Context, q *reform.Querier) error {
+// Returned events should be published after transaction is committed.
+func (svc *Service) ApplyPrometheusConfiguration(ctx context.Context, q *reform.Querier) ([]events.Event, error) {
 	postgreSQLConfig := &prometheus.ScrapeConfig{
 		JobName:        "remote-postgresql",
 		ScrapeInterval: "1s",
@@ -114,17 +123,17 @@ func (svc *Service) ApplyPrometheusConfiguration(ctx context.Context, q *reform.
 
 	nodes, err := q.FindAllFrom(models.RemoteNodeTable, "type", models.RemoteNodeType)
 	if err != nil {
-		return errors.WithStack(err)
+		return nil, errors.WithStack(err)
 	}
 	for _, n := range nodes {
 		node := n.(*models.RemoteNode)
 
-		postgreSQLServices, e := q.SelectAllFrom(models.PostgreSQLServiceTable, "WHERE node_id = ?", node.ID)
+		postgreSQLServices, e := q.SelectAllFrom(models.PostgreSQLServiceTable, "WHERE node_id = "+q.Placeholder(1), node.ID)
 		if e != nil {
-			return errors.WithStack(e)
+			return nil, errors.WithStack(e)
 		}
 		if len(postgreSQLServices) != 1 {
-			return errors.Errorf("expected to fetch 1 record, fetched %d. %v", len(postgreSQLServices), postgreSQLServices)
+			return nil, errors.Errorf("expected to fetch 1 record, fetched %d. %v", len(postgreSQLServices), postgreSQLServices)
 		}
 		service := postgreSQLServices[0].(*models.PostgreSQLService)
 		if service.Type != models.PostgreSQLServiceType {
@@ -133,14 +142,14 @@ func (svc *Service) ApplyPrometheusConfiguration(ctx context.Context, q *reform.
 
 		agents, err := models.AgentsForServiceID(q, service.ID)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		for _, agent := range agents {
 			switch agent.Type {
 			case models.PostgresExporterAgentType:
 				a := models.PostgresExporter{ID: agent.ID}
 				if e := q.Reload(&a); e != nil {
-					return errors.WithStack(e)
+					return nil, errors.WithStack(e)
 				}
 				logger.Get(ctx).WithField("component", "postgresql").Infof("%s %s %d", a.Type, node.Name, *a.ListenPort)
 
@@ -169,12 +178,14 @@ func (svc *Service) ApplyPrometheusConfiguration(ctx context.Context, q *reform.
 type Instance struct {
 	Node    models.RemoteNode
 	Service models.PostgreSQLService
+	Health  agents.Health
 }
 
 func (svc *Service) List(ctx context.Context) ([]Instance, error) {
 	var res []Instance
+	var instanceAgents [][]models.Agent
 	err := svc.DB.InTransaction(func(tx *reform.TX) error {
-		structs, e := tx.SelectAllFrom(models.RemoteNodeTable, "WHERE type = ? ORDER BY id", models.RemoteNodeType)
+		structs, e := tx.SelectAllFrom(models.RemoteNodeTable, "WHERE type = "+tx.Placeholder(1)+" ORDER BY id", models.RemoteNodeType)
 		if e != nil {
 			return e
 		}
@@ -183,7 +194,7 @@ func (svc *Service) List(ctx context.Context) ([]Instance, error) {
 			nodes[i] = *str.(*models.RemoteNode)
 		}
 
-		structs, e = tx.SelectAllFrom(models.PostgreSQLServiceTable, "WHERE type = ? ORDER BY id", models.PostgreSQLServiceType)
+		structs, e = tx.SelectAllFrom(models.PostgreSQLServiceTable, "WHERE type = "+tx.Placeholder(1)+" ORDER BY id", models.PostgreSQLServiceType)
 		if e != nil {
 			return e
 		}
@@ -195,16 +206,32 @@ func (svc *Service) List(ctx context.Context) ([]Instance, error) {
 		for _, node := range nodes {
 			for _, service := range services {
 				if node.ID == service.NodeID {
+					a, e := agents.ForInstance(tx.Querier, node.ID, service.ID)
+					if e != nil {
+						return e
+					}
 					res = append(res, Instance{
 						Node:    node,
 						Service: service,
+						Health:  agents.HealthUnknown,
 					})
+					instanceAgents = append(instanceAgents, a)
 				}
 			}
 		}
 		return nil
 	})
-	return res, err
+	if err != nil {
+		return nil, err
+	}
+
+	// check Agents outside of transaction
+	if svc.Agents != nil {
+		for i, h := range svc.Agents.Health(ctx, instanceAgents) {
+			res[i].Health = h
+		}
+	}
+	return res, nil
 }
 
 // Add new postgreSQL service and start postgres_exporter
@@ -226,6 +253,7 @@ func (svc *Service) Add(ctx context.Context, name, address string, port uint32,
 	}
 
 	var id int32
+	var evs, reloaded []events.Event
 	err := svc.DB.InTransaction(func(tx *reform.TX) error {
 		// insert node
 		node := &models.RemoteNode{
@@ -234,7 +262,7 @@ func (svc *Service) Add(ctx context.Context, name, address string, port uint32,
 			Region: models.RemoteNodeRegion,
 		}
 		if err := tx.Insert(node); err != nil {
-			if err, ok := err.(*mysql.MySQLError); ok && err.Number == 0x426 {
+			if models.IsUniqueViolation(err) {
 				return status.Errorf(codes.AlreadyExists, "PostgreSQL instance %q already exists.",
 					node.Name)
 			}
@@ -264,18 +292,29 @@ func (svc *Service) Add(ctx context.Context, name, address string, port uint32,
 		if err := svc.addPostgresExporter(ctx, tx, service, username, password); err != nil {
 			return err
 		}
+		if evs, err = events.InstanceAdded(tx.Querier, node.ID, service.ID); err != nil {
+			return err
+		}
 
-		return svc.ApplyPrometheusConfiguration(ctx, tx.Querier)
+		reloaded, err = svc.ApplyPrometheusConfiguration(ctx, tx.Querier)
+		return err
 	})
+	if err != nil {
+		return 0, err
+	}
+
+	svc.Events.Publish(append(evs, reloaded...)...)
 
-	return id, err
+	svc.Annotator.AnnotateInstance(ctx, grafana.EventInstanceAdded, name, fmt.Sprintf("PostgreSQL instance %q added.", name))
+	svc.Dashboards.InstanceAdded(ctx, grafana.InstanceTypePostgreSQL)
+	return id, nil
 }
 
 func (svc *Service) engineAndEngineVersion(ctx context.Context, host string, port uint32, username string, password string) (string, string, error) {
 	var databaseVersion string
 	agent := models.PostgresExporter{
 		ServiceUsername: pointer.ToString(username),
-		ServicePassword: pointer.ToString(password),
+		ServicePassword: models.NewSecret(password),
 	}
 	service := &models.PostgreSQLService{
 		Address: &host,
@@ -315,19 +354,25 @@ func (svc *Service) engineAndVersionFromPlainText(databaseVersion string) (strin
 // Remove stops postgres_exporter and agent and remove agent from db
 func (svc *Service) Remove(ctx context.Context, id int32) error {
 	var err error
-	return svc.DB.InTransaction(func(tx *reform.TX) error {
+	var name string
+	var evs, reloaded []events.Event
+	err = svc.DB.InTransaction(func(tx *reform.TX) error {
 		var node models.RemoteNode
-		if err = tx.SelectOneTo(&node, "WHERE type = ? AND id = ?", models.RemoteNodeType, id); err != nil {
+		if err = tx.SelectOneTo(&node, "WHERE type = "+tx.Placeholder(1)+" AND id = "+tx.Placeholder(2), models.RemoteNodeType, id); err != nil {
 			if err == reform.ErrNoRows {
 				return status.Errorf(codes.NotFound, "PostgreSQL instance with ID %d not found.", id)
 			}
 			return errors.WithStack(err)
 		}
+		name = node.Name
 
 		var service models.PostgreSQLService
-		if err = tx.SelectOneTo(&service, "WHERE node_id = ? and type = ?", node.ID, models.PostgreSQLServiceType); err != nil {
+		if err = tx.SelectOneTo(&service, "WHERE node_id = "+tx.Placeholder(1)+" and type = "+tx.Placeholder(2), node.ID, models.PostgreSQLServiceType); err != nil {
 			return errors.WithStack(err)
 		}
+		if evs, err = events.InstanceRemoved(tx.Querier, node.ID, service.ID); err != nil {
+			return err
+		}
 
 		// remove associations of the service and agents
 		agentsForService, err := models.AgentsForServiceID(tx.Querier, service.ID)
@@ -336,7 +381,7 @@ func (svc *Service) Remove(ctx context.Context, id int32) error {
 		}
 		for _, agent := range agentsForService {
 			var deleted uint
-			deleted, err = tx.DeleteFrom(models.AgentServiceView, "WHERE service_id = ? AND agent_id = ?", service.ID, agent.ID)
+			deleted, err = tx.DeleteFrom(models.AgentServiceView, "WHERE service_id = "+tx.Placeholder(1)+" AND agent_id = "+tx.Placeholder(2), service.ID, agent.ID)
 			if err != nil {
 				return errors.WithStack(err)
 			}
@@ -352,7 +397,7 @@ func (svc *Service) Remove(ctx context.Context, id int32) error {
 		}
 		for _, agent := range agentsForNode {
 			var deleted uint
-			deleted, err = tx.DeleteFrom(models.AgentNodeView, "WHERE node_id = ? AND agent_id = ?", node.ID, agent.ID)
+			deleted, err = tx.DeleteFrom(models.AgentNodeView, "WHERE node_id = "+tx.Placeholder(1)+" AND agent_id = "+tx.Placeholder(2), node.ID, agent.ID)
 			if err != nil {
 				return errors.WithStack(err)
 			}
@@ -381,6 +426,9 @@ func (svc *Service) Remove(ctx context.Context, id int32) error {
 						return err
 					}
 				}
+				if err = svc.PortsRegistry.Release(tx.Querier, *a.ListenPort); err != nil {
+					return err
+				}
 			}
 		}
 
@@ -398,13 +446,21 @@ func (svc *Service) Remove(ctx context.Context, id int32) error {
 			return errors.WithStack(err)
 		}
 
-		return svc.ApplyPrometheusConfiguration(ctx, tx.Querier)
+		reloaded, err = svc.ApplyPrometheusConfiguration(ctx, tx.Querier)
+		return err
 	})
+	if err != nil {
+		return err
+	}
+
+	svc.Events.Publish(append(evs, reloaded...)...)
+	svc.Annotator.AnnotateInstance(ctx, grafana.EventInstanceRemoved, name, fmt.Sprintf("PostgreSQL instance %q removed.", name))
+	return nil
 }
 
 func (svc *Service) addPostgresExporter(ctx context.Context, tx *reform.TX, service *models.PostgreSQLService, username, password string) error {
 	// insert postgres_exporter agent and association
-	port, err := svc.PortsRegistry.Reserve()
+	port, err := svc.PortsRegistry.Reserve(tx)
 	if err != nil {
 		return err
 	}
@@ -413,7 +469,7 @@ func (svc *Service) addPostgresExporter(ctx context.Context, tx *reform.TX, serv
 		RunsOnNodeID: svc.pmmServerNode.ID,
 
 		ServiceUsername: &username,
-		ServicePassword: &password,
+		ServicePassword: models.NewSecret(password),
 		ListenPort:      &port,
 	}
 	if err = tx.Insert(agent); err != nil {
@@ -456,6 +512,37 @@ func (svc *Service) addPostgresExporter(ctx context.Context, tx *reform.TX, serv
 	return nil
 }
 
+// ExporterCommands returns effective postgres_exporter command lines for diagnostics.
+func (svc *Service) ExporterCommands(ctx context.Context) ([]byte, error) {
+	var buf bytes.Buffer
+	err := svc.DB.InTransaction(func(tx *reform.TX) error {
+		structs, err := tx.SelectAllFrom(models.PostgreSQLServiceTable, "WHERE type = "+tx.Placeholder(1)+" ORDER BY id", models.PostgreSQLServiceType)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		for _, str := range structs {
+			service := str.(*models.PostgreSQLService)
+			agents, err := models.AgentsForServiceID(tx.Querier, service.ID)
+			if err != nil {
+				return err
+			}
+			for _, agent := range agents {
+				if agent.Type != models.PostgresExporterAgentType {
+					continue
+				}
+				a := &models.PostgresExporter{ID: agent.ID}
+				if err = tx.Reload(a); err != nil {
+					return errors.WithStack(err)
+				}
+				cfg := svc.postgresExporterCfg(a, a.DSN(service))
+				buf.WriteString(services.FormatConfig(cfg))
+			}
+		}
+		return nil
+	})
+	return buf.Bytes(), err
+}
+
 // Restore configuration from database.
 func (svc *Service) Restore(ctx context.Context, tx *reform.TX) error {
 	nodes, err := tx.FindAllFrom(models.RemoteNodeTable, "type", models.RemoteNodeType)
@@ -465,7 +552,7 @@ func (svc *Service) Restore(ctx context.Context, tx *reform.TX) error {
 	for _, n := range nodes {
 		node := n.(*models.RemoteNode)
 
-		postgreSQLServices, e := tx.SelectAllFrom(models.PostgreSQLServiceTable, "WHERE node_id = ?", node.ID)
+		postgreSQLServices, e := tx.SelectAllFrom(models.PostgreSQLServiceTable, "WHERE node_id = "+tx.Placeholder(1), node.ID)
 		if e != nil {
 			return errors.WithStack(e)
 		}
@@ -519,7 +606,7 @@ func (svc *Service) postgresExporterCfg(agent *models.PostgresExporter, dsn stri
 	}
 	sort.Strings(arguments)
 
-	return &servicelib.Config{
+	cfg := &servicelib.Config{
 		Name:        name,
 		DisplayName: name,
 		Description: name,
@@ -527,4 +614,6 @@ func (svc *Service) postgresExporterCfg(agent *models.PostgresExporter, dsn stri
 		Arguments:   arguments,
 		Environment: []string{fmt.Sprintf("DATA_SOURCE_NAME=%s", dsn)},
 	}
+	svc.PostgresExporterLimits.Apply(cfg)
+	return cfg
 }
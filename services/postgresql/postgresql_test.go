@@ -32,9 +32,9 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"gopkg.in/reform.v1"
-	"gopkg.in/reform.v1/dialects/mysql"
 
 	"github.com/percona/pmm-managed/models"
+	"github.com/percona/pmm-managed/services/agents"
 	"github.com/percona/pmm-managed/services/mocks"
 	"github.com/percona/pmm-managed/services/prometheus"
 	"github.com/percona/pmm-managed/utils/ports"
@@ -53,8 +53,8 @@ func setup(t *testing.T) (context.Context, *Service, *sql.DB, []byte, string, *m
 	ctx, p, before := prometheus.SetupTest(t)
 
 	sqlDB := tests.OpenTestDB(t)
-	db := reform.NewDB(sqlDB, mysql.Dialect, reform.NewPrintfLogger(t.Logf))
-	portsRegistry := ports.NewRegistry(30000, 30999, nil)
+	db := reform.NewDB(sqlDB, tests.TestDBDialect(t), reform.NewPrintfLogger(t.Logf))
+	portsRegistry := ports.NewRegistry(30000, 30999)
 
 	supervisor := &mocks.Supervisor{}
 	svc, err := NewService(&ServiceConfig{
@@ -121,6 +121,7 @@ func TestAddListRemove(t *testing.T) {
 			Engine:        pointer.ToString("PostgreSQL"),
 			EngineVersion: pointer.ToString("10.5"),
 		},
+		Health: agents.HealthUnknown,
 	}}
 	assert.Equal(t, expected, actual)
 
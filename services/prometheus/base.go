@@ -34,8 +34,11 @@ import (
 	"google.golang.org/grpc/status"
 	"gopkg.in/yaml.v2"
 
-	"github.com/percona/pmm-managed/services/consul"
+	"github.com/percona/pmm-managed/services/events"
+	"github.com/percona/pmm-managed/services/grafana"
+	"github.com/percona/pmm-managed/services/kv"
 	"github.com/percona/pmm-managed/utils/logger"
+	"github.com/percona/pmm-managed/utils/secrets"
 )
 
 var checkFailedRE = regexp.MustCompile(`FAILED: parsing YAML file \S+: (.+)\n`)
@@ -50,11 +53,20 @@ type Service struct {
 	baseURL      *url.URL
 	client       *http.Client
 	promtoolPath string
-	consul       *consul.Client
+	kv           kv.Store
 	lock         sync.RWMutex // for Prometheus configuration file and, by extension, for most methods
+
+	// Annotator is used for annotating scrape configs changes made via API; may be nil.
+	Annotator *grafana.Annotator
+
+	// Events is used for publishing scrape configs changes and Prometheus reloads; may be nil.
+	Events *events.Bus
+
+	// Keyring is used for encryption of basic auth passwords stored in settings store; may be nil.
+	Keyring *secrets.Keyring
 }
 
-func NewService(config string, baseURL string, promtool string, consul *consul.Client) (*Service, error) {
+func NewService(config string, baseURL string, promtool string, kv kv.Store) (*Service, error) {
 	u, err := url.Parse(baseURL)
 	if err != nil {
 		return nil, errors.WithStack(err)
@@ -64,7 +76,7 @@ func NewService(config string, baseURL string, promtool string, consul *consul.C
 		baseURL:      u,
 		client:       new(http.Client),
 		promtoolPath: promtool,
-		consul:       consul,
+		kv:           kv,
 	}, nil
 }
 
@@ -79,6 +91,7 @@ func (svc *Service) loadConfig() (*config.Config, error) {
 
 // saveConfigAndReload saves given Prometheus configuration to file and reloads Prometheus.
 // If configuration can't be reloaded for some reason, old file is restored, and configuration is reloaded again.
+// Callers are responsible for publishing PrometheusReloaded event.
 func (svc *Service) saveConfigAndReload(ctx context.Context, cfg *config.Config) error {
 	// read existing content
 	old, err := ioutil.ReadFile(svc.ConfigPath)
@@ -230,7 +243,11 @@ func (svc *Service) Check(ctx context.Context) error {
 
 	if changed {
 		l.Info("Prometheus configuration updated.")
-		return svc.saveConfigAndReload(ctx, config)
+		if err = svc.saveConfigAndReload(ctx, config); err != nil {
+			return err
+		}
+		svc.Events.Publish(events.Event{Type: events.PrometheusReloaded})
+		return nil
 	}
 	l.Info("Prometheus configuration not changed.")
 	return nil
@@ -34,10 +34,14 @@ import (
 	"github.com/prometheus/common/model"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+
+	"github.com/percona/pmm-managed/services/events"
+	"github.com/percona/pmm-managed/services/grafana"
+	"github.com/percona/pmm-managed/utils/logger"
 )
 
 const (
-	// store scrape configs in Consul under that key
+	// store scrape configs in settings store under that key
 	ConsulKey = "prometheus/scrape_configs"
 )
 
@@ -109,8 +113,9 @@ type consulData struct {
 	ScrapeConfigs []ScrapeConfig
 }
 
-func (svc *Service) getFromConsul() ([]ScrapeConfig, error) {
-	b, err := svc.consul.GetKV(ConsulKey)
+// getRawFromConsul returns scrape configs stored in settings store without decrypting passwords.
+func (svc *Service) getRawFromConsul() ([]ScrapeConfig, error) {
+	b, err := svc.kv.GetKV(ConsulKey)
 	if err != nil {
 		return nil, err
 	}
@@ -124,15 +129,76 @@ func (svc *Service) getFromConsul() ([]ScrapeConfig, error) {
 	return cd.ScrapeConfigs, nil
 }
 
+func (svc *Service) getFromConsul() ([]ScrapeConfig, error) {
+	scs, err := svc.getRawFromConsul()
+	if err != nil {
+		return nil, err
+	}
+	for i, sc := range scs {
+		if sc.BasicAuth == nil {
+			continue
+		}
+		if scs[i].BasicAuth.Password, err = svc.Keyring.Decrypt(sc.BasicAuth.Password); err != nil {
+			return nil, errors.Wrapf(err, "failed to decrypt password for scrape config %q", sc.JobName)
+		}
+	}
+	return scs, nil
+}
+
 func (svc *Service) putToConsul(scs []ScrapeConfig) error {
+	// encrypt passwords in copies, scrape configs are still used by caller
 	cd := consulData{
-		ScrapeConfigs: scs,
+		ScrapeConfigs: make([]ScrapeConfig, len(scs)),
+	}
+	for i, sc := range scs {
+		if sc.BasicAuth != nil {
+			password, err := svc.Keyring.Encrypt(sc.BasicAuth.Password)
+			if err != nil {
+				return err
+			}
+			sc.BasicAuth = &BasicAuth{
+				Username: sc.BasicAuth.Username,
+				Password: password,
+			}
+		}
+		cd.ScrapeConfigs[i] = sc
 	}
 	b, err := json.Marshal(cd)
 	if err != nil {
 		return errors.WithStack(err)
 	}
-	return svc.consul.PutKV(ConsulKey, b)
+	return svc.kv.PutKV(ConsulKey, b)
+}
+
+// EncryptSecrets encrypts all not yet encrypted scrape configs passwords stored in Consul,
+// and re-encrypts passwords encrypted with old master keys. It returns a number of changed passwords.
+// It does nothing if encryption is disabled.
+func (svc *Service) EncryptSecrets(ctx context.Context) (int, error) {
+	svc.lock.Lock()
+	defer svc.lock.Unlock()
+
+	scs, err := svc.getRawFromConsul()
+	if err != nil {
+		return 0, err
+	}
+	var res int
+	for _, sc := range scs {
+		if sc.BasicAuth != nil && svc.Keyring.NeedsEncryption(sc.BasicAuth.Password) {
+			res++
+		}
+	}
+	if res == 0 {
+		return 0, nil
+	}
+
+	if scs, err = svc.getFromConsul(); err != nil {
+		return 0, err
+	}
+	if err = svc.putToConsul(scs); err != nil {
+		return 0, err
+	}
+	logger.Get(ctx).Infof("Encrypted %d scrape config password(s) in Consul.", res)
+	return res, nil
 }
 
 // getTargetsHealth gets all targets from Prometheus and converts response to job -> instance -> health map.
@@ -462,7 +528,16 @@ func (svc *Service) CreateScrapeConfig(ctx context.Context, cfg *ScrapeConfig, c
 	if err = svc.saveConfigAndReload(ctx, config); err != nil {
 		return err
 	}
-	return svc.putToConsul(updater.consulData)
+	if err = svc.putToConsul(updater.consulData); err != nil {
+		return err
+	}
+
+	svc.Events.Publish(
+		events.Event{Type: events.PrometheusReloaded},
+		events.Event{Type: events.ScrapeConfigCreated, JobName: cfg.JobName},
+	)
+	svc.Annotator.Annotate(ctx, grafana.EventScrapeConfigCreated, fmt.Sprintf("Scrape config %q created.", cfg.JobName), grafana.JobTag(cfg.JobName))
+	return nil
 }
 
 // UpdateScrapeConfig updates existing scrape config by job name.
@@ -513,7 +588,16 @@ func (svc *Service) UpdateScrapeConfig(ctx context.Context, cfg *ScrapeConfig, c
 	if err = svc.saveConfigAndReload(ctx, config); err != nil {
 		return err
 	}
-	return svc.putToConsul(updater.consulData)
+	if err = svc.putToConsul(updater.consulData); err != nil {
+		return err
+	}
+
+	svc.Events.Publish(
+		events.Event{Type: events.PrometheusReloaded},
+		events.Event{Type: events.ScrapeConfigUpdated, JobName: cfg.JobName},
+	)
+	svc.Annotator.Annotate(ctx, grafana.EventScrapeConfigUpdated, fmt.Sprintf("Scrape config %q updated.", cfg.JobName), grafana.JobTag(cfg.JobName))
+	return nil
 }
 
 // DeleteScrapeConfig removes existing scrape config by job name.
@@ -540,12 +624,23 @@ func (svc *Service) DeleteScrapeConfig(ctx context.Context, jobName string) erro
 	if err = svc.saveConfigAndReload(ctx, config); err != nil {
 		return err
 	}
-	return svc.putToConsul(updater.consulData)
+	if err = svc.putToConsul(updater.consulData); err != nil {
+		return err
+	}
+
+	svc.Events.Publish(
+		events.Event{Type: events.PrometheusReloaded},
+		events.Event{Type: events.ScrapeConfigDeleted, JobName: jobName},
+	)
+	svc.Annotator.Annotate(ctx, grafana.EventScrapeConfigDeleted, fmt.Sprintf("Scrape config %q deleted.", jobName), grafana.JobTag(jobName))
+	return nil
 }
 
 // SetScrapeConfigs creates new or completely replaces existing scrape configs with a given names.
+// It is typically called inside database transaction, so PrometheusReloaded event is returned instead of being published;
+// caller should publish it after transaction is committed.
 // Errors: InvalidArgument(3) if some argument is not valid.
-func (svc *Service) SetScrapeConfigs(ctx context.Context, useConsul bool, configs ...*ScrapeConfig) error {
+func (svc *Service) SetScrapeConfigs(ctx context.Context, useConsul bool, configs ...*ScrapeConfig) ([]events.Event, error) {
 	// That method is implemented for RDS and Inventory API. It does not uses Consul.
 	// The only reason for useConsul argument existence is to draw attention to that fact, to make it harder to misuse.
 	if useConsul {
@@ -559,13 +654,13 @@ func (svc *Service) SetScrapeConfigs(ctx context.Context, useConsul bool, config
 
 	config, err := svc.loadConfig()
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	for _, cfg := range configs {
 		scrapeConfig, err := convertScrapeConfig(cfg)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		var found bool
@@ -581,5 +676,8 @@ func (svc *Service) SetScrapeConfigs(ctx context.Context, useConsul bool, config
 		}
 	}
 
-	return svc.saveConfigAndReload(ctx, config)
+	if err = svc.saveConfigAndReload(ctx, config); err != nil {
+		return nil, err
+	}
+	return []events.Event{{Type: events.PrometheusReloaded}}, nil
 }
@@ -0,0 +1,159 @@
+// pmm-managed
+// Copyright (C) 2017 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package prometheus
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// get returns response body for a given path relative to Prometheus base URL.
+func (svc *Service) get(ctx context.Context, apiPath string) (*http.Response, []byte, error) {
+	u := *svc.baseURL
+	u.Path = path.Join(u.Path, apiPath)
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+	resp, err := svc.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+	defer resp.Body.Close()
+
+	b, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, nil, errors.WithStack(err)
+	}
+	return resp, b, nil
+}
+
+// getAPI returns data field of Prometheus HTTP API response for a given path.
+func (svc *Service) getAPI(ctx context.Context, apiPath string) (json.RawMessage, error) {
+	resp, b, err := svc.get(ctx, apiPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var res struct {
+		Status string          `json:"status"`
+		Data   json.RawMessage `json:"data"`
+		Error  string          `json:"error"`
+	}
+	if err = json.Unmarshal(b, &res); err != nil {
+		return nil, errors.Wrapf(err, "%s: %s", resp.Status, b)
+	}
+	if res.Status != "success" {
+		return nil, errors.Errorf("%s: %s", resp.Status, res.Error)
+	}
+	return res.Data, nil
+}
+
+// StatusConfig returns currently loaded Prometheus configuration (YAML) for diagnostics.
+func (svc *Service) StatusConfig(ctx context.Context) ([]byte, error) {
+	data, err := svc.getAPI(ctx, "api/v1/status/config")
+	if err != nil {
+		return nil, err
+	}
+	var config struct {
+		YAML string `json:"yaml"`
+	}
+	if err = json.Unmarshal(data, &config); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return []byte(config.YAML), nil
+}
+
+// getAPIJSON returns data field of Prometheus HTTP API response for a given path as indented JSON.
+func (svc *Service) getAPIJSON(ctx context.Context, apiPath string) ([]byte, error) {
+	data, err := svc.getAPI(ctx, apiPath)
+	if err != nil {
+		return nil, err
+	}
+	var buf bytes.Buffer
+	if err = json.Indent(&buf, data, "", "  "); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return buf.Bytes(), nil
+}
+
+// Targets returns active and dropped targets with their health (JSON) for diagnostics.
+func (svc *Service) Targets(ctx context.Context) ([]byte, error) {
+	return svc.getAPIJSON(ctx, "api/v1/targets")
+}
+
+// StatusFlags returns Prometheus command-line flags values (JSON) for diagnostics.
+func (svc *Service) StatusFlags(ctx context.Context) ([]byte, error) {
+	return svc.getAPIJSON(ctx, "api/v1/status/flags")
+}
+
+// Rules returns alerting and recording rules with their state (JSON) for diagnostics.
+func (svc *Service) Rules(ctx context.Context) ([]byte, error) {
+	return svc.getAPIJSON(ctx, "api/v1/rules")
+}
+
+// tsdbHeadMetricsPrefix is a prefix of Prometheus own metrics with TSDB head stats.
+const tsdbHeadMetricsPrefix = "prometheus_tsdb_head_"
+
+// TSDBStatus returns TSDB head stats (JSON) for diagnostics.
+// Prometheus versions without /api/v1/status/tsdb (before 2.14) expose them only as own metrics,
+// so they are used as a fallback.
+func (svc *Service) TSDBStatus(ctx context.Context) ([]byte, error) {
+	b, apiErr := svc.getAPIJSON(ctx, "api/v1/status/tsdb")
+	if apiErr == nil {
+		return b, nil
+	}
+
+	resp, b, err := svc.get(ctx, "metrics")
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != 200 {
+		return nil, errors.Errorf("%s (%s: %s)", apiErr, resp.Status, b)
+	}
+
+	metrics := make(map[string]string)
+	s := bufio.NewScanner(bytes.NewReader(b))
+	for s.Scan() {
+		line := s.Text()
+		if !strings.HasPrefix(line, tsdbHeadMetricsPrefix) {
+			continue
+		}
+		i := strings.LastIndex(line, " ")
+		if i < 0 {
+			continue
+		}
+		metrics[line[:i]] = line[i+1:]
+	}
+	if err = s.Err(); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	res := struct {
+		HeadMetrics map[string]string `json:"headMetrics"`
+	}{metrics}
+	b, err = json.MarshalIndent(res, "", "  ")
+	return b, errors.WithStack(err)
+}
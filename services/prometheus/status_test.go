@@ -0,0 +1,78 @@
+// pmm-managed
+// Copyright (C) 2017 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package prometheus
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatus(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/prometheus/api/v1/status/config":
+			rw.Write([]byte(`{"status":"success","data":{"yaml":"global:\n  scrape_interval: 1m\n"}}`))
+		case "/prometheus/api/v1/status/flags":
+			rw.Write([]byte(`{"status":"success","data":{"storage.tsdb.retention":"720h"}}`))
+		case "/prometheus/metrics":
+			rw.Write([]byte("# HELP prometheus_tsdb_head_series Total number of series in the head block.\n" +
+				"# TYPE prometheus_tsdb_head_series gauge\n" +
+				"prometheus_tsdb_head_series 1234\n" +
+				"prometheus_tsdb_head_chunks 5678\n" +
+				"prometheus_tsdb_reloads_total 3\n"))
+		default:
+			rw.WriteHeader(404)
+			rw.Write([]byte(`{"status":"error","errorType":"not_found","error":"not found"}`))
+		}
+	}))
+	defer ts.Close()
+
+	u, err := url.Parse(ts.URL + "/prometheus/")
+	require.NoError(t, err)
+	svc := &Service{
+		baseURL: u,
+		client:  new(http.Client),
+	}
+
+	b, err := svc.StatusConfig(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "global:\n  scrape_interval: 1m\n", string(b))
+
+	b, err = svc.StatusFlags(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "{\n  \"storage.tsdb.retention\": \"720h\"\n}", string(b))
+
+	// fallback for Prometheus versions without /api/v1/status/tsdb
+	b, err = svc.TSDBStatus(context.Background())
+	require.NoError(t, err)
+	expected := `{
+  "headMetrics": {
+    "prometheus_tsdb_head_chunks": "5678",
+    "prometheus_tsdb_head_series": "1234"
+  }
+}`
+	assert.Equal(t, expected, string(b))
+
+	_, err = svc.getAPI(context.Background(), "api/v1/no-such")
+	assert.EqualError(t, err, "404 Not Found: not found")
+}
@@ -0,0 +1,88 @@
+// pmm-managed
+// Copyright (C) 2017 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package prometheus
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/percona/pmm-managed/services/events"
+	"github.com/percona/pmm-managed/utils/logger"
+)
+
+// targetsCheckInterval is an interval between managed scrape targets health checks in WatchTargets.
+const targetsCheckInterval = 30 * time.Second
+
+// targetKey identifies scrape target.
+type targetKey struct {
+	jobName  string
+	instance string
+}
+
+// WatchTargets publishes TargetDown events when health of managed scrape targets changes to down,
+// until ctx is canceled.
+func (svc *Service) WatchTargets(ctx context.Context) {
+	l := logger.Get(ctx).WithField("component", "prometheus")
+
+	t := time.NewTicker(targetsCheckInterval)
+	defer t.Stop()
+
+	var prev map[targetKey]Health
+	for {
+		_, health, err := svc.ListScrapeConfigs(ctx)
+		if err != nil {
+			l.Errorf("Failed to get scrape targets health: %+v", err)
+		} else {
+			current := make(map[targetKey]Health, len(health))
+			for _, h := range health {
+				current[targetKey{jobName: h.JobName, instance: h.Instance}] = h.Health
+			}
+			svc.Events.Publish(targetsDown(prev, current)...)
+			prev = current
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+	}
+}
+
+// targetsDown returns TargetDown events for targets which were not down and now are.
+// Targets absent in prev (added since the previous poll) are considered not down.
+// Nil prev means the first poll: nothing is returned to avoid repeating events on every pmm-managed restart.
+func targetsDown(prev, current map[targetKey]Health) []events.Event {
+	if prev == nil {
+		return nil
+	}
+
+	var res []events.Event
+	for key, health := range current {
+		if prev[key] != HealthDown && health == HealthDown {
+			res = append(res, events.Event{Type: events.TargetDown, JobName: key.jobName, Instance: key.instance})
+		}
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].JobName != res[j].JobName {
+			return res[i].JobName < res[j].JobName
+		}
+		return res[i].Instance < res[j].Instance
+	})
+	return res
+}
@@ -0,0 +1,59 @@
+// pmm-managed
+// Copyright (C) 2017 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package prometheus
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/percona/pmm-managed/services/events"
+)
+
+func TestTargetsDown(t *testing.T) {
+	prev := map[targetKey]Health{
+		{"job1", "a"}: HealthUp,
+		{"job1", "b"}: HealthUnknown,
+		{"job1", "c"}: HealthDown,
+		{"job2", "a"}: HealthUp,
+		{"job2", "b"}: HealthUp,
+	}
+	current := map[targetKey]Health{
+		{"job1", "a"}: HealthDown,
+		{"job1", "b"}: HealthDown,
+		{"job1", "c"}: HealthDown,
+		{"job2", "a"}: HealthUp,
+		{"job3", "a"}: HealthDown,
+	}
+	expected := []events.Event{
+		{Type: events.TargetDown, JobName: "job1", Instance: "a"},
+		{Type: events.TargetDown, JobName: "job1", Instance: "b"},
+		{Type: events.TargetDown, JobName: "job3", Instance: "a"}, // added and down
+	}
+	assert.Equal(t, expected, targetsDown(prev, current))
+	assert.Empty(t, targetsDown(nil, current), "the first poll")
+	assert.Empty(t, targetsDown(current, current))
+
+	// no targets on the first poll, then targets were added
+	expected = []events.Event{
+		{Type: events.TargetDown, JobName: "job1", Instance: "a"},
+		{Type: events.TargetDown, JobName: "job1", Instance: "b"},
+		{Type: events.TargetDown, JobName: "job1", Instance: "c"},
+		{Type: events.TargetDown, JobName: "job3", Instance: "a"},
+	}
+	assert.Equal(t, expected, targetsDown(map[targetKey]Health{}, current))
+}
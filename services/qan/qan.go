@@ -48,13 +48,16 @@ import (
 type Service struct {
 	baseDir    string
 	supervisor services.Supervisor
+	limits     *services.ResourceLimits
 	qanAPI     *http.Client
 }
 
-func NewService(ctx context.Context, baseDir string, supervisor services.Supervisor) (*Service, error) {
+// NewService creates a new service. Given resource limits (may be nil) are applied to qan-agent process.
+func NewService(ctx context.Context, baseDir string, supervisor services.Supervisor, limits *services.ResourceLimits) (*Service, error) {
 	svc := &Service{
 		baseDir:    baseDir,
 		supervisor: supervisor,
+		limits:     limits,
 		qanAPI:     new(http.Client),
 	}
 
@@ -66,6 +69,28 @@ func (svc *Service) qanAgentConfigPath() string {
 	return filepath.Join(svc.baseDir, "config", "agent.conf")
 }
 
+// AgentConfigs returns content of qan-agent configuration files for diagnostics.
+func (svc *Service) AgentConfigs(ctx context.Context) ([]byte, error) {
+	dir := filepath.Join(svc.baseDir, "config")
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	var buf bytes.Buffer
+	for _, f := range files {
+		if f.IsDir() {
+			continue
+		}
+		b, err := ioutil.ReadFile(filepath.Join(dir, f.Name()))
+		if err != nil {
+			return buf.Bytes(), errors.WithStack(err)
+		}
+		fmt.Fprintf(&buf, "# %s\n%s\n", filepath.Join(dir, f.Name()), bytes.TrimSpace(b))
+	}
+	return buf.Bytes(), nil
+}
+
 // ensureAgentIsRegistered registers a single qan-agent instance on PMM Server node in QAN.
 // It does not re-register or change configuration if agent is already registered.
 // QAN API URL is always returned when no error is encountered.
@@ -139,6 +164,7 @@ func (svc *Service) ensureAgentRuns(ctx context.Context, nameForSupervisor strin
 				fmt.Sprintf("-listen=127.0.0.1:%d", port),
 			},
 		}
+		svc.limits.Apply(config)
 		err = svc.supervisor.Start(ctx, config)
 	}
 
@@ -232,7 +258,7 @@ func (svc *Service) restoreConfigs(ctx context.Context, agent models.QanAgent) (
 	// restore db instance.
 	path = filepath.Join(svc.baseDir, "instance", fmt.Sprintf("%s.json", dbInstance.UUID))
 	if _, err := os.Stat(path); os.IsNotExist(err) {
-		dbInstance.DSN = strings.Replace(dbInstance.DSN, "***", *agent.ServicePassword, 1)
+		dbInstance.DSN = strings.Replace(dbInstance.DSN, "***", string(*agent.ServicePassword), 1)
 		dbInstance.DSN = fmt.Sprintf("%s/?timeout=5s", dbInstance.DSN)
 		dbInstanceJSON, err := json.MarshalIndent(dbInstance, "", "    ")
 
@@ -45,6 +45,9 @@ import (
 
 	"github.com/percona/pmm-managed/models"
 	"github.com/percona/pmm-managed/services"
+	"github.com/percona/pmm-managed/services/agents"
+	"github.com/percona/pmm-managed/services/events"
+	"github.com/percona/pmm-managed/services/grafana"
 	"github.com/percona/pmm-managed/services/prometheus"
 	"github.com/percona/pmm-managed/services/qan"
 	"github.com/percona/pmm-managed/utils/logger"
@@ -64,14 +67,20 @@ const (
 
 type ServiceConfig struct {
 	MySQLdExporterPath    string
+	MySQLdExporterLimits  *services.ResourceLimits
 	RDSExporterPath       string
 	RDSExporterConfigPath string
+	RDSExporterLimits     *services.ResourceLimits
 
 	Prometheus    *prometheus.Service
 	Supervisor    services.Supervisor
+	Agents        *agents.Service
 	DB            *reform.DB
 	PortsRegistry *ports.Registry
 	QAN           *qan.Service
+	Annotator     *grafana.Annotator
+	Dashboards    *grafana.Provisioner
+	Events        *events.Bus
 
 	RDSEnableGovCloud bool
 	RDSEnableCnCloud  bool
@@ -126,9 +135,12 @@ type InstanceID struct {
 type Instance struct {
 	Node    models.RDSNode
 	Service models.RDSService
+	Health  agents.Health
 }
 
-func (svc *Service) ApplyPrometheusConfiguration(ctx context.Context, q *reform.Querier) error {
+// ApplyPrometheusConfiguration adds RDS instances to Prometheus configuration and applies it.
+// Returned events should be published after transaction is committed.
+func (svc *Service) ApplyPrometheusConfiguration(ctx context.Context, q *reform.Querier) ([]events.Event, error) {
 	rdsMySQLHR := &prometheus.ScrapeConfig{
 		JobName:        "rds-mysql-hr",
 		ScrapeInterval: "1s",
@@ -179,14 +191,14 @@ func (svc *Service) ApplyPrometheusConfiguration(ctx context.Context, q *reform.
 
 	nodes, err := q.FindAllFrom(models.RDSNodeTable, "type", models.RDSNodeType)
 	if err != nil {
-		return errors.WithStack(err)
+		return nil, errors.WithStack(err)
 	}
 	for _, n := range nodes {
 		node := n.(*models.RDSNode)
 
 		var service models.RDSService
-		if e := q.SelectOneTo(&service, "WHERE node_id = ?", node.ID); e != nil {
-			return errors.WithStack(e)
+		if e := q.SelectOneTo(&service, "WHERE node_id = "+q.Placeholder(1), node.ID); e != nil {
+			return nil, errors.WithStack(e)
 		}
 
 		// FIXME PMM 2.0: Remove labels from Prometheus configuration, fix sorting.
@@ -194,14 +206,14 @@ func (svc *Service) ApplyPrometheusConfiguration(ctx context.Context, q *reform.
 
 		agents, err := models.AgentsForServiceID(q, service.ID)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		for _, agent := range agents {
 			switch agent.Type {
 			case models.MySQLdExporterAgentType:
 				a := models.MySQLdExporter{ID: agent.ID}
 				if e := q.Reload(&a); e != nil {
-					return errors.WithStack(e)
+					return nil, errors.WithStack(e)
 				}
 				logger.Get(ctx).WithField("component", "rds").Infof("%s %s %s %d", a.Type, node.Name, node.Region, *a.ListenPort)
 
@@ -219,7 +231,7 @@ func (svc *Service) ApplyPrometheusConfiguration(ctx context.Context, q *reform.
 			case models.RDSExporterAgentType:
 				a := models.RDSExporter{ID: agent.ID}
 				if e := q.Reload(&a); e != nil {
-					return errors.WithStack(e)
+					return nil, errors.WithStack(e)
 				}
 				logger.Get(ctx).WithField("component", "rds").Infof("%s %s %s %d", a.Type, node.Name, node.Region, *a.ListenPort)
 
@@ -366,8 +378,9 @@ func (svc *Service) Discover(ctx context.Context, accessKey, secretKey string) (
 
 func (svc *Service) List(ctx context.Context) ([]Instance, error) {
 	var res []Instance
+	var instanceAgents [][]models.Agent
 	err := svc.DB.InTransaction(func(tx *reform.TX) error {
-		structs, e := tx.SelectAllFrom(models.RDSNodeTable, "WHERE type = ? ORDER BY id", models.RDSNodeType)
+		structs, e := tx.SelectAllFrom(models.RDSNodeTable, "WHERE type = "+tx.Placeholder(1)+" ORDER BY id", models.RDSNodeType)
 		if e != nil {
 			return e
 		}
@@ -376,7 +389,7 @@ func (svc *Service) List(ctx context.Context) ([]Instance, error) {
 			nodes[i] = *str.(*models.RDSNode)
 		}
 
-		structs, e = tx.SelectAllFrom(models.RDSServiceTable, "WHERE type = ? ORDER BY id", models.RDSServiceType)
+		structs, e = tx.SelectAllFrom(models.RDSServiceTable, "WHERE type = "+tx.Placeholder(1)+" ORDER BY id", models.RDSServiceType)
 		if e != nil {
 			return e
 		}
@@ -388,21 +401,37 @@ func (svc *Service) List(ctx context.Context) ([]Instance, error) {
 		for _, node := range nodes {
 			for _, service := range services {
 				if node.ID == service.NodeID {
+					a, e := agents.ForInstance(tx.Querier, node.ID, service.ID)
+					if e != nil {
+						return e
+					}
 					res = append(res, Instance{
 						Node:    node,
 						Service: service,
+						Health:  agents.HealthUnknown,
 					})
+					instanceAgents = append(instanceAgents, a)
 				}
 			}
 		}
 		return nil
 	})
-	return res, err
+	if err != nil {
+		return nil, err
+	}
+
+	// check Agents outside of transaction
+	if svc.Agents != nil {
+		for i, h := range svc.Agents.Health(ctx, instanceAgents) {
+			res[i].Health = h
+		}
+	}
+	return res, nil
 }
 
 func (svc *Service) addMySQLdExporter(ctx context.Context, tx *reform.TX, service *models.RDSService, username, password string) error {
 	// insert mysqld_exporter agent and association
-	port, err := svc.PortsRegistry.Reserve()
+	port, err := svc.PortsRegistry.Reserve(tx)
 	if err != nil {
 		return err
 	}
@@ -411,7 +440,7 @@ func (svc *Service) addMySQLdExporter(ctx context.Context, tx *reform.TX, servic
 		RunsOnNodeID: svc.pmmServerNode.ID,
 
 		ServiceUsername: &username,
-		ServicePassword: &password,
+		ServicePassword: models.NewSecret(password),
 		ListenPort:      &port,
 	}
 	if err = tx.Insert(agent); err != nil {
@@ -483,7 +512,7 @@ func (svc *Service) mysqlExporterCfg(agent *models.MySQLdExporter, dsn string) *
 	}
 	sort.Strings(arguments)
 
-	return &servicelib.Config{
+	cfg := &servicelib.Config{
 		Name:        name,
 		DisplayName: name,
 		Description: name,
@@ -491,6 +520,8 @@ func (svc *Service) mysqlExporterCfg(agent *models.MySQLdExporter, dsn string) *
 		Arguments:   arguments,
 		Environment: []string{fmt.Sprintf("DATA_SOURCE_NAME=%s", dsn)},
 	}
+	svc.MySQLdExporterLimits.Apply(cfg)
+	return cfg
 }
 
 func (svc *Service) updateRDSExporterConfig(tx *reform.TX, service *models.RDSService) (*rdsExporterConfig, error) {
@@ -507,7 +538,7 @@ func (svc *Service) updateRDSExporterConfig(tx *reform.TX, service *models.RDSSe
 			Instance:     node.Name,
 			Type:         unknown,
 			AWSAccessKey: service.AWSAccessKey,
-			AWSSecretKey: service.AWSSecretKey,
+			AWSSecretKey: (*string)(service.AWSSecretKey),
 		}
 		switch *service.Engine {
 		case "aurora":
@@ -539,7 +570,7 @@ func (svc *Service) updateRDSExporterConfig(tx *reform.TX, service *models.RDSSe
 func (svc *Service) rdsExporterServiceConfig(agent *models.RDSExporter) *servicelib.Config {
 	name := models.NameForSupervisor(agent.Type, *agent.ListenPort)
 
-	return &servicelib.Config{
+	cfg := &servicelib.Config{
 		Name:        name,
 		DisplayName: name,
 		Description: name,
@@ -549,6 +580,8 @@ func (svc *Service) rdsExporterServiceConfig(agent *models.RDSExporter) *service
 			fmt.Sprintf("--web.listen-address=127.0.0.1:%d", *agent.ListenPort),
 		},
 	}
+	svc.RDSExporterLimits.Apply(cfg)
+	return cfg
 }
 
 func (svc *Service) addRDSExporter(ctx context.Context, tx *reform.TX, service *models.RDSService, node *models.RDSNode) error {
@@ -599,7 +632,7 @@ func (svc *Service) addQanAgent(ctx context.Context, tx *reform.TX, service *mod
 		RunsOnNodeID: svc.pmmServerNode.ID,
 
 		ServiceUsername: &username,
-		ServicePassword: &password,
+		ServicePassword: models.NewSecret(password),
 		ListenPort:      pointer.ToUint16(qanAgentPort),
 	}
 	var err error
@@ -668,7 +701,8 @@ func (svc *Service) Add(ctx context.Context, accessKey, secretKey string, id *In
 		return status.Errorf(codes.NotFound, "RDS instance %q not found in region %q.", id.Name, id.Region)
 	}
 
-	return svc.DB.InTransaction(func(tx *reform.TX) error {
+	var evs, reloaded []events.Event
+	err = svc.DB.InTransaction(func(tx *reform.TX) error {
 		// insert node
 		node := &models.RDSNode{
 			Type: models.RDSNodeType,
@@ -677,7 +711,7 @@ func (svc *Service) Add(ctx context.Context, accessKey, secretKey string, id *In
 			Region: add.Node.Region,
 		}
 		if err = tx.Insert(node); err != nil {
-			if err, ok := err.(*mysql.MySQLError); ok && err.Number == 0x426 {
+			if models.IsUniqueViolation(err) {
 				return status.Errorf(codes.AlreadyExists, "RDS instance %q already exists in region %q.",
 					node.Name, node.Region)
 			}
@@ -696,7 +730,7 @@ func (svc *Service) Add(ctx context.Context, accessKey, secretKey string, id *In
 		}
 		if accessKey != "" || secretKey != "" {
 			service.AWSAccessKey = &accessKey
-			service.AWSSecretKey = &secretKey
+			service.AWSSecretKey = models.NewSecret(secretKey)
 		}
 		if err = tx.Insert(service); err != nil {
 			return errors.WithStack(err)
@@ -711,9 +745,23 @@ func (svc *Service) Add(ctx context.Context, accessKey, secretKey string, id *In
 		if err = svc.addQanAgent(ctx, tx, service, node, username, password); err != nil {
 			return err
 		}
+		if evs, err = events.InstanceAdded(tx.Querier, node.ID, service.ID); err != nil {
+			return err
+		}
 
-		return svc.ApplyPrometheusConfiguration(ctx, tx.Querier)
+		reloaded, err = svc.ApplyPrometheusConfiguration(ctx, tx.Querier)
+		return err
 	})
+	if err != nil {
+		return err
+	}
+
+	svc.Events.Publish(append(evs, reloaded...)...)
+
+	svc.Annotator.AnnotateInstance(ctx, grafana.EventInstanceAdded, id.Name,
+		fmt.Sprintf("RDS instance %q in region %q added.", id.Name, id.Region))
+	svc.Dashboards.InstanceAdded(ctx, grafana.InstanceTypeRDS)
+	return nil
 }
 
 func (svc *Service) Remove(ctx context.Context, id *InstanceID) error {
@@ -725,9 +773,10 @@ func (svc *Service) Remove(ctx context.Context, id *InstanceID) error {
 	}
 
 	var err error
-	return svc.DB.InTransaction(func(tx *reform.TX) error {
+	var evs, reloaded []events.Event
+	err = svc.DB.InTransaction(func(tx *reform.TX) error {
 		var node models.RDSNode
-		if err = tx.SelectOneTo(&node, "WHERE type = ? AND name = ? AND region = ?", models.RDSNodeType, id.Name, id.Region); err != nil {
+		if err = tx.SelectOneTo(&node, "WHERE type = "+tx.Placeholder(1)+" AND name = "+tx.Placeholder(2)+" AND region = "+tx.Placeholder(3), models.RDSNodeType, id.Name, id.Region); err != nil {
 			if err == reform.ErrNoRows {
 				return status.Errorf(codes.NotFound, "RDS instance %q not found in region %q.", id.Name, id.Region)
 			}
@@ -735,9 +784,12 @@ func (svc *Service) Remove(ctx context.Context, id *InstanceID) error {
 		}
 
 		var service models.RDSService
-		if err = tx.SelectOneTo(&service, "WHERE node_id = ?", node.ID); err != nil {
+		if err = tx.SelectOneTo(&service, "WHERE node_id = "+tx.Placeholder(1), node.ID); err != nil {
 			return errors.WithStack(err)
 		}
+		if evs, err = events.InstanceRemoved(tx.Querier, node.ID, service.ID); err != nil {
+			return err
+		}
 
 		// remove associations of the service and agents
 		agentsForService, err := models.AgentsForServiceID(tx.Querier, service.ID)
@@ -746,7 +798,7 @@ func (svc *Service) Remove(ctx context.Context, id *InstanceID) error {
 		}
 		for _, agent := range agentsForService {
 			var deleted uint
-			deleted, err = tx.DeleteFrom(models.AgentServiceView, "WHERE service_id = ? AND agent_id = ?", service.ID, agent.ID)
+			deleted, err = tx.DeleteFrom(models.AgentServiceView, "WHERE service_id = "+tx.Placeholder(1)+" AND agent_id = "+tx.Placeholder(2), service.ID, agent.ID)
 			if err != nil {
 				return errors.WithStack(err)
 			}
@@ -762,7 +814,7 @@ func (svc *Service) Remove(ctx context.Context, id *InstanceID) error {
 		}
 		for _, agent := range agentsForNode {
 			var deleted uint
-			deleted, err = tx.DeleteFrom(models.AgentNodeView, "WHERE node_id = ? AND agent_id = ?", node.ID, agent.ID)
+			deleted, err = tx.DeleteFrom(models.AgentNodeView, "WHERE node_id = "+tx.Placeholder(1)+" AND agent_id = "+tx.Placeholder(2), node.ID, agent.ID)
 			if err != nil {
 				return errors.WithStack(err)
 			}
@@ -791,6 +843,9 @@ func (svc *Service) Remove(ctx context.Context, id *InstanceID) error {
 						return err
 					}
 				}
+				if err = svc.PortsRegistry.Release(tx.Querier, *a.ListenPort); err != nil {
+					return err
+				}
 
 			case models.RDSExporterAgentType:
 				a := models.RDSExporter{ID: agent.ID}
@@ -843,8 +898,17 @@ func (svc *Service) Remove(ctx context.Context, id *InstanceID) error {
 			return errors.WithStack(err)
 		}
 
-		return svc.ApplyPrometheusConfiguration(ctx, tx.Querier)
+		reloaded, err = svc.ApplyPrometheusConfiguration(ctx, tx.Querier)
+		return err
 	})
+	if err != nil {
+		return err
+	}
+
+	svc.Events.Publish(append(evs, reloaded...)...)
+	svc.Annotator.AnnotateInstance(ctx, grafana.EventInstanceRemoved, id.Name,
+		fmt.Sprintf("RDS instance %q in region %q removed.", id.Name, id.Region))
+	return nil
 }
 
 // Restore configuration from database.
@@ -857,7 +921,7 @@ func (svc *Service) Restore(ctx context.Context, tx *reform.TX) error {
 		node := n.(*models.RDSNode)
 
 		service := &models.RDSService{}
-		if e := tx.SelectOneTo(service, "WHERE node_id = ?", node.ID); e != nil {
+		if e := tx.SelectOneTo(service, "WHERE node_id = "+tx.Placeholder(1), node.ID); e != nil {
 			return errors.WithStack(e)
 		}
 
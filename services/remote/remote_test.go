@@ -29,7 +29,6 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"gopkg.in/reform.v1"
-	"gopkg.in/reform.v1/dialects/mysql"
 
 	"github.com/percona/pmm-managed/models"
 	"github.com/percona/pmm-managed/services/mocks"
@@ -51,8 +50,8 @@ func setup(t *testing.T) (context.Context, *postgresql.Service, *Service, *sql.D
 	ctx, p, before := prometheus.SetupTest(t)
 
 	sqlDB := tests.OpenTestDB(t)
-	db := reform.NewDB(sqlDB, mysql.Dialect, reform.NewPrintfLogger(t.Logf))
-	portsRegistry := ports.NewRegistry(30000, 30999, nil)
+	db := reform.NewDB(sqlDB, tests.TestDBDialect(t), reform.NewPrintfLogger(t.Logf))
+	portsRegistry := ports.NewRegistry(30000, 30999)
 
 	supervisor := &mocks.Supervisor{}
 	postgreSQLService, err := postgresql.NewService(&postgresql.ServiceConfig{
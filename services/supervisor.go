@@ -18,10 +18,34 @@ package services
 
 import (
 	"context"
+	"fmt"
+	"strings"
+	"time"
 
 	servicelib "github.com/percona/kardianos-service"
 )
 
+// ProcessState represents supervised process state.
+type ProcessState string
+
+// Supervised process states.
+const (
+	ProcessUnknown ProcessState = "unknown"
+	ProcessRunning ProcessState = "running"
+	ProcessStopped ProcessState = "stopped"
+	ProcessFailed  ProcessState = "failed" // exited and waiting for restart
+)
+
+// ProcessStatus represents supervised process status.
+// Only State is always set; other fields are set only by supervisors that track processes themselves.
+type ProcessStatus struct {
+	State     ProcessState
+	PID       int
+	StartedAt time.Time
+	Restarts  int
+	LastError string
+}
+
 //go:generate mockery -name=Supervisor -case=snake
 
 // Supervisor is an interface for supervisor.Supervisor for mock generation.
@@ -35,4 +59,22 @@ type Supervisor interface {
 	// Status returns nil if service is installed and running.
 	// It returns error otherwise or if service status can't be determined.
 	Status(ctx context.Context, name string) error
+
+	// ProcessStatus returns detailed service status.
+	ProcessStatus(ctx context.Context, name string) *ProcessStatus
+}
+
+// FormatConfig returns human-readable representation of supervised process configuration for diagnostics:
+// environment variables and command line.
+func FormatConfig(config *servicelib.Config) string {
+	args := make([]string, 0, len(config.Environment)+len(config.Arguments)+1)
+	args = append(args, config.Environment...)
+	args = append(args, config.Executable)
+	args = append(args, config.Arguments...)
+	for i, arg := range args {
+		if strings.ContainsAny(arg, " \t\"'") {
+			args[i] = fmt.Sprintf("%q", arg)
+		}
+	}
+	return fmt.Sprintf("# %s\n%s\n", config.Name, strings.Join(args, " "))
 }
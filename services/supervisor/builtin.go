@@ -0,0 +1,164 @@
+// pmm-managed
+// Copyright (C) 2017 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package supervisor
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	servicelib "github.com/percona/kardianos-service"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"github.com/percona/pmm-managed/services"
+	"github.com/percona/pmm-managed/utils/logger"
+)
+
+// Builtin starts and stops external processes (typically Agents) as child processes of pmm-managed.
+// Unlike Supervisor, it tracks them itself: processes are restarted with exponential backoff,
+// their output is written to rotating per-agent log files.
+type Builtin struct {
+	logDir string
+	l      *logrus.Entry
+
+	limitsWarner limitsWarner
+
+	rw        sync.RWMutex
+	processes map[string]*process
+}
+
+// NewBuiltin creates a new in-process supervisor. Agent logs are written to logDir.
+func NewBuiltin(l *logrus.Entry, logDir string) *Builtin {
+	l = l.WithField("component", "supervisor")
+	l.Infof("Using built-in supervisor, logs directory %s", logDir)
+	return &Builtin{
+		logDir:    logDir,
+		l:         l,
+		processes: make(map[string]*process),
+	}
+}
+
+func (b *Builtin) Start(ctx context.Context, config *servicelib.Config) error {
+	if config.Name == "" {
+		return errors.New("service name is not given")
+	}
+	if err := os.MkdirAll(b.logDir, 0755); err != nil {
+		return errors.WithStack(err)
+	}
+
+	b.rw.Lock()
+	defer b.rw.Unlock()
+
+	if _, ok := b.processes[config.Name]; ok {
+		return errors.Errorf("failed to install %s: already exists", config.Name)
+	}
+
+	attr, err := sysProcAttr(config.UserName)
+	if err != nil {
+		return errors.Wrapf(err, "failed to start %s", config.Name)
+	}
+	for _, limit := range unenforcedLimits(services.ResourceLimitsFromConfig(config)) {
+		msg := fmt.Sprintf("%s can't be enforced by built-in supervisor, ignoring it for all Agents.", limit)
+		b.limitsWarner.warnOnce(logger.Get(ctx).WithField("component", "supervisor"), msg)
+	}
+
+	logger.Get(ctx).WithField("component", "supervisor").Infof("Starting %s", config.Name)
+	log := newRotatingWriter(filepath.Join(b.logDir, config.Name+".log"), logMaxSize, logMaxBackups)
+	p := newProcess(config, attr, log, b.l.WithField("agent", config.Name))
+	if err := p.start(); err != nil {
+		log.Close()
+		return errors.Wrapf(err, "failed to start %s", config.Name)
+	}
+	b.processes[config.Name] = p
+	return nil
+}
+
+func (b *Builtin) Stop(ctx context.Context, name string) error {
+	b.rw.Lock()
+	p, ok := b.processes[name]
+	delete(b.processes, name)
+	b.rw.Unlock()
+
+	if !ok {
+		return errors.Errorf("failed to stop %s: not found", name)
+	}
+
+	logger.Get(ctx).WithField("component", "supervisor").Infof("Stopping %s", name)
+	p.stop()
+	return nil
+}
+
+func (b *Builtin) Status(ctx context.Context, name string) error {
+	b.rw.RLock()
+	p, ok := b.processes[name]
+	b.rw.RUnlock()
+
+	var err error
+	switch {
+	case !ok:
+		err = fmt.Errorf("%s is not installed", name)
+	case !p.running():
+		err = fmt.Errorf("%s is not running", name)
+	}
+
+	if err == nil {
+		logger.Get(ctx).WithField("component", "supervisor").Infof("%s is running", name)
+	} else {
+		logger.Get(ctx).WithField("component", "supervisor").Warnf("%s is not running: %s", name, err)
+	}
+	return err
+}
+
+func (b *Builtin) ProcessStatus(ctx context.Context, name string) *services.ProcessStatus {
+	b.rw.RLock()
+	p, ok := b.processes[name]
+	b.rw.RUnlock()
+
+	if !ok {
+		return &services.ProcessStatus{
+			State: services.ProcessStopped,
+		}
+	}
+	return p.status()
+}
+
+// StopAll gracefully stops all processes. It should be called on pmm-managed shutdown.
+func (b *Builtin) StopAll(ctx context.Context) {
+	b.rw.Lock()
+	processes := b.processes
+	b.processes = make(map[string]*process)
+	b.rw.Unlock()
+
+	var wg sync.WaitGroup
+	for name, p := range processes {
+		wg.Add(1)
+		go func(name string, p *process) {
+			defer wg.Done()
+			logger.Get(ctx).WithField("component", "supervisor").Infof("Stopping %s", name)
+			p.stop()
+		}(name, p)
+	}
+	wg.Wait()
+}
+
+// check interfaces
+var (
+	_ services.Supervisor = (*Builtin)(nil)
+)
@@ -0,0 +1,184 @@
+// pmm-managed
+// Copyright (C) 2017 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package supervisor
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	servicelib "github.com/percona/kardianos-service"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/percona/pmm-managed/services"
+	"github.com/percona/pmm-managed/utils/logger"
+)
+
+func setupBuiltin(t *testing.T) (context.Context, *Builtin, string) {
+	logDir, err := ioutil.TempDir("", "pmm-managed-test-supervisor-")
+	require.NoError(t, err)
+
+	minRestartBackoff = 10 * time.Millisecond
+	maxRestartBackoff = 50 * time.Millisecond
+	stopTimeout = time.Second
+
+	ctx, _ := logger.Set(context.Background(), t.Name())
+	return ctx, NewBuiltin(logrus.WithField("test", t.Name()), logDir), logDir
+}
+
+func teardownBuiltin(t *testing.T, ctx context.Context, b *Builtin, logDir string) {
+	b.StopAll(ctx)
+	assert.NoError(t, os.RemoveAll(logDir))
+}
+
+func shConfig(name, script string) *servicelib.Config {
+	return &servicelib.Config{
+		Name:       name,
+		Executable: "/bin/sh",
+		Arguments:  []string{"-c", script},
+	}
+}
+
+func readLog(t *testing.T, logDir, name string) string {
+	b, err := ioutil.ReadFile(filepath.Join(logDir, name+".log"))
+	require.NoError(t, err)
+	return string(b)
+}
+
+// waitForLog waits until log file contains given text; it is used to ensure that signal traps are installed.
+func waitForLog(t *testing.T, logDir, name, text string) {
+	for i := 0; i < 100; i++ {
+		b, _ := ioutil.ReadFile(filepath.Join(logDir, name+".log"))
+		if strings.Contains(string(b), text) {
+			return
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	t.Fatalf("%q not found in log", text)
+}
+
+func TestBuiltin(t *testing.T) {
+	t.Run("StartStop", func(t *testing.T) {
+		ctx, b, logDir := setupBuiltin(t)
+		defer teardownBuiltin(t, ctx, b, logDir)
+
+		assert.EqualError(t, b.Status(ctx, "test"), "test is not installed")
+
+		err := b.Start(ctx, shConfig("test", `trap "echo terminated; exit 0" TERM; echo started; while true; do sleep 0.01; done`))
+		require.NoError(t, err)
+		assert.NoError(t, b.Status(ctx, "test"))
+		waitForLog(t, logDir, "test", "started")
+		status := b.ProcessStatus(ctx, "test")
+		assert.Equal(t, services.ProcessRunning, status.State)
+		assert.NotZero(t, status.PID)
+		assert.Zero(t, status.Restarts)
+
+		err = b.Start(ctx, shConfig("test", "true"))
+		assert.EqualError(t, err, "failed to install test: already exists")
+
+		require.NoError(t, b.Stop(ctx, "test"))
+		assert.EqualError(t, b.Status(ctx, "test"), "test is not installed")
+		assert.EqualError(t, b.Stop(ctx, "test"), "failed to stop test: not found")
+		assert.Equal(t, services.ProcessStopped, b.ProcessStatus(ctx, "test").State)
+
+		assert.Equal(t, "started\nterminated\n", readLog(t, logDir, "test"))
+	})
+
+	t.Run("Restart", func(t *testing.T) {
+		ctx, b, logDir := setupBuiltin(t)
+		defer teardownBuiltin(t, ctx, b, logDir)
+
+		require.NoError(t, b.Start(ctx, shConfig("test", "echo run; exit 1")))
+		for {
+			status := b.ProcessStatus(ctx, "test")
+			if status.Restarts >= 3 {
+				assert.Equal(t, "exit status 1", status.LastError)
+				break
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+
+		require.NoError(t, b.Stop(ctx, "test"))
+		assert.True(t, strings.Count(readLog(t, logDir, "test"), "run\n") >= 3)
+	})
+
+	t.Run("Kill", func(t *testing.T) {
+		ctx, b, logDir := setupBuiltin(t)
+		defer teardownBuiltin(t, ctx, b, logDir)
+
+		stopTimeout = 50 * time.Millisecond
+		err := b.Start(ctx, shConfig("test", `trap "echo ignored" TERM; echo started; while true; do sleep 0.01; done`))
+		require.NoError(t, err)
+
+		waitForLog(t, logDir, "test", "started")
+		p := b.processes["test"]
+		require.NoError(t, b.Stop(ctx, "test"))
+		assert.EqualError(t, p.lastErr, "signal: killed")
+	})
+
+	t.Run("Limits", func(t *testing.T) {
+		ctx, b, logDir := setupBuiltin(t)
+		defer teardownBuiltin(t, ctx, b, logDir)
+
+		config := shConfig("test", "ulimit -n; echo done; while true; do sleep 0.01; done")
+		(&services.ResourceLimits{NoFile: 100}).Apply(config)
+		require.NoError(t, b.Start(ctx, config))
+		waitForLog(t, logDir, "test", "done")
+		assert.Equal(t, "100\ndone\n", readLog(t, logDir, "test"))
+	})
+
+	t.Run("BadExecutable", func(t *testing.T) {
+		ctx, b, logDir := setupBuiltin(t)
+		defer teardownBuiltin(t, ctx, b, logDir)
+
+		err := b.Start(ctx, &servicelib.Config{Name: "test", Executable: filepath.Join(logDir, "missing")})
+		assert.Error(t, err)
+		assert.EqualError(t, b.Status(ctx, "test"), "test is not installed")
+	})
+}
+
+func TestRotatingWriter(t *testing.T) {
+	dir, err := ioutil.TempDir("", "pmm-managed-test-supervisor-")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir) //nolint:errcheck
+
+	path := filepath.Join(dir, "test.log")
+	w := newRotatingWriter(path, 10, 2)
+	for _, s := range []string{"11111\n", "2222\n", "333\n", "44\n", "5\n"} {
+		_, err = w.Write([]byte(s))
+		require.NoError(t, err)
+	}
+	require.NoError(t, w.Close())
+
+	for file, expected := range map[string]string{
+		"test.log":   "44\n5\n",
+		"test.log.1": "2222\n333\n",
+		"test.log.2": "11111\n",
+	} {
+		b, err := ioutil.ReadFile(filepath.Join(dir, file))
+		require.NoError(t, err)
+		assert.Equal(t, expected, string(b), "%s", file)
+	}
+	_, err = os.Stat(filepath.Join(dir, "test.log.3"))
+	assert.True(t, os.IsNotExist(err))
+}
@@ -0,0 +1,201 @@
+// pmm-managed
+// Copyright (C) 2017 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package supervisor
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+
+	servicelib "github.com/percona/kardianos-service"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"github.com/percona/pmm-managed/services"
+)
+
+// overridden in tests
+var (
+	systemdDir        = "/etc/systemd/system"
+	kernelReleaseFile = "/proc/sys/kernel/osrelease"
+)
+
+// systemdDropInPath returns a path of systemd unit drop-in file with resource limits.
+func systemdDropInPath(name string) string {
+	return filepath.Join(systemdDir, name+".service.d", "pmm-limits.conf")
+}
+
+// systemdDropIn returns systemd unit drop-in file content with resource limits,
+// or nil if there are no limits to set. User is handled by servicelib itself.
+func systemdDropIn(limits *services.ResourceLimits) []byte {
+	var buf bytes.Buffer
+	if limits.MemoryLimit != 0 {
+		// MemoryLimit= instead of MemoryMax= for compatibility with older systemd versions
+		fmt.Fprintf(&buf, "MemoryLimit=%d\n", limits.MemoryLimit)
+	}
+	if limits.CPUQuota != 0 {
+		fmt.Fprintf(&buf, "CPUQuota=%d%%\n", limits.CPUQuota)
+	}
+	if limits.Nice != 0 {
+		fmt.Fprintf(&buf, "Nice=%d\n", limits.Nice)
+	}
+	if limits.NoFile != 0 {
+		fmt.Fprintf(&buf, "LimitNOFILE=%d\n", limits.NoFile)
+	}
+	if buf.Len() == 0 {
+		return nil
+	}
+	return append([]byte("[Service]\n"), buf.Bytes()...)
+}
+
+// writeSystemdDropIn writes systemd unit drop-in file with resource limits, if any.
+// It should be called before service installation as it does not reload systemd configuration.
+func writeSystemdDropIn(name string, limits *services.ResourceLimits) error {
+	b := systemdDropIn(limits)
+	if b == nil {
+		return nil
+	}
+	path := systemdDropInPath(name)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return errors.WithStack(err)
+	}
+	return errors.WithStack(ioutil.WriteFile(path, b, 0644))
+}
+
+// removeSystemdDropIn removes systemd unit drop-in directory.
+func removeSystemdDropIn(name string) error {
+	return errors.WithStack(os.RemoveAll(filepath.Dir(systemdDropInPath(name))))
+}
+
+// shellQuote quotes s for POSIX shell.
+func shellQuote(s string) string {
+	return "'" + strings.Replace(s, "'", `'\''`, -1) + "'"
+}
+
+// kernelAtLeast returns true if Linux kernel release (like "4.15.0-45-generic") is at least major.minor.
+func kernelAtLeast(release string, major, minor int) bool {
+	parts := strings.SplitN(strings.TrimSpace(release), ".", 3)
+	if len(parts) < 2 {
+		return false
+	}
+	ma, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return false
+	}
+	mi, err := strconv.Atoi(strings.TrimRightFunc(parts[1], func(r rune) bool { return r < '0' || r > '9' }))
+	if err != nil {
+		return false
+	}
+	return ma > major || (ma == major && mi >= minor)
+}
+
+// unenforcedLimits returns names of resource limits that are set, but can't be enforced by limitsScript.
+func unenforcedLimits(limits *services.ResourceLimits) []string {
+	var res []string
+	if limits.MemoryLimit != 0 {
+		// before Linux 4.7, RLIMIT_DATA does not include mmap'ed memory used by Go runtime and most programs
+		b, _ := ioutil.ReadFile(kernelReleaseFile)
+		if !kernelAtLeast(string(b), 4, 7) {
+			res = append(res, "Memory limit")
+		}
+	}
+	if limits.CPUQuota != 0 {
+		res = append(res, "CPU quota")
+	}
+	return res
+}
+
+// limitsWarner logs warnings about resource limits that can't be enforced once per supervisor,
+// not on every Agent start: default flags set the same limits for all Agents.
+type limitsWarner struct {
+	m      sync.Mutex
+	warned map[string]struct{}
+}
+
+// warnOnce logs a given message if it was not logged before.
+func (w *limitsWarner) warnOnce(l logrus.FieldLogger, msg string) {
+	w.m.Lock()
+	defer w.m.Unlock()
+
+	if _, ok := w.warned[msg]; ok {
+		return
+	}
+	if w.warned == nil {
+		w.warned = make(map[string]struct{})
+	}
+	w.warned[msg] = struct{}{}
+	l.Warn(msg)
+}
+
+// limitsScript returns POSIX shell script that sets resource limits and then executes a program,
+// or empty string if there are no limits to set that way.
+// Memory is limited by the data segment size (RLIMIT_DATA), that includes heap and, since Linux 4.7,
+// all private anonymous mappings. Virtual memory size (RLIMIT_AS) is not used as Go runtime reserves
+// much more address space than it uses. Unlike cgroup limit, it is applied to each process separately,
+// and allocations above it fail instead of triggering OOM killer.
+// CPU quota and user are not handled; see unenforcedLimits.
+func limitsScript(executable string, arguments []string, limits *services.ResourceLimits) string {
+	var commands []string
+	if limits.NoFile != 0 {
+		commands = append(commands, fmt.Sprintf("ulimit -n %d", limits.NoFile))
+	}
+	if limits.MemoryLimit != 0 {
+		commands = append(commands, fmt.Sprintf("ulimit -d %d", (limits.MemoryLimit+1023)/1024))
+	}
+
+	exec := []string{"exec"}
+	if limits.Nice != 0 {
+		exec = append(exec, "nice", "-n", strconv.Itoa(limits.Nice))
+	}
+	if len(commands) == 0 && len(exec) == 1 {
+		return ""
+	}
+
+	exec = append(exec, shellQuote(executable))
+	for _, arg := range arguments {
+		exec = append(exec, shellQuote(arg))
+	}
+	commands = append(commands, strings.Join(exec, " "))
+	return strings.Join(commands, " && ")
+}
+
+// supervisordQuote quotes s for supervisord's command option.
+func supervisordQuote(s string) string {
+	s = strings.Replace(s, `\`, `\\`, -1)
+	s = strings.Replace(s, `"`, `\"`, -1)
+	s = strings.Replace(s, `%`, `%%`, -1) // supervisord expands %(ENV_X)s
+	return `"` + s + `"`
+}
+
+// applySupervisordLimits returns a copy of config with a command wrapped into a shell script
+// that sets resource limits.
+func applySupervisordLimits(config *servicelib.Config, limits *services.ResourceLimits) *servicelib.Config {
+	script := limitsScript(config.Executable, config.Arguments, limits)
+	if script == "" {
+		return config
+	}
+
+	c := *config
+	c.Executable = "/bin/sh"
+	c.Arguments = []string{"-c", supervisordQuote(script)}
+	return &c
+}
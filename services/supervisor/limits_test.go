@@ -0,0 +1,116 @@
+// pmm-managed
+// Copyright (C) 2017 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package supervisor
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	servicelib "github.com/percona/kardianos-service"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/percona/pmm-managed/services"
+)
+
+func TestSystemdDropIn(t *testing.T) {
+	dir, err := ioutil.TempDir("", "pmm-managed-test-supervisor-")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir) //nolint:errcheck
+	systemdDir = dir
+
+	limits := &services.ResourceLimits{MemoryLimit: 512 * 1024 * 1024, CPUQuota: 50, Nice: 10, NoFile: 4096, User: "pmm"}
+	require.NoError(t, writeSystemdDropIn("pmm-test", limits))
+	b, err := ioutil.ReadFile(filepath.Join(dir, "pmm-test.service.d", "pmm-limits.conf"))
+	require.NoError(t, err)
+	expected := "[Service]\nMemoryLimit=536870912\nCPUQuota=50%\nNice=10\nLimitNOFILE=4096\n"
+	assert.Equal(t, expected, string(b))
+
+	require.NoError(t, removeSystemdDropIn("pmm-test"))
+	_, err = os.Stat(filepath.Join(dir, "pmm-test.service.d"))
+	assert.True(t, os.IsNotExist(err))
+
+	// no file for user only
+	require.NoError(t, writeSystemdDropIn("pmm-test", &services.ResourceLimits{User: "pmm"}))
+	_, err = os.Stat(filepath.Join(dir, "pmm-test.service.d"))
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestUnenforcedLimits(t *testing.T) {
+	for release, expected := range map[string]bool{
+		"4.15.0-45-generic\n":          true,
+		"4.7.0":                        true,
+		"5.0.0":                        true,
+		"3.10.0-957.el7.x86_64":        false,
+		"4.6.7-300.fc24.x86_64":        false,
+		"4.4+":                         false,
+		"":                             false,
+		"4.19.0-1-cloud-amd64 garbage": true,
+	} {
+		assert.Equal(t, expected, kernelAtLeast(release, 4, 7), "%q", release)
+	}
+
+	f, err := ioutil.TempFile("", "pmm-managed-test-osrelease-")
+	require.NoError(t, err)
+	defer os.Remove(f.Name()) //nolint:errcheck
+	_, err = f.WriteString("3.10.0-957.el7.x86_64\n")
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+	defer func(old string) { kernelReleaseFile = old }(kernelReleaseFile)
+	kernelReleaseFile = f.Name()
+
+	limits := &services.ResourceLimits{MemoryLimit: 1024, CPUQuota: 50, Nice: 10, NoFile: 4096}
+	assert.Equal(t, []string{"Memory limit", "CPU quota"}, unenforcedLimits(limits))
+	assert.Empty(t, unenforcedLimits(&services.ResourceLimits{Nice: 10, NoFile: 4096}))
+}
+
+func TestLimitsWarner(t *testing.T) {
+	var buf bytes.Buffer
+	l := logrus.New()
+	l.Out = &buf
+
+	var w limitsWarner
+	for i := 0; i < 3; i++ {
+		w.warnOnce(l, "CPU quota can't be enforced by test, ignoring it for all Agents.")
+		w.warnOnce(l, "Memory limit can't be enforced by test, ignoring it for all Agents.")
+	}
+	assert.Equal(t, 1, strings.Count(buf.String(), "CPU quota"), "%s", buf.String())
+	assert.Equal(t, 1, strings.Count(buf.String(), "Memory limit"), "%s", buf.String())
+}
+
+func TestSupervisordLimits(t *testing.T) {
+	config := &servicelib.Config{
+		Name:       "pmm-test",
+		Executable: "/usr/sbin/exporter",
+		Arguments:  []string{"-web.listen-address=127.0.0.1:10000", `-query=it's "100%"`},
+	}
+
+	actual := applySupervisordLimits(config, &services.ResourceLimits{CPUQuota: 50, User: "pmm"})
+	assert.Equal(t, config, actual)
+
+	actual = applySupervisordLimits(config, &services.ResourceLimits{MemoryLimit: 1000, Nice: 10, NoFile: 4096})
+	assert.Equal(t, "/bin/sh", actual.Executable)
+	expected := `"ulimit -n 4096 && ulimit -d 1 && exec nice -n 10 '/usr/sbin/exporter' ` +
+		`'-web.listen-address=127.0.0.1:10000' '-query=it'\\''s \"100%%\"'"`
+	assert.Equal(t, []string{"-c", expected}, actual.Arguments)
+	assert.Equal(t, "/usr/sbin/exporter", config.Executable, "original config should not be changed")
+}
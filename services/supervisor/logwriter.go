@@ -0,0 +1,119 @@
+// pmm-managed
+// Copyright (C) 2017 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package supervisor
+
+import (
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	logMaxSize    = 10 * 1024 * 1024
+	logMaxBackups = 3
+)
+
+// rotatingWriter writes process output to a file. When file size exceeds maxSize,
+// it is renamed to <path>.1 (<path>.1 is renamed to <path>.2, and so on),
+// and a new file is created. At most maxBackups old files are kept.
+type rotatingWriter struct {
+	path       string
+	maxSize    int64
+	maxBackups int
+
+	m    sync.Mutex
+	f    *os.File
+	size int64
+}
+
+func newRotatingWriter(path string, maxSize int64, maxBackups int) *rotatingWriter {
+	return &rotatingWriter{
+		path:       path,
+		maxSize:    maxSize,
+		maxBackups: maxBackups,
+	}
+}
+
+// Write implements io.Writer.
+func (w *rotatingWriter) Write(p []byte) (int, error) {
+	w.m.Lock()
+	defer w.m.Unlock()
+
+	if w.f != nil && w.size+int64(len(p)) > w.maxSize {
+		if err := w.rotate(); err != nil {
+			return 0, err
+		}
+	}
+	if w.f == nil {
+		if err := w.open(); err != nil {
+			return 0, err
+		}
+	}
+
+	n, err := w.f.Write(p)
+	w.size += int64(n)
+	return n, errors.WithStack(err)
+}
+
+// Close implements io.Closer.
+func (w *rotatingWriter) Close() error {
+	w.m.Lock()
+	defer w.m.Unlock()
+
+	if w.f == nil {
+		return nil
+	}
+	err := w.f.Close()
+	w.f = nil
+	return errors.WithStack(err)
+}
+
+func (w *rotatingWriter) open() error {
+	f, err := os.OpenFile(w.path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0644)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	fi, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return errors.WithStack(err)
+	}
+
+	w.f = f
+	w.size = fi.Size()
+	return nil
+}
+
+func (w *rotatingWriter) rotate() error {
+	if err := w.f.Close(); err != nil {
+		return errors.WithStack(err)
+	}
+	w.f = nil
+
+	for i := w.maxBackups - 1; i > 0; i-- {
+		err := os.Rename(fmt.Sprintf("%s.%d", w.path, i), fmt.Sprintf("%s.%d", w.path, i+1))
+		if err != nil && !os.IsNotExist(err) {
+			return errors.WithStack(err)
+		}
+	}
+	if w.maxBackups > 0 {
+		return errors.WithStack(os.Rename(w.path, w.path+".1"))
+	}
+	return errors.WithStack(os.Remove(w.path))
+}
@@ -0,0 +1,252 @@
+// pmm-managed
+// Copyright (C) 2017 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package supervisor
+
+import (
+	"context"
+	"io"
+	"os/exec"
+	"sync"
+	"syscall"
+	"time"
+
+	servicelib "github.com/percona/kardianos-service"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+
+	"github.com/percona/pmm-managed/services"
+)
+
+// overridden in tests
+var (
+	minRestartBackoff = time.Second
+	maxRestartBackoff = time.Minute
+	stopTimeout       = 10 * time.Second
+)
+
+// process is considered healthy and restart backoff is reset if it runs longer than that
+const healthyRunTime = time.Minute
+
+// process runs a single external program and restarts it when it exits.
+type process struct {
+	executable string
+	arguments  []string
+	config     *servicelib.Config
+	attr       *syscall.SysProcAttr
+	log        io.WriteCloser
+	l          *logrus.Entry
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	done   chan struct{}
+
+	rw        sync.RWMutex
+	pid       int
+	startedAt time.Time
+	restarts  int
+	lastErr   error
+}
+
+func newProcess(config *servicelib.Config, attr *syscall.SysProcAttr, log io.WriteCloser, l *logrus.Entry) *process {
+	// apply resource limits with a shell wrapper, if any
+	executable, arguments := config.Executable, config.Arguments
+	if script := limitsScript(executable, arguments, services.ResourceLimitsFromConfig(config)); script != "" {
+		executable, arguments = "/bin/sh", []string{"-c", script}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	return &process{
+		executable: executable,
+		arguments:  arguments,
+		config:     config,
+		attr:       attr,
+		log:        log,
+		l:          l,
+		ctx:        ctx,
+		cancel:     cancel,
+		done:       make(chan struct{}),
+	}
+}
+
+// start starts program for the first time and then keeps it running in the background.
+func (p *process) start() error {
+	cmd, err := p.startCmd()
+	if err != nil {
+		return err
+	}
+	go p.run(cmd)
+	return nil
+}
+
+// stop gracefully stops program and waits for it to exit.
+func (p *process) stop() {
+	p.cancel()
+	<-p.done
+}
+
+// running returns true if program is currently running.
+func (p *process) running() bool {
+	p.rw.RLock()
+	defer p.rw.RUnlock()
+	return p.pid != 0
+}
+
+// status returns current process status.
+func (p *process) status() *services.ProcessStatus {
+	p.rw.RLock()
+	defer p.rw.RUnlock()
+
+	res := &services.ProcessStatus{
+		State:    services.ProcessRunning,
+		PID:      p.pid,
+		Restarts: p.restarts,
+	}
+	if p.pid == 0 {
+		res.State = services.ProcessFailed
+	} else {
+		res.StartedAt = p.startedAt
+	}
+	if p.lastErr != nil {
+		res.LastError = p.lastErr.Error()
+	}
+	return res
+}
+
+func (p *process) startCmd() (*exec.Cmd, error) {
+	cmd := exec.Command(p.executable, p.arguments...) //nolint:gosec
+	cmd.Dir = p.config.WorkingDirectory
+	if len(p.config.Environment) != 0 {
+		cmd.Env = p.config.Environment
+	}
+	cmd.Stdout = p.log
+	cmd.Stderr = p.log
+	cmd.SysProcAttr = p.attr
+	if err := cmd.Start(); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	p.rw.Lock()
+	p.pid = cmd.Process.Pid
+	p.startedAt = time.Now()
+	p.rw.Unlock()
+
+	p.l.Infof("Process started, PID %d.", cmd.Process.Pid)
+	return cmd, nil
+}
+
+// wait waits for program to exit. If process is stopping, it sends SIGTERM first,
+// and then SIGKILL after stopTimeout.
+func (p *process) wait(cmd *exec.Cmd) error {
+	exited := make(chan error, 1)
+	go func() {
+		exited <- cmd.Wait()
+	}()
+
+	select {
+	case err := <-exited:
+		return err
+	case <-p.ctx.Done():
+	}
+
+	p.l.Debugf("Sending SIGTERM to PID %d.", cmd.Process.Pid)
+	if err := cmd.Process.Signal(syscall.SIGTERM); err != nil {
+		p.l.Warnf("Failed to send SIGTERM: %s.", err)
+	}
+
+	t := time.NewTimer(stopTimeout)
+	defer t.Stop()
+	select {
+	case err := <-exited:
+		return err
+	case <-t.C:
+	}
+
+	p.l.Warnf("Process did not exit in %s, sending SIGKILL to PID %d.", stopTimeout, cmd.Process.Pid)
+	if err := cmd.Process.Kill(); err != nil {
+		p.l.Warnf("Failed to send SIGKILL: %s.", err)
+	}
+	return <-exited
+}
+
+// run keeps program running until process is stopped.
+func (p *process) run(cmd *exec.Cmd) {
+	defer func() {
+		if err := p.log.Close(); err != nil {
+			p.l.Warn(err)
+		}
+		close(p.done)
+	}()
+
+	backoff := minRestartBackoff
+	for {
+		err := p.wait(cmd)
+
+		p.rw.Lock()
+		runTime := time.Since(p.startedAt)
+		p.pid = 0
+		p.lastErr = err
+		p.rw.Unlock()
+
+		if p.ctx.Err() != nil {
+			p.l.Infof("Process stopped: %v.", err)
+			return
+		}
+
+		if runTime > healthyRunTime {
+			backoff = minRestartBackoff
+		}
+		p.l.Warnf("Process exited after %s: %v. Restarting in %s.", runTime, err, backoff)
+
+		if cmd = p.restart(&backoff); cmd == nil {
+			p.l.Infof("Process stopped.")
+			return
+		}
+	}
+}
+
+// restart starts program again after backoff, doubling it after each attempt.
+// It returns nil if process is stopped.
+func (p *process) restart(backoff *time.Duration) *exec.Cmd {
+	for {
+		t := time.NewTimer(*backoff)
+		select {
+		case <-p.ctx.Done():
+			t.Stop()
+			return nil
+		case <-t.C:
+		}
+
+		p.rw.Lock()
+		p.restarts++
+		p.rw.Unlock()
+
+		*backoff *= 2
+		if *backoff > maxRestartBackoff {
+			*backoff = maxRestartBackoff
+		}
+
+		cmd, err := p.startCmd()
+		if err == nil {
+			return cmd
+		}
+
+		p.rw.Lock()
+		p.lastErr = err
+		p.rw.Unlock()
+		p.l.Errorf("Failed to restart process: %s. Restarting in %s.", err, *backoff)
+	}
+}
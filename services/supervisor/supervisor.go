@@ -14,11 +14,16 @@
 // You should have received a copy of the GNU Affero General Public License
 // along with this program. If not, see <https://www.gnu.org/licenses/>.
 
-// Package supervisor provides facilities for working with system process supervisors.
+// Package supervisor provides facilities for working with system process supervisors
+// and a built-in one.
 package supervisor
 
 import (
 	"context"
+	"fmt"
+	"os/exec"
+	"strings"
+	"time"
 
 	servicelib "github.com/percona/kardianos-service"
 	"github.com/pkg/errors"
@@ -32,6 +37,7 @@ import (
 // (systemd or supervisord).
 // It does not tracks them itself.
 type Supervisor struct {
+	limitsWarner limitsWarner
 }
 
 func New(l *logrus.Entry) *Supervisor {
@@ -50,17 +56,35 @@ func makeService(config *servicelib.Config) (servicelib.Service, error) {
 }
 
 func (s *Supervisor) Start(ctx context.Context, config *servicelib.Config) error {
+	l := logger.Get(ctx).WithField("component", "supervisor")
+	limits := services.ResourceLimitsFromConfig(config)
+	switch platform := servicelib.Platform(); platform {
+	case "linux-systemd":
+		if err := writeSystemdDropIn(config.Name, limits); err != nil {
+			return errors.Wrapf(err, "failed to set resource limits for %s", config.Name)
+		}
+	case "linux-supervisord":
+		for _, limit := range unenforcedLimits(limits) {
+			s.limitsWarner.warnOnce(l, fmt.Sprintf("%s can't be enforced by %s, ignoring it for all Agents.", limit, platform))
+		}
+		config = applySupervisordLimits(config, limits)
+	default:
+		if limits.MemoryLimit != 0 || limits.CPUQuota != 0 || limits.Nice != 0 || limits.NoFile != 0 {
+			s.limitsWarner.warnOnce(l, fmt.Sprintf("Resource limits are not supported by %s, ignoring them for all Agents.", platform))
+		}
+	}
+
 	svc, err := makeService(config)
 	if err != nil {
 		return err
 	}
 
-	logger.Get(ctx).WithField("component", "supervisor").Infof("Installing %s", config.Name)
+	l.Infof("Installing %s", config.Name)
 	if err := svc.Install(); err != nil {
 		return errors.Wrapf(err, "failed to install %s", config.Name)
 	}
 
-	logger.Get(ctx).WithField("component", "supervisor").Infof("Starting %s", config.Name)
+	l.Infof("Starting %s", config.Name)
 	return errors.Wrapf(svc.Start(), "failed to start %s", config.Name)
 }
 
@@ -77,7 +101,14 @@ func (s *Supervisor) Stop(ctx context.Context, name string) error {
 	}
 
 	logger.Get(ctx).WithField("component", "supervisor").Infof("Uninstalling %s", config.Name)
-	return errors.Wrapf(svc.Uninstall(), "failed to uninstall %s", config.Name)
+	if err := svc.Uninstall(); err != nil {
+		return errors.Wrapf(err, "failed to uninstall %s", config.Name)
+	}
+
+	if servicelib.Platform() == "linux-systemd" {
+		return errors.Wrapf(removeSystemdDropIn(name), "failed to remove resource limits for %s", name)
+	}
+	return nil
 }
 
 func (s *Supervisor) Status(ctx context.Context, name string) error {
@@ -96,6 +127,113 @@ func (s *Supervisor) Status(ctx context.Context, name string) error {
 	return err
 }
 
+// statusTimeout is a timeout for systemctl and supervisorctl status commands.
+const statusTimeout = 5 * time.Second
+
+// parseSystemdState returns process state from "systemctl show -p LoadState,ActiveState,SubState" output.
+func parseSystemdState(output string) (services.ProcessState, error) {
+	props := make(map[string]string)
+	for _, line := range strings.Split(output, "\n") {
+		if parts := strings.SplitN(strings.TrimSpace(line), "=", 2); len(parts) == 2 {
+			props[parts[0]] = parts[1]
+		}
+	}
+
+	if props["LoadState"] != "loaded" {
+		return services.ProcessUnknown, errors.Errorf("unit load state: %q", props["LoadState"])
+	}
+	switch props["ActiveState"] {
+	case "active", "reloading":
+		return services.ProcessRunning, nil
+	case "activating":
+		if props["SubState"] == "auto-restart" {
+			return services.ProcessFailed, nil
+		}
+		return services.ProcessRunning, nil
+	case "inactive", "deactivating":
+		return services.ProcessStopped, nil
+	case "failed":
+		return services.ProcessFailed, nil
+	default:
+		return services.ProcessUnknown, errors.Errorf("unit active state: %q", props["ActiveState"])
+	}
+}
+
+// parseSupervisordState returns process state from "supervisorctl status <name>" output.
+func parseSupervisordState(output string) (services.ProcessState, error) {
+	fields := strings.Fields(output)
+	if len(fields) < 2 {
+		return services.ProcessUnknown, errors.Errorf("unexpected supervisorctl output: %q", output)
+	}
+	switch fields[1] {
+	case "RUNNING", "STARTING":
+		return services.ProcessRunning, nil
+	case "STOPPED", "STOPPING", "EXITED":
+		return services.ProcessStopped, nil
+	case "BACKOFF", "FATAL":
+		return services.ProcessFailed, nil
+	default:
+		return services.ProcessUnknown, errors.Errorf("unexpected supervisorctl output: %q", strings.TrimSpace(output))
+	}
+}
+
+// systemState returns process state from systemd or supervisord.
+func systemState(ctx context.Context, platform, name string) (services.ProcessState, error) {
+	ctx, cancel := context.WithTimeout(ctx, statusTimeout)
+	defer cancel()
+
+	switch platform {
+	case "linux-systemd":
+		b, err := exec.CommandContext(ctx, "systemctl", "show", "-p", "LoadState,ActiveState,SubState", name+".service").Output()
+		if err != nil {
+			return services.ProcessUnknown, errors.WithStack(err)
+		}
+		return parseSystemdState(string(b))
+
+	case "linux-supervisord":
+		// some versions of supervisorctl exit with non-zero code if process is not running
+		b, err := exec.CommandContext(ctx, "supervisorctl", "status", name).Output()
+		if err != nil && len(b) == 0 {
+			return services.ProcessUnknown, errors.WithStack(err)
+		}
+		return parseSupervisordState(string(b))
+
+	default:
+		return services.ProcessUnknown, errors.Errorf("process state is not supported by %s", platform)
+	}
+}
+
+func (s *Supervisor) ProcessStatus(ctx context.Context, name string) *services.ProcessStatus {
+	switch platform := servicelib.Platform(); platform {
+	case "linux-systemd", "linux-supervisord":
+		state, err := systemState(ctx, platform, name)
+		res := &services.ProcessStatus{
+			State: state,
+		}
+		if err != nil {
+			res.LastError = err.Error()
+		}
+		return res
+	}
+
+	config := &servicelib.Config{Name: name}
+	svc, err := makeService(config)
+	if err == nil {
+		err = svc.Status()
+	}
+
+	// error can also mean that service status can't be determined
+	if err != nil {
+		return &services.ProcessStatus{
+			State:     services.ProcessUnknown,
+			LastError: err.Error(),
+		}
+	}
+	return &services.ProcessStatus{
+		State: services.ProcessRunning,
+	}
+}
+
 // check interfaces
 var (
 	_ services.Supervisor = (*Supervisor)(nil)
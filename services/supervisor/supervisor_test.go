@@ -0,0 +1,61 @@
+// pmm-managed
+// Copyright (C) 2017 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package supervisor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/percona/pmm-managed/services"
+)
+
+func TestParseSystemdState(t *testing.T) {
+	for output, expected := range map[string]services.ProcessState{
+		"LoadState=loaded\nActiveState=active\nSubState=running\n":          services.ProcessRunning,
+		"LoadState=loaded\nActiveState=inactive\nSubState=dead\n":           services.ProcessStopped,
+		"LoadState=loaded\nActiveState=failed\nSubState=failed\n":           services.ProcessFailed,
+		"LoadState=loaded\nActiveState=activating\nSubState=auto-restart\n": services.ProcessFailed,
+		"LoadState=loaded\nActiveState=activating\nSubState=start\n":        services.ProcessRunning,
+	} {
+		actual, err := parseSystemdState(output)
+		require.NoError(t, err, "%q", output)
+		assert.Equal(t, expected, actual, "%q", output)
+	}
+
+	actual, err := parseSystemdState("LoadState=not-found\nActiveState=inactive\nSubState=dead\n")
+	assert.EqualError(t, err, `unit load state: "not-found"`)
+	assert.Equal(t, services.ProcessUnknown, actual)
+}
+
+func TestParseSupervisordState(t *testing.T) {
+	for output, expected := range map[string]services.ProcessState{
+		"pmm-mysqld_exporter-42002        RUNNING   pid 1234, uptime 0:01:02\n":                          services.ProcessRunning,
+		"pmm-mysqld_exporter-42002        STOPPED   Jan 02 03:04 PM\n":                                   services.ProcessStopped,
+		"pmm-mysqld_exporter-42002        EXITED    Jan 02 03:04 PM\n":                                   services.ProcessStopped,
+		"pmm-mysqld_exporter-42002        BACKOFF   Exited too quickly (process log may have details)\n": services.ProcessFailed,
+	} {
+		actual, err := parseSupervisordState(output)
+		require.NoError(t, err, "%q", output)
+		assert.Equal(t, expected, actual, "%q", output)
+	}
+
+	actual, err := parseSupervisordState("pmm-mysqld_exporter-42002: ERROR (no such process)\n")
+	assert.EqualError(t, err, `unexpected supervisorctl output: "pmm-mysqld_exporter-42002: ERROR (no such process)"`)
+	assert.Equal(t, services.ProcessUnknown, actual)
+}
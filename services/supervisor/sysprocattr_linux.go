@@ -0,0 +1,54 @@
+// pmm-managed
+// Copyright (C) 2017 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package supervisor
+
+import (
+	"os/user"
+	"strconv"
+	"syscall"
+
+	"github.com/pkg/errors"
+)
+
+// sysProcAttr returns attributes for child processes running as a given user (current user if empty).
+func sysProcAttr(userName string) (*syscall.SysProcAttr, error) {
+	attr := &syscall.SysProcAttr{
+		// kill child if pmm-managed dies unexpectedly
+		Pdeathsig: syscall.SIGKILL,
+	}
+	if userName == "" {
+		return attr, nil
+	}
+
+	u, err := user.Lookup(userName)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	uid, err := strconv.ParseUint(u.Uid, 10, 32)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	gid, err := strconv.ParseUint(u.Gid, 10, 32)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	attr.Credential = &syscall.Credential{
+		Uid: uint32(uid),
+		Gid: uint32(gid),
+	}
+	return attr, nil
+}
@@ -0,0 +1,33 @@
+// pmm-managed
+// Copyright (C) 2017 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+// +build !linux
+
+package supervisor
+
+import (
+	"syscall"
+
+	"github.com/pkg/errors"
+)
+
+// sysProcAttr returns attributes for child processes. Running as another user is not supported.
+func sysProcAttr(userName string) (*syscall.SysProcAttr, error) {
+	if userName != "" {
+		return nil, errors.Errorf("running as user %q is not supported on this platform", userName)
+	}
+	return nil, nil
+}
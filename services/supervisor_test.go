@@ -0,0 +1,36 @@
+// pmm-managed
+// Copyright (C) 2017 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package services
+
+import (
+	"testing"
+
+	servicelib "github.com/percona/kardianos-service"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatConfig(t *testing.T) {
+	cfg := &servicelib.Config{
+		Name:        "mysqld_exporter-42000",
+		Executable:  "/usr/sbin/mysqld_exporter",
+		Arguments:   []string{"-collect.global_status", "-web.listen-address=127.0.0.1:42000"},
+		Environment: []string{"DATA_SOURCE_NAME=pmm:pmm@tcp(127.0.0.1:3306)/", "LABEL=with space"},
+	}
+	expected := "# mysqld_exporter-42000\n" +
+		`DATA_SOURCE_NAME=pmm:pmm@tcp(127.0.0.1:3306)/ "LABEL=with space" /usr/sbin/mysqld_exporter -collect.global_status -web.listen-address=127.0.0.1:42000` + "\n"
+	assert.Equal(t, expected, FormatConfig(cfg))
+}
@@ -27,11 +27,14 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 const (
@@ -43,33 +46,66 @@ const (
 	envDisable = "DISABLE_TELEMETRY"
 	envURL     = "PERCONA_VERSION_CHECK_URL" // the same name as for the Toolkit
 	envOS      = "TELEMETRY_OS"              // set by AMI and OVF, empty for Docker image
+
+	// EnabledKey is a key for persisted enabled flag, next to telemetry/uuid.
+	EnabledKey = "telemetry/enabled"
 )
 
+// how often to retry reading persisted enabled flag; overridden in tests
+var loadRetryInterval = time.Minute
+
+// KV is a subset of consul.Client methods used for persisting telemetry settings.
+type KV interface {
+	GetKV(key string) ([]byte, error)
+	PutKV(key string, value []byte) error
+}
+
+// Settings represents telemetry settings.
+type Settings struct {
+	Enabled       bool // effective state
+	DisabledByEnv bool // true if disabled by DISABLE_TELEMETRY environment variable; can't be enabled then
+	UUID          string
+	URL           string
+	Interval      time.Duration
+}
+
 // Service is responsible for interactions with Percona Call Home service.
 type Service struct {
 	uuid       string
 	pmmVersion string
+	kv         KV
+	collectors []Collector
 
-	l   *logrus.Entry
-	os  string
-	url string
+	l             *logrus.Entry
+	initOnce      sync.Once
+	os            string
+	url           string
+	disabledByEnv bool
+
+	rw      sync.RWMutex
+	enabled bool
+	loaded  bool // true if persisted enabled flag was read or set
+	wakeup  chan struct{}
 }
 
-// NewService creates a new service with given UUID and PMM version.
-func NewService(uuid string, pmmVersion string) *Service {
+// NewService creates a new service with given UUID, PMM version, settings storage (may be nil),
+// and collectors of additional statistics.
+func NewService(uuid string, pmmVersion string, kv KV, collectors ...Collector) *Service {
 	return &Service{
 		uuid:       uuid,
 		pmmVersion: pmmVersion,
+		kv:         kv,
+		collectors: collectors,
+		l:          logrus.WithField("component", "telemetry"),
+		wakeup:     make(chan struct{}, 1),
 	}
 }
 
-func (s *Service) init() bool {
-	s.l = logrus.WithField("component", "telemetry")
-
+func (s *Service) init() {
 	disabledStr := strings.TrimSpace(strings.ToLower(os.Getenv(envDisable)))
 	if disabled, err := strconv.ParseBool(disabledStr); err == nil && disabled {
 		s.l.Infof("Disabled by %s environment variable.", envDisable)
-		return false
+		s.disabledByEnv = true
 	}
 
 	if os := os.Getenv(envOS); os != "" {
@@ -90,33 +126,166 @@ func (s *Service) init() bool {
 	}
 	s.l.Debugf("Using %q as the endpoint.", s.url)
 
-	s.l.Infof("Enabled. UUID: %s", s.uuid)
-	return true
+	if err := s.loadEnabled(); err != nil {
+		s.l.Warnf("Disabled until settings can be read: %s.", err)
+		return
+	}
+
+	if s.disabledByEnv {
+		return
+	}
+	if s.isEnabled() {
+		s.l.Infof("Enabled. UUID: %s", s.uuid)
+	} else {
+		s.l.Infof("Disabled by settings. UUID: %s", s.uuid)
+	}
+}
+
+// loadEnabled reads persisted enabled flag if it was not read or set yet.
+// Telemetry stays disabled if it can't be read: it may be disabled by the user.
+func (s *Service) loadEnabled() error {
+	s.rw.Lock()
+	defer s.rw.Unlock()
+
+	if s.loaded {
+		return nil
+	}
+
+	enabled := true
+	if s.kv != nil {
+		b, err := s.kv.GetKV(EnabledKey)
+		if err != nil {
+			return errors.Wrapf(err, "failed to get %s", EnabledKey)
+		}
+		if len(b) > 0 {
+			if enabled, err = strconv.ParseBool(string(b)); err != nil {
+				s.l.Warnf("Failed to parse %s: %s.", EnabledKey, err)
+				enabled = true
+			}
+		}
+	}
+
+	s.enabled = enabled
+	s.loaded = true
+	return nil
+}
+
+func (s *Service) isEnabled() bool {
+	s.rw.RLock()
+	defer s.rw.RUnlock()
+	return s.enabled && !s.disabledByEnv
+}
+
+func (s *Service) isLoaded() bool {
+	s.rw.RLock()
+	defer s.rw.RUnlock()
+	return s.loaded
 }
 
 // Run runs telemetry service, sending data every interval until context is canceled.
+// Data is not sent while telemetry is disabled by settings.
 func (s *Service) Run(ctx context.Context) {
-	if !s.init() {
+	s.initOnce.Do(s.init)
+	if s.disabledByEnv {
 		return
 	}
 
 	ticker := time.NewTicker(interval)
 	defer ticker.Stop()
 
-	for {
+	// retry reading settings if they were not read by init
+	var retry <-chan time.Time
+	if !s.isLoaded() {
+		t := time.NewTicker(loadRetryInterval)
+		defer t.Stop()
+		retry = t.C
+	}
+
+	var sent bool
+	if s.isEnabled() {
 		s.sendOnce(ctx)
+		sent = true
+	}
 
+	for {
 		select {
 		case <-ticker.C:
-			// continue with next loop iteration
+			if s.isEnabled() {
+				s.sendOnce(ctx)
+				sent = true
+			}
+		case <-retry:
+			if err := s.loadEnabled(); err != nil {
+				s.l.Debugf("Still disabled: %s.", err)
+				continue
+			}
+			retry = nil
+			s.l.Infof("Settings were read, enabled: %t.", s.isEnabled())
+			if !sent && s.isEnabled() {
+				s.sendOnce(ctx)
+				sent = true
+			}
+		case <-s.wakeup:
+			// send immediately if telemetry was enabled for the first time
+			if !sent && s.isEnabled() {
+				s.sendOnce(ctx)
+				sent = true
+			}
 		case <-ctx.Done():
 			return
 		}
 	}
 }
 
+// Settings returns current telemetry settings.
+func (s *Service) Settings() *Settings {
+	s.initOnce.Do(s.init)
+
+	return &Settings{
+		Enabled:       s.isEnabled(),
+		DisabledByEnv: s.disabledByEnv,
+		UUID:          s.uuid,
+		URL:           s.url,
+		Interval:      interval,
+	}
+}
+
+// SetEnabled enables or disables telemetry and persists that setting. It takes effect immediately.
+func (s *Service) SetEnabled(ctx context.Context, enabled bool) error {
+	s.initOnce.Do(s.init)
+	if enabled && s.disabledByEnv {
+		return status.Errorf(codes.FailedPrecondition, "Telemetry is disabled by %s environment variable.", envDisable)
+	}
+
+	s.rw.Lock()
+	defer s.rw.Unlock()
+
+	if s.kv != nil {
+		if err := s.kv.PutKV(EnabledKey, []byte(strconv.FormatBool(enabled))); err != nil {
+			return errors.Wrapf(err, "failed to put %s", EnabledKey)
+		}
+	}
+	if s.enabled != enabled {
+		s.l.Infof("Enabled: %t.", enabled)
+	}
+	s.enabled = enabled
+	s.loaded = true
+
+	select {
+	case s.wakeup <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// Preview returns the exact payload that is sent (or would be sent if telemetry is disabled).
+func (s *Service) Preview(ctx context.Context) []byte {
+	s.initOnce.Do(s.init)
+	return s.makePayload(ctx)
+}
+
 func (s *Service) sendOnce(ctx context.Context) error {
-	payload := s.makePayload()
+	payload := s.makePayload(ctx)
 	err := s.sendRequest(ctx, payload)
 	if err != nil {
 		s.l.Debugf("Failed to send info: %s", err)
@@ -124,10 +293,22 @@ func (s *Service) sendOnce(ctx context.Context) error {
 	return err
 }
 
-func (s *Service) makePayload() []byte {
+func (s *Service) makePayload(ctx context.Context) []byte {
 	var w bytes.Buffer
 	fmt.Fprintf(&w, "%s;%s;%s\n", s.uuid, "OS", s.os)
 	fmt.Fprintf(&w, "%s;%s;%s\n", s.uuid, "PMM", s.pmmVersion)
+
+	// do not let a single collector error break the whole payload
+	for _, c := range s.collectors {
+		metrics, err := c.Collect(ctx)
+		if err != nil {
+			s.l.Debugf("Failed to collect statistics: %s", err)
+			continue
+		}
+		for _, m := range metrics {
+			fmt.Fprintf(&w, "%s;%s;%s\n", s.uuid, m.Key, m.Value)
+		}
+	}
 	return w.Bytes()
 }
 
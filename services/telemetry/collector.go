@@ -0,0 +1,178 @@
+// pmm-managed
+// Copyright (C) 2017 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package telemetry
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"gopkg.in/reform.v1"
+
+	"github.com/percona/pmm-managed/models"
+	"github.com/percona/pmm-managed/services/prometheus"
+)
+
+// Metric is a single telemetry payload entry.
+type Metric struct {
+	Key   string
+	Value string
+}
+
+// Collector collects anonymized aggregate statistics for telemetry payload.
+// Counts should be bucketed with Bucket to avoid fingerprinting.
+type Collector interface {
+	Collect(ctx context.Context) ([]Metric, error)
+}
+
+// buckets are upper bounds of count buckets.
+var buckets = []int{0, 1, 5, 10, 20, 50, 100, 200, 500}
+
+// Bucket returns bucket for a given count: "0", "1", "2-5", "6-10", ..., "201-500", ">500".
+func Bucket(n int) string {
+	for i, upper := range buckets {
+		if n > upper {
+			continue
+		}
+		if i == 0 || buckets[i-1]+1 == upper {
+			return strconv.Itoa(upper)
+		}
+		return fmt.Sprintf("%d-%d", buckets[i-1]+1, upper)
+	}
+	return fmt.Sprintf(">%d", buckets[len(buckets)-1])
+}
+
+// majorVersion returns engine's major version: 5.7 for 5.7.19, 10 for PostgreSQL 10.5, 9.6 for PostgreSQL 9.6.10.
+func majorVersion(serviceType models.ServiceType, version string) string {
+	parts := strings.SplitN(version, ".", 3)
+	if serviceType == models.PostgreSQLServiceType {
+		if major, err := strconv.Atoi(parts[0]); err == nil && major >= 10 {
+			return parts[0]
+		}
+	}
+	if len(parts) < 2 {
+		return parts[0]
+	}
+	return parts[0] + "." + parts[1]
+}
+
+// InventoryCollector collects numbers of monitored instances by type, engine and major version,
+// and QAN status from the database.
+type InventoryCollector struct {
+	db *reform.DB
+}
+
+// NewInventoryCollector creates a new InventoryCollector.
+func NewInventoryCollector(db *reform.DB) *InventoryCollector {
+	return &InventoryCollector{
+		db: db,
+	}
+}
+
+// Collect implements Collector.
+func (c *InventoryCollector) Collect(ctx context.Context) ([]Metric, error) {
+	var services []*models.RemoteService
+	var qanAgents int
+	err := c.db.InTransaction(func(tx *reform.TX) error {
+		structs, err := tx.SelectAllFrom(models.RemoteServiceTable, "")
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		services = make([]*models.RemoteService, len(structs))
+		for i, str := range structs {
+			services[i] = str.(*models.RemoteService)
+		}
+
+		agents, err := tx.SelectAllFrom(models.AgentTable, "WHERE type = "+tx.Placeholder(1), models.QanAgentAgentType)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		qanAgents = len(agents)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return inventoryMetrics(services, qanAgents), nil
+}
+
+// keyReplacer removes payload separators from keys.
+var keyReplacer = strings.NewReplacer(";", " ", "\n", " ")
+
+// inventoryMetrics returns bucketed metrics for given services and number of QAN agents.
+func inventoryMetrics(services []*models.RemoteService, qanAgents int) []Metric {
+	counts := make(map[string]int)
+	for _, s := range services {
+		engine, version := "unknown", "unknown"
+		if s.Engine != nil && *s.Engine != "" {
+			engine = *s.Engine
+		}
+		if s.EngineVersion != nil && *s.EngineVersion != "" {
+			version = majorVersion(s.Type, *s.EngineVersion)
+		}
+		key := keyReplacer.Replace(fmt.Sprintf("Instances/%s/%s/%s", s.Type, engine, version))
+		counts[key]++
+	}
+
+	res := make([]Metric, 0, len(counts)+1)
+	for key, n := range counts {
+		res = append(res, Metric{key, Bucket(n)})
+	}
+	sort.Slice(res, func(i, j int) bool { return res[i].Key < res[j].Key })
+
+	qan := "disabled"
+	if qanAgents > 0 {
+		qan = "enabled"
+	}
+	return append(res, Metric{"QAN", qan})
+}
+
+// ScrapeConfigsLister is a subset of prometheus.Service methods used by ScrapeJobsCollector.
+type ScrapeConfigsLister interface {
+	ListScrapeConfigs(ctx context.Context) ([]prometheus.ScrapeConfig, []prometheus.ScrapeTargetHealth, error)
+}
+
+// ScrapeJobsCollector collects number of user-managed Prometheus scrape jobs.
+type ScrapeJobsCollector struct {
+	prometheus ScrapeConfigsLister
+}
+
+// NewScrapeJobsCollector creates a new ScrapeJobsCollector.
+func NewScrapeJobsCollector(prometheus ScrapeConfigsLister) *ScrapeJobsCollector {
+	return &ScrapeJobsCollector{
+		prometheus: prometheus,
+	}
+}
+
+// Collect implements Collector.
+func (c *ScrapeJobsCollector) Collect(ctx context.Context) ([]Metric, error) {
+	configs, _, err := c.prometheus.ListScrapeConfigs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return []Metric{{"ScrapeJobs", Bucket(len(configs))}}, nil
+}
+
+// check interfaces
+var (
+	_ Collector           = (*InventoryCollector)(nil)
+	_ Collector           = (*ScrapeJobsCollector)(nil)
+	_ ScrapeConfigsLister = (*prometheus.Service)(nil)
+)
@@ -0,0 +1,125 @@
+// pmm-managed
+// Copyright (C) 2017 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package telemetry
+
+import (
+	"context"
+	"testing"
+
+	"github.com/AlekSi/pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/percona/pmm-managed/models"
+	"github.com/percona/pmm-managed/services/prometheus"
+)
+
+func TestBucket(t *testing.T) {
+	for n, expected := range map[int]string{
+		0:    "0",
+		1:    "1",
+		2:    "2-5",
+		5:    "2-5",
+		6:    "6-10",
+		10:   "6-10",
+		11:   "11-20",
+		42:   "21-50",
+		100:  "51-100",
+		101:  "101-200",
+		500:  "201-500",
+		501:  ">500",
+		1000: ">500",
+	} {
+		assert.Equal(t, expected, Bucket(n), "%d", n)
+	}
+}
+
+func TestMajorVersion(t *testing.T) {
+	for _, tc := range []struct {
+		serviceType models.ServiceType
+		version     string
+		expected    string
+	}{
+		{models.MySQLServiceType, "5.7.19", "5.7"},
+		{models.MySQLServiceType, "10.2.14", "10.2"},
+		{models.RDSServiceType, "5.6.10a", "5.6"},
+		{models.PostgreSQLServiceType, "9.6.10", "9.6"},
+		{models.PostgreSQLServiceType, "10.5", "10"},
+		{models.PostgreSQLServiceType, "11", "11"},
+		{models.MySQLServiceType, "8", "8"},
+	} {
+		assert.Equal(t, tc.expected, majorVersion(tc.serviceType, tc.version), "%+v", tc)
+	}
+}
+
+func TestInventoryMetrics(t *testing.T) {
+	service := func(serviceType models.ServiceType, engine, version string) *models.RemoteService {
+		return &models.RemoteService{
+			Type:          serviceType,
+			Engine:        pointer.ToString(engine),
+			EngineVersion: pointer.ToString(version),
+		}
+	}
+
+	t.Run("Empty", func(t *testing.T) {
+		assert.Equal(t, []Metric{{"QAN", "disabled"}}, inventoryMetrics(nil, 0))
+	})
+
+	t.Run("Normal", func(t *testing.T) {
+		services := []*models.RemoteService{
+			service(models.MySQLServiceType, "MySQL", "5.7.19"),
+			service(models.MySQLServiceType, "MySQL", "5.7.22"),
+			service(models.MySQLServiceType, "Percona Server", "5.7.23-23"),
+			service(models.MySQLServiceType, "MariaDB", "10.2.14"),
+			service(models.PostgreSQLServiceType, "PostgreSQL", "10.5"),
+			service(models.PostgreSQLServiceType, "PostgreSQL", "9.6.10"),
+			service(models.RDSServiceType, "Amazon Aurora MySQL", "5.6.10a"),
+			service(models.RDSServiceType, "", ""),
+			service(models.RDSServiceType, "Evil;Engine", "1\n2"),
+		}
+		for i := 0; i < 6; i++ {
+			services = append(services, service(models.RDSServiceType, "MySQL", "5.7.19"))
+		}
+
+		expected := []Metric{
+			{"Instances/mysql/MariaDB/10.2", "1"},
+			{"Instances/mysql/MySQL/5.7", "2-5"},
+			{"Instances/mysql/Percona Server/5.7", "1"},
+			{"Instances/postgresql/PostgreSQL/10", "1"},
+			{"Instances/postgresql/PostgreSQL/9.6", "1"},
+			{"Instances/rds/Amazon Aurora MySQL/5.6", "1"},
+			{"Instances/rds/Evil Engine/1 2", "1"},
+			{"Instances/rds/MySQL/5.7", "6-10"},
+			{"Instances/rds/unknown/unknown", "1"},
+			{"QAN", "enabled"},
+		}
+		assert.Equal(t, expected, inventoryMetrics(services, 3))
+	})
+}
+
+type testScrapeConfigsLister []prometheus.ScrapeConfig
+
+func (l testScrapeConfigsLister) ListScrapeConfigs(ctx context.Context) ([]prometheus.ScrapeConfig, []prometheus.ScrapeTargetHealth, error) {
+	return l, nil, nil
+}
+
+func TestScrapeJobsCollector(t *testing.T) {
+	c := NewScrapeJobsCollector(testScrapeConfigsLister(make([]prometheus.ScrapeConfig, 3)))
+	metrics, err := c.Collect(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, []Metric{{"ScrapeJobs", "2-5"}}, metrics)
+}
@@ -18,12 +18,21 @@ package telemetry
 
 import (
 	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"strconv"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/percona/pmm-managed/utils/tests"
 )
 
 func TestIntegration(t *testing.T) {
@@ -37,15 +46,158 @@ func TestIntegration(t *testing.T) {
 
 	uuid, err := GenerateUUID()
 	require.NoError(t, err)
-	s := NewService(uuid, "1.3.1")
-	assert.True(t, s.init())
+	s := NewService(uuid, "1.3.1", nil)
+	s.init()
+	assert.True(t, s.isEnabled())
 	assert.NoError(t, s.sendOnce(context.Background()))
 }
 
+type testCollector struct {
+	metrics []Metric
+	err     error
+}
+
+func (c *testCollector) Collect(ctx context.Context) ([]Metric, error) {
+	return c.metrics, c.err
+}
+
 func TestMakePayload(t *testing.T) {
-	s := NewService("ECAB81E4C47D456CA9EC20AEBF91AB44", "1.3.1")
+	s := NewService("ECAB81E4C47D456CA9EC20AEBF91AB44", "1.3.1", nil)
 	expected := "ECAB81E4C47D456CA9EC20AEBF91AB44;OS;\nECAB81E4C47D456CA9EC20AEBF91AB44;PMM;1.3.1\n"
-	assert.Equal(t, expected, string(s.makePayload())) // \n are important
+	assert.Equal(t, expected, string(s.makePayload(context.Background()))) // \n are important
+
+	s = NewService("ECAB81E4C47D456CA9EC20AEBF91AB44", "1.3.1", nil,
+		&testCollector{metrics: []Metric{{"Instances/mysql/MySQL/5.7", "2-5"}, {"QAN", "enabled"}}},
+		&testCollector{err: errors.New("test error")},
+		&testCollector{metrics: []Metric{{"ScrapeJobs", "0"}}},
+	)
+	expected = "ECAB81E4C47D456CA9EC20AEBF91AB44;OS;\n" +
+		"ECAB81E4C47D456CA9EC20AEBF91AB44;PMM;1.3.1\n" +
+		"ECAB81E4C47D456CA9EC20AEBF91AB44;Instances/mysql/MySQL/5.7;2-5\n" +
+		"ECAB81E4C47D456CA9EC20AEBF91AB44;QAN;enabled\n" +
+		"ECAB81E4C47D456CA9EC20AEBF91AB44;ScrapeJobs;0\n"
+	assert.Equal(t, expected, string(s.makePayload(context.Background())))
+}
+
+type testKV map[string][]byte
+
+func (kv testKV) GetKV(key string) ([]byte, error) {
+	return kv[key], nil
+}
+
+func (kv testKV) PutKV(key string, value []byte) error {
+	kv[key] = value
+	return nil
+}
+
+// failingKV returns error from GetKV while fail is not zero.
+type failingKV struct {
+	testKV
+	fail int32
+}
+
+func (kv *failingKV) GetKV(key string) ([]byte, error) {
+	if atomic.LoadInt32(&kv.fail) != 0 {
+		return nil, errors.New("unavailable")
+	}
+	return kv.testKV.GetKV(key)
+}
+
+func TestSettings(t *testing.T) {
+	if v, ok := os.LookupEnv(envDisable); ok {
+		os.Unsetenv(envDisable)
+		defer os.Setenv(envDisable, v)
+	}
+
+	t.Run("Normal", func(t *testing.T) {
+		kv := make(testKV)
+		s := NewService("ECAB81E4C47D456CA9EC20AEBF91AB44", "1.3.1", kv)
+		settings := s.Settings()
+		assert.True(t, settings.Enabled)
+		assert.False(t, settings.DisabledByEnv)
+		assert.Equal(t, "ECAB81E4C47D456CA9EC20AEBF91AB44", settings.UUID)
+
+		require.NoError(t, s.SetEnabled(context.Background(), false))
+		assert.False(t, s.Settings().Enabled)
+		assert.Equal(t, "false", string(kv[EnabledKey]))
+
+		// setting is persisted
+		s = NewService("ECAB81E4C47D456CA9EC20AEBF91AB44", "1.3.1", kv)
+		assert.False(t, s.Settings().Enabled)
+		require.NoError(t, s.SetEnabled(context.Background(), true))
+		assert.True(t, s.Settings().Enabled)
+		assert.Equal(t, "true", string(kv[EnabledKey]))
+	})
+
+	t.Run("FailingKV", func(t *testing.T) {
+		sent := make(chan struct{}, 1)
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			select {
+			case sent <- struct{}{}:
+			default:
+			}
+		}))
+		defer ts.Close()
+		os.Setenv(envURL, ts.URL)
+		defer os.Unsetenv(envURL)
+
+		oldRetry := loadRetryInterval
+		loadRetryInterval = 10 * time.Millisecond
+		defer func() { loadRetryInterval = oldRetry }()
+
+		kv := &failingKV{testKV: testKV{EnabledKey: []byte("true")}, fail: 1}
+		s := NewService("ECAB81E4C47D456CA9EC20AEBF91AB44", "1.3.1", kv)
+		assert.False(t, s.Settings().Enabled, "should be disabled until setting is read")
+
+		ctx, cancel := context.WithCancel(context.Background())
+		done := make(chan struct{})
+		go func() {
+			s.Run(ctx)
+			close(done)
+		}()
+
+		select {
+		case <-sent:
+			t.Fatal("data should not be sent while setting can't be read")
+		case <-time.After(100 * time.Millisecond):
+		}
+
+		atomic.StoreInt32(&kv.fail, 0)
+		select {
+		case <-sent:
+		case <-time.After(5 * time.Second):
+			t.Fatal("data should be sent after setting is read")
+		}
+		assert.True(t, s.Settings().Enabled)
+
+		cancel()
+		<-done
+	})
+
+	t.Run("DisabledByEnv", func(t *testing.T) {
+		os.Setenv(envDisable, "1")
+		defer os.Unsetenv(envDisable)
+
+		s := NewService("ECAB81E4C47D456CA9EC20AEBF91AB44", "1.3.1", make(testKV))
+		settings := s.Settings()
+		assert.False(t, settings.Enabled)
+		assert.True(t, settings.DisabledByEnv)
+
+		err := s.SetEnabled(context.Background(), true)
+		tests.AssertGRPCError(t, status.New(codes.FailedPrecondition, "Telemetry is disabled by DISABLE_TELEMETRY environment variable."), err)
+		assert.NoError(t, s.SetEnabled(context.Background(), false))
+	})
+}
+
+func TestPreview(t *testing.T) {
+	os.Setenv(envOS, "Test OS")
+	defer os.Unsetenv(envOS)
+
+	s := NewService("ECAB81E4C47D456CA9EC20AEBF91AB44", "1.3.1", nil, &testCollector{metrics: []Metric{{"QAN", "disabled"}}})
+	expected := "ECAB81E4C47D456CA9EC20AEBF91AB44;OS;Test OS\n" +
+		"ECAB81E4C47D456CA9EC20AEBF91AB44;PMM;1.3.1\n" +
+		"ECAB81E4C47D456CA9EC20AEBF91AB44;QAN;disabled\n"
+	assert.Equal(t, expected, string(s.Preview(context.Background())))
 }
 
 func TestGetLinuxDistribution(t *testing.T) {
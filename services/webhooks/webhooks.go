@@ -0,0 +1,492 @@
+// pmm-managed
+// Copyright (C) 2017 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+// Package webhooks delivers inventory and health events to outbound webhooks.
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gopkg.in/reform.v1"
+
+	"github.com/percona/pmm-managed/models"
+	"github.com/percona/pmm-managed/services/events"
+	"github.com/percona/pmm-managed/utils/logger"
+)
+
+// Event types sent to webhooks.
+const (
+	InstanceAdded   = "instance_added"
+	InstanceRemoved = "instance_removed"
+	TargetDown      = "target_down"
+
+	// sent only by Service.Test
+	testEvent = "test"
+)
+
+// eventTypes maps bus events to webhook event types; other bus events are not sent.
+var eventTypes = map[events.Type]string{
+	events.ServiceAdded:   InstanceAdded,
+	events.ServiceRemoved: InstanceRemoved,
+	events.TargetDown:     TargetDown,
+}
+
+// HTTP headers of webhook requests.
+const (
+	EventHeader     = "X-PMM-Event"
+	SignatureHeader = "X-PMM-Signature" // "sha256=" + hex-encoded HMAC-SHA256 of request body
+)
+
+const (
+	requestTimeout = 10 * time.Second
+
+	// delivery is retried with exponential backoff, then event is stored as a dead letter
+	maxAttempts    = 5
+	initialBackoff = 2 * time.Second
+	maxBackoff     = time.Minute
+
+	maxConcurrentRequests = 16
+	maxPendingDeliveries  = 1000 // events for slow webhooks are stored as dead letters above that
+	maxDeadLetters        = 1000
+)
+
+// Payload is a JSON body of webhook request.
+type Payload struct {
+	Event    string    `json:"event"`
+	Time     time.Time `json:"time"`
+	StreamID string    `json:"stream_id,omitempty"` // see Events API
+	Seq      uint64    `json:"seq,omitempty"`       // see Events API
+
+	// for instance events
+	NodeID      int32  `json:"node_id,omitempty"`
+	NodeType    string `json:"node_type,omitempty"`
+	NodeName    string `json:"node_name,omitempty"`
+	ServiceID   int32  `json:"service_id,omitempty"`
+	ServiceType string `json:"service_type,omitempty"`
+
+	// for target_down events
+	JobName  string `json:"job_name,omitempty"`
+	Instance string `json:"instance,omitempty"`
+}
+
+// Sign returns signature of webhook request body for SignatureHeader.
+func Sign(secret string, body []byte) string {
+	h := hmac.New(sha256.New, []byte(secret))
+	h.Write(body) //nolint:errcheck
+	return "sha256=" + hex.EncodeToString(h.Sum(nil))
+}
+
+// ServiceConfig contains configuration for webhooks.Service
+type ServiceConfig struct {
+	DB     *reform.DB
+	Events *events.Bus
+}
+
+// Service is responsible for webhooks management and asynchronous delivery.
+type Service struct {
+	*ServiceConfig
+	httpClient *http.Client
+	sem        chan struct{} // limits concurrent requests
+	pending    int32         // number of pending deliveries, accessed atomically
+	wg         sync.WaitGroup
+
+	// webhooks cache for dispatch; reset by Create and Delete
+	cacheM   sync.Mutex
+	cache    []models.Webhook // nil if not loaded
+	cacheGen int              // incremented on reset
+
+	// changed by tests
+	maxAttempts    int
+	initialBackoff time.Duration
+}
+
+// NewService creates a new service.
+func NewService(config *ServiceConfig) *Service {
+	return &Service{
+		ServiceConfig: config,
+		httpClient: &http.Client{
+			Timeout: requestTimeout,
+		},
+		sem:            make(chan struct{}, maxConcurrentRequests),
+		maxAttempts:    maxAttempts,
+		initialBackoff: initialBackoff,
+	}
+}
+
+// Create creates a new webhook. Empty event types slice means all event types.
+func (svc *Service) Create(ctx context.Context, webhookURL, secret string, types []string) (*models.Webhook, error) {
+	u, err := url.Parse(webhookURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid webhook URL %q.", webhookURL)
+	}
+	if secret == "" {
+		return nil, status.Error(codes.InvalidArgument, "Webhook secret is not given.")
+	}
+	for _, t := range types {
+		switch t {
+		case InstanceAdded, InstanceRemoved, TargetDown:
+		default:
+			return nil, status.Errorf(codes.InvalidArgument, "Unknown webhook event type %q.", t)
+		}
+	}
+
+	webhook := &models.Webhook{
+		URL:       webhookURL,
+		Secret:    models.Secret(secret),
+		CreatedAt: time.Now().UTC().Truncate(time.Second),
+	}
+	webhook.SetEventTypes(types)
+	if err = svc.DB.Insert(webhook); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	svc.resetCache()
+	return webhook, nil
+}
+
+// List returns all webhooks.
+func (svc *Service) List(ctx context.Context) ([]models.Webhook, error) {
+	structs, err := svc.DB.SelectAllFrom(models.WebhookTable, "ORDER BY id")
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	webhooks := make([]models.Webhook, len(structs))
+	for i, str := range structs {
+		webhooks[i] = *str.(*models.Webhook)
+	}
+	return webhooks, nil
+}
+
+// cached returns all webhooks from cache, loading them if needed.
+func (svc *Service) cached(ctx context.Context) ([]models.Webhook, error) {
+	svc.cacheM.Lock()
+	webhooks, gen := svc.cache, svc.cacheGen
+	svc.cacheM.Unlock()
+	if webhooks != nil {
+		return webhooks, nil
+	}
+
+	webhooks, err := svc.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if webhooks == nil {
+		webhooks = []models.Webhook{}
+	}
+
+	svc.cacheM.Lock()
+	if svc.cacheGen == gen { // do not store stale list if cache was reset while loading
+		svc.cache = webhooks
+	}
+	svc.cacheM.Unlock()
+	return webhooks, nil
+}
+
+// resetCache resets webhooks cache after changes.
+func (svc *Service) resetCache() {
+	svc.cacheM.Lock()
+	svc.cache = nil
+	svc.cacheGen++
+	svc.cacheM.Unlock()
+}
+
+func (svc *Service) get(q *reform.Querier, id int32) (*models.Webhook, error) {
+	webhook := &models.Webhook{ID: id}
+	if err := q.Reload(webhook); err != nil {
+		if err == reform.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "Webhook with ID %d not found.", id)
+		}
+		return nil, errors.WithStack(err)
+	}
+	return webhook, nil
+}
+
+// Delete removes webhook and its dead letters.
+func (svc *Service) Delete(ctx context.Context, id int32) error {
+	defer svc.resetCache()
+
+	return svc.DB.InTransaction(func(tx *reform.TX) error {
+		webhook, err := svc.get(tx.Querier, id)
+		if err != nil {
+			return err
+		}
+		if _, err = tx.DeleteFrom(models.WebhookDeadLetterTable, "WHERE webhook_id = "+tx.Placeholder(1), id); err != nil {
+			return errors.WithStack(err)
+		}
+		return errors.WithStack(tx.Delete(webhook))
+	})
+}
+
+// ListDeadLetters returns dead letters of a given webhook (or all if webhookID is zero), from the newest to the oldest.
+func (svc *Service) ListDeadLetters(ctx context.Context, webhookID int32) ([]models.WebhookDeadLetter, error) {
+	return models.FindWebhookDeadLetters(svc.DB.Querier, webhookID)
+}
+
+// TestResult represents a result of a single test delivery.
+type TestResult struct {
+	StatusCode int   // zero if request was not sent
+	Err        error // delivery error, if any
+}
+
+// Test synchronously sends a single test event to webhook, without retries.
+// Delivery errors are returned in TestResult.
+func (svc *Service) Test(ctx context.Context, id int32) (*TestResult, error) {
+	webhook, err := svc.get(svc.DB.Querier, id)
+	if err != nil {
+		return nil, err
+	}
+	body, err := json.Marshal(&Payload{
+		Event: testEvent,
+		Time:  time.Now().UTC(),
+	})
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	var res TestResult
+	res.StatusCode, res.Err = svc.send(ctx, webhook, testEvent, body)
+	return &res, nil
+}
+
+// send makes a single delivery attempt. It returns HTTP status code (zero if request was not sent)
+// and error for failed requests and non-2xx responses.
+func (svc *Service) send(ctx context.Context, webhook *models.Webhook, event string, body []byte) (int, error) {
+	req, err := http.NewRequest("POST", webhook.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventHeader, event)
+	req.Header.Set(SignatureHeader, Sign(string(webhook.Secret), body))
+
+	resp, err := svc.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+	defer resp.Body.Close()
+	io.Copy(ioutil.Discard, io.LimitReader(resp.Body, 1024*1024)) //nolint:errcheck
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, errors.Errorf("unexpected response status %q", resp.Status)
+	}
+	return resp.StatusCode, nil
+}
+
+// Run delivers events to webhooks until ctx is canceled, then waits for in-flight deliveries to finish.
+// Events are delivered asynchronously, concurrently and in no particular order.
+func (svc *Service) Run(ctx context.Context) {
+	l := logger.Get(ctx).WithField("component", "webhooks")
+	defer svc.wg.Wait()
+
+	var lastSeq uint64
+	streamID := svc.Events.StreamID()
+	for ctx.Err() == nil {
+		sub, err := svc.Events.Subscribe(streamID, lastSeq)
+		if err != nil {
+			l.Warnf("Events after %s-%d are not available, they will not be delivered: %s.", streamID, lastSeq, err)
+			lastSeq = 0
+			streamID = svc.Events.StreamID()
+			continue
+		}
+		lastSeq = svc.consume(ctx, sub, lastSeq)
+		sub.Close()
+	}
+}
+
+// consume dispatches events from subscription until error and returns the last dispatched sequence number.
+func (svc *Service) consume(ctx context.Context, sub *events.Subscription, lastSeq uint64) uint64 {
+	l := logger.Get(ctx).WithField("component", "webhooks")
+
+	for {
+		e, err := sub.Next(ctx)
+		if err != nil {
+			if err == events.ErrOverflow {
+				l.Warnf("Too many events are queued, resubscribing.")
+			}
+			return lastSeq
+		}
+		lastSeq = e.Seq
+
+		event, ok := eventTypes[e.Type]
+		if !ok {
+			continue
+		}
+		if err = svc.dispatch(ctx, event, e); err != nil {
+			l.Errorf("Failed to dispatch event %d: %+v", e.Seq, err)
+		}
+	}
+}
+
+// dispatch starts delivery of a given event to all subscribed webhooks without waiting for it.
+func (svc *Service) dispatch(ctx context.Context, event string, e *events.Event) error {
+	webhooks, err := svc.cached(ctx)
+	if err != nil {
+		return err
+	}
+
+	body, err := json.Marshal(&Payload{
+		Event:       event,
+		Time:        e.Time,
+		StreamID:    e.StreamID,
+		Seq:         e.Seq,
+		NodeID:      e.NodeID,
+		NodeType:    string(e.NodeType),
+		NodeName:    e.NodeName,
+		ServiceID:   e.ServiceID,
+		ServiceType: string(e.ServiceType),
+		JobName:     e.JobName,
+		Instance:    e.Instance,
+	})
+	if err != nil {
+		return errors.WithStack(err)
+	}
+
+	for i := range webhooks {
+		webhook := &webhooks[i]
+		if !subscribed(webhook, event) {
+			continue
+		}
+
+		if atomic.AddInt32(&svc.pending, 1) > maxPendingDeliveries {
+			atomic.AddInt32(&svc.pending, -1)
+			svc.storeDeadLetter(ctx, webhook, event, body, 0, errors.New("too many pending deliveries"))
+			continue
+		}
+		svc.wg.Add(1)
+		go func() {
+			defer func() {
+				atomic.AddInt32(&svc.pending, -1)
+				svc.wg.Done()
+			}()
+			svc.deliver(ctx, webhook, event, body)
+		}()
+	}
+	return nil
+}
+
+// subscribed returns true if webhook should receive events of a given type.
+func subscribed(webhook *models.Webhook, event string) bool {
+	types := webhook.EventTypes()
+	if len(types) == 0 {
+		return true
+	}
+	for _, t := range types {
+		if t == event {
+			return true
+		}
+	}
+	return false
+}
+
+// deliver sends event to webhook, retrying with exponential backoff. If all attempts fail,
+// or ctx is canceled before that, event is stored as a dead letter.
+func (svc *Service) deliver(ctx context.Context, webhook *models.Webhook, event string, body []byte) {
+	l := logger.Get(ctx).WithField("component", "webhooks")
+
+	backoff := svc.initialBackoff
+	var attempt int
+	var lastErr error
+	for attempt = 1; ; attempt++ {
+		// do not hold semaphore during backoff, so retries do not block other deliveries
+		select {
+		case svc.sem <- struct{}{}:
+			_, lastErr = svc.send(ctx, webhook, event, body)
+			<-svc.sem
+		case <-ctx.Done():
+			lastErr = ctx.Err()
+		}
+		if lastErr == nil {
+			return
+		}
+		l.Warnf("Webhook %d: attempt %d of %d failed: %s.", webhook.ID, attempt, svc.maxAttempts, lastErr)
+		if attempt >= svc.maxAttempts {
+			break
+		}
+
+		t := time.NewTimer(backoff)
+		select {
+		case <-t.C:
+		case <-ctx.Done():
+			t.Stop()
+		}
+		if ctx.Err() != nil {
+			lastErr = errors.Wrap(ctx.Err(), "pmm-managed is shutting down")
+			break
+		}
+		if backoff *= 2; backoff > maxBackoff {
+			backoff = maxBackoff
+		}
+
+		// stop if webhook was removed
+		webhooks, err := svc.cached(ctx)
+		if err != nil {
+			l.Errorf("%+v", err)
+			continue
+		}
+		var found bool
+		for _, w := range webhooks {
+			if w.ID == webhook.ID {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return
+		}
+	}
+
+	svc.storeDeadLetter(ctx, webhook, event, body, attempt, lastErr)
+}
+
+// storeDeadLetter stores undelivered event.
+func (svc *Service) storeDeadLetter(ctx context.Context, webhook *models.Webhook, event string, body []byte, attempts int, lastErr error) {
+	l := logger.Get(ctx).WithField("component", "webhooks")
+
+	err := svc.DB.InTransaction(func(tx *reform.TX) error {
+		letter := &models.WebhookDeadLetter{
+			WebhookID: webhook.ID,
+			CreatedAt: time.Now().UTC(),
+			Event:     event,
+			Payload:   string(body),
+			Attempts:  attempts,
+			LastError: lastErr.Error(),
+		}
+		if err := tx.Insert(letter); err != nil {
+			return errors.WithStack(err)
+		}
+		_, err := models.PruneWebhookDeadLetters(tx.Querier, maxDeadLetters)
+		return err
+	})
+	if err != nil {
+		l.Errorf("Failed to store dead letter for webhook %d: %+v", webhook.ID, err)
+		return
+	}
+	l.Errorf("Webhook %d: event %s was not delivered, stored as a dead letter.", webhook.ID, event)
+}
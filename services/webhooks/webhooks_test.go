@@ -0,0 +1,337 @@
+// pmm-managed
+// Copyright (C) 2017 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gopkg.in/reform.v1"
+
+	"github.com/percona/pmm-managed/models"
+	"github.com/percona/pmm-managed/services/events"
+	"github.com/percona/pmm-managed/utils/logger"
+	"github.com/percona/pmm-managed/utils/tests"
+)
+
+// receiver is a test webhook endpoint.
+type receiver struct {
+	t      *testing.T
+	secret string
+	fail   int // number of requests to fail
+
+	m        sync.Mutex
+	requests int
+	payloads []Payload
+}
+
+func (r *receiver) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	b, err := ioutil.ReadAll(req.Body)
+	require.NoError(r.t, err)
+	assert.Equal(r.t, Sign(r.secret, b), req.Header.Get(SignatureHeader))
+
+	var p Payload
+	require.NoError(r.t, json.Unmarshal(b, &p))
+	assert.Equal(r.t, p.Event, req.Header.Get(EventHeader))
+
+	r.m.Lock()
+	defer r.m.Unlock()
+	r.requests++
+	if r.fail != 0 {
+		r.fail--
+		rw.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	r.payloads = append(r.payloads, p)
+}
+
+func (r *receiver) get() (int, []Payload) {
+	r.m.Lock()
+	defer r.m.Unlock()
+	return r.requests, r.payloads
+}
+
+func setup(t *testing.T) (context.Context, *Service, func()) {
+	t.Helper()
+
+	ctx, _ := logger.Set(context.Background(), t.Name())
+	sqlDB := tests.OpenTestDB(t)
+	db := reform.NewDB(sqlDB, tests.TestDBDialect(t), reform.NewPrintfLogger(t.Logf))
+	svc := NewService(&ServiceConfig{
+		DB:     db,
+		Events: events.NewBus(events.DefaultHistorySize),
+	})
+	svc.initialBackoff = time.Millisecond
+	return ctx, svc, func() {
+		require.NoError(t, sqlDB.Close())
+	}
+}
+
+// run delivers events published after it returns until returned function is called.
+func run(ctx context.Context, t *testing.T, svc *Service) func() {
+	sub, err := svc.Events.Subscribe("", 0)
+	require.NoError(t, err)
+	ctx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	go func() {
+		svc.consume(ctx, sub, 0)
+		close(done)
+	}()
+	return func() {
+		cancel()
+		<-done
+		sub.Close()
+		svc.wg.Wait()
+	}
+}
+
+// waitFor waits until cond returns true, or fails test after timeout.
+func waitFor(t *testing.T, cond func() bool) {
+	t.Helper()
+
+	for deadline := time.Now().Add(5 * time.Second); !cond(); {
+		if time.Now().After(deadline) {
+			t.Fatal("Timeout.")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestCRUD(t *testing.T) {
+	ctx, svc, teardown := setup(t)
+	defer teardown()
+
+	for _, tc := range []struct {
+		url    string
+		secret string
+		types  []string
+	}{
+		{"", "secret", nil},
+		{"ftp://127.0.0.1/", "secret", nil},
+		{"http:///path", "secret", nil},
+		{"http://127.0.0.1/", "", nil},
+		{"http://127.0.0.1/", "secret", []string{InstanceAdded, "node_added"}},
+	} {
+		_, err := svc.Create(ctx, tc.url, tc.secret, tc.types)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err), "%+v", tc)
+	}
+
+	w1, err := svc.Create(ctx, "http://127.0.0.1/1", "secret1", nil)
+	require.NoError(t, err)
+	w2, err := svc.Create(ctx, "https://127.0.0.1/2", "secret2", []string{TargetDown})
+	require.NoError(t, err)
+
+	webhooks, err := svc.List(ctx)
+	require.NoError(t, err)
+	require.Len(t, webhooks, 2)
+	assert.Equal(t, *w1, webhooks[0])
+	assert.Equal(t, *w2, webhooks[1])
+	assert.Equal(t, []string{TargetDown}, webhooks[1].EventTypes())
+
+	require.NoError(t, svc.Delete(ctx, w1.ID))
+	err = svc.Delete(ctx, w1.ID)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	webhooks, err = svc.List(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []models.Webhook{*w2}, webhooks)
+}
+
+func TestTest(t *testing.T) {
+	ctx, svc, teardown := setup(t)
+	defer teardown()
+
+	r := &receiver{t: t, secret: "secret", fail: 1}
+	ts := httptest.NewServer(r)
+	defer ts.Close()
+	w, err := svc.Create(ctx, ts.URL, r.secret, []string{InstanceAdded})
+	require.NoError(t, err)
+
+	res, err := svc.Test(ctx, w.ID)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusInternalServerError, res.StatusCode)
+	assert.EqualError(t, res.Err, `unexpected response status "500 Internal Server Error"`)
+
+	res, err = svc.Test(ctx, w.ID)
+	require.NoError(t, err)
+	assert.Equal(t, &TestResult{StatusCode: http.StatusOK}, res)
+	_, payloads := r.get()
+	require.Len(t, payloads, 1)
+	assert.Equal(t, "test", payloads[0].Event)
+
+	_, err = svc.Test(ctx, w.ID+1)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestDelivery(t *testing.T) {
+	t.Run("Normal", func(t *testing.T) {
+		ctx, svc, teardown := setup(t)
+		defer teardown()
+
+		all := &receiver{t: t, secret: "secret1", fail: 1}
+		ts1 := httptest.NewServer(all)
+		defer ts1.Close()
+		_, err := svc.Create(ctx, ts1.URL, all.secret, nil)
+		require.NoError(t, err)
+
+		down := &receiver{t: t, secret: "secret2"}
+		ts2 := httptest.NewServer(down)
+		defer ts2.Close()
+		_, err = svc.Create(ctx, ts2.URL, down.secret, []string{TargetDown})
+		require.NoError(t, err)
+
+		stop := run(ctx, t, svc)
+		svc.Events.Publish(
+			events.Event{Type: events.NodeAdded, NodeID: 1},
+			events.Event{Type: events.ServiceAdded, NodeID: 1, NodeType: models.RemoteNodeType, NodeName: "db1", ServiceID: 1000, ServiceType: models.MySQLServiceType},
+		)
+		svc.Events.Publish(events.Event{Type: events.TargetDown, JobName: "mysql", Instance: "db1"})
+		waitFor(t, func() bool {
+			_, a := all.get()
+			_, d := down.get()
+			return len(a) == 2 && len(d) == 1 && atomic.LoadInt32(&svc.pending) == 0
+		})
+		stop()
+
+		requests, payloads := all.get()
+		assert.Equal(t, 3, requests, "the first request should be retried")
+		byEvent := make(map[string]Payload)
+		for _, p := range payloads {
+			byEvent[p.Event] = p
+		}
+		added := byEvent[InstanceAdded]
+		assert.Equal(t, uint64(2), added.Seq)
+		assert.Equal(t, int32(1000), added.ServiceID)
+		assert.Equal(t, "remote", added.NodeType)
+		assert.Equal(t, "db1", added.NodeName)
+		assert.False(t, added.Time.IsZero())
+		assert.Equal(t, "mysql", byEvent[TargetDown].JobName)
+
+		_, payloads = down.get()
+		assert.Equal(t, TargetDown, payloads[0].Event)
+		assert.Equal(t, "db1", payloads[0].Instance)
+
+		letters, err := svc.ListDeadLetters(ctx, 0)
+		require.NoError(t, err)
+		assert.Empty(t, letters)
+	})
+
+	t.Run("DeadLetter", func(t *testing.T) {
+		ctx, svc, teardown := setup(t)
+		defer teardown()
+		svc.maxAttempts = 3
+
+		r := &receiver{t: t, secret: "secret", fail: 100}
+		ts := httptest.NewServer(r)
+		defer ts.Close()
+		w, err := svc.Create(ctx, ts.URL, r.secret, nil)
+		require.NoError(t, err)
+
+		stop := run(ctx, t, svc)
+		svc.Events.Publish(events.Event{Type: events.ServiceRemoved, ServiceID: 1000})
+		var letters []models.WebhookDeadLetter
+		waitFor(t, func() bool {
+			letters, err = svc.ListDeadLetters(ctx, w.ID)
+			require.NoError(t, err)
+			return len(letters) == 1
+		})
+		stop()
+
+		requests, _ := r.get()
+		assert.Equal(t, 3, requests)
+		assert.Equal(t, InstanceRemoved, letters[0].Event)
+		assert.Equal(t, 3, letters[0].Attempts)
+		assert.Equal(t, `unexpected response status "500 Internal Server Error"`, letters[0].LastError)
+		var p Payload
+		require.NoError(t, json.Unmarshal([]byte(letters[0].Payload), &p))
+		assert.Equal(t, int32(1000), p.ServiceID)
+
+		require.NoError(t, svc.Delete(ctx, w.ID))
+		letters, err = svc.ListDeadLetters(ctx, 0)
+		require.NoError(t, err)
+		assert.Empty(t, letters)
+	})
+
+	t.Run("Cache", func(t *testing.T) {
+		ctx, svc, teardown := setup(t)
+		defer teardown()
+
+		r1 := &receiver{t: t, secret: "secret1"}
+		ts1 := httptest.NewServer(r1)
+		defer ts1.Close()
+		w1, err := svc.Create(ctx, ts1.URL, r1.secret, nil)
+		require.NoError(t, err)
+
+		stop := run(ctx, t, svc)
+		svc.Events.Publish(events.Event{Type: events.ServiceAdded, ServiceID: 1000})
+		waitFor(t, func() bool { _, p := r1.get(); return len(p) == 1 })
+
+		// webhooks created and deleted after the first event are taken into account
+		r2 := &receiver{t: t, secret: "secret2"}
+		ts2 := httptest.NewServer(r2)
+		defer ts2.Close()
+		_, err = svc.Create(ctx, ts2.URL, r2.secret, nil)
+		require.NoError(t, err)
+		svc.Events.Publish(events.Event{Type: events.ServiceRemoved, ServiceID: 1000})
+		waitFor(t, func() bool { _, p1 := r1.get(); _, p2 := r2.get(); return len(p1) == 2 && len(p2) == 1 })
+
+		require.NoError(t, svc.Delete(ctx, w1.ID))
+		svc.Events.Publish(events.Event{Type: events.TargetDown, JobName: "mysql", Instance: "db1"})
+		waitFor(t, func() bool { _, p := r2.get(); return len(p) == 2 })
+		stop()
+
+		requests, _ := r1.get()
+		assert.Equal(t, 2, requests)
+	})
+
+	t.Run("TooManyPending", func(t *testing.T) {
+		ctx, svc, teardown := setup(t)
+		defer teardown()
+
+		r := &receiver{t: t, secret: "secret"}
+		ts := httptest.NewServer(r)
+		defer ts.Close()
+		w, err := svc.Create(ctx, ts.URL, r.secret, nil)
+		require.NoError(t, err)
+
+		svc.pending = maxPendingDeliveries
+		stop := run(ctx, t, svc)
+		svc.Events.Publish(events.Event{Type: events.ServiceAdded, ServiceID: 1000})
+		var letters []models.WebhookDeadLetter
+		waitFor(t, func() bool {
+			letters, err = svc.ListDeadLetters(ctx, w.ID)
+			require.NoError(t, err)
+			return len(letters) == 1
+		})
+		stop()
+
+		requests, _ := r.get()
+		assert.Equal(t, 0, requests)
+		assert.Equal(t, 0, letters[0].Attempts)
+		assert.Equal(t, "too many pending deliveries", letters[0].LastError)
+	})
+}
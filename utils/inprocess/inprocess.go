@@ -0,0 +1,89 @@
+// pmm-managed
+// Copyright (C) 2017 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+// Package inprocess provides a listener for connections within a single process.
+package inprocess
+
+import (
+	"net"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// errClosed is returned by Accept and Dial after listener is closed.
+var errClosed = errors.New("in-process listener is closed")
+
+// addr is a net.Addr of in-process listener.
+type addr struct{}
+
+func (addr) Network() string { return "inprocess" }
+func (addr) String() string  { return "inprocess" }
+
+// Listener is a net.Listener that accepts connections made by its Dial method.
+// Each connection is a synchronous in-memory pipe (see net.Pipe).
+type Listener struct {
+	conns     chan net.Conn
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+// NewListener creates a new in-process listener.
+func NewListener() *Listener {
+	return &Listener{
+		conns: make(chan net.Conn),
+		done:  make(chan struct{}),
+	}
+}
+
+// Accept waits for and returns the next connection made by Dial.
+func (l *Listener) Accept() (net.Conn, error) {
+	select {
+	case c := <-l.conns:
+		return c, nil
+	case <-l.done:
+		return nil, errClosed
+	}
+}
+
+// Close closes listener. Already accepted connections are not closed.
+func (l *Listener) Close() error {
+	l.closeOnce.Do(func() { close(l.done) })
+	return nil
+}
+
+// Addr returns listener's address.
+func (l *Listener) Addr() net.Addr {
+	return addr{}
+}
+
+// Dial creates a new connection to the listener. It blocks until connection is accepted or listener is closed.
+func (l *Listener) Dial() (net.Conn, error) {
+	server, client := net.Pipe()
+	select {
+	case l.conns <- server:
+		return client, nil
+	case <-l.done:
+		server.Close() //nolint:errcheck
+		client.Close() //nolint:errcheck
+		return nil, errClosed
+	}
+}
+
+// check interfaces
+var (
+	_ net.Listener = (*Listener)(nil)
+)
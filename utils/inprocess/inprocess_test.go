@@ -0,0 +1,83 @@
+// pmm-managed
+// Copyright (C) 2017 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package inprocess
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/percona/pmm-managed/api"
+)
+
+type testBaseServer struct{}
+
+func (testBaseServer) Version(context.Context, *api.BaseVersionRequest) (*api.BaseVersionResponse, error) {
+	return &api.BaseVersionResponse{Version: "1.2.3"}, nil
+}
+
+func (testBaseServer) SchemaVersion(context.Context, *api.BaseSchemaVersionRequest) (*api.BaseSchemaVersionResponse, error) {
+	return new(api.BaseSchemaVersionResponse), nil
+}
+
+func TestListener(t *testing.T) {
+	t.Run("GRPC", func(t *testing.T) {
+		l := NewListener()
+		server := grpc.NewServer()
+		api.RegisterBaseServer(server, testBaseServer{})
+		served := make(chan error, 1)
+		go func() {
+			served <- server.Serve(l)
+		}()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		conn, err := grpc.DialContext(ctx, "inprocess", grpc.WithInsecure(), grpc.WithBlock(),
+			grpc.WithDialer(func(string, time.Duration) (net.Conn, error) {
+				return l.Dial()
+			}),
+		)
+		require.NoError(t, err)
+
+		for i := 0; i < 10; i++ {
+			resp, err := api.NewBaseClient(conn).Version(ctx, new(api.BaseVersionRequest))
+			require.NoError(t, err)
+			assert.Equal(t, "1.2.3", resp.Version)
+		}
+
+		require.NoError(t, conn.Close())
+		server.GracefulStop()
+		assert.NoError(t, <-served)
+	})
+
+	t.Run("Closed", func(t *testing.T) {
+		l := NewListener()
+		assert.Equal(t, "inprocess", l.Addr().String())
+		require.NoError(t, l.Close())
+		require.NoError(t, l.Close())
+
+		_, err := l.Accept()
+		assert.Equal(t, errClosed, err)
+		_, err = l.Dial()
+		assert.Equal(t, errClosed, err)
+	})
+}
@@ -0,0 +1,243 @@
+// pmm-managed
+// Copyright (C) 2017 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package interceptors
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"github.com/pkg/errors"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"gopkg.in/reform.v1"
+
+	"github.com/percona/pmm-managed/models"
+	"github.com/percona/pmm-managed/utils/logger"
+)
+
+// readOnlyMethods contains RPCs which do not change anything, but do not follow naming conventions in IsMutating.
+var readOnlyMethods = map[string]bool{
+	"/api.Base/Version":       true,
+	"/api.Base/SchemaVersion": true,
+	"/api.Agents/Status":      true,
+	"/api.Demo/Error":         true,
+	"/api.Logs/All":           true,
+	"/api.Logs/Bundle":        true,
+	"/api.RDS/Discover":       true,
+	"/api.Telemetry/Preview":  true,
+}
+
+// IsMutating returns true if RPC with given full method name may change something and should be audited.
+// RPCs with names starting with List, Get and Export are read-only; all others are mutating unless listed
+// in readOnlyMethods, so new RPCs are audited by default.
+func IsMutating(fullMethod string) bool {
+	if readOnlyMethods[fullMethod] {
+		return false
+	}
+
+	method := fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+	for _, p := range []string{"List", "Get", "Export"} {
+		if strings.HasPrefix(method, p) {
+			return false
+		}
+	}
+	return true
+}
+
+const (
+	// auditSecretKeys matches request field names with secrets; see also logs.DefaultRedactPatterns.
+	auditSecretKeys = `(?i)^[\w.-]*(?:password|passwd|secret|secret_?(?:access_?)?key|token)$`
+
+	auditRedactedMask = "[REDACTED]"
+
+	// longer request string fields (for example, dashboard JSON models) are replaced with their length
+	auditMaxStringLen = 1024
+
+	// default interval between audit log pruning
+	auditPruneInterval = time.Hour
+)
+
+var auditSecretKeysRE = regexp.MustCompile(auditSecretKeys)
+
+// Audit records mutating RPCs into the database audit log.
+type Audit struct {
+	db        *reform.DB
+	retention time.Duration
+}
+
+// NewAudit creates a new Audit for given database.
+// Entries older than retention are removed by Run; zero retention means entries are kept forever.
+func NewAudit(db *reform.DB, retention time.Duration) *Audit {
+	return &Audit{
+		db:        db,
+		retention: retention,
+	}
+}
+
+// auditCall is stored in context of audited RPC. Auth sets caller there, so callers of rejected RPCs are recorded too.
+type auditCall struct {
+	identity *Identity
+}
+
+type auditCallKey struct{}
+
+// setAuditIdentity sets caller of audited RPC, if any.
+func setAuditIdentity(ctx context.Context, identity *Identity) {
+	if c, ok := ctx.Value(auditCallKey{}).(*auditCall); ok {
+		c.identity = identity
+	}
+}
+
+// Unary records mutating unary RPCs, including ones rejected by Auth.
+// It should be called after Unary (for context logger) and before Auth.Unary, see ChainUnary.
+func (a *Audit) Unary(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if !IsMutating(info.FullMethod) {
+		return handler(ctx, req)
+	}
+
+	call := new(auditCall)
+	start := time.Now()
+	res, err := handler(context.WithValue(ctx, auditCallKey{}, call), req)
+	duration := time.Since(start)
+
+	identity := call.identity
+	if identity == nil {
+		identity = IdentityFromContext(ctx) // authentication is disabled
+	}
+	s, _ := status.FromError(err)
+	entry := &models.AuditEntry{
+		CreatedAt:  start.UTC().Truncate(time.Second),
+		RequestID:  logger.RequestID(ctx),
+		Method:     info.FullMethod,
+		Caller:     identity.Name,
+		AuthMethod: identity.Method,
+		Request:    auditRequest(req),
+		Code:       s.Code().String(),
+		Error:      s.Message(),
+		Duration:   duration,
+	}
+	if e := a.db.Insert(entry); e != nil {
+		logger.Get(ctx).Errorf("Failed to record audit entry for %s: %+v", info.FullMethod, errors.WithStack(e))
+	}
+	return res, err
+}
+
+// Run removes old audit log entries until ctx is canceled.
+func (a *Audit) Run(ctx context.Context) {
+	if a.retention == 0 {
+		return
+	}
+
+	l := logger.Get(ctx)
+	t := time.NewTicker(auditPruneInterval)
+	defer t.Stop()
+	for {
+		n, err := models.PruneAuditEntries(a.db.Querier, time.Now().Add(-a.retention))
+		if err != nil {
+			l.Errorf("Failed to prune audit log: %+v", err)
+		}
+		if n != 0 {
+			l.Infof("Pruned %d audit log entries.", n)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+	}
+}
+
+// auditRequest returns request in JSON format with secrets redacted and long strings truncated.
+func auditRequest(req interface{}) string {
+	m, ok := req.(proto.Message)
+	if !ok {
+		return "{}"
+	}
+	marshaler := jsonpb.Marshaler{OrigName: true}
+	s, err := marshaler.MarshalToString(m)
+	if err != nil {
+		return fmt.Sprintf(`{"error": %q}`, err.Error())
+	}
+
+	var v interface{}
+	d := json.NewDecoder(strings.NewReader(s))
+	d.UseNumber()
+	if err = d.Decode(&v); err != nil {
+		return fmt.Sprintf(`{"error": %q}`, err.Error())
+	}
+	var buf bytes.Buffer
+	e := json.NewEncoder(&buf)
+	e.SetEscapeHTML(false)
+	if err = e.Encode(redactRequest(v)); err != nil {
+		return fmt.Sprintf(`{"error": %q}`, err.Error())
+	}
+	return strings.TrimSpace(buf.String())
+}
+
+// redactURL returns absolute URL without user information (which may contain password) and query
+// (which may contain tokens). Other strings are returned as is.
+func redactURL(s string) string {
+	u, err := url.Parse(s)
+	if err != nil || u.Scheme == "" || u.Host == "" || (u.User == nil && u.RawQuery == "") {
+		return s
+	}
+	u.User = nil
+	u.RawQuery = ""
+	u.ForceQuery = false
+	return u.String()
+}
+
+// redactRequest replaces secrets, URL credentials and long strings in decoded JSON value.
+func redactRequest(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, e := range v {
+			if s, ok := e.(string); ok && s != "" && auditSecretKeysRE.MatchString(k) {
+				v[k] = auditRedactedMask
+				continue
+			}
+			v[k] = redactRequest(e)
+		}
+		return v
+	case []interface{}:
+		for i, e := range v {
+			v[i] = redactRequest(e)
+		}
+		return v
+	case string:
+		if len(v) > auditMaxStringLen {
+			return fmt.Sprintf("[%d bytes]", len(v))
+		}
+		return redactURL(v)
+	default:
+		return v
+	}
+}
+
+// check interfaces
+var (
+	_ grpc.UnaryServerInterceptor = (*Audit)(nil).Unary
+)
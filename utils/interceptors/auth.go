@@ -0,0 +1,456 @@
+// pmm-managed
+// Copyright (C) 2017 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package interceptors
+
+import (
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/bcrypt"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"gopkg.in/yaml.v2"
+
+	"github.com/percona/pmm-managed/utils/logger"
+)
+
+// Role represents API caller's role. Each role includes all permissions of lower roles.
+type Role int
+
+// Roles.
+const (
+	RoleNone   Role = iota // only public RPCs
+	RoleViewer             // List/Get RPCs
+	RoleEditor             // Add/Remove/Create/Update/Delete RPCs
+	RoleAdmin              // everything, including settings and logs
+)
+
+func (r Role) String() string {
+	switch r {
+	case RoleNone:
+		return "none"
+	case RoleViewer:
+		return "viewer"
+	case RoleEditor:
+		return "editor"
+	case RoleAdmin:
+		return "admin"
+	default:
+		return fmt.Sprintf("Role(%d)", int(r))
+	}
+}
+
+// ParseRole returns role by name.
+func ParseRole(s string) (Role, error) {
+	for _, r := range []Role{RoleNone, RoleViewer, RoleEditor, RoleAdmin} {
+		if r.String() == strings.ToLower(strings.TrimSpace(s)) {
+			return r, nil
+		}
+	}
+	return RoleNone, errors.Errorf("unknown role %q", s)
+}
+
+// methodRoles contains roles required for RPCs which do not follow naming conventions in RequiredRole.
+var methodRoles = map[string]Role{
+	"/api.Base/Version":       RoleNone,
+	"/api.Base/SchemaVersion": RoleViewer,
+	"/api.Agents/Status":      RoleViewer,
+	"/api.Telemetry/Preview":  RoleViewer,
+	"/api.RDS/Discover":       RoleEditor,
+	"/api.Audit/List":         RoleAdmin,
+	"/api.Events/Subscribe":   RoleViewer,
+
+	// webhook URLs may contain credentials, and payloads are sent to external systems
+	"/api.Webhooks/Create":          RoleAdmin,
+	"/api.Webhooks/List":            RoleAdmin,
+	"/api.Webhooks/Delete":          RoleAdmin,
+	"/api.Webhooks/ListDeadLetters": RoleAdmin,
+}
+
+// RequiredRole returns minimal role required to call RPC with given full method name.
+// RPCs with names starting with List, Get and Export require viewer role;
+// Add, Remove, Create, Update, Delete and Import require editor role;
+// all others (settings, logs, etc.) require admin role.
+func RequiredRole(fullMethod string) Role {
+	if r, ok := methodRoles[fullMethod]; ok {
+		return r
+	}
+
+	method := fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+	for _, p := range []string{"List", "Get", "Export"} {
+		if strings.HasPrefix(method, p) {
+			return RoleViewer
+		}
+	}
+	for _, p := range []string{"Add", "Remove", "Create", "Update", "Delete", "Import"} {
+		if strings.HasPrefix(method, p) {
+			return RoleEditor
+		}
+	}
+	return RoleAdmin
+}
+
+// Identity represents authenticated API caller.
+type Identity struct {
+	Name   string // token name, username, or upstream user
+	Method string // authentication method: "token", "basic", "upstream", or empty if authentication is disabled
+	Role   Role
+}
+
+// disabledIdentity is used when authentication is disabled.
+var disabledIdentity = &Identity{Name: "anonymous", Role: RoleAdmin}
+
+type identityKey struct{}
+
+// IdentityFromContext returns authenticated API caller for given context.
+// If authentication is disabled, anonymous caller with admin role is returned.
+func IdentityFromContext(ctx context.Context) *Identity {
+	if i, ok := ctx.Value(identityKey{}).(*Identity); ok {
+		return i
+	}
+	return disabledIdentity
+}
+
+// AuthConfig represents authentication configuration file.
+type AuthConfig struct {
+	// Static API tokens passed as "Authorization: Bearer <token>".
+	Tokens []struct {
+		Name  string `yaml:"name"`
+		Token string `yaml:"token"`
+		Role  string `yaml:"role"`
+	} `yaml:"tokens"`
+
+	// Users for HTTP basic authentication with bcrypt password hashes.
+	Users []struct {
+		Username     string `yaml:"username"`
+		PasswordHash string `yaml:"password_hash"`
+		Role         string `yaml:"role"`
+	} `yaml:"users"`
+
+	// Upstream authentication by reverse proxy (nginx).
+	// Proxy should set user header (and, optionally, role header), and secret header to a shared secret
+	// that proves that request came through it.
+	Upstream *struct {
+		Secret       string `yaml:"secret"`
+		SecretHeader string `yaml:"secret_header"` // X-Upstream-Secret by default
+		UserHeader   string `yaml:"user_header"`   // X-Remote-User by default
+		RoleHeader   string `yaml:"role_header"`   // if empty, DefaultRole is used
+		DefaultRole  string `yaml:"default_role"`  // viewer by default
+	} `yaml:"upstream"`
+}
+
+// LoadAuthConfig loads authentication configuration from YAML file.
+func LoadAuthConfig(path string) (*AuthConfig, error) {
+	b, err := ioutil.ReadFile(path) //nolint:gosec
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	var config AuthConfig
+	if err = yaml.UnmarshalStrict(b, &config); err != nil {
+		return nil, errors.Wrapf(err, "failed to parse %s", path)
+	}
+	return &config, nil
+}
+
+type authToken struct {
+	name  string
+	token []byte
+	role  Role
+}
+
+type authUser struct {
+	hash []byte
+	role Role
+}
+
+type upstreamAuth struct {
+	secret       []byte
+	secretHeader string
+	userHeader   string
+	roleHeader   string
+	defaultRole  Role
+}
+
+// Auth authenticates and authorizes API callers.
+// Nil *Auth is valid: authentication is disabled, and all callers have admin role.
+type Auth struct {
+	tokens   []authToken
+	users    map[string]authUser
+	upstream *upstreamAuth
+}
+
+// NewAuth creates a new Auth for given configuration.
+func NewAuth(config *AuthConfig) (*Auth, error) {
+	a := &Auth{
+		users: make(map[string]authUser),
+	}
+
+	for _, t := range config.Tokens {
+		if t.Token == "" {
+			return nil, errors.Errorf("empty token %q", t.Name)
+		}
+		role, err := ParseRole(t.Role)
+		if err != nil {
+			return nil, errors.Wrapf(err, "token %q", t.Name)
+		}
+		a.tokens = append(a.tokens, authToken{name: t.Name, token: []byte(t.Token), role: role})
+	}
+
+	for _, u := range config.Users {
+		if u.Username == "" {
+			return nil, errors.New("empty username")
+		}
+		if _, err := bcrypt.Cost([]byte(u.PasswordHash)); err != nil {
+			return nil, errors.Wrapf(err, "user %q: invalid bcrypt password hash", u.Username)
+		}
+		role, err := ParseRole(u.Role)
+		if err != nil {
+			return nil, errors.Wrapf(err, "user %q", u.Username)
+		}
+		if _, ok := a.users[u.Username]; ok {
+			return nil, errors.Errorf("duplicate user %q", u.Username)
+		}
+		a.users[u.Username] = authUser{hash: []byte(u.PasswordHash), role: role}
+	}
+
+	if u := config.Upstream; u != nil {
+		if u.Secret == "" {
+			return nil, errors.New("upstream: empty secret")
+		}
+		a.upstream = &upstreamAuth{
+			secret:       []byte(u.Secret),
+			secretHeader: strings.ToLower(u.SecretHeader),
+			userHeader:   strings.ToLower(u.UserHeader),
+			roleHeader:   strings.ToLower(u.RoleHeader),
+			defaultRole:  RoleViewer,
+		}
+		if a.upstream.secretHeader == "" {
+			a.upstream.secretHeader = "x-upstream-secret"
+		}
+		if a.upstream.userHeader == "" {
+			a.upstream.userHeader = "x-remote-user"
+		}
+		if u.DefaultRole != "" {
+			role, err := ParseRole(u.DefaultRole)
+			if err != nil {
+				return nil, errors.Wrap(err, "upstream")
+			}
+			a.upstream.defaultRole = role
+		}
+	}
+
+	return a, nil
+}
+
+// authenticate returns caller's identity for given incoming metadata.
+func (a *Auth) authenticate(md metadata.MD) (*Identity, error) {
+	get := func(key string) string {
+		if v := md.Get(key); len(v) > 0 {
+			return v[0]
+		}
+		return ""
+	}
+
+	// upstream authentication has priority, but only if secret matches
+	if u := a.upstream; u != nil {
+		if secret := get(u.secretHeader); secret != "" {
+			if subtle.ConstantTimeCompare([]byte(secret), u.secret) != 1 {
+				return nil, status.Error(codes.Unauthenticated, "Invalid upstream secret.")
+			}
+			user := get(u.userHeader)
+			if user == "" {
+				return nil, status.Error(codes.Unauthenticated, "Upstream user is not given.")
+			}
+			role := u.defaultRole
+			if u.roleHeader != "" {
+				if h := get(u.roleHeader); h != "" {
+					var err error
+					if role, err = ParseRole(h); err != nil {
+						return nil, status.Errorf(codes.Unauthenticated, "Invalid upstream role %q.", h)
+					}
+				}
+			}
+			return &Identity{Name: user, Method: "upstream", Role: role}, nil
+		}
+	}
+
+	authorization := get("authorization")
+	if authorization == "" {
+		return &Identity{Name: "anonymous", Role: RoleNone}, nil
+	}
+	parts := strings.SplitN(authorization, " ", 2)
+	if len(parts) != 2 {
+		return nil, status.Error(codes.Unauthenticated, "Invalid authorization header.")
+	}
+	switch strings.ToLower(parts[0]) {
+	case "bearer":
+		token := []byte(strings.TrimSpace(parts[1]))
+		var found *authToken
+		for i, t := range a.tokens {
+			// check all tokens to avoid timing leaks
+			if subtle.ConstantTimeCompare(token, t.token) == 1 {
+				found = &a.tokens[i]
+			}
+		}
+		if found == nil {
+			return nil, status.Error(codes.Unauthenticated, "Invalid token.")
+		}
+		return &Identity{Name: found.name, Method: "token", Role: found.role}, nil
+
+	case "basic":
+		b, err := base64.StdEncoding.DecodeString(strings.TrimSpace(parts[1]))
+		if err != nil {
+			return nil, status.Error(codes.Unauthenticated, "Invalid basic authorization header.")
+		}
+		userPass := strings.SplitN(string(b), ":", 2)
+		if len(userPass) != 2 {
+			return nil, status.Error(codes.Unauthenticated, "Invalid basic authorization header.")
+		}
+		user, ok := a.users[userPass[0]]
+		if !ok || bcrypt.CompareHashAndPassword(user.hash, []byte(userPass[1])) != nil {
+			return nil, status.Error(codes.Unauthenticated, "Invalid username or password.")
+		}
+		return &Identity{Name: userPass[0], Method: "basic", Role: user.role}, nil
+
+	default:
+		return nil, status.Errorf(codes.Unauthenticated, "Unsupported authorization type %q.", parts[0])
+	}
+}
+
+// authorize authenticates caller and checks that it can call given method.
+// It returns derived context with caller's identity.
+func (a *Auth) authorize(ctx context.Context, fullMethod string) (context.Context, error) {
+	if a == nil {
+		return ctx, nil
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	identity, err := a.authenticate(md)
+	if err != nil {
+		setAuditIdentity(ctx, &Identity{Name: "anonymous", Role: RoleNone})
+		return nil, err
+	}
+	setAuditIdentity(ctx, identity)
+
+	required := RequiredRole(fullMethod)
+	if identity.Role < required {
+		if identity.Role == RoleNone {
+			return nil, status.Error(codes.Unauthenticated, "Authentication required.")
+		}
+		return nil, status.Errorf(codes.PermissionDenied, "Role %s is required, caller %q has role %s.", required, identity.Name, identity.Role)
+	}
+	return context.WithValue(ctx, identityKey{}, identity), nil
+}
+
+// Unary adds authentication and authorization to unary server RPC.
+// It should be called after Unary (for context logger) and Audit.Unary, see ChainUnary.
+func (a *Auth) Unary(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	authCtx, err := a.authorize(ctx, info.FullMethod)
+	if err != nil {
+		logger.Get(ctx).Warnf("%s: %s", info.FullMethod, err)
+		return nil, err
+	}
+	return handler(authCtx, req)
+}
+
+// authServerStream overrides context of wrapped stream.
+type authServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authServerStream) Context() context.Context {
+	return s.ctx
+}
+
+// Stream adds authentication and authorization to Stream interceptor.
+func (a *Auth) Stream(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return Stream(srv, ss, info, func(srv interface{}, ss grpc.ServerStream) error {
+		ctx, err := a.authorize(ss.Context(), info.FullMethod)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &authServerStream{ServerStream: ss, ctx: ctx})
+	})
+}
+
+// HeaderMatcher returns grpc-gateway incoming header matcher that propagates upstream authentication headers
+// (Authorization header is always propagated by grpc-gateway).
+func (a *Auth) HeaderMatcher() func(string) (string, bool) {
+	return func(key string) (string, bool) {
+		if u := a.upstreamOrNil(); u != nil {
+			switch strings.ToLower(key) {
+			case u.secretHeader, u.userHeader, u.roleHeader:
+				return strings.ToLower(key), true
+			}
+		}
+		return runtime.DefaultHeaderMatcher(key)
+	}
+}
+
+func (a *Auth) upstreamOrNil() *upstreamAuth {
+	if a == nil {
+		return nil
+	}
+	return a.upstream
+}
+
+// HTTPHandler wraps plain HTTP handler (not served by grpc-gateway) with authentication,
+// requiring given role.
+func (a *Auth) HTTPHandler(required Role, h http.Handler) http.Handler {
+	if a == nil {
+		return h
+	}
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		md := make(metadata.MD, len(req.Header))
+		for k, v := range req.Header {
+			md[strings.ToLower(k)] = v
+		}
+		identity, err := a.authenticate(md)
+		if err == nil && identity.Role < required {
+			err = status.Error(codes.PermissionDenied, "Permission denied.")
+			if identity.Role == RoleNone {
+				err = status.Error(codes.Unauthenticated, "Authentication required.")
+			}
+		}
+		if err != nil {
+			code := http.StatusForbidden
+			if status.Code(err) == codes.Unauthenticated {
+				code = http.StatusUnauthorized
+				rw.Header().Set("WWW-Authenticate", `Basic realm="pmm-managed"`)
+			}
+			http.Error(rw, status.Convert(err).Message(), code)
+			return
+		}
+		h.ServeHTTP(rw, req.WithContext(context.WithValue(req.Context(), identityKey{}, identity)))
+	})
+}
+
+// check interfaces
+var (
+	_ grpc.UnaryServerInterceptor  = (*Auth)(nil).Unary
+	_ grpc.StreamServerInterceptor = (*Auth)(nil).Stream
+)
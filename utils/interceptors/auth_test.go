@@ -0,0 +1,243 @@
+// pmm-managed
+// Copyright (C) 2017 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package interceptors
+
+import (
+	"encoding/base64"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/percona/pmm-managed/utils/logger"
+)
+
+func TestRequiredRole(t *testing.T) {
+	for method, expected := range map[string]Role{
+		"/api.Base/Version":            RoleNone,
+		"/api.Base/SchemaVersion":      RoleViewer,
+		"/api.MySQL/List":              RoleViewer,
+		"/api.ScrapeConfigs/Get":       RoleViewer,
+		"/api.Telemetry/GetSettings":   RoleViewer,
+		"/api.Dashboards/ListFolders":  RoleViewer,
+		"/api.Dashboards/Export":       RoleViewer,
+		"/api.Events/Subscribe":        RoleViewer,
+		"/api.RDS/Discover":            RoleEditor,
+		"/api.RDS/Add":                 RoleEditor,
+		"/api.PostgreSQL/Remove":       RoleEditor,
+		"/api.Annotations/Create":      RoleEditor,
+		"/api.Dashboards/DeleteFolder": RoleEditor,
+		"/api.Telemetry/SetEnabled":    RoleAdmin,
+		"/api.Logs/Bundle":             RoleAdmin,
+		"/api.Audit/List":              RoleAdmin,
+		"/api.Webhooks/List":           RoleAdmin,
+		"/api.Webhooks/Test":           RoleAdmin,
+		"/api.NewService/NewMethod":    RoleAdmin,
+	} {
+		assert.Equal(t, expected, RequiredRole(method), "%s", method)
+	}
+}
+
+func TestParseRole(t *testing.T) {
+	r, err := ParseRole(" Editor")
+	require.NoError(t, err)
+	assert.Equal(t, RoleEditor, r)
+
+	_, err = ParseRole("root")
+	assert.EqualError(t, err, `unknown role "root"`)
+}
+
+func setupAuth(t *testing.T) *Auth {
+	f, err := ioutil.TempFile("", "pmm-managed-auth-")
+	require.NoError(t, err)
+	defer os.Remove(f.Name()) //nolint:errcheck
+
+	hash, err := bcrypt.GenerateFromPassword([]byte("pass"), bcrypt.MinCost)
+	require.NoError(t, err)
+	_, err = f.WriteString(`
+tokens:
+  - name: ci
+    token: ci-token
+    role: editor
+users:
+  - username: admin
+    password_hash: ` + string(hash) + `
+    role: admin
+upstream:
+  secret: nginx-secret
+  role_header: X-Remote-Role
+`)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	config, err := LoadAuthConfig(f.Name())
+	require.NoError(t, err)
+	a, err := NewAuth(config)
+	require.NoError(t, err)
+	return a
+}
+
+func basic(user, password string) string {
+	return "Basic " + base64.StdEncoding.EncodeToString([]byte(user+":"+password))
+}
+
+func TestAuthorize(t *testing.T) {
+	a := setupAuth(t)
+
+	for _, tc := range []struct {
+		name     string
+		md       metadata.MD
+		method   string
+		identity *Identity
+		err      error
+	}{
+		{"Public", nil, "/api.Base/Version", &Identity{Name: "anonymous", Role: RoleNone}, nil},
+		{"NoAuth", nil, "/api.MySQL/List", nil, status.Error(codes.Unauthenticated, "Authentication required.")},
+		{
+			"Token", metadata.Pairs("authorization", "Bearer ci-token"), "/api.MySQL/Add",
+			&Identity{Name: "ci", Method: "token", Role: RoleEditor}, nil,
+		},
+		{
+			"TokenPermissionDenied", metadata.Pairs("authorization", "Bearer ci-token"), "/api.Telemetry/SetEnabled",
+			nil, status.Error(codes.PermissionDenied, `Role admin is required, caller "ci" has role editor.`),
+		},
+		{
+			"InvalidToken", metadata.Pairs("authorization", "Bearer ci-token2"), "/api.MySQL/List",
+			nil, status.Error(codes.Unauthenticated, "Invalid token."),
+		},
+		{
+			"Basic", metadata.Pairs("authorization", basic("admin", "pass")), "/api.Telemetry/SetEnabled",
+			&Identity{Name: "admin", Method: "basic", Role: RoleAdmin}, nil,
+		},
+		{
+			"BasicInvalidPassword", metadata.Pairs("authorization", basic("admin", "pass2")), "/api.MySQL/List",
+			nil, status.Error(codes.Unauthenticated, "Invalid username or password."),
+		},
+		{
+			"BasicInvalidUser", metadata.Pairs("authorization", basic("nobody", "pass")), "/api.MySQL/List",
+			nil, status.Error(codes.Unauthenticated, "Invalid username or password."),
+		},
+		{
+			"UnsupportedType", metadata.Pairs("authorization", "Digest foo"), "/api.MySQL/List",
+			nil, status.Error(codes.Unauthenticated, `Unsupported authorization type "Digest".`),
+		},
+		{
+			"Upstream", metadata.Pairs("x-upstream-secret", "nginx-secret", "x-remote-user", "alice"), "/api.MySQL/List",
+			&Identity{Name: "alice", Method: "upstream", Role: RoleViewer}, nil,
+		},
+		{
+			"UpstreamRole", metadata.Pairs("x-upstream-secret", "nginx-secret", "x-remote-user", "alice", "x-remote-role", "editor"), "/api.MySQL/Add",
+			&Identity{Name: "alice", Method: "upstream", Role: RoleEditor}, nil,
+		},
+		{
+			"UpstreamInvalidSecret", metadata.Pairs("x-upstream-secret", "forged", "x-remote-user", "alice"), "/api.MySQL/List",
+			nil, status.Error(codes.Unauthenticated, "Invalid upstream secret."),
+		},
+		{
+			"UpstreamUserWithoutSecret", metadata.Pairs("x-remote-user", "alice", "x-remote-role", "admin"), "/api.MySQL/List",
+			nil, status.Error(codes.Unauthenticated, "Authentication required."),
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := metadata.NewIncomingContext(context.Background(), tc.md)
+			ctx, err := a.authorize(ctx, tc.method)
+			assert.Equal(t, tc.err, err)
+			if tc.identity != nil {
+				assert.Equal(t, tc.identity, IdentityFromContext(ctx))
+			}
+		})
+	}
+
+	t.Run("Disabled", func(t *testing.T) {
+		var a *Auth
+		ctx, err := a.authorize(context.Background(), "/api.Telemetry/SetEnabled")
+		require.NoError(t, err)
+		assert.Equal(t, RoleAdmin, IdentityFromContext(ctx).Role)
+	})
+}
+
+func TestUnary(t *testing.T) {
+	a := setupAuth(t)
+	info := &grpc.UnaryServerInfo{FullMethod: "/api.MySQL/Add"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return IdentityFromContext(ctx).Name, nil
+	}
+
+	ctx, _ := logger.Set(context.Background(), t.Name())
+	res, err := a.Unary(metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer ci-token")), nil, info, handler)
+	require.NoError(t, err)
+	assert.Equal(t, "ci", res)
+
+	_, err = a.Unary(ctx, nil, info, handler)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestHTTPHandler(t *testing.T) {
+	a := setupAuth(t)
+	h := a.HTTPHandler(RoleAdmin, http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Write([]byte(IdentityFromContext(req.Context()).Name)) //nolint:errcheck
+	}))
+
+	for _, tc := range []struct {
+		authorization string
+		code          int
+		body          string
+	}{
+		{"", 401, "Authentication required.\n"},
+		{"Bearer ci-token", 403, "Permission denied.\n"},
+		{basic("admin", "pass"), 200, "admin"},
+	} {
+		req := httptest.NewRequest("GET", "/logs.zip", nil)
+		if tc.authorization != "" {
+			req.Header.Set("Authorization", tc.authorization)
+		}
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		assert.Equal(t, tc.code, rec.Code, "%s", tc.authorization)
+		assert.Equal(t, tc.body, rec.Body.String(), "%s", tc.authorization)
+	}
+}
+
+func TestHeaderMatcher(t *testing.T) {
+	m := setupAuth(t).HeaderMatcher()
+	for header, expected := range map[string]string{
+		"X-Upstream-Secret": "x-upstream-secret",
+		"X-Remote-User":     "x-remote-user",
+		"X-Remote-Role":     "x-remote-role",
+		"Content-Type":      "grpcgateway-Content-Type",
+	} {
+		actual, ok := m(header)
+		assert.True(t, ok)
+		assert.Equal(t, expected, actual)
+	}
+	_, ok := m("X-Other")
+	assert.False(t, ok)
+
+	var disabled *Auth
+	_, ok = disabled.HeaderMatcher()("X-Remote-User")
+	assert.False(t, ok)
+}
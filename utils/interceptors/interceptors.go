@@ -14,7 +14,7 @@
 // You should have received a copy of the GNU Affero General Public License
 // along with this program. If not, see <https://www.gnu.org/licenses/>.
 
-// Package interceptors contains gRPC wrappers for logging and Prometheus metrics.
+// Package interceptors contains gRPC wrappers for logging, Prometheus metrics, authentication and audit.
 package interceptors
 
 import (
@@ -37,8 +37,23 @@ func Stream(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo,
 	return grpc_prometheus.StreamServerInterceptor(srv, ss, info, handler)
 }
 
+// ChainUnary returns unary server interceptor that calls given interceptors in order: the first one is the outermost.
+func ChainUnary(interceptors ...grpc.UnaryServerInterceptor) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		h := handler
+		for i := len(interceptors) - 1; i >= 0; i-- {
+			interceptor, next := interceptors[i], h
+			h = func(ctx context.Context, req interface{}) (interface{}, error) {
+				return interceptor(ctx, req, info, next)
+			}
+		}
+		return h(ctx, req)
+	}
+}
+
 // check interfaces
 var (
 	_ grpc.UnaryServerInterceptor  = Unary
 	_ grpc.StreamServerInterceptor = Stream
+	_ grpc.UnaryServerInterceptor  = ChainUnary()
 )
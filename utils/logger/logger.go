@@ -25,6 +25,9 @@ import (
 	"github.com/sirupsen/logrus"
 )
 
+// requestField is a name of logrus field with request ID.
+const requestField = "request"
+
 // key is unexported to prevent collisions - it is different from any other type in other packages
 type key struct{}
 
@@ -44,7 +47,7 @@ func Set(ctx context.Context, requestID string) (context.Context, *logrus.Entry)
 		return nil, nil
 	}
 
-	l := logrus.WithField("request", requestID)
+	l := logrus.WithField(requestField, requestID)
 	return context.WithValue(ctx, key{}, l), l
 }
 
@@ -52,3 +55,9 @@ func Set(ctx context.Context, requestID string) (context.Context, *logrus.Entry)
 func MakeRequestID() string {
 	return fmt.Sprintf("%016x", time.Now().UnixNano())
 }
+
+// RequestID returns request ID for given context. Set must be called before this method is called.
+func RequestID(ctx context.Context) string {
+	id, _ := Get(ctx).Data[requestField].(string)
+	return id
+}
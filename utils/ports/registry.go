@@ -14,85 +14,232 @@
 // You should have received a copy of the GNU Affero General Public License
 // along with this program. If not, see <https://www.gnu.org/licenses/>.
 
+// Package ports allocates Agent listen ports.
 package ports
 
 import (
 	"fmt"
 	"net"
+	"sort"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/pkg/errors"
-)
+	"gopkg.in/reform.v1"
 
-var (
-	errNoFreePort      = errors.New("ports registry: no free port")
-	errPortBusy        = errors.New("ports registry: port busy")
-	errNotReservedPort = errors.New("ports registry: not reserved port")
+	"github.com/percona/pmm-managed/models"
 )
 
-// Registry keeps track of reserved ports.
+var errNoFreePort = errors.New("ports registry: no free port")
+
+// savepoint name used for port allocation, see insert
+const savepoint = "ports_registry_reserve"
+
+// AgentTypes contains types of Agents with listen ports allocated by Registry.
+// Other Agents use fixed ports.
+var AgentTypes = []models.AgentType{
+	models.MySQLdExporterAgentType,
+	models.PostgresExporterAgentType,
+}
+
+// Registry allocates Agent listen ports from configured range.
+// Allocations are stored in the database (see models.Port) and participate in the caller's transaction,
+// so allocated port is released automatically if transaction is rolled back.
 type Registry struct {
 	lock     sync.Mutex
 	min, max uint16
-	m        map[uint16]struct{}
 }
 
-func NewRegistry(min, max uint16, reserved []uint16) *Registry {
+// NewRegistry creates a new registry for a given ports range (inclusive).
+func NewRegistry(min, max uint16) *Registry {
 	if min > max {
 		panic("min > max")
 	}
 
-	m := make(map[uint16]struct{})
-	for _, p := range reserved {
-		m[p] = struct{}{}
-	}
-
 	return &Registry{
-		min: min, max: max,
-		m: m,
+		min: min,
+		max: max,
 	}
 }
 
-func (r *Registry) Reserve() (uint16, error) {
+// Range returns configured ports range (inclusive).
+func (r *Registry) Range() (min, max uint16) {
+	return r.min, r.max
+}
+
+// Reserve allocates the first port in range which is not allocated yet and is not used by any other process.
+func (r *Registry) Reserve(tx *reform.TX) (uint16, error) {
+	// serialize allocations by this process; concurrent transactions are handled by insert
 	r.lock.Lock()
 	defer r.lock.Unlock()
 
-	for port := r.min; port <= r.max; port++ {
-		if _, ok := r.m[port]; ok {
-			continue
-		}
+	tail := "WHERE port >= " + tx.Placeholder(1) + " AND port <= " + tx.Placeholder(2)
+	structs, err := tx.SelectAllFrom(models.PortTable, tail, r.min, r.max)
+	if err != nil {
+		return 0, errors.WithStack(err)
+	}
+	allocated := make(map[uint16]struct{}, len(structs))
+	for _, str := range structs {
+		allocated[str.(*models.Port).Port] = struct{}{}
+	}
 
-		l, err := net.Listen("tcp", fmt.Sprintf("127.0.0.1:%d", port))
-		if l != nil {
-			l.Close()
+	for p := int(r.min); p <= int(r.max); p++ {
+		port := uint16(p)
+		if _, ok := allocated[port]; ok {
+			continue
 		}
-		if err != nil {
+		if !canListen(port) {
 			continue
 		}
 
-		r.m[port] = struct{}{}
-		return port, nil
+		ok, err := insert(tx, port)
+		if err != nil {
+			return 0, err
+		}
+		if ok {
+			return port, nil
+		}
 	}
 
 	return 0, errNoFreePort
 }
 
-func (r *Registry) Release(port uint16) error {
-	r.lock.Lock()
-	defer r.lock.Unlock()
-
-	if _, ok := r.m[port]; !ok {
-		return errNotReservedPort
-	}
+// Release releases allocated port. It is not an error to release port which is not allocated.
+func (r *Registry) Release(q *reform.Querier, port uint16) error {
+	_, err := q.DeleteFrom(models.PortTable, "WHERE port = "+q.Placeholder(1), port)
+	return errors.WithStack(err)
+}
 
+// canListen returns true if port is not used by any other process.
+func canListen(port uint16) bool {
 	l, err := net.Listen("tcp", fmt.Sprintf("127.0.0.1:%d", port))
 	if l != nil {
-		l.Close()
+		l.Close() //nolint:errcheck
+	}
+	return err == nil
+}
+
+// insert tries to allocate a given port. It returns false if port was already allocated by concurrent transaction.
+// Savepoint is used so unique constraint violation does not abort the whole transaction (like in PostgreSQL).
+func insert(tx *reform.TX, port uint16) (bool, error) {
+	if _, err := tx.Exec("SAVEPOINT " + savepoint); err != nil {
+		return false, errors.WithStack(err)
 	}
+
+	err := tx.Insert(&models.Port{
+		Port:      port,
+		CreatedAt: time.Now().UTC().Truncate(time.Second),
+	})
 	if err != nil {
-		return errPortBusy
+		if !models.IsUniqueViolation(err) {
+			return false, errors.WithStack(err)
+		}
+		if _, e := tx.Exec("ROLLBACK TO SAVEPOINT " + savepoint); e != nil {
+			return false, errors.WithStack(e)
+		}
 	}
 
-	delete(r.m, port)
-	return nil
+	if _, e := tx.Exec("RELEASE SAVEPOINT " + savepoint); e != nil {
+		return false, errors.WithStack(e)
+	}
+	return err == nil, nil
+}
+
+// ConflictKind represents a kind of allocation conflict.
+type ConflictKind string
+
+// Conflict kinds.
+const (
+	ConflictUnallocated ConflictKind = "unallocated"  // port is used by Agent, but not allocated
+	ConflictUnused      ConflictKind = "unused"       // port is allocated, but not used by any Agent
+	ConflictShared      ConflictKind = "shared"       // port is used by several Agents
+	ConflictOutOfRange  ConflictKind = "out_of_range" // allocated port is outside of configured range
+)
+
+// Allocation represents allocated port and Agents using it.
+type Allocation struct {
+	Port      uint16
+	CreatedAt time.Time
+	AgentIDs  []int32
+}
+
+// Conflict represents allocation conflict for a single port.
+type Conflict struct {
+	Port     uint16
+	Kind     ConflictKind
+	AgentIDs []int32
+}
+
+// Diagnostics contains allocated ports and conflicts between allocations and Agents.
+type Diagnostics struct {
+	Min, Max    uint16
+	Allocations []Allocation
+	Conflicts   []Conflict
+}
+
+// Diagnose returns allocated ports and conflicts between allocations and Agents.
+func (r *Registry) Diagnose(q *reform.Querier) (*Diagnostics, error) {
+	structs, err := q.SelectAllFrom(models.PortTable, "ORDER BY port")
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	args := make([]interface{}, len(AgentTypes))
+	for i, t := range AgentTypes {
+		args[i] = t
+	}
+	tail := "WHERE type IN (" + strings.Join(q.Placeholders(1, len(args)), ", ") + ") ORDER BY id"
+	agents, err := q.SelectAllFrom(models.AgentTable, tail, args...)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	used := make(map[uint16][]int32)
+	for _, str := range agents {
+		agent := str.(*models.Agent)
+		if agent.ListenPort != nil {
+			used[*agent.ListenPort] = append(used[*agent.ListenPort], agent.ID)
+		}
+	}
+
+	res := &Diagnostics{
+		Min: r.min,
+		Max: r.max,
+	}
+	for _, str := range structs {
+		port := str.(*models.Port)
+		agentIDs := used[port.Port]
+		delete(used, port.Port)
+		res.Allocations = append(res.Allocations, Allocation{
+			Port:      port.Port,
+			CreatedAt: port.CreatedAt,
+			AgentIDs:  agentIDs,
+		})
+
+		if port.Port < r.min || port.Port > r.max {
+			res.Conflicts = append(res.Conflicts, Conflict{Port: port.Port, Kind: ConflictOutOfRange, AgentIDs: agentIDs})
+		}
+		switch len(agentIDs) {
+		case 0:
+			res.Conflicts = append(res.Conflicts, Conflict{Port: port.Port, Kind: ConflictUnused})
+		case 1:
+		default:
+			res.Conflicts = append(res.Conflicts, Conflict{Port: port.Port, Kind: ConflictShared, AgentIDs: agentIDs})
+		}
+	}
+
+	// remaining ports are not allocated
+	for port, agentIDs := range used {
+		res.Conflicts = append(res.Conflicts, Conflict{Port: port, Kind: ConflictUnallocated, AgentIDs: agentIDs})
+		if len(agentIDs) > 1 {
+			res.Conflicts = append(res.Conflicts, Conflict{Port: port, Kind: ConflictShared, AgentIDs: agentIDs})
+		}
+	}
+	sort.SliceStable(res.Conflicts, func(i, j int) bool {
+		if res.Conflicts[i].Port != res.Conflicts[j].Port {
+			return res.Conflicts[i].Port < res.Conflicts[j].Port
+		}
+		return res.Conflicts[i].Kind < res.Conflicts[j].Kind
+	})
+	return res, nil
 }
@@ -17,55 +17,144 @@
 package ports
 
 import (
+	"database/sql"
 	"net"
 	"testing"
+	"time"
 
+	"github.com/AlekSi/pointer"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/reform.v1"
+
+	"github.com/percona/pmm-managed/models"
+	"github.com/percona/pmm-managed/utils/tests"
 )
 
+func setup(t *testing.T) *reform.DB {
+	sqlDB := tests.OpenTestDB(t)
+	return reform.NewDB(sqlDB, tests.TestDBDialect(t), reform.NewPrintfLogger(t.Logf))
+}
+
+func reserve(t *testing.T, db *reform.DB, r *Registry) (uint16, error) {
+	var port uint16
+	err := db.InTransaction(func(tx *reform.TX) error {
+		var e error
+		port, e = r.Reserve(tx)
+		return e
+	})
+	return port, err
+}
+
 func TestRegistry(t *testing.T) {
-	// 10000 is marked as reserved, 10001 is busy, 10002 is free
-	r := NewRegistry(10000, 10002, []uint16{10000})
+	db := setup(t)
+	defer db.DBInterface().(*sql.DB).Close() //nolint:errcheck
+
+	// 10000 is allocated, 10001 is busy, 10002 is free
+	r := NewRegistry(10000, 10002)
+	require.NoError(t, db.Insert(&models.Port{Port: 10000, CreatedAt: time.Now().UTC().Truncate(time.Second)}))
 	l1, err := net.Listen("tcp", "127.0.0.1:10001")
 	require.NoError(t, err)
 	defer l1.Close()
 
-	p, err := r.Reserve()
+	p, err := reserve(t, db, r)
 	assert.NoError(t, err)
 	assert.EqualValues(t, 10002, p)
-	_, err = r.Reserve()
+	_, err = reserve(t, db, r)
 	assert.Equal(t, errNoFreePort, err)
 
-	l2, err := net.Listen("tcp", "127.0.0.1:10002")
-	require.NoError(t, err)
-	defer l2.Close()
+	t.Run("Rollback", func(t *testing.T) {
+		require.NoError(t, r.Release(db.Querier, 10002))
 
-	err = r.Release(10000)
-	assert.NoError(t, err)
-	err = r.Release(10001)
-	assert.Equal(t, errNotReservedPort, err)
-	err = r.Release(10002)
-	assert.Equal(t, errPortBusy, err)
+		rollback := errors.New("rollback")
+		err = db.InTransaction(func(tx *reform.TX) error {
+			p, err = r.Reserve(tx)
+			require.NoError(t, err)
+			assert.EqualValues(t, 10002, p)
+			return rollback
+		})
+		assert.Equal(t, rollback, err)
 
-	l1.Close()
-	l2.Close()
+		p, err = reserve(t, db, r)
+		assert.NoError(t, err)
+		assert.EqualValues(t, 10002, p)
+	})
 
-	p, err = r.Reserve()
-	assert.NoError(t, err)
-	assert.EqualValues(t, 10000, p)
-	p, err = r.Reserve()
-	assert.NoError(t, err)
-	assert.EqualValues(t, 10001, p)
-	_, err = r.Reserve()
-	assert.Equal(t, errNoFreePort, err)
+	t.Run("Release", func(t *testing.T) {
+		require.NoError(t, r.Release(db.Querier, 10000))
+		require.NoError(t, r.Release(db.Querier, 10000), "releasing not allocated port is not an error")
+		l1.Close()
 
-	err = r.Release(10002)
-	assert.NoError(t, err)
+		p, err = reserve(t, db, r)
+		assert.NoError(t, err)
+		assert.EqualValues(t, 10000, p)
+		p, err = reserve(t, db, r)
+		assert.NoError(t, err)
+		assert.EqualValues(t, 10001, p)
+		_, err = reserve(t, db, r)
+		assert.Equal(t, errNoFreePort, err)
+	})
 
-	p, err = r.Reserve()
-	assert.NoError(t, err)
-	assert.EqualValues(t, 10002, p)
-	_, err = r.Reserve()
-	assert.Equal(t, errNoFreePort, err)
+	t.Run("Concurrent", func(t *testing.T) {
+		// port allocated by concurrent transaction does not abort the current one
+		err = db.InTransaction(func(tx *reform.TX) error {
+			ok, e := insert(tx, 10000)
+			require.NoError(t, e)
+			assert.False(t, ok)
+
+			ok, e = insert(tx, 10003)
+			require.NoError(t, e)
+			assert.True(t, ok)
+			return nil
+		})
+		require.NoError(t, err)
+		require.NoError(t, r.Release(db.Querier, 10003))
+	})
+}
+
+func TestDiagnose(t *testing.T) {
+	db := setup(t)
+	defer db.DBInterface().(*sql.DB).Close() //nolint:errcheck
+
+	r := NewRegistry(10000, 10002)
+	now := time.Now().UTC().Truncate(time.Second)
+	for _, port := range []uint16{10000, 10002, 20000} {
+		require.NoError(t, db.Insert(&models.Port{Port: port, CreatedAt: now}))
+	}
+	var ids []int32
+	for _, port := range []uint16{10000, 10001, 20000, 10000} {
+		agent := &models.Agent{
+			Type:         models.MySQLdExporterAgentType,
+			RunsOnNodeID: 1,
+			ListenPort:   pointer.ToUint16(port),
+		}
+		require.NoError(t, db.Insert(agent))
+		ids = append(ids, agent.ID)
+	}
+	// fixed port is ignored
+	require.NoError(t, db.Insert(&models.Agent{Type: models.QanAgentAgentType, RunsOnNodeID: 1, ListenPort: pointer.ToUint16(10001)}))
+
+	d, err := r.Diagnose(db.Querier)
+	require.NoError(t, err)
+	for i := range d.Allocations {
+		assert.WithinDuration(t, now, d.Allocations[i].CreatedAt, 0)
+		d.Allocations[i].CreatedAt = time.Time{}
+	}
+	expected := &Diagnostics{
+		Min: 10000,
+		Max: 10002,
+		Allocations: []Allocation{
+			{Port: 10000, AgentIDs: []int32{ids[0], ids[3]}},
+			{Port: 10002},
+			{Port: 20000, AgentIDs: []int32{ids[2]}},
+		},
+		Conflicts: []Conflict{
+			{Port: 10000, Kind: ConflictShared, AgentIDs: []int32{ids[0], ids[3]}},
+			{Port: 10001, Kind: ConflictUnallocated, AgentIDs: []int32{ids[1]}},
+			{Port: 10002, Kind: ConflictUnused},
+			{Port: 20000, Kind: ConflictOutOfRange, AgentIDs: []int32{ids[2]}},
+		},
+	}
+	assert.Equal(t, expected, d)
 }
@@ -0,0 +1,320 @@
+// pmm-managed
+// Copyright (C) 2017 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+// Package secrets implements envelope encryption of secrets stored in the database and Consul.
+//
+// Every value is encrypted with a new random data key (AES-256-GCM), and that data key is encrypted
+// with the master key. Encrypted values are self-describing strings:
+//
+//	enc:v1:<master key ID>:<base64(encrypted data key || nonce || ciphertext)>
+//
+// Values without that prefix are treated as not yet encrypted plain text. Plain text values starting with
+// "enc:" are stored with "enc:plain:" prefix, so they can't be confused with encrypted ones. Values stored before
+// encryption was introduced are not escaped, so malformed encrypted values are treated as plain text, too.
+package secrets
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	prefix      = "enc:v1:"
+	plainPrefix = "enc:plain:"
+	escapedMark = "enc:" // plain text values starting with it are escaped
+
+	// AES-GCM standard nonce and tag sizes; used only to detect malformed values
+	gcmNonceSize = 12
+	gcmTagSize   = 16
+
+	// KeySize is a size of master key in bytes.
+	KeySize = 32
+
+	// size of master key ID (truncated SHA-256 of the key) in bytes
+	keyIDSize = 4
+
+	// EnvKey is a name of environment variable with base64-encoded master key.
+	EnvKey = "PMM_MANAGED_ENCRYPTION_KEY"
+)
+
+// key is a master key with its ID.
+type key struct {
+	id   string
+	aead cipher.AEAD
+}
+
+func newKey(b []byte) (*key, error) {
+	if len(b) != KeySize {
+		return nil, errors.Errorf("master key should be %d bytes, got %d", KeySize, len(b))
+	}
+	aead, err := newAEAD(b)
+	if err != nil {
+		return nil, err
+	}
+	sum := sha256.Sum256(b)
+	return &key{
+		id:   hex.EncodeToString(sum[:keyIDSize]),
+		aead: aead,
+	}, nil
+}
+
+func newAEAD(b []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(b)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	aead, err := cipher.NewGCM(block)
+	return aead, errors.WithStack(err)
+}
+
+// Keyring encrypts values with the primary master key, and decrypts values encrypted with any known master key.
+// Nil Keyring is valid: it does not encrypt values and fails to decrypt encrypted ones.
+type Keyring struct {
+	primary *key
+	keys    map[string]*key
+}
+
+// NewKeyring creates a new Keyring. The first key is primary, others are used only for decryption (key rotation).
+func NewKeyring(primary []byte, old ...[]byte) (*Keyring, error) {
+	p, err := newKey(primary)
+	if err != nil {
+		return nil, err
+	}
+	k := &Keyring{
+		primary: p,
+		keys:    map[string]*key{p.id: p},
+	}
+	for _, b := range old {
+		o, err := newKey(b)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := k.keys[o.id]; !ok {
+			k.keys[o.id] = o
+		}
+	}
+	return k, nil
+}
+
+// ParseKey decodes base64-encoded master key.
+func ParseKey(s string) ([]byte, error) {
+	b, err := base64.StdEncoding.DecodeString(strings.TrimSpace(s))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode master key")
+	}
+	if len(b) != KeySize {
+		return nil, errors.Errorf("master key should be %d bytes, got %d", KeySize, len(b))
+	}
+	return b, nil
+}
+
+// LoadKey reads base64-encoded master key from a given file, or, if file name is empty,
+// from environment variable EnvKey. It returns nil key without error if neither is set.
+func LoadKey(file string) ([]byte, error) {
+	if file != "" {
+		b, err := ioutil.ReadFile(file) //nolint:gosec
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		return ParseKey(string(b))
+	}
+
+	if s := os.Getenv(EnvKey); s != "" {
+		return ParseKey(s)
+	}
+	return nil, nil
+}
+
+// GenerateKey returns a new random base64-encoded master key.
+func GenerateKey() (string, error) {
+	b := make([]byte, KeySize)
+	if _, err := io.ReadFull(rand.Reader, b); err != nil {
+		return "", errors.WithStack(err)
+	}
+	return base64.StdEncoding.EncodeToString(b), nil
+}
+
+// KeyID returns primary master key ID, or empty string for nil Keyring.
+func (k *Keyring) KeyID() string {
+	if k == nil {
+		return ""
+	}
+	return k.primary.id
+}
+
+// IsEncrypted returns true if value is encrypted (with any master key).
+func IsEncrypted(s string) bool {
+	_, _, ok := parse(s)
+	return ok
+}
+
+// parse returns master key ID and decoded data of encrypted value.
+// It returns false for plain text values, including malformed values with encrypted value prefix.
+func parse(s string) (string, []byte, bool) {
+	if !strings.HasPrefix(s, prefix) {
+		return "", nil, false
+	}
+	parts := strings.SplitN(strings.TrimPrefix(s, prefix), ":", 2)
+	if len(parts) != 2 || len(parts[0]) != hex.EncodedLen(keyIDSize) {
+		return "", nil, false
+	}
+	if _, err := hex.DecodeString(parts[0]); err != nil {
+		return "", nil, false
+	}
+	b, err := base64.StdEncoding.DecodeString(parts[1])
+	if err != nil || len(b) < 2*(gcmNonceSize+gcmTagSize)+KeySize {
+		return "", nil, false
+	}
+	return parts[0], b, true
+}
+
+// escape returns plain text value that can't be confused with encrypted one.
+func escape(plaintext string) string {
+	if strings.HasPrefix(plaintext, escapedMark) {
+		return plainPrefix + plaintext
+	}
+	return plaintext
+}
+
+// NeedsEncryption returns true if value is not encrypted with the primary master key:
+// it is either plain text, or encrypted with an old master key.
+// It always returns false for nil Keyring.
+func (k *Keyring) NeedsEncryption(s string) bool {
+	if k == nil {
+		return false
+	}
+	id, _, ok := parse(s)
+	return !ok || id != k.primary.id
+}
+
+// Encrypt encrypts plain text value with the primary master key.
+// Nil Keyring returns value as is, escaping it if needed.
+func (k *Keyring) Encrypt(plaintext string) (string, error) {
+	if k == nil {
+		return escape(plaintext), nil
+	}
+
+	dataKey := make([]byte, KeySize)
+	if _, err := io.ReadFull(rand.Reader, dataKey); err != nil {
+		return "", errors.WithStack(err)
+	}
+	dataAEAD, err := newAEAD(dataKey)
+	if err != nil {
+		return "", err
+	}
+
+	header := prefix + k.primary.id + ":"
+	ad := []byte(header)
+
+	// nonce || encrypted data key
+	keyNonce := make([]byte, k.primary.aead.NonceSize())
+	if _, err = io.ReadFull(rand.Reader, keyNonce); err != nil {
+		return "", errors.WithStack(err)
+	}
+	b := k.primary.aead.Seal(keyNonce, keyNonce, dataKey, ad)
+
+	// nonce || ciphertext
+	dataNonce := make([]byte, dataAEAD.NonceSize())
+	if _, err = io.ReadFull(rand.Reader, dataNonce); err != nil {
+		return "", errors.WithStack(err)
+	}
+	b = append(b, dataNonce...)
+	b = dataAEAD.Seal(b, dataNonce, []byte(plaintext), ad)
+
+	return header + base64.StdEncoding.EncodeToString(b), nil
+}
+
+// Decrypt decrypts value encrypted with any known master key.
+// Plain text values are returned as is (unescaped).
+func (k *Keyring) Decrypt(s string) (string, error) {
+	if strings.HasPrefix(s, plainPrefix) {
+		return strings.TrimPrefix(s, plainPrefix), nil
+	}
+	id, b, ok := parse(s)
+	if !ok {
+		return s, nil
+	}
+
+	if k == nil {
+		return "", errors.Errorf("value is encrypted with master key %s, but encryption is not configured", id)
+	}
+	mk := k.keys[id]
+	if mk == nil {
+		return "", errors.Errorf("value is encrypted with unknown master key %s", id)
+	}
+	ad := []byte(prefix + mk.id + ":")
+
+	keyNonceSize := mk.aead.NonceSize()
+	keySize := keyNonceSize + KeySize + mk.aead.Overhead()
+	if len(b) < keySize {
+		return "", errors.New("encrypted value is too short")
+	}
+	dataKey, err := mk.aead.Open(nil, b[:keyNonceSize], b[keyNonceSize:keySize], ad)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to decrypt data key")
+	}
+	dataAEAD, err := newAEAD(dataKey)
+	if err != nil {
+		return "", err
+	}
+
+	b = b[keySize:]
+	dataNonceSize := dataAEAD.NonceSize()
+	if len(b) < dataNonceSize+dataAEAD.Overhead() {
+		return "", errors.New("encrypted value is too short")
+	}
+	plaintext, err := dataAEAD.Open(nil, b[:dataNonceSize], b[dataNonceSize:], ad)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to decrypt value")
+	}
+	return string(plaintext), nil
+}
+
+// Reencrypt decrypts value and encrypts it again with the primary master key if needed.
+// It returns value and true if it was changed.
+func (k *Keyring) Reencrypt(s string) (string, bool, error) {
+	if !k.NeedsEncryption(s) {
+		return s, false, nil
+	}
+	plaintext, err := k.Decrypt(s)
+	if err != nil {
+		return "", false, err
+	}
+	res, err := k.Encrypt(plaintext)
+	if err != nil {
+		return "", false, err
+	}
+	return res, true, nil
+}
+
+// String returns a short description of Keyring for logging.
+func (k *Keyring) String() string {
+	if k == nil {
+		return "encryption disabled"
+	}
+	return fmt.Sprintf("primary master key %s, %d old key(s)", k.primary.id, len(k.keys)-1)
+}
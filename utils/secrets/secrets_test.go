@@ -0,0 +1,209 @@
+// pmm-managed
+// Copyright (C) 2017 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package secrets
+
+import (
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func generate(t *testing.T) []byte {
+	s, err := GenerateKey()
+	require.NoError(t, err)
+	b, err := ParseKey(s)
+	require.NoError(t, err)
+	return b
+}
+
+func TestKeyring(t *testing.T) {
+	key1 := generate(t)
+	key2 := generate(t)
+
+	t.Run("EncryptDecrypt", func(t *testing.T) {
+		k, err := NewKeyring(key1)
+		require.NoError(t, err)
+
+		for _, plaintext := range []string{"", "password", strings.Repeat("x", 255)} {
+			s, err := k.Encrypt(plaintext)
+			require.NoError(t, err)
+			assert.True(t, IsEncrypted(s))
+			assert.False(t, k.NeedsEncryption(s))
+			if plaintext != "" {
+				assert.NotContains(t, s, plaintext)
+			}
+
+			actual, err := k.Decrypt(s)
+			require.NoError(t, err)
+			assert.Equal(t, plaintext, actual)
+		}
+
+		// random data keys and nonces
+		s1, err := k.Encrypt("password")
+		require.NoError(t, err)
+		s2, err := k.Encrypt("password")
+		require.NoError(t, err)
+		assert.NotEqual(t, s1, s2)
+	})
+
+	t.Run("PlainText", func(t *testing.T) {
+		k, err := NewKeyring(key1)
+		require.NoError(t, err)
+
+		assert.True(t, k.NeedsEncryption("password"))
+		actual, err := k.Decrypt("password")
+		require.NoError(t, err)
+		assert.Equal(t, "password", actual)
+
+		s, changed, err := k.Reencrypt("password")
+		require.NoError(t, err)
+		assert.True(t, changed)
+		assert.True(t, IsEncrypted(s))
+	})
+
+	t.Run("PlainTextWithPrefix", func(t *testing.T) {
+		k, err := NewKeyring(key1)
+		require.NoError(t, err)
+
+		// values stored before encryption was enabled are not escaped
+		for _, plaintext := range []string{"enc:v1:", "enc:v1:password", "enc:v1:" + k.KeyID() + ":cGFzc3dvcmQ="} {
+			assert.False(t, IsEncrypted(plaintext), "%q", plaintext)
+			assert.True(t, k.NeedsEncryption(plaintext), "%q", plaintext)
+			actual, err := k.Decrypt(plaintext)
+			require.NoError(t, err)
+			assert.Equal(t, plaintext, actual)
+
+			s, changed, err := k.Reencrypt(plaintext)
+			require.NoError(t, err)
+			assert.True(t, changed)
+			actual, err = k.Decrypt(s)
+			require.NoError(t, err)
+			assert.Equal(t, plaintext, actual)
+		}
+
+		// values stored with encryption disabled are escaped
+		var nilKeyring *Keyring
+		for _, plaintext := range []string{"enc:v1:password", "enc:plain:password", "enc:"} {
+			s, err := nilKeyring.Encrypt(plaintext)
+			require.NoError(t, err)
+			assert.Equal(t, "enc:plain:"+plaintext, s)
+
+			actual, err := nilKeyring.Decrypt(s)
+			require.NoError(t, err)
+			assert.Equal(t, plaintext, actual)
+
+			s, _, err = k.Reencrypt(s)
+			require.NoError(t, err)
+			assert.True(t, IsEncrypted(s))
+			actual, err = k.Decrypt(s)
+			require.NoError(t, err)
+			assert.Equal(t, plaintext, actual)
+		}
+	})
+
+	t.Run("Rotation", func(t *testing.T) {
+		old, err := NewKeyring(key1)
+		require.NoError(t, err)
+		s, err := old.Encrypt("password")
+		require.NoError(t, err)
+
+		k, err := NewKeyring(key2)
+		require.NoError(t, err)
+		_, err = k.Decrypt(s)
+		assert.EqualError(t, err, "value is encrypted with unknown master key "+old.KeyID())
+
+		k, err = NewKeyring(key2, key1)
+		require.NoError(t, err)
+		assert.True(t, k.NeedsEncryption(s))
+		s, changed, err := k.Reencrypt(s)
+		require.NoError(t, err)
+		assert.True(t, changed)
+		assert.True(t, strings.HasPrefix(s, "enc:v1:"+k.KeyID()+":"), "%s", s)
+
+		_, changed, err = k.Reencrypt(s)
+		require.NoError(t, err)
+		assert.False(t, changed)
+
+		k, err = NewKeyring(key2)
+		require.NoError(t, err)
+		actual, err := k.Decrypt(s)
+		require.NoError(t, err)
+		assert.Equal(t, "password", actual)
+	})
+
+	t.Run("Tampered", func(t *testing.T) {
+		k, err := NewKeyring(key1)
+		require.NoError(t, err)
+		s, err := k.Encrypt("password")
+		require.NoError(t, err)
+
+		b := []byte(s)
+		i := len(b) - 3
+		if b[i] == 'A' {
+			b[i] = 'B'
+		} else {
+			b[i] = 'A'
+		}
+		_, err = k.Decrypt(string(b))
+		assert.EqualError(t, err, "failed to decrypt value: cipher: message authentication failed")
+	})
+
+	t.Run("Nil", func(t *testing.T) {
+		var k *Keyring
+		s, err := k.Encrypt("password")
+		require.NoError(t, err)
+		assert.Equal(t, "password", s)
+		assert.False(t, k.NeedsEncryption(s))
+
+		k1, err := NewKeyring(key1)
+		require.NoError(t, err)
+		s, err = k1.Encrypt("password")
+		require.NoError(t, err)
+		_, err = k.Decrypt(s)
+		assert.EqualError(t, err, "value is encrypted with master key "+k1.KeyID()+", but encryption is not configured")
+	})
+}
+
+func TestLoadKey(t *testing.T) {
+	s, err := GenerateKey()
+	require.NoError(t, err)
+
+	f, err := ioutil.TempFile("", "pmm-managed-key-")
+	require.NoError(t, err)
+	defer os.Remove(f.Name()) //nolint:errcheck
+	_, err = f.WriteString(s + "\n")
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	b, err := LoadKey(f.Name())
+	require.NoError(t, err)
+	assert.Len(t, b, KeySize)
+
+	os.Unsetenv(EnvKey) //nolint:errcheck
+	b, err = LoadKey("")
+	require.NoError(t, err)
+	assert.Nil(t, b)
+
+	os.Setenv(EnvKey, "c2hvcnQ=") //nolint:errcheck
+	defer os.Unsetenv(EnvKey)     //nolint:errcheck
+	_, err = LoadKey("")
+	assert.EqualError(t, err, "master key should be 32 bytes, got 5")
+}
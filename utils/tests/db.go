@@ -17,29 +17,116 @@
 package tests
 
 import (
+	"context"
 	"database/sql"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"gopkg.in/reform.v1"
 
 	"github.com/percona/pmm-managed/models"
 )
 
+// testDBConfigs contains connection parameters for test databases (see docker-compose.yml).
+var testDBConfigs = map[string]models.DBConfig{
+	models.MySQLDriver: {
+		Driver:       models.MySQLDriver,
+		Username:     "pmm-managed",
+		Password:     "pmm-managed",
+		MaxOpenConns: 10,
+		MaxIdleConns: 10,
+	},
+	models.PostgreSQLDriver: {
+		Driver:       models.PostgreSQLDriver,
+		Username:     "username",
+		Password:     "password",
+		MaxOpenConns: 10,
+		MaxIdleConns: 10,
+	},
+}
+
+// EnvTestDBDriver is an environment variable with test database driver for OpenTestDB.
+const EnvTestDBDriver = "PMM_MANAGED_TEST_DB_DRIVER"
+
+// TestDBDriver returns test database driver for OpenTestDB:
+// the value of EnvTestDBDriver environment variable, or embedded SQLite if it is not set.
+func TestDBDriver() string {
+	if driver := os.Getenv(EnvTestDBDriver); driver != "" {
+		return driver
+	}
+	return models.SQLite3Driver
+}
+
+// TestDBDialect returns reform dialect for test database returned by OpenTestDB.
+func TestDBDialect(t testing.TB) reform.Dialect {
+	t.Helper()
+
+	dialect, err := models.ReformDialect(TestDBDriver())
+	require.NoError(t, err)
+	return dialect
+}
+
+// OpenTestDB recreates test database (see TestDBDriver), applies migrations, and returns it.
 func OpenTestDB(t testing.TB) *sql.DB {
 	t.Helper()
 
-	db, err := models.OpenDB("", "pmm-managed", "pmm-managed", t.Logf)
+	return OpenTestDBForDriver(t, TestDBDriver())
+}
+
+// OpenTestDBForDriver recreates test database for given driver, applies migrations, and returns it.
+func OpenTestDBForDriver(t testing.TB, driver string) *sql.DB {
+	t.Helper()
+
+	ctx := context.Background()
+	if driver == models.SQLite3Driver {
+		return openTestSQLite3DB(ctx, t)
+	}
+
+	config := testDBConfigs[driver]
+	dialect, err := models.ReformDialect(driver)
+	require.NoError(t, err)
+	db, err := models.OpenDB(ctx, &config, t.Logf)
 	require.NoError(t, err)
 
 	const testDatabase = "pmm-managed-dev"
-	_, err = db.Exec("DROP DATABASE `" + testDatabase + "`")
+	_, err = db.Exec("DROP DATABASE IF EXISTS " + dialect.QuoteIdentifier(testDatabase))
 	require.NoError(t, err)
-	_, err = db.Exec("CREATE DATABASE `" + testDatabase + "`")
+	_, err = db.Exec("CREATE DATABASE " + dialect.QuoteIdentifier(testDatabase))
 	require.NoError(t, err)
 
 	db.Close()
 
-	db, err = models.OpenDB(testDatabase, "pmm-managed", "pmm-managed", t.Logf)
+	config.Name = testDatabase
+	db, err = models.OpenDB(ctx, &config, t.Logf)
+	require.NoError(t, err)
+	err = models.MigrateUp(ctx, db, t.Logf)
+	require.NoError(t, err)
+	return db
+}
+
+// openTestSQLite3DB creates SQLite test database in a new temporary directory, applies migrations, and returns it.
+// Each test gets its own database, so tests can run in parallel.
+func openTestSQLite3DB(ctx context.Context, t testing.TB) *sql.DB {
+	t.Helper()
+
+	dir, err := ioutil.TempDir("", "pmm-managed-test-db-")
+	require.NoError(t, err)
+	// remove directory after the test if supported by Go version
+	if c, ok := t.(interface{ Cleanup(func()) }); ok {
+		c.Cleanup(func() { os.RemoveAll(dir) }) //nolint:errcheck
+	}
+
+	db, err := models.OpenDB(ctx, &models.DBConfig{
+		Driver:       models.SQLite3Driver,
+		Name:         filepath.Join(dir, "pmm-managed.sqlite"),
+		MaxOpenConns: 10,
+		MaxIdleConns: 10,
+	}, t.Logf)
+	require.NoError(t, err)
+	err = models.MigrateUp(ctx, db, t.Logf)
 	require.NoError(t, err)
 	return db
 }
@@ -0,0 +1,126 @@
+// pmm-managed
+// Copyright (C) 2017 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+// Package tlsconfig provides TLS configuration with certificates reloading.
+package tlsconfig
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"io/ioutil"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// nextProtos are used for ALPN: HTTP/2 is required for gRPC.
+var nextProtos = []string{"h2", "http/1.1"}
+
+// Reloader holds server certificate and optional client CA certificates for mutual TLS,
+// and reloads them from files on request (typically on SIGHUP).
+type Reloader struct {
+	certFile     string
+	keyFile      string
+	clientCAFile string
+
+	rw        sync.RWMutex
+	cert      *tls.Certificate
+	clientCAs *x509.CertPool
+}
+
+// NewReloader creates a new Reloader and loads certificates from given files.
+// If clientCAFile is not empty, clients are required to present certificates signed by those CAs.
+func NewReloader(certFile, keyFile, clientCAFile string) (*Reloader, error) {
+	if certFile == "" || keyFile == "" {
+		return nil, errors.New("both certificate and key files should be given")
+	}
+
+	r := &Reloader{
+		certFile:     certFile,
+		keyFile:      keyFile,
+		clientCAFile: clientCAFile,
+	}
+	if err := r.Reload(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// Reload loads certificates from files. On error, previously loaded certificates are kept.
+func (r *Reloader) Reload() error {
+	cert, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+	if err != nil {
+		return errors.Wrap(err, "failed to load certificate")
+	}
+
+	var clientCAs *x509.CertPool
+	if r.clientCAFile != "" {
+		b, err := ioutil.ReadFile(r.clientCAFile)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		clientCAs = x509.NewCertPool()
+		if !clientCAs.AppendCertsFromPEM(b) {
+			return errors.Errorf("no certificates found in %s", r.clientCAFile)
+		}
+	}
+
+	r.rw.Lock()
+	r.cert = &cert
+	r.clientCAs = clientCAs
+	r.rw.Unlock()
+	return nil
+}
+
+// MutualTLS returns true if client certificates are required.
+func (r *Reloader) MutualTLS() bool {
+	r.rw.RLock()
+	defer r.rw.RUnlock()
+	return r.clientCAs != nil
+}
+
+// current returns TLS configuration for currently loaded certificates.
+func (r *Reloader) current() *tls.Config {
+	r.rw.RLock()
+	defer r.rw.RUnlock()
+
+	config := &tls.Config{
+		MinVersion:   tls.VersionTLS12,
+		NextProtos:   nextProtos,
+		Certificates: []tls.Certificate{*r.cert},
+	}
+	if r.clientCAs != nil {
+		config.ClientCAs = r.clientCAs
+		config.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+	return config
+}
+
+// Config returns TLS server configuration that always uses currently loaded certificates.
+func (r *Reloader) Config() *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		NextProtos: nextProtos,
+		GetCertificate: func(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+			r.rw.RLock()
+			defer r.rw.RUnlock()
+			return r.cert, nil
+		},
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			return r.current(), nil
+		},
+	}
+}
@@ -0,0 +1,180 @@
+// pmm-managed
+// Copyright (C) 2017 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package tlsconfig
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testCert struct {
+	cert    *x509.Certificate
+	key     *ecdsa.PrivateKey
+	certPEM []byte
+	keyPEM  []byte
+}
+
+// makeCert creates certificate with given serial number signed by parent (self-signed if nil).
+func makeCert(t *testing.T, serial int64, parent *testCert, isCA bool) *testCert {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(serial),
+		Subject:               pkix.Name{CommonName: "127.0.0.1"},
+		IPAddresses:           []net.IP{net.IPv4(127, 0, 0, 1)},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  isCA,
+	}
+	parentCert, parentKey := template, key
+	if parent != nil {
+		parentCert, parentKey = parent.cert, parent.key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parentCert, &key.PublicKey, parentKey)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+	return &testCert{
+		cert:    cert,
+		key:     key,
+		certPEM: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		keyPEM:  pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}),
+	}
+}
+
+func (c *testCert) write(t *testing.T, certFile, keyFile string) {
+	require.NoError(t, ioutil.WriteFile(certFile, c.certPEM, 0600))
+	require.NoError(t, ioutil.WriteFile(keyFile, c.keyPEM, 0600))
+}
+
+func (c *testCert) tlsCertificate(t *testing.T) tls.Certificate {
+	cert, err := tls.X509KeyPair(c.certPEM, c.keyPEM)
+	require.NoError(t, err)
+	return cert
+}
+
+// serve accepts TLS connections and completes handshakes until listener is closed.
+func serve(l net.Listener) {
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		go func() {
+			conn.(*tls.Conn).Handshake() //nolint:errcheck
+			conn.Close()                 //nolint:errcheck
+		}()
+	}
+}
+
+func TestReloader(t *testing.T) {
+	dir, err := ioutil.TempDir("", "pmm-managed-tlsconfig-")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir) //nolint:errcheck
+	certFile, keyFile, caFile := filepath.Join(dir, "cert.pem"), filepath.Join(dir, "key.pem"), filepath.Join(dir, "ca.pem")
+
+	ca := makeCert(t, 1, nil, true)
+	roots := x509.NewCertPool()
+	roots.AddCert(ca.cert)
+	makeCert(t, 2, ca, false).write(t, certFile, keyFile)
+
+	_, err = NewReloader(certFile, "", "")
+	assert.EqualError(t, err, "both certificate and key files should be given")
+
+	r, err := NewReloader(certFile, keyFile, "")
+	require.NoError(t, err)
+	assert.False(t, r.MutualTLS())
+
+	l, err := tls.Listen("tcp", "127.0.0.1:0", r.Config())
+	require.NoError(t, err)
+	defer l.Close() //nolint:errcheck
+	go serve(l)
+
+	dial := func(certs ...tls.Certificate) (*x509.Certificate, error) {
+		conn, err := tls.Dial("tcp", l.Addr().String(), &tls.Config{RootCAs: roots, Certificates: certs})
+		if err != nil {
+			return nil, err
+		}
+		defer conn.Close() //nolint:errcheck
+		// client certificate may be verified by server after client's handshake is complete,
+		// so read until server closes connection to get an error
+		conn.SetReadDeadline(time.Now().Add(5 * time.Second)) //nolint:errcheck
+		if _, err = conn.Read(make([]byte, 1)); err != io.EOF {
+			return nil, err
+		}
+		return conn.ConnectionState().PeerCertificates[0], nil
+	}
+
+	t.Run("Reload", func(t *testing.T) {
+		cert, err := dial()
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), cert.SerialNumber.Int64())
+
+		makeCert(t, 3, ca, false).write(t, certFile, keyFile)
+		require.NoError(t, r.Reload())
+		cert, err = dial()
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), cert.SerialNumber.Int64())
+
+		// broken files do not affect served certificate
+		require.NoError(t, ioutil.WriteFile(keyFile, []byte("garbage"), 0600))
+		assert.Error(t, r.Reload())
+		cert, err = dial()
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), cert.SerialNumber.Int64())
+	})
+
+	t.Run("MutualTLS", func(t *testing.T) {
+		makeCert(t, 4, ca, false).write(t, certFile, keyFile)
+		require.NoError(t, ioutil.WriteFile(caFile, ca.certPEM, 0600))
+		r.clientCAFile = caFile
+		require.NoError(t, r.Reload())
+		assert.True(t, r.MutualTLS())
+
+		_, err := dial()
+		assert.Error(t, err, "client certificate is required")
+
+		otherCA := makeCert(t, 5, nil, true)
+		_, err = dial(makeCert(t, 6, otherCA, false).tlsCertificate(t))
+		assert.Error(t, err, "client certificate signed by unknown CA")
+
+		cert, err := dial(makeCert(t, 7, ca, false).tlsCertificate(t))
+		require.NoError(t, err)
+		assert.Equal(t, int64(4), cert.SerialNumber.Int64())
+	})
+}